@@ -0,0 +1,269 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Weight",type=integer,JSONPath=`.status.canaryWeight`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// Canary progressively shifts traffic of an HTTPRoute rule from its stable backend to a canary backend.
+// NGINX Gateway Fabric records the weight of the canary backend in the status of the Canary and applies it
+// instead of the weights of the backendRefs of the rule. The HTTPRoute itself is not modified.
+type Canary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the Canary.
+	Spec CanarySpec `json:"spec"`
+
+	// Status defines the state of the Canary.
+	Status CanaryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CanaryList contains a list of Canaries.
+type CanaryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Canary `json:"items"`
+}
+
+// CanarySpec defines the desired state of the Canary.
+type CanarySpec struct {
+	// Match routes the requests that match a header or a cookie to the canary backend,
+	// regardless of the current weight.
+	//
+	// +optional
+	Match *CanaryMatch `json:"match,omitempty"`
+
+	// Analysis gates the progress of the Canary on the error rate of the canary backend.
+	// When the error rate exceeds the threshold, the Canary is rolled back.
+	// Analysis requires NGINX Plus.
+	//
+	// +optional
+	Analysis *CanaryAnalysis `json:"analysis,omitempty"`
+
+	// Interval is the default duration of every step.
+	// Default: 1m.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// TargetRef identifies the HTTPRoute rule that the Canary applies to.
+	TargetRef CanaryTargetRef `json:"targetRef"`
+
+	// CanaryBackend is the name of the Service in the same namespace that receives the canary traffic.
+	// The rule must have exactly two backendRefs: the canary backend and the stable backend.
+	CanaryBackend v1.ObjectName `json:"canaryBackend"`
+
+	// Steps is the list of weights that the canary backend gets in order.
+	// The stable backend gets the remainder of 100.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	Steps []CanaryStep `json:"steps"`
+
+	// Paused stops the Canary from progressing. The current weights are kept.
+	//
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// CanaryTargetRef identifies an HTTPRoute rule in the same namespace as the Canary.
+type CanaryTargetRef struct {
+	// SectionName is the name of the rule of the HTTPRoute.
+	// If unset, the HTTPRoute must have exactly one rule.
+	//
+	// +optional
+	SectionName *v1.SectionName `json:"sectionName,omitempty"`
+
+	// Name is the name of the HTTPRoute.
+	Name v1.ObjectName `json:"name"`
+}
+
+// CanaryStep is a step of a Canary.
+type CanaryStep struct {
+	// Pause is the duration of the step. It overrides the interval of the Canary.
+	//
+	// +optional
+	Pause *Duration `json:"pause,omitempty"`
+
+	// Weight is the weight of the canary backend during the step.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+}
+
+// CanaryMatch matches a request by a header or a cookie.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of header or cookie must be set",rule="has(self.header) != has(self.cookie)"
+//
+//nolint:lll
+type CanaryMatch struct {
+	// Header is the name of the request header.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+$`
+	Header *string `json:"header,omitempty"`
+
+	// Cookie is the name of the cookie.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]+$`
+	Cookie *string `json:"cookie,omitempty"`
+
+	// Value is the exact value of the header or the cookie.
+	//
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9._~-]+$`
+	Value string `json:"value"`
+}
+
+// CanaryAnalysis defines the error rate threshold of the canary backend.
+// The error rate is the percentage of 5xx responses of the canary upstream during the current step.
+type CanaryAnalysis struct {
+	// MaxErrorRate is the maximum error rate in percent. When it is exceeded, the Canary is rolled back.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxErrorRate int32 `json:"maxErrorRate"`
+
+	// MinRequests is the minimum number of responses of the canary upstream during a step before
+	// the error rate is evaluated. The Canary doesn't progress to the next step until it is reached.
+	// A step is never evaluated without any responses, even if MinRequests is 0.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinRequests *int32 `json:"minRequests,omitempty"`
+
+	// MinRequestsTimeout is how long a step waits for MinRequests after its duration has passed.
+	// When it passes, the Canary is rolled back if the error rate of the responses so far exceeds
+	// MaxErrorRate. Otherwise, the Canary is held at the step with the Stalled condition until
+	// MinRequests is reached.
+	// Default: 10m.
+	//
+	// +optional
+	MinRequestsTimeout *Duration `json:"minRequestsTimeout,omitempty"`
+}
+
+// CanaryPhase is the phase of a Canary.
+//
+// +kubebuilder:validation:Enum=Progressing;Paused;Succeeded;RolledBack
+type CanaryPhase string
+
+const (
+	// CanaryPhaseProgressing means that the Canary is moving through its steps.
+	CanaryPhaseProgressing CanaryPhase = "Progressing"
+
+	// CanaryPhasePaused means that the Canary is paused.
+	CanaryPhasePaused CanaryPhase = "Paused"
+
+	// CanaryPhaseSucceeded means that the Canary completed its last step.
+	CanaryPhaseSucceeded CanaryPhase = "Succeeded"
+
+	// CanaryPhaseRolledBack means that the error rate of the canary backend exceeded the threshold
+	// and all traffic was shifted back to the stable backend.
+	CanaryPhaseRolledBack CanaryPhase = "RolledBack"
+)
+
+// CanaryStatus defines the state of the Canary.
+type CanaryStatus struct {
+	// StepStartTime is the time when the current step started.
+	//
+	// +optional
+	StepStartTime *metav1.Time `json:"stepStartTime,omitempty"`
+
+	// Phase is the phase of the Canary.
+	//
+	// +optional
+	Phase CanaryPhase `json:"phase,omitempty"`
+
+	// Conditions describe the status of the Canary.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the generation of the Canary that the status is for.
+	// When the spec of the Canary changes, the Canary starts again from the first step.
+	//
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// CurrentStep is the index of the current step.
+	//
+	// +optional
+	CurrentStep int32 `json:"currentStep,omitempty"`
+
+	// CanaryWeight is the weight of the canary backend. The stable backend gets the remainder of 100.
+	// These weights are applied instead of the weights of the backendRefs of the rule.
+	//
+	// +optional
+	CanaryWeight int32 `json:"canaryWeight,omitempty"`
+}
+
+// CanaryConditionType is a type of condition associated with Canary.
+type CanaryConditionType string
+
+// CanaryConditionReason is a reason for a Canary condition type.
+type CanaryConditionReason string
+
+const (
+	// CanaryConditionTypeAccepted indicates that the Canary is accepted.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * Accepted
+	//
+	// Possible reasons for this condition to be False:
+	//
+	// * Invalid
+	// * Conflicted.
+	CanaryConditionTypeAccepted CanaryConditionType = "Accepted"
+
+	// CanaryConditionReasonAccepted is used with the Accepted condition type when
+	// the condition is true.
+	CanaryConditionReasonAccepted CanaryConditionReason = "Accepted"
+
+	// CanaryConditionReasonInvalid is used with the Accepted condition type when
+	// the Canary is invalid.
+	CanaryConditionReasonInvalid CanaryConditionReason = "Invalid"
+
+	// CanaryConditionReasonConflicted is used with the Accepted condition type when
+	// another Canary targets the same HTTPRoute rule.
+	CanaryConditionReasonConflicted CanaryConditionReason = "Conflicted"
+
+	// CanaryConditionTypeRolledBack indicates that the Canary was rolled back.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * ErrorRateExceeded.
+	CanaryConditionTypeRolledBack CanaryConditionType = "RolledBack"
+
+	// CanaryConditionReasonErrorRateExceeded is used with the RolledBack condition type when
+	// the error rate of the canary backend exceeded the threshold of the analysis.
+	CanaryConditionReasonErrorRateExceeded CanaryConditionReason = "ErrorRateExceeded"
+
+	// CanaryConditionTypeStalled indicates that the Canary is held at its current step, because the
+	// canary backend didn't serve enough responses for the analysis within the MinRequestsTimeout.
+	//
+	// Possible reasons for this condition to be True:
+	//
+	// * InsufficientRequests.
+	CanaryConditionTypeStalled CanaryConditionType = "Stalled"
+
+	// CanaryConditionReasonInsufficientRequests is used with the Stalled condition type when
+	// the canary backend served fewer responses than the MinRequests of the analysis.
+	CanaryConditionReasonInsufficientRequests CanaryConditionReason = "InsufficientRequests"
+)
@@ -0,0 +1,140 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=comppolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// CompressionPolicy is an Inherited Attached Policy. It provides a way to configure the compression of responses
+// sent by NGINX Gateway Fabric to the client.
+type CompressionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CompressionPolicy.
+	Spec CompressionPolicySpec `json:"spec"`
+
+	// Status defines the state of the CompressionPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompressionPolicyList contains a list of CompressionPolicies.
+type CompressionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompressionPolicy `json:"items"`
+}
+
+// CompressionPolicySpec defines the desired state of CompressionPolicy.
+type CompressionPolicySpec struct {
+	// Gzip defines the gzip compression settings.
+	//
+	// +optional
+	Gzip *Gzip `json:"gzip,omitempty"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway or HTTPRoute",rule="(self.kind=='Gateway' || self.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="(self.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRef"`
+}
+
+// Gzip contains the settings for gzip compression of responses.
+type Gzip struct {
+	// Enable enables or disables gzip compression of responses.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip.
+	//
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// Types enables gzip compression of responses with the specified MIME types in addition to "text/html".
+	// The special value "*" matches any MIME type. Responses with the "text/html" type are always compressed.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_types.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	Types []MIMEType `json:"types,omitempty"`
+
+	// MinLength sets the minimum length of a response that will be compressed.
+	// The length is determined only from the Content-Length response header field.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_min_length.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Level sets the gzip compression level of a response. Acceptable values are in the range from 1 to 9.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_comp_level.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	Level *int32 `json:"level,omitempty"`
+
+	// Proxied enables or disables gzip compression for responses to proxied requests depending on the request
+	// and response. The fact that the request is proxied is determined by the presence of the Via request
+	// header field.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_proxied.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=9
+	Proxied []GzipProxiedCondition `json:"proxied,omitempty"`
+
+	// Vary enables or disables inserting the "Vary: Accept-Encoding" response header field.
+	// Default: https://nginx.org/en/docs/http/ngx_http_gzip_module.html#gzip_vary.
+	//
+	// +optional
+	Vary *bool `json:"vary,omitempty"`
+}
+
+// MIMEType is a MIME type of a response, for example "application/json". The special value "*" matches any
+// MIME type.
+//
+// +kubebuilder:validation:MaxLength=255
+// +kubebuilder:validation:Pattern=`^(\*|[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+*-]+)$`
+type MIMEType string
+
+// GzipProxiedCondition is a condition under which gzip compression is enabled for responses to proxied requests.
+//
+// +kubebuilder:validation:Enum=off;expired;no-cache;no-store;private;no_last_modified;no_etag;auth;any
+type GzipProxiedCondition string
+
+const (
+	// GzipProxiedOff disables compression for all proxied requests.
+	GzipProxiedOff GzipProxiedCondition = "off"
+	// GzipProxiedExpired enables compression if a response header includes the Expires field with a value that
+	// disables caching.
+	GzipProxiedExpired GzipProxiedCondition = "expired"
+	// GzipProxiedNoCache enables compression if a response header includes the Cache-Control field with the
+	// "no-cache" parameter.
+	GzipProxiedNoCache GzipProxiedCondition = "no-cache"
+	// GzipProxiedNoStore enables compression if a response header includes the Cache-Control field with the
+	// "no-store" parameter.
+	GzipProxiedNoStore GzipProxiedCondition = "no-store"
+	// GzipProxiedPrivate enables compression if a response header includes the Cache-Control field with the
+	// "private" parameter.
+	GzipProxiedPrivate GzipProxiedCondition = "private"
+	// GzipProxiedNoLastModified enables compression if a response header does not include the Last-Modified field.
+	GzipProxiedNoLastModified GzipProxiedCondition = "no_last_modified"
+	// GzipProxiedNoETag enables compression if a response header does not include the ETag field.
+	GzipProxiedNoETag GzipProxiedCondition = "no_etag"
+	// GzipProxiedAuth enables compression if a request header includes the Authorization field.
+	GzipProxiedAuth GzipProxiedCondition = "auth"
+	// GzipProxiedAny enables compression for all proxied requests.
+	GzipProxiedAny GzipProxiedCondition = "any"
+)
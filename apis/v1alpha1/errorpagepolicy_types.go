@@ -0,0 +1,165 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=eppolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ErrorPagePolicy is an Inherited Attached Policy. It provides a way to replace the error responses
+// returned by NGINX or the upstream applications with custom error pages.
+type ErrorPagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ErrorPagePolicy.
+	Spec ErrorPagePolicySpec `json:"spec"`
+
+	// Status defines the state of the ErrorPagePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ErrorPagePolicyList contains a list of ErrorPagePolicies.
+type ErrorPagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ErrorPagePolicy `json:"items"`
+}
+
+// ErrorPagePolicySpec defines the desired state of the ErrorPagePolicy.
+type ErrorPagePolicySpec struct {
+	// InterceptErrors determines whether responses from the upstream applications with the status codes
+	// listed in ErrorPages are replaced by the error pages. If false, only errors generated by NGINX are replaced.
+	// Default: true.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_intercept_errors
+	//
+	// +optional
+	InterceptErrors *bool `json:"interceptErrors,omitempty"`
+
+	// ErrorPages is the list of error pages. A status code can only be used by one error page.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_core_module.html#error_page
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ErrorPages []ErrorPage `json:"errorPages"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute.
+	//
+	// TargetRefs must be _distinct_. This means that the multi-part key defined by `kind` and `name` must
+	// be unique across all targetRef entries in the ErrorPagePolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// ErrorPage defines the error page for a set of status codes.
+// Exactly one of Response, Redirect, or BackendRef must be set.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of response, redirect, or backendRef must be set",rule="[has(self.response), has(self.redirect), has(self.backendRef)].filter(x, x).size() == 1"
+//
+//nolint:lll
+type ErrorPage struct {
+	// Response returns a static body stored in a ConfigMap.
+	//
+	// +optional
+	Response *ErrorPageResponse `json:"response,omitempty"`
+
+	// Redirect redirects the client to a URL.
+	//
+	// +optional
+	Redirect *ErrorPageRedirect `json:"redirect,omitempty"`
+
+	// BackendRef proxies the request to a Service that returns the error page. The status code and body
+	// of the Service response are returned to the client.
+	//
+	// +optional
+	BackendRef *ErrorPageBackendRef `json:"backendRef,omitempty"`
+
+	// Codes are the status codes that the error page is used for.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Codes []ErrorPageStatusCode `json:"codes"`
+}
+
+// ErrorPageStatusCode is an HTTP status code that an error page can be defined for.
+//
+// +kubebuilder:validation:Minimum=300
+// +kubebuilder:validation:Maximum=599
+type ErrorPageStatusCode int32
+
+// ErrorPageResponse defines a static error page response.
+type ErrorPageResponse struct {
+	// StatusCode overrides the status code of the response. If not set, the original status code is used.
+	//
+	// +optional
+	StatusCode *HTTPStatusCode `json:"statusCode,omitempty"`
+
+	// ContentType is the MIME type of the body.
+	// Default: text/html.
+	//
+	// +optional
+	ContentType *MIMEType `json:"contentType,omitempty"`
+
+	// ConfigMapRef references the ConfigMap key that holds the body of the response.
+	ConfigMapRef ErrorPageConfigMapReference `json:"configMapRef"`
+}
+
+// ErrorPageConfigMapReference references a key of a ConfigMap in the same namespace as the ErrorPagePolicy.
+type ErrorPageConfigMapReference struct {
+	// Name is the name of the ConfigMap.
+	Name gatewayv1.ObjectName `json:"name"`
+
+	// Key is the key in the ConfigMap data that holds the body.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	Key string `json:"key"`
+}
+
+// ErrorPageRedirect defines a redirect to a URL.
+type ErrorPageRedirect struct {
+	// StatusCode is the status code of the redirect response.
+	// Default: 302.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// URL is the absolute URL that the client is redirected to. It may contain NGINX variables.
+	//
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	URL string `json:"url"`
+}
+
+// ErrorPageBackendRef references a Service in the same namespace as the ErrorPagePolicy.
+type ErrorPageBackendRef struct {
+	// Name is the name of the Service.
+	Name gatewayv1.ObjectName `json:"name"`
+
+	// Port is the port of the Service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
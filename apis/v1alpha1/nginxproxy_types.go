@@ -1,6 +1,9 @@
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
 
 // +genclient
 // +kubebuilder:object:root=true
@@ -54,8 +57,209 @@ type NginxProxySpec struct {
 	//
 	// +optional
 	DisableHTTP2 bool `json:"disableHTTP2,omitempty"`
+	// HTTPSRedirect enables redirecting HTTP requests to HTTPS for all hostnames served on HTTPS listeners.
+	// The redirects are configured on the ports of the HTTP listeners of the Gateway. Hostnames that have
+	// Routes attached to an HTTP listener take precedence and are not redirected on that listener's port.
+	//
+	// +optional
+	HTTPSRedirect *HTTPSRedirect `json:"httpsRedirect,omitempty"`
+	// DefaultServer configures the response to requests that don't match the hostname of any listener
+	// of the Gateway. By default, NGINX returns a 404 response on HTTP listener ports and rejects the TLS
+	// handshake on HTTPS listener ports.
+	//
+	// +optional
+	DefaultServer *DefaultServer `json:"defaultServer,omitempty"`
+	// DNSResolver configures the DNS servers that NGINX uses to resolve hostnames at runtime.
+	// Routes can only reference ExternalName Services if a DNS resolver is configured,
+	// because NGINX resolves the external hostnames of the Services using these DNS servers.
+	//
+	// +optional
+	DNSResolver *DNSResolver `json:"dnsResolver,omitempty"`
+	// GRPC configures features that are specific to gRPC traffic.
+	//
+	// +optional
+	GRPC *GRPC `json:"grpc,omitempty"`
+}
+
+// GRPC defines the settings for gRPC traffic.
+type GRPC struct {
+	// EnableWeb enables gRPC-Web for all GRPCRoutes. Requests with the application/grpc-web or
+	// application/grpc-web+proto content type are translated to native gRPC requests, and the trailers of the
+	// responses are sent in the response body, as required by gRPC-Web. Native gRPC requests are not affected.
+	// The text format of gRPC-Web (application/grpc-web-text) is not supported.
+	//
+	// +optional
+	EnableWeb bool `json:"enableWeb,omitempty"`
+	// EnableHealthCheck enables a built-in handler for the grpc.health.v1.Health/Check method on all HTTP and
+	// HTTPS listeners, so that load balancers can probe the listeners with gRPC health checks. The handler
+	// reports the SERVING status for any service in the request. On HTTPS listener ports, requests that don't
+	// match the hostname of any listener are only handled if a DefaultServer action is configured, because
+	// the TLS handshake is rejected otherwise. Servers with a Route that matches the grpc.health.v1.Health
+	// service proxy the health checks to the backends of the Route instead.
+	//
+	// +optional
+	EnableHealthCheck bool `json:"enableHealthCheck,omitempty"`
+}
+
+// HTTPSRedirect defines the settings for redirecting HTTP requests to HTTPS.
+type HTTPSRedirect struct {
+	// StatusCode is the HTTP status code of the redirect response.
+	// Default is 301.
+	//
+	// +optional
+	// +kubebuilder:default:=301
+	// +kubebuilder:validation:Enum=301;308
+	StatusCode *int `json:"statusCode,omitempty"`
+}
+
+// DefaultServer defines the response to requests that don't match the hostname of any listener.
+type DefaultServer struct {
+	// Action is the action for unmatched requests on the ports of all listeners of the Gateway.
+	//
+	// +optional
+	Action *DefaultServerAction `json:"action,omitempty"`
+
+	// Listeners overrides the Action for the ports of specific listeners of the Gateway.
+	// Listeners that share a port also share the default server. If multiple listeners that share a port
+	// are configured, the action of the first of them in the Gateway's listener list is used.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []ListenerDefaultServer `json:"listeners,omitempty"`
+}
+
+// ListenerDefaultServer defines the default server action for a listener.
+type ListenerDefaultServer struct {
+	// Name is the name of the listener.
+	Name gatewayv1.SectionName `json:"name"`
+
+	// Action is the action for unmatched requests on the port of the listener.
+	Action DefaultServerAction `json:"action"`
+}
+
+// DefaultServerAction defines the action for unmatched requests.
+// Exactly one of Return, Redirect, or BackendRef must be set.
+//
+// On the port of an HTTPS listener, the TLS handshake is completed with the certificate of the listener
+// (or of the first HTTPS listener on that port) before the action is performed.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of return, redirect, or backendRef must be set",rule="[has(self.return), has(self.redirect), has(self.backendRef)].filter(x, x).size() == 1"
+//
+//nolint:lll
+type DefaultServerAction struct {
+	// Return returns a response with a status code and an optional body.
+	//
+	// +optional
+	Return *DefaultServerReturn `json:"return,omitempty"`
+
+	// Redirect redirects the client to a URL.
+	//
+	// +optional
+	Redirect *DefaultServerRedirect `json:"redirect,omitempty"`
+
+	// BackendRef proxies unmatched requests to a catch-all Service.
+	//
+	// +optional
+	BackendRef *DefaultServerBackendRef `json:"backendRef,omitempty"`
+}
+
+// DefaultServerReturn defines a fixed response.
+type DefaultServerReturn struct {
+	// Body is the body of the response.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	Body *string `json:"body,omitempty"`
+
+	// ContentType is the MIME type of the body.
+	// Default: text/html.
+	//
+	// +optional
+	ContentType *MIMEType `json:"contentType,omitempty"`
+
+	// StatusCode is the status code of the response.
+	StatusCode HTTPStatusCode `json:"statusCode"`
+}
+
+// DefaultServerRedirect defines a redirect to a URL.
+type DefaultServerRedirect struct {
+	// StatusCode is the status code of the redirect response.
+	// Default: 302.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// URL is the absolute URL that the client is redirected to. It may contain NGINX variables,
+	// for example "https://example.com$request_uri".
+	//
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^https?://[^\s]+$`
+	URL string `json:"url"`
+}
+
+// DefaultServerBackendRef references a catch-all Service.
+type DefaultServerBackendRef struct {
+	// Namespace is the namespace of the Service.
+	Namespace gatewayv1.Namespace `json:"namespace"`
+
+	// Name is the name of the Service.
+	Name gatewayv1.ObjectName `json:"name"`
+
+	// Port is the port of the Service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
 }
 
+// DNSResolver defines the DNS servers that NGINX uses to resolve hostnames.
+type DNSResolver struct {
+	// Timeout is the timeout for resolving a hostname.
+	// Default is 30s.
+	// Sets NGINX directive resolver_timeout: https://nginx.org/en/docs/http/ngx_http_core_module.html#resolver_timeout
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// CacheTTL overrides the time that NGINX caches the answers of the DNS servers.
+	// By default, NGINX uses the TTL of the answers.
+	//
+	// +optional
+	CacheTTL *Duration `json:"cacheTTL,omitempty"`
+
+	// Addresses are the addresses of the DNS servers. NGINX queries the DNS servers on port 53
+	// in a round-robin fashion.
+	// Sets NGINX directive resolver: https://nginx.org/en/docs/http/ngx_http_core_module.html#resolver
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Addresses []DNSResolverAddress `json:"addresses"`
+}
+
+// DNSResolverAddress specifies the address of a DNS server.
+type DNSResolverAddress struct {
+	// Type specifies the type of address.
+	Type DNSResolverAddressType `json:"type"`
+
+	// Value specifies the address value.
+	Value string `json:"value"`
+}
+
+// DNSResolverAddressType specifies the type of address of a DNS server.
+// +kubebuilder:validation:Enum=IPAddress;Hostname
+type DNSResolverAddressType string
+
+const (
+	// DNSResolverIPAddressType specifies that the address is an IP address.
+	DNSResolverIPAddressType DNSResolverAddressType = "IPAddress"
+
+	// DNSResolverHostnameAddressType specifies that the address is a hostname.
+	DNSResolverHostnameAddressType DNSResolverAddressType = "Hostname"
+)
+
 // Telemetry specifies the OpenTelemetry configuration.
 type Telemetry struct {
 	// Exporter specifies OpenTelemetry export parameters.
@@ -0,0 +1,189 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=pcpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ProxyCachePolicy is an Inherited Attached Policy. It provides a way to cache responses from the upstream
+// applications in NGINX.
+type ProxyCachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ProxyCachePolicy.
+	Spec ProxyCachePolicySpec `json:"spec"`
+
+	// Status defines the state of the ProxyCachePolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProxyCachePolicyList contains a list of ProxyCachePolicies.
+type ProxyCachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProxyCachePolicy `json:"items"`
+}
+
+// ProxyCachePolicySpec defines the desired state of the ProxyCachePolicy.
+type ProxyCachePolicySpec struct {
+	// Zone defines the cache zone that stores the cached responses. The zone is created in the http context
+	// and is stored on the ephemeral cache volume of the NGINX container.
+	//
+	// +optional
+	Zone *ProxyCacheZone `json:"zone,omitempty"`
+
+	// Key defines the key for caching. It may contain NGINX variables.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_key
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +kubebuilder:validation:Pattern=`^([^"\\\s;{}]|\\[^\s])*$`
+	Key *string `json:"key,omitempty"`
+
+	// Valid sets the caching time for different response codes.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_valid
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Valid []ProxyCacheValid `json:"valid,omitempty"`
+
+	// Bypass defines the conditions under which the response will not be taken from the cache.
+	// Each condition is an NGINX variable. If at least one of the variables is not empty and is not equal
+	// to "0", the response is not taken from the cache.
+	// Examples: $cookie_nocache, $arg_nocache, $http_pragma.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_bypass
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Bypass []NginxVariable `json:"bypass,omitempty"`
+
+	// UseStale determines in which cases a stale cached response can be used during communication
+	// with the upstream application.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_use_stale
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=12
+	UseStale []ProxyCacheUseStaleCondition `json:"useStale,omitempty"`
+
+	// CacheStatusHeader is the name of a response header that is set to the cache status of the response,
+	// for example HIT, MISS or BYPASS.
+	// Variable: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#var_upstream_cache_status
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9-]+$`
+	CacheStatusHeader *string `json:"cacheStatusHeader,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute.
+	//
+	// TargetRefs must be _distinct_. This means that the multi-part key defined by `kind` and `name` must
+	// be unique across all targetRef entries in the ProxyCachePolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRefs Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind=='Gateway' || t.kind=='HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRefs Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// ProxyCacheZone defines the settings of a cache zone.
+// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path
+type ProxyCacheZone struct {
+	// Size is the size of the shared memory zone that stores the cache keys and metadata.
+	// One megabyte zone can store about 8 thousand keys.
+	// Default: 10m.
+	//
+	// +optional
+	Size *Size `json:"size,omitempty"`
+
+	// Inactive defines the time after which cached data that is not accessed is removed from the cache,
+	// regardless of its freshness.
+	// Default: 10m.
+	//
+	// +optional
+	Inactive *Duration `json:"inactive,omitempty"`
+
+	// MaxSize is the maximum size of the cached data on disk. When the size is exceeded, the least
+	// recently used data is removed.
+	// Since the cache is stored on an ephemeral volume, setting MaxSize is recommended.
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+}
+
+// ProxyCacheValid defines the caching time for a set of response codes.
+type ProxyCacheValid struct {
+	// Codes are the response codes that are cached for the specified duration.
+	// If no codes are specified, only 200, 301 and 302 responses are cached.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Codes []HTTPStatusCode `json:"codes,omitempty"`
+
+	// Duration is the caching time for the response codes.
+	Duration Duration `json:"duration"`
+}
+
+// HTTPStatusCode is an HTTP response status code.
+//
+// +kubebuilder:validation:Minimum=100
+// +kubebuilder:validation:Maximum=599
+type HTTPStatusCode int32
+
+// NginxVariable is the name of an NGINX variable, including the leading '$'.
+// Examples: $cookie_nocache, $http_pragma.
+//
+// +kubebuilder:validation:MaxLength=128
+// +kubebuilder:validation:Pattern=`^\$[a-zA-Z0-9_]+$`
+type NginxVariable string
+
+// ProxyCacheUseStaleCondition is a condition in which a stale cached response can be used.
+//
+// +kubebuilder:validation:Enum=error;timeout;invalid_header;updating;http_500;http_502;http_503;http_504;http_403;http_404;http_429;off
+type ProxyCacheUseStaleCondition string
+
+const (
+	// ProxyCacheUseStaleError uses a stale response if an error occurred while establishing a connection with
+	// the upstream, passing a request to it, or reading the response header.
+	ProxyCacheUseStaleError ProxyCacheUseStaleCondition = "error"
+	// ProxyCacheUseStaleTimeout uses a stale response if a timeout occurred while communicating with the upstream.
+	ProxyCacheUseStaleTimeout ProxyCacheUseStaleCondition = "timeout"
+	// ProxyCacheUseStaleInvalidHeader uses a stale response if the upstream returned an empty or invalid response.
+	ProxyCacheUseStaleInvalidHeader ProxyCacheUseStaleCondition = "invalid_header"
+	// ProxyCacheUseStaleUpdating uses a stale response if it is currently being updated.
+	ProxyCacheUseStaleUpdating ProxyCacheUseStaleCondition = "updating"
+	// ProxyCacheUseStaleHTTP500 uses a stale response if the upstream returned a 500 response.
+	ProxyCacheUseStaleHTTP500 ProxyCacheUseStaleCondition = "http_500"
+	// ProxyCacheUseStaleHTTP502 uses a stale response if the upstream returned a 502 response.
+	ProxyCacheUseStaleHTTP502 ProxyCacheUseStaleCondition = "http_502"
+	// ProxyCacheUseStaleHTTP503 uses a stale response if the upstream returned a 503 response.
+	ProxyCacheUseStaleHTTP503 ProxyCacheUseStaleCondition = "http_503"
+	// ProxyCacheUseStaleHTTP504 uses a stale response if the upstream returned a 504 response.
+	ProxyCacheUseStaleHTTP504 ProxyCacheUseStaleCondition = "http_504"
+	// ProxyCacheUseStaleHTTP403 uses a stale response if the upstream returned a 403 response.
+	ProxyCacheUseStaleHTTP403 ProxyCacheUseStaleCondition = "http_403"
+	// ProxyCacheUseStaleHTTP404 uses a stale response if the upstream returned a 404 response.
+	ProxyCacheUseStaleHTTP404 ProxyCacheUseStaleCondition = "http_404"
+	// ProxyCacheUseStaleHTTP429 uses a stale response if the upstream returned a 429 response.
+	ProxyCacheUseStaleHTTP429 ProxyCacheUseStaleCondition = "http_429"
+	// ProxyCacheUseStaleOff disables the use of stale responses.
+	ProxyCacheUseStaleOff ProxyCacheUseStaleCondition = "off"
+)
@@ -0,0 +1,153 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=pspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// ProxySettingsPolicy is an Inherited Attached Policy. It provides a way to configure the buffering and the
+// timeouts of the requests that NGINX Gateway Fabric proxies to the upstream applications.
+type ProxySettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ProxySettingsPolicy.
+	Spec ProxySettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the ProxySettingsPolicy.
+	Status gatewayv1alpha2.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ProxySettingsPolicyList contains a list of ProxySettingsPolicies.
+type ProxySettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProxySettingsPolicy `json:"items"`
+}
+
+// ProxySettingsPolicySpec defines the desired state of ProxySettingsPolicy.
+type ProxySettingsPolicySpec struct {
+	// Buffering defines the buffering of the requests to and the responses from the upstream applications.
+	//
+	// +optional
+	Buffering *ProxyBuffering `json:"buffering,omitempty"`
+
+	// Timeout defines the timeouts of the connections to the upstream applications.
+	//
+	// +optional
+	Timeout *ProxyTimeout `json:"timeout,omitempty"`
+
+	// TargetRef identifies an API object to apply the policy to.
+	// Object must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute, GRPCRoute.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="(self.kind=='Gateway' || self.kind=='HTTPRoute' || self.kind=='GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io.",rule="(self.group=='gateway.networking.k8s.io')"
+	//nolint:lll
+	TargetRef gatewayv1alpha2.LocalPolicyTargetReference `json:"targetRef"`
+}
+
+// ProxyBuffering contains the buffering settings of proxied requests. The buffering settings don't apply to
+// GRPCRoutes, except for BufferSize.
+//
+// BufferSize, Buffers and BusyBuffersSize depend on each other, so they are configured together: when a policy
+// sets any of them, the NGINX defaults are used for the ones it doesn't set. A policy that targets a Route and
+// sets any of them therefore overrides all three values of a policy that targets the Gateway, not only the ones
+// it sets. Two policies that target the same resource can't both set any of them.
+type ProxyBuffering struct {
+	// Disable disables the buffering of responses from the upstream application. When buffering is disabled,
+	// the response is passed to the client synchronously, immediately as it is received, which is required for
+	// streaming responses like Server-Sent Events.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffering.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// DisableRequest disables the buffering of the request body of the client. When buffering is disabled,
+	// the request body is sent to the upstream application immediately as it is received.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_request_buffering.
+	//
+	// +optional
+	DisableRequest *bool `json:"disableRequest,omitempty"`
+
+	// BufferSize sets the size of the buffer used for reading the first part of the response from the upstream
+	// application. This part usually contains the response header.
+	// When set, Buffers and BusyBuffersSize use the NGINX defaults if they are not set in the same policy,
+	// even if a policy that targets the Gateway sets them.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffer_size.
+	//
+	// +optional
+	BufferSize *Size `json:"bufferSize,omitempty"`
+
+	// Buffers sets the number and the size of the buffers used for reading a response from the upstream
+	// application, for a single connection.
+	// When set, BufferSize and BusyBuffersSize use the NGINX defaults if they are not set in the same policy,
+	// even if a policy that targets the Gateway sets them.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_buffers.
+	//
+	// +optional
+	Buffers *ProxyBuffers `json:"buffers,omitempty"`
+
+	// BusyBuffersSize limits the total size of the buffers that can be busy sending a response to the client
+	// while the response is not yet fully read. It must be at least the larger of BufferSize and the size of
+	// one buffer, and not more than the size of all Buffers minus one buffer.
+	// When set, BufferSize and Buffers use the NGINX defaults if they are not set in the same policy,
+	// even if a policy that targets the Gateway sets them.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_busy_buffers_size.
+	//
+	// +optional
+	BusyBuffersSize *Size `json:"busyBuffersSize,omitempty"`
+
+	// MaxTempFileSize sets the maximum size of the temporary file that a response that doesn't fit into
+	// the buffers is written to. Setting the size to 0 disables writing responses to temporary files.
+	// Otherwise, it must be at least the larger of BufferSize and the size of one buffer.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_max_temp_file_size.
+	//
+	// +optional
+	MaxTempFileSize *Size `json:"maxTempFileSize,omitempty"`
+}
+
+// ProxyBuffers defines the number and the size of the buffers of a connection.
+type ProxyBuffers struct {
+	// Size is the size of a buffer.
+	Size Size `json:"size"`
+
+	// Number is the number of buffers.
+	//
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=1024
+	Number int32 `json:"number"`
+}
+
+// ProxyTimeout contains the timeouts of the connections to the upstream applications.
+type ProxyTimeout struct {
+	// Connect defines a timeout for establishing a connection with the upstream application.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_connect_timeout.
+	//
+	// +optional
+	Connect *Duration `json:"connect,omitempty"`
+
+	// Read defines a timeout for reading a response from the upstream application. The timeout is set only
+	// between two successive read operations, not for the transmission of the whole response.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_read_timeout.
+	//
+	// +optional
+	Read *Duration `json:"read,omitempty"`
+
+	// Send defines a timeout for transmitting a request to the upstream application. The timeout is set only
+	// between two successive write operations, not for the transmission of the whole request.
+	// Default: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_send_timeout.
+	//
+	// +optional
+	Send *Duration `json:"send,omitempty"`
+}
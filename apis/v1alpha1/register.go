@@ -44,6 +44,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SnippetsFilterList{},
 		&UpstreamSettingsPolicy{},
 		&UpstreamSettingsPolicyList{},
+		&ProxyCachePolicy{},
+		&ProxyCachePolicyList{},
+		&CompressionPolicy{},
+		&CompressionPolicyList{},
+		&ErrorPagePolicy{},
+		&ErrorPagePolicyList{},
+		&Canary{},
+		&CanaryList{},
+		&ProxySettingsPolicy{},
+		&ProxySettingsPolicyList{},
 	)
 	// AddToGroupVersion allows the serialization of client types like ListOptions.
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -5,8 +5,9 @@
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
@@ -25,6 +26,219 @@ func (in *Address) DeepCopy() *Address {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Canary) DeepCopyInto(out *Canary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canary.
+func (in *Canary) DeepCopy() *Canary {
+	if in == nil {
+		return nil
+	}
+	out := new(Canary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Canary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryAnalysis) DeepCopyInto(out *CanaryAnalysis) {
+	*out = *in
+	if in.MinRequests != nil {
+		in, out := &in.MinRequests, &out.MinRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinRequestsTimeout != nil {
+		in, out := &in.MinRequestsTimeout, &out.MinRequestsTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryAnalysis.
+func (in *CanaryAnalysis) DeepCopy() *CanaryAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryList) DeepCopyInto(out *CanaryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Canary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryList.
+func (in *CanaryList) DeepCopy() *CanaryList {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanaryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryMatch) DeepCopyInto(out *CanaryMatch) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryMatch.
+func (in *CanaryMatch) DeepCopy() *CanaryMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanarySpec) DeepCopyInto(out *CanarySpec) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(CanaryMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(CanaryAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanarySpec.
+func (in *CanarySpec) DeepCopy() *CanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	if in.StepStartTime != nil {
+		in, out := &in.StepStartTime, &out.StepStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryTargetRef) DeepCopyInto(out *CanaryTargetRef) {
+	*out = *in
+	if in.SectionName != nil {
+		in, out := &in.SectionName, &out.SectionName
+		*out = new(v1.SectionName)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryTargetRef.
+func (in *CanaryTargetRef) DeepCopy() *CanaryTargetRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryTargetRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientBody) DeepCopyInto(out *ClientBody) {
 	*out = *in
@@ -190,24 +404,566 @@ func (in *ClientSettingsPolicySpec) DeepCopy() *ClientSettingsPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicy) DeepCopyInto(out *CompressionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicy.
+func (in *CompressionPolicy) DeepCopy() *CompressionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CompressionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicyList) DeepCopyInto(out *CompressionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CompressionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicyList.
+func (in *CompressionPolicyList) DeepCopy() *CompressionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CompressionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionPolicySpec) DeepCopyInto(out *CompressionPolicySpec) {
+	*out = *in
+	if in.Gzip != nil {
+		in, out := &in.Gzip, &out.Gzip
+		*out = new(Gzip)
+		(*in).DeepCopyInto(*out)
+	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionPolicySpec.
+func (in *CompressionPolicySpec) DeepCopy() *CompressionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerStatus) DeepCopyInto(out *ControllerStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerStatus.
-func (in *ControllerStatus) DeepCopy() *ControllerStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerStatus.
+func (in *ControllerStatus) DeepCopy() *ControllerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolver) DeepCopyInto(out *DNSResolver) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]DNSResolverAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolver.
+func (in *DNSResolver) DeepCopy() *DNSResolver {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolverAddress) DeepCopyInto(out *DNSResolverAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolverAddress.
+func (in *DNSResolverAddress) DeepCopy() *DNSResolverAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolverAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServer) DeepCopyInto(out *DefaultServer) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(DefaultServerAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]ListenerDefaultServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServer.
+func (in *DefaultServer) DeepCopy() *DefaultServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServerAction) DeepCopyInto(out *DefaultServerAction) {
+	*out = *in
+	if in.Return != nil {
+		in, out := &in.Return, &out.Return
+		*out = new(DefaultServerReturn)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(DefaultServerRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(DefaultServerBackendRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServerAction.
+func (in *DefaultServerAction) DeepCopy() *DefaultServerAction {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServerAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServerBackendRef) DeepCopyInto(out *DefaultServerBackendRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServerBackendRef.
+func (in *DefaultServerBackendRef) DeepCopy() *DefaultServerBackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServerBackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServerRedirect) DeepCopyInto(out *DefaultServerRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServerRedirect.
+func (in *DefaultServerRedirect) DeepCopy() *DefaultServerRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServerRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultServerReturn) DeepCopyInto(out *DefaultServerReturn) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(MIMEType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultServerReturn.
+func (in *DefaultServerReturn) DeepCopy() *DefaultServerReturn {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultServerReturn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPage) DeepCopyInto(out *ErrorPage) {
+	*out = *in
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(ErrorPageResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(ErrorPageRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(ErrorPageBackendRef)
+		**out = **in
+	}
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]ErrorPageStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPage.
+func (in *ErrorPage) DeepCopy() *ErrorPage {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageBackendRef) DeepCopyInto(out *ErrorPageBackendRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageBackendRef.
+func (in *ErrorPageBackendRef) DeepCopy() *ErrorPageBackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageBackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageConfigMapReference) DeepCopyInto(out *ErrorPageConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageConfigMapReference.
+func (in *ErrorPageConfigMapReference) DeepCopy() *ErrorPageConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicy) DeepCopyInto(out *ErrorPagePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicy.
+func (in *ErrorPagePolicy) DeepCopy() *ErrorPagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicyList) DeepCopyInto(out *ErrorPagePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ErrorPagePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicyList.
+func (in *ErrorPagePolicyList) DeepCopy() *ErrorPagePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ErrorPagePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPagePolicySpec) DeepCopyInto(out *ErrorPagePolicySpec) {
+	*out = *in
+	if in.InterceptErrors != nil {
+		in, out := &in.InterceptErrors, &out.InterceptErrors
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ErrorPages != nil {
+		in, out := &in.ErrorPages, &out.ErrorPages
+		*out = make([]ErrorPage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPagePolicySpec.
+func (in *ErrorPagePolicySpec) DeepCopy() *ErrorPagePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPagePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageRedirect) DeepCopyInto(out *ErrorPageRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageRedirect.
+func (in *ErrorPageRedirect) DeepCopy() *ErrorPageRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorPageResponse) DeepCopyInto(out *ErrorPageResponse) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(HTTPStatusCode)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(MIMEType)
+		**out = **in
+	}
+	out.ConfigMapRef = in.ConfigMapRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorPageResponse.
+func (in *ErrorPageResponse) DeepCopy() *ErrorPageResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorPageResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPC) DeepCopyInto(out *GRPC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPC.
+func (in *GRPC) DeepCopy() *GRPC {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Gzip) DeepCopyInto(out *Gzip) {
+	*out = *in
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]MIMEType, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Proxied != nil {
+		in, out := &in.Proxied, &out.Proxied
+		*out = make([]GzipProxiedCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Vary != nil {
+		in, out := &in.Vary, &out.Vary
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gzip.
+func (in *Gzip) DeepCopy() *Gzip {
+	if in == nil {
+		return nil
+	}
+	out := new(Gzip)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPSRedirect) DeepCopyInto(out *HTTPSRedirect) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSRedirect.
+func (in *HTTPSRedirect) DeepCopy() *HTTPSRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPSRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenerDefaultServer) DeepCopyInto(out *ListenerDefaultServer) {
+	*out = *in
+	in.Action.DeepCopyInto(&out.Action)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerDefaultServer.
+func (in *ListenerDefaultServer) DeepCopy() *ListenerDefaultServer {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerStatus)
+	out := new(ListenerDefaultServer)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -316,7 +1072,7 @@ func (in *NginxGatewayStatus) DeepCopyInto(out *NginxGatewayStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -434,6 +1190,26 @@ func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
 		*out = new(NginxLogging)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTPSRedirect != nil {
+		in, out := &in.HTTPSRedirect, &out.HTTPSRedirect
+		*out = new(HTTPSRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultServer != nil {
+		in, out := &in.DefaultServer, &out.DefaultServer
+		*out = new(DefaultServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSResolver != nil {
+		in, out := &in.DNSResolver, &out.DNSResolver
+		*out = new(DNSResolver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(GRPC)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxProxySpec.
@@ -532,6 +1308,344 @@ func (in *ObservabilityPolicySpec) DeepCopy() *ObservabilityPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyBuffering) DeepCopyInto(out *ProxyBuffering) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableRequest != nil {
+		in, out := &in.DisableRequest, &out.DisableRequest
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BufferSize != nil {
+		in, out := &in.BufferSize, &out.BufferSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Buffers != nil {
+		in, out := &in.Buffers, &out.Buffers
+		*out = new(ProxyBuffers)
+		**out = **in
+	}
+	if in.BusyBuffersSize != nil {
+		in, out := &in.BusyBuffersSize, &out.BusyBuffersSize
+		*out = new(Size)
+		**out = **in
+	}
+	if in.MaxTempFileSize != nil {
+		in, out := &in.MaxTempFileSize, &out.MaxTempFileSize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyBuffering.
+func (in *ProxyBuffering) DeepCopy() *ProxyBuffering {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyBuffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyBuffers) DeepCopyInto(out *ProxyBuffers) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyBuffers.
+func (in *ProxyBuffers) DeepCopy() *ProxyBuffers {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyBuffers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyCachePolicy) DeepCopyInto(out *ProxyCachePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyCachePolicy.
+func (in *ProxyCachePolicy) DeepCopy() *ProxyCachePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyCachePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxyCachePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyCachePolicyList) DeepCopyInto(out *ProxyCachePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProxyCachePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyCachePolicyList.
+func (in *ProxyCachePolicyList) DeepCopy() *ProxyCachePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyCachePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxyCachePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyCachePolicySpec) DeepCopyInto(out *ProxyCachePolicySpec) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(ProxyCacheZone)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Valid != nil {
+		in, out := &in.Valid, &out.Valid
+		*out = make([]ProxyCacheValid, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bypass != nil {
+		in, out := &in.Bypass, &out.Bypass
+		*out = make([]NginxVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.UseStale != nil {
+		in, out := &in.UseStale, &out.UseStale
+		*out = make([]ProxyCacheUseStaleCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.CacheStatusHeader != nil {
+		in, out := &in.CacheStatusHeader, &out.CacheStatusHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1alpha2.LocalPolicyTargetReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyCachePolicySpec.
+func (in *ProxyCachePolicySpec) DeepCopy() *ProxyCachePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyCachePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyCacheValid) DeepCopyInto(out *ProxyCacheValid) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]HTTPStatusCode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyCacheValid.
+func (in *ProxyCacheValid) DeepCopy() *ProxyCacheValid {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyCacheValid)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyCacheZone) DeepCopyInto(out *ProxyCacheZone) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Inactive != nil {
+		in, out := &in.Inactive, &out.Inactive
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyCacheZone.
+func (in *ProxyCacheZone) DeepCopy() *ProxyCacheZone {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyCacheZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicy) DeepCopyInto(out *ProxySettingsPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicy.
+func (in *ProxySettingsPolicy) DeepCopy() *ProxySettingsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySettingsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxySettingsPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicyList) DeepCopyInto(out *ProxySettingsPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProxySettingsPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicyList.
+func (in *ProxySettingsPolicyList) DeepCopy() *ProxySettingsPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySettingsPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProxySettingsPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySettingsPolicySpec) DeepCopyInto(out *ProxySettingsPolicySpec) {
+	*out = *in
+	if in.Buffering != nil {
+		in, out := &in.Buffering, &out.Buffering
+		*out = new(ProxyBuffering)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(ProxyTimeout)
+		(*in).DeepCopyInto(*out)
+	}
+	in.TargetRef.DeepCopyInto(&out.TargetRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySettingsPolicySpec.
+func (in *ProxySettingsPolicySpec) DeepCopy() *ProxySettingsPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySettingsPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyTimeout) DeepCopyInto(out *ProxyTimeout) {
+	*out = *in
+	if in.Connect != nil {
+		in, out := &in.Connect, &out.Connect
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Send != nil {
+		in, out := &in.Send, &out.Send
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTimeout.
+func (in *ProxyTimeout) DeepCopy() *ProxyTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RewriteClientIP) DeepCopyInto(out *RewriteClientIP) {
 	*out = *in
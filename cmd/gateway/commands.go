@@ -64,6 +64,9 @@ func createStaticModeCommand() *cobra.Command {
 		metricsPortFlag                = "metrics-port"
 		healthDisableFlag              = "health-disable"
 		healthPortFlag                 = "health-port"
+		debugServerFlag                = "debug-server"
+		debugServerPortFlag            = "debug-server-port"
+		debugServerTokenFileFlag       = "debug-server-token-file"
 		leaderElectionDisableFlag      = "leader-election-disable"
 		leaderElectionLockNameFlag     = "leader-election-lock-name"
 		productTelemetryDisableFlag    = "product-telemetry-disable"
@@ -75,6 +78,12 @@ func createStaticModeCommand() *cobra.Command {
 		usageReportClientSSLSecretFlag = "usage-report-client-ssl-secret" //nolint:gosec // not credentials
 		usageReportCASecretFlag        = "usage-report-ca-secret"         //nolint:gosec // not credentials
 		snippetsFiltersFlag            = "snippets-filters"
+		dynamicUpstreamsFlag           = "dynamic-upstreams"
+		endpointDrainPeriodFlag        = "endpoint-drain-period"
+		eventBatchMinDelayFlag         = "event-batch-min-delay"
+		eventBatchMaxDelayFlag         = "event-batch-max-delay"
+		eventBatchMaxSizeFlag          = "event-batch-max-size"
+		eventBatchMinIntervalFlag      = "event-batch-min-interval"
 	)
 
 	// flag values
@@ -106,6 +115,12 @@ func createStaticModeCommand() *cobra.Command {
 			validator: validatePort,
 			value:     8081,
 		}
+		enableDebugServer bool
+		debugServerPort   = intValidatingValue{
+			validator: validatePort,
+			value:     8082,
+		}
+		debugServerTokenFile string
 
 		disableLeaderElection  bool
 		leaderElectionLockName = stringValidatingValue{
@@ -119,6 +134,25 @@ func createStaticModeCommand() *cobra.Command {
 
 		snippetsFilters bool
 
+		dynamicUpstreams bool
+
+		endpointDrainPeriod = durationValidatingValue{
+			validator: validateNonNegativeDuration,
+		}
+
+		eventBatchMinDelay = durationValidatingValue{
+			validator: validateNonNegativeDuration,
+		}
+		eventBatchMaxDelay = durationValidatingValue{
+			validator: validateNonNegativeDuration,
+		}
+		eventBatchMaxSize = intValidatingValue{
+			validator: validateNonNegativeInt,
+		}
+		eventBatchMinInterval = durationValidatingValue{
+			validator: validateNonNegativeDuration,
+		}
+
 		plus                  bool
 		usageReportSkipVerify bool
 		usageReportSecretName = stringValidatingValue{
@@ -158,10 +192,23 @@ func createStaticModeCommand() *cobra.Command {
 			)
 			log.SetLogger(logger)
 
-			if err := ensureNoPortCollisions(metricsListenPort.value, healthListenPort.value); err != nil {
+			ports := []int{metricsListenPort.value, healthListenPort.value}
+			if enableDebugServer {
+				ports = append(ports, debugServerPort.value)
+			}
+
+			if err := ensureNoPortCollisions(ports...); err != nil {
 				return fmt.Errorf("error validating ports: %w", err)
 			}
 
+			if enableDebugServer && debugServerTokenFile == "" {
+				return errors.New("debug-server-token-file is required when the debug server is enabled")
+			}
+
+			if err := ensureValidEventBatchDelays(eventBatchMinDelay.value, eventBatchMaxDelay.value); err != nil {
+				return fmt.Errorf("error validating event batch delays: %w", err)
+			}
+
 			imageSource := os.Getenv("BUILD_AGENT")
 			if imageSource != "gha" && imageSource != "local" {
 				imageSource = "unknown"
@@ -193,6 +240,15 @@ func createStaticModeCommand() *cobra.Command {
 				return errors.New("usage-report-secret is required when using NGINX Plus")
 			}
 
+			if plus && dynamicUpstreams {
+				return errors.New("dynamic-upstreams is not supported with NGINX Plus, " +
+					"which updates upstream servers without reloads using the NGINX Plus API")
+			}
+
+			if !plus && endpointDrainPeriod.value > 0 {
+				return errors.New("endpoint-drain-period is only supported with NGINX Plus")
+			}
+
 			if plus {
 				usageReportConfig = config.UsageReportConfig{
 					SecretName:          usageReportSecretName.value,
@@ -229,6 +285,18 @@ func createStaticModeCommand() *cobra.Command {
 					Port:    metricsListenPort.value,
 					Secure:  metricsSecure,
 				},
+				DebugConfig: config.DebugConfig{
+					Enabled:   enableDebugServer,
+					Port:      debugServerPort.value,
+					TokenFile: debugServerTokenFile,
+				},
+				EventBatchingConfig: config.EventBatchingConfig{
+					MinDelay:     eventBatchMinDelay.value,
+					MaxDelay:     eventBatchMaxDelay.value,
+					MaxBatchSize: eventBatchMaxSize.value,
+					MinInterval:  eventBatchMinInterval.value,
+				},
+				EndpointDrainPeriod: endpointDrainPeriod.value,
 				LeaderElection: config.LeaderElectionConfig{
 					Enabled:  !disableLeaderElection,
 					LockName: leaderElectionLockName.String(),
@@ -249,7 +317,8 @@ func createStaticModeCommand() *cobra.Command {
 					Names:  flagKeys,
 					Values: flagValues,
 				},
-				SnippetsFilters: snippetsFilters,
+				SnippetsFilters:  snippetsFilters,
+				DynamicUpstreams: dynamicUpstreams,
 			}
 
 			if err := static.StartManager(conf); err != nil {
@@ -340,6 +409,29 @@ func createStaticModeCommand() *cobra.Command {
 		"Set the port where the health probe server is exposed. Format: [1024 - 65535]",
 	)
 
+	cmd.Flags().BoolVar(
+		&enableDebugServer,
+		debugServerFlag,
+		false,
+		"Enable the debug server. The debug server exposes the current NGINX configuration, "+
+			"the route attachment summary and the result of the last NGINX reload for troubleshooting. "+
+			"Requests must be authenticated with the bearer token from the debug-server-token-file.",
+	)
+
+	cmd.Flags().Var(
+		&debugServerPort,
+		debugServerPortFlag,
+		"Set the port where the debug server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().StringVar(
+		&debugServerTokenFile,
+		debugServerTokenFileFlag,
+		"",
+		"The path to the file that contains the bearer token for authenticating requests to the debug server. "+
+			"Required if the debug server is enabled.",
+	)
+
 	cmd.Flags().BoolVar(
 		&disableLeaderElection,
 		leaderElectionDisableFlag,
@@ -428,6 +520,54 @@ func createStaticModeCommand() *cobra.Command {
 			"generated NGINX config for HTTPRoute and GRPCRoute resources.",
 	)
 
+	cmd.Flags().BoolVar(
+		&dynamicUpstreams,
+		dynamicUpstreamsFlag,
+		false,
+		"Update the servers of HTTP upstreams without reloading NGINX when only the endpoints of Services change. "+
+			"Requests are load balanced randomly across the servers, "+
+			"ignoring the load balancing method of the upstream, connections to the servers are not kept alive, "+
+			"and failed requests are not retried on another server. "+
+			"Not supported with NGINX Plus, which always updates upstream servers without reloads.",
+	)
+
+	cmd.Flags().Var(
+		&endpointDrainPeriod,
+		endpointDrainPeriodFlag,
+		"The maximum time NGINX Plus drains the connections to the endpoints of Services that are terminating "+
+			"before removing them. Draining endpoints don't receive new requests. "+
+			"Only supported with NGINX Plus. Set to 0 to remove terminating endpoints immediately.",
+	)
+
+	cmd.Flags().Var(
+		&eventBatchMinDelay,
+		eventBatchMinDelayFlag,
+		"The time to wait for more Kubernetes events after receiving an event before processing the batch of events. "+
+			"Every new event restarts the wait, until the event-batch-max-delay is reached. "+
+			"Set to 0 to process events without waiting.",
+	)
+
+	cmd.Flags().Var(
+		&eventBatchMaxDelay,
+		eventBatchMaxDelayFlag,
+		"The maximum time the oldest event in a batch waits because of the event-batch-min-delay. "+
+			"Must not be less than event-batch-min-delay. Set to 0 for no maximum.",
+	)
+
+	cmd.Flags().Var(
+		&eventBatchMaxSize,
+		eventBatchMaxSizeFlag,
+		"The number of events at which a batch is processed without waiting for the event-batch-min-delay. "+
+			"Set to 0 for no maximum.",
+	)
+
+	cmd.Flags().Var(
+		&eventBatchMinInterval,
+		eventBatchMinIntervalFlag,
+		"The minimum time between processing two consecutive batches of events. Because processing a batch "+
+			"usually reloads NGINX, this limits the rate of NGINX reloads. Set to 0 for no limit.",
+	)
+
 	return cmd
 }
 
@@ -560,7 +700,7 @@ func createInitializeCommand() *cobra.Command {
 
 			return initialize(initializeConfig{
 				fileManager:   file.NewStdLibOSFileManager(),
-				fileGenerator: ngxConfig.NewGeneratorImpl(plus, nil, logger.WithName("generator")),
+				fileGenerator: ngxConfig.NewGeneratorImpl(plus, false, nil, logger.WithName("generator")),
 				logger:        logger,
 				plus:          plus,
 				collector:     dcc,
@@ -598,6 +738,93 @@ func createInitializeCommand() *cobra.Command {
 	return cmd
 }
 
+func createRenderCommand() *cobra.Command {
+	// flag names
+	const (
+		fileFlag      = "file"
+		outputDirFlag = "output-dir"
+	)
+
+	// flag values
+	var (
+		gatewayCtlrName = stringValidatingValue{
+			validator: validateGatewayControllerName,
+		}
+		gatewayClassName = stringValidatingValue{
+			validator: validateResourceName,
+		}
+		inputFiles []string
+		outputDir  string
+		plus       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render the NGINX configuration and resource statuses for resources in YAML files without a cluster",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := validateRenderArgs(inputFiles, outputDir); err != nil {
+				return err
+			}
+
+			logger := ctlrZap.New()
+			klog.SetLogger(logger)
+			log.SetLogger(logger)
+
+			return static.Render(cmd.Context(), static.RenderConfig{
+				Logger:           logger,
+				GatewayCtlrName:  gatewayCtlrName.value,
+				GatewayClassName: gatewayClassName.value,
+				InputFiles:       inputFiles,
+				OutputDir:        outputDir,
+				Plus:             plus,
+			})
+		},
+	}
+
+	cmd.Flags().Var(
+		&gatewayCtlrName,
+		gatewayCtlrNameFlag,
+		fmt.Sprintf(gatewayCtlrNameUsageFmt, domain),
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayCtlrNameFlag))
+
+	cmd.Flags().Var(
+		&gatewayClassName,
+		gatewayClassFlag,
+		gatewayClassNameUsage,
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayClassFlag))
+
+	cmd.Flags().StringSliceVarP(
+		&inputFiles,
+		fileFlag,
+		"f",
+		[]string{},
+		"The YAML files with the Gateway API, NGINX Gateway Fabric and Kubernetes resources to render. "+
+			"EndpointSlices in the files are used to resolve the endpoints of Services.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(fileFlag))
+
+	cmd.Flags().StringVarP(
+		&outputDir,
+		outputDirFlag,
+		"o",
+		"",
+		"The directory to write the NGINX configuration files and the status report to. "+
+			"The NGINX configuration files are written under their paths relative to this directory.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(outputDirFlag))
+
+	cmd.Flags().BoolVar(
+		&plus,
+		plusFlag,
+		false,
+		"Render the configuration for NGINX Plus",
+	)
+
+	return cmd
+}
+
 func parseFlags(flags *pflag.FlagSet) ([]string, []string) {
 	var flagKeys, flagValues []string
 
@@ -671,6 +898,8 @@ func createGatewayPodConfig(svcName string) (config.GatewayPodConfig, error) {
 		Namespace:   ns,
 		Name:        name,
 		UID:         podUID,
+		// NODE_NAME is optional, because it is only used for topology-aware endpoint selection.
+		NodeName: os.Getenv("NODE_NAME"),
 	}
 
 	return c, nil
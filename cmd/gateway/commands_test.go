@@ -150,6 +150,9 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--metrics-secure-serving",
 				"--health-port=8081",
 				"--health-disable",
+				"--debug-server",
+				"--debug-server-port=8083",
+				"--debug-server-token-file=/var/run/secrets/debug/token",
 				"--leader-election-lock-name=my-lock",
 				"--leader-election-disable=false",
 				"--nginx-plus",
@@ -159,6 +162,12 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 				"--usage-report-ca-secret=ca-secret",
 				"--usage-report-client-ssl-secret=client-secret",
 				"--snippets-filters",
+				"--dynamic-upstreams",
+				"--endpoint-drain-period=30s",
+				"--event-batch-min-delay=1s",
+				"--event-batch-max-delay=5s",
+				"--event-batch-max-size=100",
+				"--event-batch-min-interval=500ms",
 			},
 			wantErr: false,
 		},
@@ -396,6 +405,24 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			wantErr:           true,
 			expectedErrPrefix: `invalid argument "!@#$" for "--usage-report-client-ssl-secret" flag: invalid format: `,
 		},
+		{
+			name: "debug-server is not a bool",
+			args: []string{
+				"--debug-server=not-a-bool",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--debug-server" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+		},
+		{
+			name: "debug-server-port is invalid",
+			args: []string{
+				"--debug-server-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-server-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
 		{
 			name: "snippets-filters is not a bool",
 			expectedErrPrefix: `invalid argument "not-a-bool" for "--snippets-filters" flag: strconv.ParseBool:` +
@@ -405,6 +432,66 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "dynamic-upstreams is not a bool",
+			expectedErrPrefix: `invalid argument "not-a-bool" for "--dynamic-upstreams" flag: strconv.ParseBool:` +
+				` parsing "not-a-bool": invalid syntax`,
+			args: []string{
+				"--dynamic-upstreams=not-a-bool",
+			},
+			wantErr: true,
+		},
+		{
+			name: "endpoint-drain-period is not a duration",
+			args: []string{
+				"--endpoint-drain-period=30",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "30" for "--endpoint-drain-period" flag: ` +
+				`failed to parse duration value: time: missing unit in duration "30"`,
+		},
+		{
+			name: "endpoint-drain-period is negative",
+			args: []string{
+				"--endpoint-drain-period=-30s",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-30s" for "--endpoint-drain-period" flag: must not be negative: -30s`,
+		},
+		{
+			name: "event-batch-min-delay is not a duration",
+			args: []string{
+				"--event-batch-min-delay=1",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "1" for "--event-batch-min-delay" flag: ` +
+				`failed to parse duration value: time: missing unit in duration "1"`,
+		},
+		{
+			name: "event-batch-max-delay is negative",
+			args: []string{
+				"--event-batch-max-delay=-1s",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-1s" for "--event-batch-max-delay" flag: must not be negative: -1s`,
+		},
+		{
+			name: "event-batch-max-size is negative",
+			args: []string{
+				"--event-batch-max-size=-1",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "-1" for "--event-batch-max-size" flag: must not be negative: -1`,
+		},
+		{
+			name: "event-batch-min-interval is negative",
+			args: []string{
+				"--event-batch-min-interval=-1ms",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "-1ms" for "--event-batch-min-interval" flag: ` +
+				`must not be negative: -1ms`,
+		},
 	}
 
 	// common flags validation is tested separately
@@ -476,6 +563,63 @@ func TestSleepCmdFlagValidation(t *testing.T) {
 	}
 }
 
+func TestRenderCmdFlagValidation(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--file=resources.yaml",
+				"-f=more-resources.yaml",
+				"--output-dir=output",
+				"--nginx-plus",
+			},
+			wantErr: false,
+		},
+		{
+			name: "file is omitted",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--output-dir=output",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "file" not set`,
+		},
+		{
+			name: "output-dir is omitted",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--file=resources.yaml",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "output-dir" not set`,
+		},
+		{
+			name: "gatewayclass is invalid",
+			args: []string{
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=!@#$",
+				"--file=resources.yaml",
+				"--output-dir=output",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "!@#$" for "--gatewayclass" flag: invalid format`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cmd := createRenderCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
+
 func TestInitializeCmdFlagValidation(t *testing.T) {
 	t.Parallel()
 	tests := []flagTestCase{
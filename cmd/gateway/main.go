@@ -25,6 +25,7 @@ func main() {
 		createProvisionerModeCommand(),
 		createInitializeCommand(),
 		createSleepCommand(),
+		createRenderCommand(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
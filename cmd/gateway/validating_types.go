@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -57,6 +58,35 @@ func (v *intValidatingValue) Type() string {
 	return "int"
 }
 
+// durationValidatingValue is a duration flag value with custom validation logic.
+// it implements the pflag.Value interface.
+type durationValidatingValue struct {
+	validator func(v time.Duration) error
+	value     time.Duration
+}
+
+func (v *durationValidatingValue) String() string {
+	return v.value.String()
+}
+
+func (v *durationValidatingValue) Set(param string) error {
+	duration, err := time.ParseDuration(param)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration value: %w", err)
+	}
+
+	if err := v.validator(duration); err != nil {
+		return err
+	}
+
+	v.value = duration
+	return nil
+}
+
+func (v *durationValidatingValue) Type() string {
+	return "duration"
+}
+
 // namespacedNameValue is a string flag value that represents a namespaced name.
 // it implements the pflag.Value interface.
 type namespacedNameValue struct {
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -206,6 +207,30 @@ func ensureNoPortCollisions(ports ...int) error {
 	return nil
 }
 
+// validateNonNegativeInt makes sure a given int value is not negative.
+func validateNonNegativeInt(value int) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative: %v", value)
+	}
+	return nil
+}
+
+// validateNonNegativeDuration makes sure a given duration is not negative.
+func validateNonNegativeDuration(duration time.Duration) error {
+	if duration < 0 {
+		return fmt.Errorf("must not be negative: %v", duration)
+	}
+	return nil
+}
+
+// ensureValidEventBatchDelays checks that the maximum event batch delay, if set, is not less than the minimum delay.
+func ensureValidEventBatchDelays(minDelay, maxDelay time.Duration) error {
+	if maxDelay != 0 && maxDelay < minDelay {
+		return fmt.Errorf("maximum event batch delay %v is less than the minimum delay %v", maxDelay, minDelay)
+	}
+	return nil
+}
+
 // validateCopyArgs ensures that arguments to the sleep command are set.
 func validateCopyArgs(srcFiles []string, dest string) error {
 	if len(srcFiles) == 0 {
@@ -217,3 +242,15 @@ func validateCopyArgs(srcFiles []string, dest string) error {
 
 	return nil
 }
+
+// validateRenderArgs ensures that the input files and the output directory of the render command are set.
+func validateRenderArgs(inputFiles []string, outputDir string) error {
+	if len(inputFiles) == 0 {
+		return errors.New("input files must be set")
+	}
+	if outputDir == "" {
+		return errors.New("output directory must be set")
+	}
+
+	return nil
+}
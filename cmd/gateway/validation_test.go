@@ -2,6 +2,7 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
@@ -554,6 +555,39 @@ func TestEnsureNoPortCollisions(t *testing.T) {
 	g.Expect(ensureNoPortCollisions(9113, 9113)).ToNot(Succeed())
 }
 
+func TestValidateNonNegativeValues(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateNonNegativeInt(0)).To(Succeed())
+	g.Expect(validateNonNegativeInt(10)).To(Succeed())
+	g.Expect(validateNonNegativeInt(-1)).ToNot(Succeed())
+
+	g.Expect(validateNonNegativeDuration(0)).To(Succeed())
+	g.Expect(validateNonNegativeDuration(time.Second)).To(Succeed())
+	g.Expect(validateNonNegativeDuration(-time.Second)).ToNot(Succeed())
+}
+
+func TestEnsureValidEventBatchDelays(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(ensureValidEventBatchDelays(0, 0)).To(Succeed())
+	g.Expect(ensureValidEventBatchDelays(time.Second, 0)).To(Succeed())
+	g.Expect(ensureValidEventBatchDelays(time.Second, time.Second)).To(Succeed())
+	g.Expect(ensureValidEventBatchDelays(time.Second, 5*time.Second)).To(Succeed())
+	g.Expect(ensureValidEventBatchDelays(5*time.Second, time.Second)).ToNot(Succeed())
+}
+
+func TestValidateRenderArgs(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(validateRenderArgs([]string{"resources.yaml"}, "output")).To(Succeed())
+	g.Expect(validateRenderArgs(nil, "output")).ToNot(Succeed())
+	g.Expect(validateRenderArgs([]string{"resources.yaml"}, "")).ToNot(Succeed())
+}
+
 func TestValidateSleepArgs(t *testing.T) {
 	t.Parallel()
 
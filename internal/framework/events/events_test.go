@@ -2,6 +2,7 @@ package events
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -10,7 +11,7 @@ import (
 func TestEventLoop_SwapBatches(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
-	eventLoop := NewEventLoop(nil, zap.New(), nil, nil)
+	eventLoop := NewEventLoop(nil, zap.New(), nil, nil, BatchingConfig{}, NoopMetricsCollector{})
 
 	eventLoop.currentBatch = EventBatch{
 		"event0",
@@ -34,3 +35,119 @@ func TestEventLoop_SwapBatches(t *testing.T) {
 	g.Expect(eventLoop.nextBatch).To(BeEmpty())
 	g.Expect(eventLoop.nextBatch).To(HaveCap(3))
 }
+
+func TestEventLoop_NextBatchDelay(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name               string
+		firstEventTime     time.Time
+		lastEventTime      time.Time
+		lastBatchStartTime time.Time
+		batchingCfg        BatchingConfig
+		batchSize          int
+		expected           time.Duration
+	}{
+		{
+			name:           "no batching config",
+			firstEventTime: now,
+			lastEventTime:  now,
+			batchSize:      1,
+			expected:       0,
+		},
+		{
+			name:           "min delay since last event",
+			firstEventTime: now.Add(-5 * time.Second),
+			lastEventTime:  now.Add(-1 * time.Second),
+			batchingCfg: BatchingConfig{
+				MinDelay: 3 * time.Second,
+			},
+			batchSize: 2,
+			expected:  2 * time.Second,
+		},
+		{
+			name:           "min delay passed",
+			firstEventTime: now.Add(-5 * time.Second),
+			lastEventTime:  now.Add(-4 * time.Second),
+			batchingCfg: BatchingConfig{
+				MinDelay: 3 * time.Second,
+			},
+			batchSize: 2,
+			expected:  -1 * time.Second,
+		},
+		{
+			name:           "max delay limits min delay",
+			firstEventTime: now.Add(-5 * time.Second),
+			lastEventTime:  now,
+			batchingCfg: BatchingConfig{
+				MinDelay: 3 * time.Second,
+				MaxDelay: 6 * time.Second,
+			},
+			batchSize: 2,
+			expected:  1 * time.Second,
+		},
+		{
+			name:           "max batch size reached",
+			firstEventTime: now,
+			lastEventTime:  now,
+			batchingCfg: BatchingConfig{
+				MinDelay:     3 * time.Second,
+				MaxBatchSize: 2,
+			},
+			batchSize: 2,
+			expected:  0,
+		},
+		{
+			name:           "max batch size not reached",
+			firstEventTime: now,
+			lastEventTime:  now,
+			batchingCfg: BatchingConfig{
+				MinDelay:     3 * time.Second,
+				MaxBatchSize: 3,
+			},
+			batchSize: 2,
+			expected:  3 * time.Second,
+		},
+		{
+			name:               "min interval takes precedence",
+			firstEventTime:     now,
+			lastEventTime:      now,
+			lastBatchStartTime: now.Add(-1 * time.Second),
+			batchingCfg: BatchingConfig{
+				MinDelay:     3 * time.Second,
+				MaxBatchSize: 1,
+				MinInterval:  5 * time.Second,
+			},
+			batchSize: 1,
+			expected:  4 * time.Second,
+		},
+		{
+			name:               "min interval passed",
+			firstEventTime:     now,
+			lastEventTime:      now,
+			lastBatchStartTime: now.Add(-10 * time.Second),
+			batchingCfg: BatchingConfig{
+				MinInterval: 5 * time.Second,
+			},
+			batchSize: 1,
+			expected:  0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			eventLoop := NewEventLoop(nil, zap.New(), nil, nil, test.batchingCfg, NoopMetricsCollector{})
+			eventLoop.firstEventTime = test.firstEventTime
+			eventLoop.lastEventTime = test.lastEventTime
+			eventLoop.lastBatchStartTime = test.lastBatchStartTime
+			eventLoop.nextBatch = make(EventBatch, test.batchSize)
+
+			g.Expect(eventLoop.nextBatchDelay(now)).To(Equal(test.expected))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package eventsfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/events"
+)
+
+type FakeMetricsCollector struct {
+	ObserveEventBatchQueueingTimeStub        func(time.Duration)
+	observeEventBatchQueueingTimeMutex       sync.RWMutex
+	observeEventBatchQueueingTimeArgsForCall []struct {
+		arg1 time.Duration
+	}
+	ObserveEventBatchSizeStub        func(int)
+	observeEventBatchSizeMutex       sync.RWMutex
+	observeEventBatchSizeArgsForCall []struct {
+		arg1 int
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchQueueingTime(arg1 time.Duration) {
+	fake.observeEventBatchQueueingTimeMutex.Lock()
+	fake.observeEventBatchQueueingTimeArgsForCall = append(fake.observeEventBatchQueueingTimeArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	stub := fake.ObserveEventBatchQueueingTimeStub
+	fake.recordInvocation("ObserveEventBatchQueueingTime", []interface{}{arg1})
+	fake.observeEventBatchQueueingTimeMutex.Unlock()
+	if stub != nil {
+		fake.ObserveEventBatchQueueingTimeStub(arg1)
+	}
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchQueueingTimeCallCount() int {
+	fake.observeEventBatchQueueingTimeMutex.RLock()
+	defer fake.observeEventBatchQueueingTimeMutex.RUnlock()
+	return len(fake.observeEventBatchQueueingTimeArgsForCall)
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchQueueingTimeCalls(stub func(time.Duration)) {
+	fake.observeEventBatchQueueingTimeMutex.Lock()
+	defer fake.observeEventBatchQueueingTimeMutex.Unlock()
+	fake.ObserveEventBatchQueueingTimeStub = stub
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchQueueingTimeArgsForCall(i int) time.Duration {
+	fake.observeEventBatchQueueingTimeMutex.RLock()
+	defer fake.observeEventBatchQueueingTimeMutex.RUnlock()
+	argsForCall := fake.observeEventBatchQueueingTimeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchSize(arg1 int) {
+	fake.observeEventBatchSizeMutex.Lock()
+	fake.observeEventBatchSizeArgsForCall = append(fake.observeEventBatchSizeArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.ObserveEventBatchSizeStub
+	fake.recordInvocation("ObserveEventBatchSize", []interface{}{arg1})
+	fake.observeEventBatchSizeMutex.Unlock()
+	if stub != nil {
+		fake.ObserveEventBatchSizeStub(arg1)
+	}
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchSizeCallCount() int {
+	fake.observeEventBatchSizeMutex.RLock()
+	defer fake.observeEventBatchSizeMutex.RUnlock()
+	return len(fake.observeEventBatchSizeArgsForCall)
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchSizeCalls(stub func(int)) {
+	fake.observeEventBatchSizeMutex.Lock()
+	defer fake.observeEventBatchSizeMutex.Unlock()
+	fake.ObserveEventBatchSizeStub = stub
+}
+
+func (fake *FakeMetricsCollector) ObserveEventBatchSizeArgsForCall(i int) int {
+	fake.observeEventBatchSizeMutex.RLock()
+	defer fake.observeEventBatchSizeMutex.RUnlock()
+	argsForCall := fake.observeEventBatchSizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeMetricsCollector) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.observeEventBatchQueueingTimeMutex.RLock()
+	defer fake.observeEventBatchQueueingTimeMutex.RUnlock()
+	fake.observeEventBatchSizeMutex.RLock()
+	defer fake.observeEventBatchSizeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMetricsCollector) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ events.MetricsCollector = new(FakeMetricsCollector)
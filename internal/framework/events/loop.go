@@ -3,10 +3,46 @@ package events
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 )
 
+//counterfeiter:generate . MetricsCollector
+
+// MetricsCollector collects metrics for the EventLoop.
+type MetricsCollector interface {
+	// ObserveEventBatchSize observes the number of events in a batch.
+	ObserveEventBatchSize(size int)
+	// ObserveEventBatchQueueingTime observes how long the oldest event in a batch waited before the batch
+	// started being handled.
+	ObserveEventBatchQueueingTime(duration time.Duration)
+}
+
+// NoopMetricsCollector is a MetricsCollector that doesn't collect any metrics.
+type NoopMetricsCollector struct{}
+
+func (NoopMetricsCollector) ObserveEventBatchSize(_ int) {}
+
+func (NoopMetricsCollector) ObserveEventBatchQueueingTime(_ time.Duration) {}
+
+// BatchingConfig configures how the EventLoop batches events.
+// The zero value handles a batch as soon as no other batch is being handled.
+type BatchingConfig struct {
+	// MinDelay is how long the EventLoop waits for more events after receiving an event before it handles the batch.
+	// Every new event restarts the wait, until MaxDelay is reached.
+	MinDelay time.Duration
+	// MaxDelay is the maximum time the oldest event in a batch waits because of MinDelay.
+	// Zero means there is no maximum.
+	MaxDelay time.Duration
+	// MaxBatchSize is the number of events at which a batch is handled without waiting for MinDelay.
+	// Zero means there is no maximum.
+	MaxBatchSize int
+	// MinInterval is the minimum time between the start of handling two consecutive batches.
+	// Because handling a batch typically reloads NGINX, it limits the rate of NGINX reloads.
+	MinInterval time.Duration
+}
+
 // EventLoop is the main event loop of the Gateway. It handles events coming through the event channel.
 //
 // When a new event comes, there are two cases:
@@ -22,11 +58,16 @@ import (
 // FIXME(pleshakov): better document the side effects and how to prevent and mitigate them.
 // So when the EventLoop have 100 saved events, it is better to process them at once rather than one by one.
 // https://github.com/nginx/nginx-gateway-fabric/issues/551
+//
+// The BatchingConfig allows delaying the handling of saved events, so that bursts of events -- for example, during
+// a rolling update of a Deployment -- end up in fewer batches, and limiting how often batches are handled.
 type EventLoop struct {
-	handler  EventHandler
-	preparer FirstEventBatchPreparer
-	eventCh  <-chan interface{}
-	logger   logr.Logger
+	handler          EventHandler
+	preparer         FirstEventBatchPreparer
+	metricsCollector MetricsCollector
+	eventCh          <-chan interface{}
+	logger           logr.Logger
+	batchingCfg      BatchingConfig
 
 	// The EventLoop uses double buffering to handle event batch processing.
 	// The goroutine that handles the batch will always read from the currentBatch slice.
@@ -35,6 +76,13 @@ type EventLoop struct {
 	currentBatch EventBatch
 	nextBatch    EventBatch
 
+	// firstEventTime is the time when the first event was added to the next batch.
+	firstEventTime time.Time
+	// lastEventTime is the time when the last event was added to the next batch.
+	lastEventTime time.Time
+	// lastBatchStartTime is the time when handling of the current or the last batch started.
+	lastBatchStartTime time.Time
+
 	// the ID of the current batch
 	currentBatchID int
 }
@@ -45,14 +93,18 @@ func NewEventLoop(
 	logger logr.Logger,
 	handler EventHandler,
 	preparer FirstEventBatchPreparer,
+	batchingCfg BatchingConfig,
+	metricsCollector MetricsCollector,
 ) *EventLoop {
 	return &EventLoop{
-		eventCh:      eventCh,
-		logger:       logger,
-		handler:      handler,
-		preparer:     preparer,
-		currentBatch: make(EventBatch, 0),
-		nextBatch:    make(EventBatch, 0),
+		eventCh:          eventCh,
+		logger:           logger,
+		handler:          handler,
+		preparer:         preparer,
+		batchingCfg:      batchingCfg,
+		metricsCollector: metricsCollector,
+		currentBatch:     make(EventBatch, 0),
+		nextBatch:        make(EventBatch, 0),
 	}
 }
 
@@ -65,6 +117,8 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	handlingDone := make(chan struct{})
 
 	handleBatch := func() {
+		el.lastBatchStartTime = time.Now()
+
 		go func(batch EventBatch) {
 			el.currentBatchID++
 			batchLogger := el.logger.WithName("eventHandler").WithValues("batchID", el.currentBatchID)
@@ -79,11 +133,46 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	}
 
 	swapAndHandleBatch := func() {
+		el.metricsCollector.ObserveEventBatchSize(len(el.nextBatch))
+		el.metricsCollector.ObserveEventBatchQueueingTime(time.Since(el.firstEventTime))
+
 		el.swapBatches()
 		handleBatch()
 		handling = true
 	}
 
+	// delayTimer fires when the next batch is due to be handled. delayCh is nil when the timer is not running.
+	var (
+		delayTimer *time.Timer
+		delayCh    <-chan time.Time
+	)
+
+	stopDelayTimer := func() {
+		if delayTimer != nil {
+			delayTimer.Stop()
+			delayTimer = nil
+			delayCh = nil
+		}
+	}
+
+	// handleNextBatchWhenDue begins handling the next batch if it is due, or starts the timer to handle it later.
+	handleNextBatchWhenDue := func() {
+		stopDelayTimer()
+
+		if handling || len(el.nextBatch) == 0 {
+			return
+		}
+
+		delay := el.nextBatchDelay(time.Now())
+		if delay <= 0 {
+			swapAndHandleBatch()
+			return
+		}
+
+		delayTimer = time.NewTimer(delay)
+		delayCh = delayTimer.C
+	}
+
 	// Prepare the fist event batch, which includes the UpsertEvents for all relevant cluster resources.
 	// This is necessary so that the first time the EventHandler generates NGINX configuration, it derives it from
 	// a complete view of the cluster. Otherwise, the handler would generate incomplete configuration, which can lead
@@ -111,12 +200,20 @@ func (el *EventLoop) Start(ctx context.Context) error {
 	for {
 		select {
 		case <-ctx.Done():
+			stopDelayTimer()
+
 			// Wait for the completion if a batch is being handled.
 			if handling {
 				<-handlingDone
 			}
 			return nil
 		case e := <-el.eventCh:
+			now := time.Now()
+			if len(el.nextBatch) == 0 {
+				el.firstEventTime = now
+			}
+			el.lastEventTime = now
+
 			// Add the event to the current batch.
 			el.nextBatch = append(el.nextBatch, e)
 
@@ -126,21 +223,42 @@ func (el *EventLoop) Start(ctx context.Context) error {
 				"total", len(el.nextBatch),
 			)
 
-			// If no batch is currently being handled, swap batches and begin handling the batch.
-			if !handling {
-				swapAndHandleBatch()
-			}
+			// If no batch is currently being handled, swap batches and begin handling the batch once it is due.
+			handleNextBatchWhenDue()
+		case <-delayCh:
+			handleNextBatchWhenDue()
 		case <-handlingDone:
 			handling = false
 
-			// If there's at least one event in the next batch, swap batches and begin handling the batch.
-			if len(el.nextBatch) > 0 {
-				swapAndHandleBatch()
-			}
+			// If there's at least one event in the next batch, swap batches and begin handling the batch once
+			// it is due.
+			handleNextBatchWhenDue()
 		}
 	}
 }
 
+// nextBatchDelay returns how long to wait before handling the next batch according to the BatchingConfig.
+func (el *EventLoop) nextBatchDelay(now time.Time) time.Duration {
+	due := el.lastEventTime.Add(el.batchingCfg.MinDelay)
+
+	if el.batchingCfg.MaxDelay > 0 {
+		if maxDue := el.firstEventTime.Add(el.batchingCfg.MaxDelay); maxDue.Before(due) {
+			due = maxDue
+		}
+	}
+
+	if el.batchingCfg.MaxBatchSize > 0 && len(el.nextBatch) >= el.batchingCfg.MaxBatchSize {
+		due = now
+	}
+
+	// the rate limit takes precedence over all other settings
+	if minDue := el.lastBatchStartTime.Add(el.batchingCfg.MinInterval); minDue.After(due) {
+		due = minDue
+	}
+
+	return due.Sub(now)
+}
+
 // swapBatches swaps the current and next batches.
 func (el *EventLoop) swapBatches() {
 	el.currentBatch, el.nextBatch = el.nextBatch, el.currentBatch
@@ -16,19 +16,28 @@ import (
 
 var _ = Describe("EventLoop", func() {
 	var (
-		fakeHandler  *eventsfakes.FakeEventHandler
-		eventCh      chan interface{}
-		fakePreparer *eventsfakes.FakeFirstEventBatchPreparer
-		eventLoop    *events.EventLoop
-		errorCh      chan error
+		fakeHandler          *eventsfakes.FakeEventHandler
+		eventCh              chan interface{}
+		fakePreparer         *eventsfakes.FakeFirstEventBatchPreparer
+		fakeMetricsCollector *eventsfakes.FakeMetricsCollector
+		eventLoop            *events.EventLoop
+		errorCh              chan error
 	)
 
 	BeforeEach(func() {
 		fakeHandler = &eventsfakes.FakeEventHandler{}
 		eventCh = make(chan interface{})
 		fakePreparer = &eventsfakes.FakeFirstEventBatchPreparer{}
+		fakeMetricsCollector = &eventsfakes.FakeMetricsCollector{}
 
-		eventLoop = events.NewEventLoop(eventCh, zap.New(), fakeHandler, fakePreparer)
+		eventLoop = events.NewEventLoop(
+			eventCh,
+			zap.New(),
+			fakeHandler,
+			fakePreparer,
+			events.BatchingConfig{},
+			fakeMetricsCollector,
+		)
 
 		errorCh = make(chan error)
 	})
@@ -73,6 +82,10 @@ var _ = Describe("EventLoop", func() {
 
 			var expectedBatch events.EventBatch = []interface{}{e}
 			Expect(batch).Should(Equal(expectedBatch))
+
+			Expect(fakeMetricsCollector.ObserveEventBatchSizeCallCount()).To(Equal(1))
+			Expect(fakeMetricsCollector.ObserveEventBatchSizeArgsForCall(0)).To(Equal(1))
+			Expect(fakeMetricsCollector.ObserveEventBatchQueueingTimeCallCount()).To(Equal(1))
 		})
 
 		It("should batch multiple events", func() {
@@ -120,6 +133,59 @@ var _ = Describe("EventLoop", func() {
 		})
 	})
 
+	Describe("Batching with delays", func() {
+		BeforeEach(func() {
+			eventLoop = events.NewEventLoop(
+				eventCh,
+				zap.New(),
+				fakeHandler,
+				fakePreparer,
+				events.BatchingConfig{
+					MinDelay: 200 * time.Millisecond,
+					MaxDelay: time.Minute,
+				},
+				fakeMetricsCollector,
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			DeferCleanup(func(dctx SpecContext) {
+				cancel()
+				var err error
+				Eventually(errorCh).WithContext(dctx).Should(Receive(&err))
+				Expect(err).ToNot(HaveOccurred())
+			}, NodeTimeout(time.Second*10))
+
+			fakePreparer.PrepareReturns(events.EventBatch{"event0"}, nil)
+
+			go func() {
+				errorCh <- eventLoop.Start(ctx)
+			}()
+
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(1))
+		})
+
+		It("should wait for more events before handling the batch", func() {
+			e1 := "event1"
+			e2 := "event2"
+
+			eventCh <- e1
+			eventCh <- e2
+
+			Consistently(fakeHandler.HandleEventBatchCallCount, "100ms").Should(Equal(1))
+			Eventually(fakeHandler.HandleEventBatchCallCount).Should(Equal(2))
+
+			_, _, batch := fakeHandler.HandleEventBatchArgsForCall(1)
+
+			var expectedBatch events.EventBatch = []interface{}{e1, e2}
+			Expect(batch).Should(Equal(expectedBatch))
+
+			Expect(fakeMetricsCollector.ObserveEventBatchSizeArgsForCall(0)).To(Equal(2))
+			Expect(fakeMetricsCollector.ObserveEventBatchQueueingTimeArgsForCall(0)).To(
+				BeNumerically(">=", 200*time.Millisecond),
+			)
+		})
+	})
+
 	Describe("Edge cases", func() {
 		It("should return error when preparer returns error without blocking", func(ctx SpecContext) {
 			preparerError := errors.New("test")
@@ -24,6 +24,7 @@ var gatewayCRDs = map[string]apiVersion{
 	"backendtlspolicies.gateway.networking.k8s.io": {},
 	"grpcroutes.gateway.networking.k8s.io":         {},
 	"tlsroutes.gateway.networking.k8s.io":          {},
+	"backendlbpolicies.gateway.networking.k8s.io":  {},
 }
 
 type apiVersion struct {
@@ -21,6 +21,8 @@ const (
 	GRPCRoute = "GRPCRoute"
 	// TLSRoute is the TLSRoute kind.
 	TLSRoute = "TLSRoute"
+	// BackendLBPolicy is the BackendLBPolicy kind.
+	BackendLBPolicy = "BackendLBPolicy"
 )
 
 // Core API Kinds.
@@ -41,6 +43,16 @@ const (
 	SnippetsFilter = "SnippetsFilter"
 	// UpstreamSettingsPolicy is the UpstreamSettingsPolicy kind.
 	UpstreamSettingsPolicy = "UpstreamSettingsPolicy"
+	// ProxyCachePolicy is the ProxyCachePolicy kind.
+	ProxyCachePolicy = "ProxyCachePolicy"
+	// CompressionPolicy is the CompressionPolicy kind.
+	CompressionPolicy = "CompressionPolicy"
+	// ErrorPagePolicy is the ErrorPagePolicy kind.
+	ErrorPagePolicy = "ErrorPagePolicy"
+	// Canary is the Canary kind.
+	Canary = "Canary"
+	// ProxySettingsPolicy is the ProxySettingsPolicy kind.
+	ProxySettingsPolicy = "ProxySettingsPolicy"
 )
 
 // MustExtractGVK is a function that extracts the GroupVersionKind (GVK) of a client.object.
@@ -141,6 +141,8 @@ func StartManager(cfg Config) error {
 		cfg.Logger.WithName("eventLoop"),
 		handler,
 		firstBatchPreparer,
+		events.BatchingConfig{},
+		events.NoopMetricsCollector{},
 	)
 
 	if err := mgr.Add(eventLoop); err != nil {
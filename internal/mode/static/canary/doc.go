@@ -0,0 +1,12 @@
+/*
+Package canary is responsible for progressing Canaries.
+
+A Canary shifts the traffic of an HTTPRoute rule from its stable backend to its canary backend in steps.
+The Progressor periodically moves every Canary in the Graph through its steps and records the progress and
+the weight of the canary backend in the status of the Canary. The Graph applies that weight to the targeted
+rule, so the HTTPRoute is not modified. With NGINX Plus,
+the Progressor rolls the Canary back when the error rate of the canary upstream exceeds the threshold, and holds
+the Canary at its step until the canary upstream served enough responses to evaluate it. The response counters
+come from the NGINX Plus API of the local NGINX instance only.
+*/
+package canary
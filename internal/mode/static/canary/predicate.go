@@ -0,0 +1,38 @@
+package canary
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+// WeightChangedPredicate implements an update predicate function based on the status of a Canary.
+// The weights of a Canary are applied from its status, so this predicate passes the update events that change
+// the weight of the canary backend or the generation that the status is for. It skips all other status updates.
+type WeightChangedPredicate struct {
+	predicate.Funcs
+}
+
+// Update filters UpdateEvents based on the weight in the status of the Canary.
+func (WeightChangedPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+
+	oldCanary, ok := e.ObjectOld.(*ngfAPI.Canary)
+	if !ok {
+		return false
+	}
+
+	newCanary, ok := e.ObjectNew.(*ngfAPI.Canary)
+	if !ok {
+		return false
+	}
+
+	oldStatus, newStatus := oldCanary.Status, newCanary.Status
+
+	return oldStatus.CanaryWeight != newStatus.CanaryWeight ||
+		oldStatus.ObservedGeneration != newStatus.ObservedGeneration ||
+		(oldStatus.Phase == "") != (newStatus.Phase == "")
+}
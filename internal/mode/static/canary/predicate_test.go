@@ -0,0 +1,99 @@
+package canary
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+func TestWeightChangedPredicate(t *testing.T) {
+	t.Parallel()
+
+	status := ngfAPI.CanaryStatus{
+		Phase:              ngfAPI.CanaryPhaseProgressing,
+		ObservedGeneration: 1,
+		CurrentStep:        1,
+		CanaryWeight:       20,
+	}
+
+	withStatus := func(mutate func(*ngfAPI.CanaryStatus)) *ngfAPI.Canary {
+		canary := &ngfAPI.Canary{Status: *status.DeepCopy()}
+		if mutate != nil {
+			mutate(&canary.Status)
+		}
+		return canary
+	}
+
+	tests := []struct {
+		event    event.UpdateEvent
+		name     string
+		expected bool
+	}{
+		{
+			name:     "nil objects",
+			event:    event.UpdateEvent{},
+			expected: false,
+		},
+		{
+			name:     "not a Canary",
+			event:    event.UpdateEvent{ObjectOld: &v1.Service{}, ObjectNew: &v1.Service{}},
+			expected: false,
+		},
+		{
+			name: "only the step start time and the conditions changed",
+			event: event.UpdateEvent{
+				ObjectOld: withStatus(nil),
+				ObjectNew: withStatus(func(s *ngfAPI.CanaryStatus) {
+					s.Phase = ngfAPI.CanaryPhasePaused
+				}),
+			},
+			expected: false,
+		},
+		{
+			name: "weight changed",
+			event: event.UpdateEvent{
+				ObjectOld: withStatus(nil),
+				ObjectNew: withStatus(func(s *ngfAPI.CanaryStatus) {
+					s.CurrentStep = 2
+					s.CanaryWeight = 40
+				}),
+			},
+			expected: true,
+		},
+		{
+			name: "observed generation changed",
+			event: event.UpdateEvent{
+				ObjectOld: withStatus(nil),
+				ObjectNew: withStatus(func(s *ngfAPI.CanaryStatus) {
+					s.ObservedGeneration = 2
+				}),
+			},
+			expected: true,
+		},
+		{
+			name: "status written for the first time",
+			event: event.UpdateEvent{
+				ObjectOld: withStatus(func(s *ngfAPI.CanaryStatus) {
+					*s = ngfAPI.CanaryStatus{}
+				}),
+				ObjectNew: withStatus(nil),
+			},
+			expected: true,
+		},
+	}
+
+	p := WeightChangedPredicate{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(p.Update(test.event)).To(Equal(test.expected))
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package canary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/status"
+	ngxruntime "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+// GraphGetter gets the latest Graph.
+type GraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+// defaultInterval is the duration of a step when neither the step nor the Canary set one.
+const defaultInterval = time.Minute
+
+// defaultMinRequestsTimeout is how long a step waits for the minimum number of requests of the analysis
+// after its duration has passed, when the Canary doesn't set it.
+const defaultMinRequestsTimeout = 10 * time.Minute
+
+// ProgressorConfig holds the configuration for the Progressor.
+type ProgressorConfig struct {
+	// K8sClient is a Kubernetes API client.
+	K8sClient client.Client
+	// GraphGetter gets the latest Graph.
+	GraphGetter GraphGetter
+	// PlusClient is the NGINX Plus API client. It is nil if NGINX Plus is not used.
+	// The Graph marks the Canaries with an analysis as invalid if NGINX Plus is not used.
+	PlusClient ngxruntime.NginxPlusClient
+	// Logger is the logger.
+	Logger logr.Logger
+}
+
+// responseCounters are the response counters of an upstream.
+type responseCounters struct {
+	total  uint64
+	errors uint64
+}
+
+// Progressor progresses the Canaries of the Graph.
+type Progressor struct {
+	// now returns the current time.
+	now func() time.Time
+	// baselines holds the response counters of the canary upstream of every Canary at the start of its
+	// current step. The error rate of a step is calculated from the difference to the baseline.
+	baselines     map[types.NamespacedName]responseCounters
+	statusUpdater *status.Updater
+	cfg           ProgressorConfig
+}
+
+// NewProgressor creates a new Progressor.
+func NewProgressor(cfg ProgressorConfig) *Progressor {
+	return &Progressor{
+		cfg:           cfg,
+		now:           time.Now,
+		baselines:     make(map[types.NamespacedName]responseCounters),
+		statusUpdater: status.NewUpdater(cfg.K8sClient, cfg.Logger),
+	}
+}
+
+// Progress progresses all Canaries of the latest Graph. It is meant to be run periodically as the worker
+// of a cronjob by the leader.
+func (p *Progressor) Progress(ctx context.Context) {
+	g := p.cfg.GraphGetter.GetLatestGraph()
+	if g == nil {
+		return
+	}
+
+	for nsname, canary := range g.Canaries {
+		if err := p.progress(ctx, g, canary); err != nil {
+			p.cfg.Logger.Error(
+				err,
+				"Failed to progress Canary",
+				"namespace", nsname.Namespace,
+				"name", nsname.Name,
+			)
+		}
+	}
+
+	for nsname := range p.baselines {
+		if _, exists := g.Canaries[nsname]; !exists {
+			delete(p.baselines, nsname)
+		}
+	}
+}
+
+func (p *Progressor) progress(ctx context.Context, g *graph.Graph, canary *graph.Canary) error {
+	nsname := client.ObjectKeyFromObject(canary.Source)
+
+	// The Canary in the Graph might be behind the status that was written in the previous run.
+	var current ngfAPI.Canary
+	if err := p.cfg.K8sClient.Get(ctx, nsname, &current); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error getting Canary: %w", err)
+	}
+
+	now := p.now()
+
+	if current.Generation != canary.Source.Generation {
+		// The Graph hasn't caught up with the latest spec yet.
+		return nil
+	}
+
+	if !canary.Valid {
+		p.updateStatus(ctx, &current, invalidStatus(current.Status, canary.Conditions, current.Generation, now))
+		return nil
+	}
+
+	newStatus := *current.Status.DeepCopy()
+
+	// When the spec changes, the Canary starts again from the first step.
+	if newStatus.ObservedGeneration != current.Generation || newStatus.Phase == "" {
+		newStatus = ngfAPI.CanaryStatus{
+			ObservedGeneration: current.Generation,
+			Phase:              ngfAPI.CanaryPhaseProgressing,
+			StepStartTime:      helpers.GetPointer(metav1.NewTime(now)),
+		}
+		delete(p.baselines, nsname)
+	}
+
+	upstreamName := getCanaryUpstreamName(g, canary)
+
+	if err := p.advance(&current, &newStatus, upstreamName, now); err != nil {
+		return err
+	}
+
+	// The Graph applies the weight from the status to the targeted rule, so the HTTPRoute is not modified.
+	newStatus.CanaryWeight = canaryWeight(current.Spec, newStatus)
+
+	rolledBack := apimeta.FindStatusCondition(newStatus.Conditions, string(ngfAPI.CanaryConditionTypeRolledBack))
+	stalled := apimeta.FindStatusCondition(newStatus.Conditions, string(ngfAPI.CanaryConditionTypeStalled))
+
+	conds := []conditions.Condition{staticConds.NewCanaryAccepted()}
+	newStatus.Conditions = conditions.ConvertConditions(conds, current.Generation, metav1.NewTime(now))
+
+	if newStatus.Phase == ngfAPI.CanaryPhaseRolledBack && rolledBack != nil {
+		newStatus.Conditions = append(newStatus.Conditions, *rolledBack)
+	}
+
+	if stalled != nil {
+		newStatus.Conditions = append(newStatus.Conditions, *stalled)
+	}
+
+	p.updateStatus(ctx, &current, newStatus)
+
+	return nil
+}
+
+// advance moves the Canary to its next phase or step, if it's time to.
+func (p *Progressor) advance(
+	canary *ngfAPI.Canary,
+	canaryStatus *ngfAPI.CanaryStatus,
+	upstreamName string,
+	now time.Time,
+) error {
+	nsname := client.ObjectKeyFromObject(canary)
+	spec := canary.Spec
+
+	apimeta.RemoveStatusCondition(&canaryStatus.Conditions, string(ngfAPI.CanaryConditionTypeStalled))
+
+	switch canaryStatus.Phase {
+	case ngfAPI.CanaryPhaseSucceeded, ngfAPI.CanaryPhaseRolledBack:
+		return nil
+	case ngfAPI.CanaryPhasePaused:
+		if spec.Paused {
+			return nil
+		}
+
+		// The time spent paused doesn't count towards the current step.
+		canaryStatus.Phase = ngfAPI.CanaryPhaseProgressing
+		canaryStatus.StepStartTime = helpers.GetPointer(metav1.NewTime(now))
+		delete(p.baselines, nsname)
+	default:
+		if spec.Paused {
+			canaryStatus.Phase = ngfAPI.CanaryPhasePaused
+			return nil
+		}
+	}
+
+	if spec.Analysis != nil {
+		responses, err := p.getStepResponses(nsname, upstreamName)
+		if err != nil {
+			return err
+		}
+
+		// A step is never evaluated without any responses, so that a Canary without traffic doesn't progress.
+		minRequests := uint64(1)
+		if spec.Analysis.MinRequests != nil && *spec.Analysis.MinRequests > 1 {
+			minRequests = uint64(*spec.Analysis.MinRequests) //nolint:gosec // validated to be non-negative
+		}
+
+		enoughRequests := responses.total >= minRequests
+		if !enoughRequests {
+			timedOut, err := minRequestsTimedOut(spec, *canaryStatus, now)
+			if err != nil {
+				return err
+			}
+
+			if !timedOut {
+				// Not enough traffic to evaluate the canary backend yet.
+				return nil
+			}
+
+			// The responses so far are evaluated, so that a failing canary backend with low traffic is rolled back.
+		}
+
+		maxErrorRate := uint64(spec.Analysis.MaxErrorRate) //nolint:gosec // validated to be non-negative
+
+		if responses.total > 0 && responses.errors*100 > maxErrorRate*responses.total {
+			errorRate := responses.errors * 100 / responses.total
+			canaryStatus.Phase = ngfAPI.CanaryPhaseRolledBack
+
+			msg := fmt.Sprintf(
+				"Error rate %d%% of the canary backend exceeded the maximum error rate %d%% at step %d",
+				errorRate,
+				spec.Analysis.MaxErrorRate,
+				canaryStatus.CurrentStep,
+			)
+			rolledBack := conditions.ConvertConditions(
+				[]conditions.Condition{staticConds.NewCanaryRolledBack(msg)},
+				canary.Generation,
+				metav1.NewTime(now),
+			)
+			apimeta.SetStatusCondition(&canaryStatus.Conditions, rolledBack[0])
+
+			return nil
+		}
+
+		if !enoughRequests {
+			// The Canary doesn't progress without evaluating the canary backend, so it's held at the step.
+			msg := fmt.Sprintf(
+				"The canary backend served fewer responses than the %d required by the analysis at step %d",
+				minRequests,
+				canaryStatus.CurrentStep,
+			)
+			stalled := conditions.ConvertConditions(
+				[]conditions.Condition{staticConds.NewCanaryStalled(msg)},
+				canary.Generation,
+				metav1.NewTime(now),
+			)
+			apimeta.SetStatusCondition(&canaryStatus.Conditions, stalled[0])
+
+			return nil
+		}
+	}
+
+	stepDuration, err := getStepDuration(spec, canaryStatus.CurrentStep)
+	if err != nil {
+		return err
+	}
+
+	if canaryStatus.StepStartTime != nil && now.Sub(canaryStatus.StepStartTime.Time) < stepDuration {
+		return nil
+	}
+
+	if int(canaryStatus.CurrentStep) >= len(spec.Steps)-1 {
+		canaryStatus.Phase = ngfAPI.CanaryPhaseSucceeded
+		return nil
+	}
+
+	canaryStatus.CurrentStep++
+	canaryStatus.StepStartTime = helpers.GetPointer(metav1.NewTime(now))
+	delete(p.baselines, nsname)
+
+	return nil
+}
+
+// getStepResponses returns the number of responses and error responses of the canary upstream since the start
+// of the current step.
+func (p *Progressor) getStepResponses(nsname types.NamespacedName, upstreamName string) (responseCounters, error) {
+	upstreams, err := p.cfg.PlusClient.GetUpstreams()
+	if err != nil {
+		return responseCounters{}, fmt.Errorf("error getting upstreams from NGINX Plus API: %w", err)
+	}
+
+	var current responseCounters
+	if upstream, exists := (*upstreams)[upstreamName]; exists {
+		for _, peer := range upstream.Peers {
+			current.total += peer.Responses.Total
+			current.errors += peer.Responses.Responses5xx
+		}
+	}
+
+	baseline, exists := p.baselines[nsname]
+	// The counters are reset when the upstream is recreated, for example, when its endpoints change.
+	if !exists || current.total < baseline.total || current.errors < baseline.errors {
+		p.baselines[nsname] = current
+		return responseCounters{}, nil
+	}
+
+	return responseCounters{
+		total:  current.total - baseline.total,
+		errors: current.errors - baseline.errors,
+	}, nil
+}
+
+func (p *Progressor) updateStatus(
+	ctx context.Context,
+	canary *ngfAPI.Canary,
+	newStatus ngfAPI.CanaryStatus,
+) {
+	p.statusUpdater.Update(ctx, status.UpdateRequest{
+		NsName:       client.ObjectKeyFromObject(canary),
+		ResourceType: &ngfAPI.Canary{},
+		Setter: func(obj client.Object) bool {
+			c := helpers.MustCastObject[*ngfAPI.Canary](obj)
+
+			if canaryStatusEqual(c.Status, newStatus) {
+				return false
+			}
+
+			c.Status = newStatus
+
+			return true
+		},
+	})
+}
+
+// invalidStatus returns the status of an invalid Canary. The progress of the Canary is kept, so that it
+// continues from the same step once it becomes valid again with the same spec.
+func invalidStatus(
+	prev ngfAPI.CanaryStatus,
+	conds []conditions.Condition,
+	generation int64,
+	now time.Time,
+) ngfAPI.CanaryStatus {
+	newStatus := *prev.DeepCopy()
+	newStatus.Conditions = conditions.ConvertConditions(conds, generation, metav1.NewTime(now))
+
+	return newStatus
+}
+
+func canaryStatusEqual(prev, cur ngfAPI.CanaryStatus) bool {
+	if prev.Phase != cur.Phase ||
+		prev.CurrentStep != cur.CurrentStep ||
+		prev.CanaryWeight != cur.CanaryWeight ||
+		prev.ObservedGeneration != cur.ObservedGeneration {
+		return false
+	}
+
+	if (prev.StepStartTime == nil) != (cur.StepStartTime == nil) {
+		return false
+	}
+
+	if prev.StepStartTime != nil && !prev.StepStartTime.Equal(cur.StepStartTime) {
+		return false
+	}
+
+	return status.ConditionsEqual(prev.Conditions, cur.Conditions)
+}
+
+// canaryWeight returns the weight of the canary backend for the phase and the step of the Canary.
+func canaryWeight(spec ngfAPI.CanarySpec, canaryStatus ngfAPI.CanaryStatus) int32 {
+	if canaryStatus.Phase == ngfAPI.CanaryPhaseRolledBack {
+		return 0
+	}
+
+	return spec.Steps[canaryStatus.CurrentStep].Weight
+}
+
+func getStepDuration(spec ngfAPI.CanarySpec, step int32) (time.Duration, error) {
+	if pause := spec.Steps[step].Pause; pause != nil {
+		return parseDuration(*pause)
+	}
+
+	if spec.Interval != nil {
+		return parseDuration(*spec.Interval)
+	}
+
+	return defaultInterval, nil
+}
+
+// minRequestsTimedOut returns whether the current step has waited for the minimum number of requests of
+// the analysis for longer than the timeout after its duration passed.
+func minRequestsTimedOut(spec ngfAPI.CanarySpec, canaryStatus ngfAPI.CanaryStatus, now time.Time) (bool, error) {
+	if canaryStatus.StepStartTime == nil {
+		return false, nil
+	}
+
+	stepDuration, err := getStepDuration(spec, canaryStatus.CurrentStep)
+	if err != nil {
+		return false, err
+	}
+
+	timeout := defaultMinRequestsTimeout
+	if spec.Analysis.MinRequestsTimeout != nil {
+		timeout, err = parseDuration(*spec.Analysis.MinRequestsTimeout)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return now.Sub(canaryStatus.StepStartTime.Time) >= stepDuration+timeout, nil
+}
+
+// parseDuration parses an NGF Duration. A Duration without a suffix is in seconds.
+func parseDuration(d ngfAPI.Duration) (time.Duration, error) {
+	s := string(d)
+	if !strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "m") && !strings.HasSuffix(s, "h") {
+		s += "s"
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing duration %q: %w", d, err)
+	}
+
+	return duration, nil
+}
+
+// getCanaryUpstreamName returns the name of the upstream of the canary backend.
+func getCanaryUpstreamName(g *graph.Graph, canary *graph.Canary) string {
+	route, exists := g.Routes[canary.RouteKey]
+	if !exists || canary.RuleIdx >= len(route.Spec.Rules) {
+		return ""
+	}
+
+	refs := route.Spec.Rules[canary.RuleIdx].BackendRefs
+	if canary.CanaryBackendIdx >= len(refs) {
+		return ""
+	}
+
+	return refs[canary.CanaryBackendIdx].ServicePortReference()
+}
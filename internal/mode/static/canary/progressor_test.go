@@ -0,0 +1,441 @@
+package canary
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
+	. "github.com/onsi/gomega"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/runtime/runtimefakes"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+)
+
+const testNs = "test"
+
+var (
+	canaryNsName = types.NamespacedName{Namespace: testNs, Name: "canary"}
+	routeNsName  = types.NamespacedName{Namespace: testNs, Name: "route"}
+)
+
+func createCanary(spec ngfAPI.CanarySpec) *ngfAPI.Canary {
+	spec.TargetRef = ngfAPI.CanaryTargetRef{Name: v1.ObjectName(routeNsName.Name)}
+	spec.CanaryBackend = "canary"
+
+	return &ngfAPI.Canary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      canaryNsName.Name,
+			Namespace: canaryNsName.Namespace,
+		},
+		Spec: spec,
+	}
+}
+
+func createGraph(canary *ngfAPI.Canary, valid bool, conds ...conditions.Condition) *graph.Graph {
+	routeKey := graph.RouteKey{NamespacedName: routeNsName, RouteType: graph.RouteTypeHTTP}
+
+	return &graph.Graph{
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			routeKey: {
+				Spec: graph.L7RouteSpec{
+					Rules: []graph.RouteRule{
+						{
+							BackendRefs: []graph.BackendRef{
+								{
+									SvcNsName: types.NamespacedName{Namespace: testNs, Name: "stable"},
+									Valid:     true,
+								},
+								{
+									SvcNsName: types.NamespacedName{Namespace: testNs, Name: "canary"},
+									Valid:     true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Canaries: map[types.NamespacedName]*graph.Canary{
+			canaryNsName: {
+				Source:           canary,
+				RouteKey:         routeKey,
+				CanaryBackendIdx: 1,
+				Conditions:       conds,
+				Valid:            valid,
+			},
+		},
+	}
+}
+
+// graphGetter returns the same Graph on every call.
+type graphGetter struct {
+	g *graph.Graph
+}
+
+func (gg graphGetter) GetLatestGraph() *graph.Graph {
+	return gg.g
+}
+
+func createK8sClient(objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1.Install(scheme))
+	utilruntime.Must(ngfAPI.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&ngfAPI.Canary{}).
+		Build()
+}
+
+func createProgressor(
+	k8sClient client.Client,
+	g *graph.Graph,
+	plusClient *runtimefakes.FakeNginxPlusClient,
+	now *time.Time,
+) *Progressor {
+	cfg := ProgressorConfig{
+		K8sClient:   k8sClient,
+		GraphGetter: graphGetter{g: g},
+		Logger:      logr.Discard(),
+	}
+	if plusClient != nil {
+		cfg.PlusClient = plusClient
+	}
+
+	p := NewProgressor(cfg)
+	p.now = func() time.Time { return *now }
+
+	return p
+}
+
+func getCanaryStatus(g *WithT, k8sClient client.Client) ngfAPI.CanaryStatus {
+	var canary ngfAPI.Canary
+	g.Expect(k8sClient.Get(context.Background(), canaryNsName, &canary)).To(Succeed())
+
+	return canary.Status
+}
+
+func TestProgressSteps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	canary := createCanary(ngfAPI.CanarySpec{
+		Interval: helpers.GetPointer[ngfAPI.Duration]("1m"),
+		Steps: []ngfAPI.CanaryStep{
+			{Weight: 10},
+			{Weight: 50, Pause: helpers.GetPointer[ngfAPI.Duration]("30")},
+			{Weight: 100},
+		},
+	})
+
+	k8sClient := createK8sClient(canary)
+	now := time.Now().Truncate(time.Second)
+	p := createProgressor(k8sClient, createGraph(canary, true), nil, &now)
+
+	steps := []struct {
+		after     time.Duration
+		expPhase  ngfAPI.CanaryPhase
+		expStep   int32
+		expWeight int32
+	}{
+		{after: 0, expPhase: ngfAPI.CanaryPhaseProgressing, expStep: 0, expWeight: 10},
+		{after: 30 * time.Second, expPhase: ngfAPI.CanaryPhaseProgressing, expStep: 0, expWeight: 10},
+		{after: 30 * time.Second, expPhase: ngfAPI.CanaryPhaseProgressing, expStep: 1, expWeight: 50},
+		{after: 30 * time.Second, expPhase: ngfAPI.CanaryPhaseProgressing, expStep: 2, expWeight: 100},
+		{after: time.Minute, expPhase: ngfAPI.CanaryPhaseSucceeded, expStep: 2, expWeight: 100},
+	}
+
+	for _, step := range steps {
+		now = now.Add(step.after)
+		p.Progress(context.Background())
+
+		status := getCanaryStatus(g, k8sClient)
+		g.Expect(status.Phase).To(Equal(step.expPhase))
+		g.Expect(status.CurrentStep).To(Equal(step.expStep))
+		g.Expect(status.CanaryWeight).To(Equal(step.expWeight))
+
+		cond := apimeta.FindStatusCondition(status.Conditions, string(ngfAPI.CanaryConditionTypeAccepted))
+		g.Expect(cond).ToNot(BeNil())
+		g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	}
+}
+
+func TestProgressPaused(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	canary := createCanary(ngfAPI.CanarySpec{
+		Steps:  []ngfAPI.CanaryStep{{Weight: 20}, {Weight: 100}},
+		Paused: true,
+	})
+
+	k8sClient := createK8sClient(canary)
+	now := time.Now()
+	p := createProgressor(k8sClient, createGraph(canary, true), nil, &now)
+
+	p.Progress(context.Background())
+
+	now = now.Add(time.Hour)
+	p.Progress(context.Background())
+
+	status := getCanaryStatus(g, k8sClient)
+	g.Expect(status.Phase).To(Equal(ngfAPI.CanaryPhasePaused))
+	g.Expect(status.CurrentStep).To(BeZero())
+	g.Expect(status.CanaryWeight).To(Equal(int32(20)))
+}
+
+func TestProgressAnalysis(t *testing.T) {
+	t.Parallel()
+
+	createUpstreams := func(total, errors uint64) *ngxclient.Upstreams {
+		return &ngxclient.Upstreams{
+			"test_canary_0": {
+				Peers: []ngxclient.Peer{
+					{Responses: ngxclient.Responses{Total: total, Responses5xx: errors}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		minRequests    *int32
+		name           string
+		expRollbackMsg string
+		expStalledMsg  string
+		expPhase       ngfAPI.CanaryPhase
+		upstreams      []*ngxclient.Upstreams
+		after          time.Duration
+		expStep        int32
+		expWeight      int32
+		upstreamsErr   bool
+	}{
+		{
+			name: "error rate below the threshold",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1100, 105),
+			},
+			expPhase:  ngfAPI.CanaryPhaseProgressing,
+			expStep:   1,
+			expWeight: 50,
+		},
+		{
+			name: "error rate above the threshold",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1100, 130),
+			},
+			expPhase:       ngfAPI.CanaryPhaseRolledBack,
+			expStep:        0,
+			expWeight:      0,
+			expRollbackMsg: "Error rate 30% of the canary backend exceeded the maximum error rate 20% at step 0",
+		},
+		{
+			name: "not enough requests",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1050, 100),
+			},
+			expPhase:  ngfAPI.CanaryPhaseProgressing,
+			expStep:   0,
+			expWeight: 10,
+		},
+		{
+			name: "not enough requests until the timeout",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1050, 105),
+			},
+			after:     defaultInterval + defaultMinRequestsTimeout,
+			expPhase:  ngfAPI.CanaryPhaseProgressing,
+			expStep:   0,
+			expWeight: 10,
+			expStalledMsg: "The canary backend served fewer responses than the 100 required by the analysis " +
+				"at step 0",
+		},
+		{
+			name: "no requests until the timeout",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1000, 100),
+			},
+			after:     defaultInterval + defaultMinRequestsTimeout,
+			expPhase:  ngfAPI.CanaryPhaseProgressing,
+			expStep:   0,
+			expWeight: 10,
+			expStalledMsg: "The canary backend served fewer responses than the 100 required by the analysis " +
+				"at step 0",
+		},
+		{
+			name: "no requests until the timeout without min requests",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1000, 100),
+			},
+			minRequests: helpers.GetPointer[int32](0),
+			after:       defaultInterval + defaultMinRequestsTimeout,
+			expPhase:    ngfAPI.CanaryPhaseProgressing,
+			expStep:     0,
+			expWeight:   10,
+			expStalledMsg: "The canary backend served fewer responses than the 1 required by the analysis " +
+				"at step 0",
+		},
+		{
+			name: "one request without min requests",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1001, 100),
+			},
+			minRequests: helpers.GetPointer[int32](0),
+			expPhase:    ngfAPI.CanaryPhaseProgressing,
+			expStep:     1,
+			expWeight:   50,
+		},
+		{
+			name: "not enough requests until the timeout with errors",
+			upstreams: []*ngxclient.Upstreams{
+				createUpstreams(1000, 100),
+				createUpstreams(1050, 130),
+			},
+			after:          defaultInterval + defaultMinRequestsTimeout,
+			expPhase:       ngfAPI.CanaryPhaseRolledBack,
+			expStep:        0,
+			expWeight:      0,
+			expRollbackMsg: "Error rate 60% of the canary backend exceeded the maximum error rate 20% at step 0",
+		},
+		{
+			// The Canary isn't progressed and its status isn't written.
+			name:         "error getting upstreams",
+			upstreamsErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			canary := createCanary(ngfAPI.CanarySpec{
+				Steps: []ngfAPI.CanaryStep{{Weight: 10}, {Weight: 50}},
+				Analysis: &ngfAPI.CanaryAnalysis{
+					MaxErrorRate: 20,
+					MinRequests:  helpers.GetPointer[int32](100),
+				},
+			})
+			if test.minRequests != nil {
+				canary.Spec.Analysis.MinRequests = test.minRequests
+			}
+
+			plusClient := &runtimefakes.FakeNginxPlusClient{}
+			if test.upstreamsErr {
+				plusClient.GetUpstreamsReturns(nil, errors.New("error"))
+			}
+			for i, u := range test.upstreams {
+				plusClient.GetUpstreamsReturnsOnCall(i, u, nil)
+			}
+
+			k8sClient := createK8sClient(canary)
+			now := time.Now()
+			p := createProgressor(k8sClient, createGraph(canary, true), plusClient, &now)
+
+			// The first run sets the baseline of the step.
+			p.Progress(context.Background())
+
+			after := test.after
+			if after == 0 {
+				after = 2 * defaultInterval
+			}
+
+			now = now.Add(after)
+			p.Progress(context.Background())
+
+			status := getCanaryStatus(g, k8sClient)
+			g.Expect(status.Phase).To(Equal(test.expPhase))
+			g.Expect(status.CurrentStep).To(Equal(test.expStep))
+			g.Expect(status.CanaryWeight).To(Equal(test.expWeight))
+
+			cond := apimeta.FindStatusCondition(status.Conditions, string(ngfAPI.CanaryConditionTypeRolledBack))
+			if test.expRollbackMsg != "" {
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Reason).To(Equal(string(ngfAPI.CanaryConditionReasonErrorRateExceeded)))
+				g.Expect(cond.Message).To(Equal(test.expRollbackMsg))
+			} else {
+				g.Expect(cond).To(BeNil())
+			}
+
+			cond = apimeta.FindStatusCondition(status.Conditions, string(ngfAPI.CanaryConditionTypeStalled))
+			if test.expStalledMsg != "" {
+				g.Expect(cond).ToNot(BeNil())
+				g.Expect(cond.Reason).To(Equal(string(ngfAPI.CanaryConditionReasonInsufficientRequests)))
+				g.Expect(cond.Message).To(Equal(test.expStalledMsg))
+			} else {
+				g.Expect(cond).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestProgressInvalid(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	canary := createCanary(ngfAPI.CanarySpec{Steps: []ngfAPI.CanaryStep{{Weight: 10}}})
+	conds := []conditions.Condition{staticConds.NewCanaryInvalid("invalid")}
+
+	k8sClient := createK8sClient(canary)
+	now := time.Now()
+	p := createProgressor(k8sClient, createGraph(canary, false, conds...), nil, &now)
+
+	p.Progress(context.Background())
+
+	status := getCanaryStatus(g, k8sClient)
+	g.Expect(status.Phase).To(BeEmpty())
+	g.Expect(status.CanaryWeight).To(BeZero())
+
+	cond := apimeta.FindStatusCondition(status.Conditions, string(ngfAPI.CanaryConditionTypeAccepted))
+	g.Expect(cond).ToNot(BeNil())
+	g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(cond.Message).To(Equal("invalid"))
+}
+
+func TestParseDuration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		duration ngfAPI.Duration
+		expected time.Duration
+	}{
+		{duration: "30", expected: 30 * time.Second},
+		{duration: "500ms", expected: 500 * time.Millisecond},
+		{duration: "10s", expected: 10 * time.Second},
+		{duration: "5m", expected: 5 * time.Minute},
+		{duration: "1h", expected: time.Hour},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.duration), func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			d, err := parseDuration(test.duration)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(d).To(Equal(test.expected))
+		})
+	}
+}
@@ -40,10 +40,19 @@ type Config struct {
 	MetricsConfig MetricsConfig
 	// HealthConfig specifies the health probe config.
 	HealthConfig HealthConfig
+	// DebugConfig specifies the debug server config.
+	DebugConfig DebugConfig
+	// EventBatchingConfig specifies how Kubernetes events are batched before they are processed.
+	EventBatchingConfig EventBatchingConfig
+	// EndpointDrainPeriod is the maximum time NGINX Plus drains the connections to terminating endpoints
+	// before removing them. Draining is disabled if it is 0.
+	EndpointDrainPeriod time.Duration
 	// UpdateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	UpdateGatewayClassStatus bool
 	// Plus indicates whether NGINX Plus is being used.
 	Plus bool
+	// DynamicUpstreams indicates if the servers of the upstreams are updated without reloading NGINX OSS.
+	DynamicUpstreams bool
 	// ExperimentalFeatures indicates if experimental features are enabled.
 	ExperimentalFeatures bool
 	// SnippetsFilters indicates if SnippetsFilters are enabled.
@@ -62,6 +71,8 @@ type GatewayPodConfig struct {
 	Name string
 	// UID is the UID of the Pod.
 	UID string
+	// NodeName is the name of the Node where the Pod runs. It is empty if unknown.
+	NodeName string
 }
 
 // MetricsConfig specifies the metrics config.
@@ -82,6 +93,28 @@ type HealthConfig struct {
 	Enabled bool
 }
 
+// DebugConfig specifies the debug server config.
+type DebugConfig struct {
+	// TokenFile is the path to the file that contains the bearer token for authenticating requests.
+	TokenFile string
+	// Port is the port that the debug server listens on.
+	Port int
+	// Enabled is the flag for toggling the debug server on or off.
+	Enabled bool
+}
+
+// EventBatchingConfig specifies how Kubernetes events are batched before they are processed.
+type EventBatchingConfig struct {
+	// MinDelay is how long to wait for more events after receiving an event before processing the batch.
+	MinDelay time.Duration
+	// MaxDelay is the maximum time the oldest event in a batch waits because of MinDelay.
+	MaxDelay time.Duration
+	// MinInterval is the minimum time between processing two consecutive batches.
+	MinInterval time.Duration
+	// MaxBatchSize is the number of events at which a batch is processed without waiting for MinDelay.
+	MaxBatchSize int
+}
+
 // LeaderElectionConfig contains the configuration for leader election.
 type LeaderElectionConfig struct {
 	// LockName holds the name of the leader election lock.
@@ -0,0 +1,357 @@
+package static
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+// maxConfigDiffEventItems is the maximum number of items listed per category in a configuration diff Event.
+// Events are meant to be short, so the remaining items are only counted. The debug log includes all items.
+const maxConfigDiffEventItems = 5
+
+// configDiff is the difference between two NGINX configurations.
+// Servers are identified by their protocol, hostname and port, locations by their server, path type and path.
+type configDiff struct {
+	addedFiles       []string
+	removedFiles     []string
+	changedFiles     []string
+	addedServers     []string
+	removedServers   []string
+	addedLocations   []string
+	removedLocations []string
+	addedUpstreams   []string
+	removedUpstreams []string
+}
+
+// configScope is the part of a configuration that belongs to a Gateway: the servers of its Listeners.
+// Listeners of different Gateways can only share a port if their hostnames don't overlap (see the graph package),
+// so a server belongs to the Gateway that has a Listener on its port with a hostname that matches its hostname.
+// The default server of a port belongs to the first Gateway that has a Listener on the port.
+type configScope struct {
+	// hostnames are the hostnames of the valid Listeners of the Gateway by port.
+	// An empty hostname matches all hostnames.
+	hostnames map[int32][]string
+	// defaultServerPorts are the ports of the default servers that belong to the Gateway.
+	defaultServerPorts map[int32]struct{}
+}
+
+func newConfigScope() configScope {
+	return configScope{
+		hostnames:          make(map[int32][]string),
+		defaultServerPorts: make(map[int32]struct{}),
+	}
+}
+
+// includesServer returns true if the server with the hostname and port belongs to the scope.
+func (s configScope) includesServer(hostname string, port int32, isDefault bool) bool {
+	if isDefault {
+		_, exists := s.defaultServerPorts[port]
+		return exists
+	}
+
+	for _, h := range s.hostnames[port] {
+		if h == "" || h == hostname || (strings.HasPrefix(h, "*.") && strings.HasSuffix(hostname, h[1:])) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// merge returns a scope that includes the servers of both scopes.
+func (s configScope) merge(other configScope) configScope {
+	merged := newConfigScope()
+
+	for _, scope := range []configScope{s, other} {
+		for port, hostnames := range scope.hostnames {
+			merged.hostnames[port] = append(merged.hostnames[port], hostnames...)
+		}
+
+		for port := range scope.defaultServerPorts {
+			merged.defaultServerPorts[port] = struct{}{}
+		}
+	}
+
+	return merged
+}
+
+// diffConfigurations computes the difference between the previous and the new NGINX configuration.
+// prevConf and prevFiles are nil if NGINX hasn't been configured yet.
+// If scope is not nil, the diff is limited to the servers of the scope, and to the upstreams and files
+// that those servers reference: the TLS secrets, the certificate bundles and the upstream state files.
+// The config version file changes with every configuration, so it is never part of the diff.
+func diffConfigurations(
+	prevConf *dataplane.Configuration,
+	prevFiles []file.File,
+	conf dataplane.Configuration,
+	files []file.File,
+	scope *configScope,
+) configDiff {
+	var diff configDiff
+
+	var prev configItems
+	if prevConf != nil {
+		prev = getConfigItems(*prevConf, scope)
+	}
+
+	cur := getConfigItems(conf, scope)
+
+	includeFile := func(path string) bool {
+		if path == ngxConfig.ConfigVersionFile {
+			return false
+		}
+
+		if scope == nil {
+			return true
+		}
+
+		id := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		_, prevExists := prev.fileIDs[id]
+		_, curExists := cur.fileIDs[id]
+
+		return prevExists || curExists
+	}
+
+	diff.addedFiles, diff.removedFiles, diff.changedFiles = diffFiles(
+		slices.DeleteFunc(slices.Clone(prevFiles), func(f file.File) bool { return !includeFile(f.Path) }),
+		slices.DeleteFunc(slices.Clone(files), func(f file.File) bool { return !includeFile(f.Path) }),
+	)
+
+	diff.addedServers, diff.removedServers = diffSets(prev.servers, cur.servers)
+	diff.addedLocations, diff.removedLocations = diffSets(prev.locations, cur.locations)
+	diff.addedUpstreams, diff.removedUpstreams = diffSets(prev.upstreams, cur.upstreams)
+
+	return diff
+}
+
+func diffFiles(prevFiles, files []file.File) (added, removed, changed []string) {
+	prevContents := make(map[string][]byte, len(prevFiles))
+	for _, f := range prevFiles {
+		prevContents[f.Path] = f.Content
+	}
+
+	contents := make(map[string][]byte, len(files))
+	for _, f := range files {
+		contents[f.Path] = f.Content
+
+		prevContent, exists := prevContents[f.Path]
+		switch {
+		case !exists:
+			added = append(added, f.Path)
+		case !bytes.Equal(prevContent, f.Content):
+			changed = append(changed, f.Path)
+		}
+	}
+
+	for _, f := range prevFiles {
+		if _, exists := contents[f.Path]; !exists {
+			removed = append(removed, f.Path)
+		}
+	}
+
+	slices.Sort(added)
+	slices.Sort(removed)
+	slices.Sort(changed)
+
+	return added, removed, changed
+}
+
+// configItems are the items of a configuration that a configDiff is computed for.
+type configItems struct {
+	servers   map[string]struct{}
+	locations map[string]struct{}
+	upstreams map[string]struct{}
+	// fileIDs are the names, without the extension, of the files that the servers reference.
+	fileIDs map[string]struct{}
+}
+
+// getConfigItems returns the items of the configuration. If scope is not nil, only the servers of the scope
+// and the upstreams and files they reference are returned.
+func getConfigItems(conf dataplane.Configuration, scope *configScope) configItems {
+	items := configItems{
+		servers:   make(map[string]struct{}),
+		locations: make(map[string]struct{}),
+		upstreams: make(map[string]struct{}),
+		fileIDs:   make(map[string]struct{}),
+	}
+
+	inScope := func(hostname string, port int32, isDefault bool) bool {
+		return scope == nil || scope.includesServer(hostname, port, isDefault)
+	}
+
+	allUpstreams := getUpstreamNames(conf)
+	addUpstream := func(name string) {
+		if _, exists := allUpstreams[name]; exists {
+			items.upstreams[name] = struct{}{}
+		}
+	}
+
+	addVirtualServers := func(protocol string, virtualServers []dataplane.VirtualServer) {
+		for _, s := range virtualServers {
+			if !inScope(s.Hostname, s.Port, s.IsDefault) {
+				continue
+			}
+
+			server := serverID(protocol, s.Hostname, s.Port, s.IsDefault)
+			items.servers[server] = struct{}{}
+
+			if s.SSL != nil {
+				items.fileIDs[string(s.SSL.KeyPairID)] = struct{}{}
+			}
+
+			for _, rule := range s.PathRules {
+				items.locations[fmt.Sprintf("%s %s %s", server, rule.PathType, rule.Path)] = struct{}{}
+
+				for _, mr := range rule.MatchRules {
+					for _, b := range mr.BackendGroup.Backends {
+						addUpstream(b.UpstreamName)
+						items.fileIDs[b.UpstreamName] = struct{}{}
+
+						if b.VerifyTLS != nil && b.VerifyTLS.CertBundleID != "" {
+							items.fileIDs[string(b.VerifyTLS.CertBundleID)] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	addVirtualServers("http", conf.HTTPServers)
+	addVirtualServers("https", conf.SSLServers)
+
+	for _, s := range conf.TLSPassthroughServers {
+		if !inScope(s.Hostname, s.Port, s.IsDefault) {
+			continue
+		}
+
+		items.servers[serverID("tls", s.Hostname, s.Port, s.IsDefault)] = struct{}{}
+
+		for _, u := range s.Upstreams {
+			addUpstream("stream/" + u.Name)
+			items.fileIDs[ngxConfig.StreamStateFilePrefix+u.Name] = struct{}{}
+		}
+	}
+
+	if scope == nil {
+		items.upstreams = allUpstreams
+	}
+
+	return items
+}
+
+func serverID(protocol, hostname string, port int32, isDefault bool) string {
+	if isDefault {
+		hostname = "default"
+	}
+
+	return fmt.Sprintf("%s://%s:%d", protocol, hostname, port)
+}
+
+func getUpstreamNames(conf dataplane.Configuration) map[string]struct{} {
+	upstreams := make(map[string]struct{}, len(conf.Upstreams)+len(conf.StreamUpstreams))
+
+	for _, u := range conf.Upstreams {
+		upstreams[u.Name] = struct{}{}
+	}
+
+	for _, u := range conf.StreamUpstreams {
+		upstreams["stream/"+u.Name] = struct{}{}
+	}
+
+	return upstreams
+}
+
+func diffSets(prev, cur map[string]struct{}) (added, removed []string) {
+	for item := range cur {
+		if _, exists := prev[item]; !exists {
+			added = append(added, item)
+		}
+	}
+
+	for item := range prev {
+		if _, exists := cur[item]; !exists {
+			removed = append(removed, item)
+		}
+	}
+
+	slices.Sort(added)
+	slices.Sort(removed)
+
+	return added, removed
+}
+
+// isEmpty returns true if the configurations are the same.
+func (d configDiff) isEmpty() bool {
+	return len(d.addedFiles) == 0 && len(d.removedFiles) == 0 && len(d.changedFiles) == 0 &&
+		len(d.addedServers) == 0 && len(d.removedServers) == 0 &&
+		len(d.addedLocations) == 0 && len(d.removedLocations) == 0 &&
+		len(d.addedUpstreams) == 0 && len(d.removedUpstreams) == 0
+}
+
+// logValues returns the key-value pairs of the diff for logging.
+func (d configDiff) logValues() []any {
+	return []any{
+		"addedServers", d.addedServers,
+		"removedServers", d.removedServers,
+		"addedLocations", d.addedLocations,
+		"removedLocations", d.removedLocations,
+		"addedUpstreams", d.addedUpstreams,
+		"removedUpstreams", d.removedUpstreams,
+		"addedFiles", d.addedFiles,
+		"removedFiles", d.removedFiles,
+		"changedFiles", d.changedFiles,
+	}
+}
+
+// eventMessage returns a short summary of the diff for a Kubernetes Event.
+func (d configDiff) eventMessage() string {
+	categories := []struct {
+		name  string
+		items []string
+	}{
+		{name: "added servers", items: d.addedServers},
+		{name: "removed servers", items: d.removedServers},
+		{name: "added locations", items: d.addedLocations},
+		{name: "removed locations", items: d.removedLocations},
+		{name: "added upstreams", items: d.addedUpstreams},
+		{name: "removed upstreams", items: d.removedUpstreams},
+	}
+
+	parts := make([]string, 0, len(categories)+1)
+
+	for _, c := range categories {
+		if len(c.items) == 0 {
+			continue
+		}
+
+		items := c.items
+		var more string
+		if len(items) > maxConfigDiffEventItems {
+			more = fmt.Sprintf(" and %d more", len(items)-maxConfigDiffEventItems)
+			items = items[:maxConfigDiffEventItems]
+		}
+
+		parts = append(parts, fmt.Sprintf("%s: %s%s", c.name, strings.Join(items, ", "), more))
+	}
+
+	if changedFiles := len(d.addedFiles) + len(d.removedFiles) + len(d.changedFiles); changedFiles > 0 {
+		parts = append(
+			parts,
+			fmt.Sprintf(
+				"files: %d added, %d removed, %d changed",
+				len(d.addedFiles),
+				len(d.removedFiles),
+				len(d.changedFiles),
+			),
+		)
+	}
+
+	return "NGINX configuration was updated; " + strings.Join(parts, "; ")
+}
@@ -0,0 +1,364 @@
+package static
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestDiffConfigurations(t *testing.T) {
+	t.Parallel()
+
+	fooServer := dataplane.VirtualServer{
+		Hostname: "foo.example.com",
+		Port:     80,
+		PathRules: []dataplane.PathRule{
+			{Path: "/", PathType: dataplane.PathTypePrefix},
+			{Path: "/api", PathType: dataplane.PathTypeExact},
+		},
+		SSL: &dataplane.SSL{KeyPairID: "foo"},
+	}
+	barServer := dataplane.VirtualServer{
+		Hostname: "bar.example.com",
+		Port:     80,
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						BackendGroup: dataplane.BackendGroup{
+							Backends: []dataplane.Backend{{UpstreamName: "test_bar_80"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	defaultServer := dataplane.VirtualServer{
+		IsDefault: true,
+		Port:      80,
+	}
+
+	prevConf := &dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{defaultServer, fooServer},
+		SSLServers:  []dataplane.VirtualServer{fooServer},
+		Upstreams: []dataplane.Upstream{
+			{Name: "test_foo_80"},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{Name: "test_tls_443"},
+		},
+	}
+	prevFiles := []file.File{
+		{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("http")},
+		{Path: "/etc/nginx/conf.d/config-version.conf", Content: []byte("1")},
+		{Path: "/etc/nginx/secrets/foo.pem", Content: []byte("foo")},
+	}
+
+	fooServerUpdated := fooServer
+	fooServerUpdated.PathRules = []dataplane.PathRule{
+		{Path: "/", PathType: dataplane.PathTypePrefix},
+		{Path: "/v2", PathType: dataplane.PathTypePrefix},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{defaultServer, fooServerUpdated, barServer},
+		SSLServers:  []dataplane.VirtualServer{fooServer},
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{Hostname: "tls.example.com", Port: 443},
+		},
+		Upstreams: []dataplane.Upstream{
+			{Name: "test_bar_80"},
+			{Name: "test_foo_80"},
+		},
+	}
+	files := []file.File{
+		{Path: "/etc/nginx/conf.d/http.conf", Content: []byte("http")},
+		{Path: "/etc/nginx/conf.d/config-version.conf", Content: []byte("2")},
+		{Path: "/etc/nginx/secrets/bar.pem", Content: []byte("bar")},
+	}
+
+	tests := []struct {
+		prevConf  *dataplane.Configuration
+		name      string
+		prevFiles []file.File
+		conf      dataplane.Configuration
+		files     []file.File
+		scope     *configScope
+		expDiff   configDiff
+	}{
+		{
+			name:      "no changes",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      *prevConf,
+			files:     prevFiles,
+			expDiff:   configDiff{},
+		},
+		{
+			name:  "first configuration",
+			conf:  *prevConf,
+			files: prevFiles,
+			expDiff: configDiff{
+				addedFiles: []string{
+					"/etc/nginx/conf.d/http.conf",
+					"/etc/nginx/secrets/foo.pem",
+				},
+				addedServers: []string{
+					"http://default:80",
+					"http://foo.example.com:80",
+					"https://foo.example.com:80",
+				},
+				addedLocations: []string{
+					"http://foo.example.com:80 exact /api",
+					"http://foo.example.com:80 prefix /",
+					"https://foo.example.com:80 exact /api",
+					"https://foo.example.com:80 prefix /",
+				},
+				addedUpstreams: []string{"stream/test_tls_443", "test_foo_80"},
+			},
+		},
+		{
+			name:  "first configuration limited to a default server",
+			conf:  *prevConf,
+			files: prevFiles,
+			scope: &configScope{defaultServerPorts: map[int32]struct{}{80: {}}},
+			expDiff: configDiff{
+				addedServers: []string{"http://default:80"},
+			},
+		},
+		{
+			name:      "changes",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			expDiff: configDiff{
+				addedFiles:   []string{"/etc/nginx/secrets/bar.pem"},
+				removedFiles: []string{"/etc/nginx/secrets/foo.pem"},
+				addedServers: []string{"http://bar.example.com:80", "tls://tls.example.com:443"},
+				addedLocations: []string{
+					"http://bar.example.com:80 prefix /",
+					"http://foo.example.com:80 prefix /v2",
+				},
+				removedLocations: []string{"http://foo.example.com:80 exact /api"},
+				addedUpstreams:   []string{"test_bar_80"},
+				removedUpstreams: []string{"stream/test_tls_443"},
+			},
+		},
+		{
+			name:      "changes limited to a port",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			scope:     &configScope{hostnames: map[int32][]string{80: {""}}},
+			expDiff: configDiff{
+				removedFiles: []string{"/etc/nginx/secrets/foo.pem"},
+				addedServers: []string{"http://bar.example.com:80"},
+				addedLocations: []string{
+					"http://bar.example.com:80 prefix /",
+					"http://foo.example.com:80 prefix /v2",
+				},
+				removedLocations: []string{"http://foo.example.com:80 exact /api"},
+				addedUpstreams:   []string{"test_bar_80"},
+			},
+		},
+		{
+			name:      "changes limited to another port",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			scope:     &configScope{hostnames: map[int32][]string{443: {""}}},
+			expDiff: configDiff{
+				addedServers: []string{"tls://tls.example.com:443"},
+			},
+		},
+		{
+			name:      "no changes on the ports",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			scope:     &configScope{hostnames: map[int32][]string{8080: {""}}},
+			expDiff:   configDiff{},
+		},
+		{
+			name:      "changes limited to a wildcard hostname",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			scope:     &configScope{hostnames: map[int32][]string{80: {"*.example.com"}}},
+			expDiff: configDiff{
+				removedFiles: []string{"/etc/nginx/secrets/foo.pem"},
+				addedServers: []string{"http://bar.example.com:80"},
+				addedLocations: []string{
+					"http://bar.example.com:80 prefix /",
+					"http://foo.example.com:80 prefix /v2",
+				},
+				removedLocations: []string{"http://foo.example.com:80 exact /api"},
+				addedUpstreams:   []string{"test_bar_80"},
+			},
+		},
+		{
+			name:      "changes limited to a hostname on a shared port",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			scope:     &configScope{hostnames: map[int32][]string{80: {"bar.example.com"}}},
+			expDiff: configDiff{
+				addedServers:   []string{"http://bar.example.com:80"},
+				addedLocations: []string{"http://bar.example.com:80 prefix /"},
+				addedUpstreams: []string{"test_bar_80"},
+			},
+		},
+		{
+			name:      "no changes on the hostname",
+			prevConf:  prevConf,
+			prevFiles: prevFiles,
+			conf:      conf,
+			files:     files,
+			scope:     &configScope{hostnames: map[int32][]string{80: {"baz.example.com"}}},
+			expDiff:   configDiff{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			diff := diffConfigurations(test.prevConf, test.prevFiles, test.conf, test.files, test.scope)
+			g.Expect(diff).To(Equal(test.expDiff))
+			g.Expect(diff.isEmpty()).To(Equal(test.expDiff.isEmpty()))
+		})
+	}
+}
+
+func TestConfigScopeIncludesServer(t *testing.T) {
+	t.Parallel()
+
+	scope := configScope{
+		hostnames: map[int32][]string{
+			80:  {"foo.example.com", "*.example.org"},
+			443: {""},
+		},
+		defaultServerPorts: map[int32]struct{}{443: {}},
+	}
+
+	merged := scope.merge(configScope{
+		hostnames:          map[int32][]string{8080: {"bar.example.com"}},
+		defaultServerPorts: map[int32]struct{}{80: {}},
+	})
+
+	tests := []struct {
+		name        string
+		hostname    string
+		port        int32
+		isDefault   bool
+		expIncluded bool
+		expMerged   bool
+	}{
+		{
+			name:        "same hostname",
+			hostname:    "foo.example.com",
+			port:        80,
+			expIncluded: true,
+			expMerged:   true,
+		},
+		{
+			name:        "hostname that matches a wildcard hostname",
+			hostname:    "foo.example.org",
+			port:        80,
+			expIncluded: true,
+			expMerged:   true,
+		},
+		{
+			name:     "other hostname",
+			hostname: "bar.example.com",
+			port:     80,
+		},
+		{
+			name:        "any hostname on a port of a listener without a hostname",
+			hostname:    "bar.example.com",
+			port:        443,
+			expIncluded: true,
+			expMerged:   true,
+		},
+		{
+			name:      "hostname of the other scope",
+			hostname:  "bar.example.com",
+			port:      8080,
+			expMerged: true,
+		},
+		{
+			name:        "default server",
+			port:        443,
+			isDefault:   true,
+			expIncluded: true,
+			expMerged:   true,
+		},
+		{
+			name:      "default server of the other scope",
+			port:      80,
+			isDefault: true,
+			expMerged: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(scope.includesServer(test.hostname, test.port, test.isDefault)).To(Equal(test.expIncluded))
+			g.Expect(merged.includesServer(test.hostname, test.port, test.isDefault)).To(Equal(test.expMerged))
+		})
+	}
+}
+
+func TestConfigDiffEventMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		diff       configDiff
+		expMessage string
+	}{
+		{
+			name: "servers, locations and upstreams",
+			diff: configDiff{
+				addedServers:     []string{"http://bar.example.com:80"},
+				removedLocations: []string{"http://foo.example.com:80 exact /api"},
+				addedUpstreams:   []string{"test_bar_80"},
+				changedFiles:     []string{"/etc/nginx/conf.d/http.conf"},
+			},
+			expMessage: "NGINX configuration was updated; added servers: http://bar.example.com:80; " +
+				"removed locations: http://foo.example.com:80 exact /api; added upstreams: test_bar_80; " +
+				"files: 0 added, 0 removed, 1 changed",
+		},
+		{
+			name: "too many items",
+			diff: configDiff{
+				removedUpstreams: []string{"u1", "u2", "u3", "u4", "u5", "u6", "u7"},
+			},
+			expMessage: "NGINX configuration was updated; removed upstreams: u1, u2, u3, u4, u5 and 2 more",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(test.diff.eventMessage()).To(Equal(test.expMessage))
+		})
+	}
+}
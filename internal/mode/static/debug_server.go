@@ -0,0 +1,346 @@
+package static
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	ngxConfig "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/status"
+)
+
+const (
+	debugConfigPath      = "/debug/config"
+	debugNginxFilesPath  = "/debug/nginx-files"
+	debugRoutesPath      = "/debug/routes"
+	debugReloadPath      = "/debug/reload"
+	debugShutdownTimeout = 5 * time.Second
+	redactedValue        = "<redacted>"
+)
+
+//counterfeiter:generate . debugStateGetter
+
+// debugStateGetter gets the latest state of the event handler.
+type debugStateGetter interface {
+	// GetLatestConfiguration gets the latest dataplane Configuration. It returns nil if no Configuration
+	// has been built yet.
+	GetLatestConfiguration() *dataplane.Configuration
+	// GetLatestReloadResult gets the result of the latest NGINX configuration update.
+	GetLatestReloadResult() status.NginxReloadResult
+}
+
+//counterfeiter:generate . debugGraphGetter
+
+// debugGraphGetter gets the latest Graph.
+type debugGraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+// debugServerConfig holds configuration parameters for the debug server.
+type debugServerConfig struct {
+	// stateGetter gets the latest Configuration and reload result.
+	stateGetter debugStateGetter
+	// graphGetter gets the latest Graph.
+	graphGetter debugGraphGetter
+	// generator generates the NGINX configuration files from the latest Configuration.
+	generator ngxConfig.Generator
+	// logger is the logger of the debug server.
+	logger logr.Logger
+	// token is the bearer token that requests must present.
+	token string
+	// port is the port the debug server listens on.
+	port int
+}
+
+// debugServer serves the latest state of NGINX Gateway Fabric for troubleshooting.
+// Every request must be authenticated with the configured bearer token.
+// Secrets, such as TLS private keys, are never served.
+//
+// debugServer implements controller-runtime manager.Runnable and manager.LeaderElectionRunnable.
+type debugServer struct {
+	cfg debugServerConfig
+}
+
+func newDebugServer(cfg debugServerConfig) *debugServer {
+	return &debugServer{cfg: cfg}
+}
+
+// Start starts the debug server and blocks until the context is canceled.
+func (s *debugServer) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.cfg.port),
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.cfg.logger.Info("Starting debug server", "port", s.cfg.port)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("debug server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), debugShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to shut down debug server: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection returns false, because every replica has its own NGINX configuration to inspect.
+func (s *debugServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *debugServer) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+debugConfigPath, s.serveConfiguration)
+	mux.HandleFunc("GET "+debugNginxFilesPath, s.serveNginxFiles)
+	mux.HandleFunc("GET "+debugRoutesPath, s.serveRoutes)
+	mux.HandleFunc("GET "+debugReloadPath, s.serveReloadResult)
+
+	return s.authenticate(mux)
+}
+
+func (s *debugServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *debugServer) serveConfiguration(w http.ResponseWriter, _ *http.Request) {
+	conf := s.cfg.stateGetter.GetLatestConfiguration()
+	if conf == nil {
+		http.Error(w, "no configuration has been generated yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.writeJSON(w, redactConfiguration(*conf))
+}
+
+// debugNginxFile is an NGINX configuration file served by the debug server.
+type debugNginxFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+func (s *debugServer) serveNginxFiles(w http.ResponseWriter, _ *http.Request) {
+	conf := s.cfg.stateGetter.GetLatestConfiguration()
+	if conf == nil {
+		http.Error(w, "no configuration has been generated yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	files := s.cfg.generator.Generate(*conf)
+
+	debugFiles := make([]debugNginxFile, 0, len(files))
+	for _, f := range files {
+		content := string(f.Content)
+		if f.Type == file.TypeSecret {
+			content = redactedValue
+		}
+
+		debugFiles = append(debugFiles, debugNginxFile{Path: f.Path, Content: content})
+	}
+
+	slices.SortFunc(debugFiles, func(a, b debugNginxFile) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	s.writeJSON(w, debugFiles)
+}
+
+// debugRoute summarizes the attachment of a Route to its Gateways.
+type debugRoute struct {
+	Kind       string           `json:"kind"`
+	Namespace  string           `json:"namespace"`
+	Name       string           `json:"name"`
+	ParentRefs []debugParentRef `json:"parentRefs"`
+	Valid      bool             `json:"valid"`
+}
+
+// debugParentRef summarizes the attachment of a Route to a Gateway.
+type debugParentRef struct {
+	AcceptedHostnames map[string][]string `json:"acceptedHostnames,omitempty"`
+	SectionName       string              `json:"sectionName,omitempty"`
+	Gateway           string              `json:"gateway"`
+	FailedReason      string              `json:"failedReason,omitempty"`
+	FailedMessage     string              `json:"failedMessage,omitempty"`
+	Attached          bool                `json:"attached"`
+}
+
+func (s *debugServer) serveRoutes(w http.ResponseWriter, _ *http.Request) {
+	gr := s.cfg.graphGetter.GetLatestGraph()
+	if gr == nil {
+		http.Error(w, "no graph has been built yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	s.writeJSON(w, buildDebugRoutes(gr))
+}
+
+func buildDebugRoutes(gr *graph.Graph) []debugRoute {
+	routes := make([]debugRoute, 0, len(gr.Routes)+len(gr.L4Routes))
+
+	for _, r := range gr.Routes {
+		kind := kinds.HTTPRoute
+		if r.RouteType == graph.RouteTypeGRPC {
+			kind = kinds.GRPCRoute
+		}
+
+		routes = append(routes, newDebugRoute(kind, r.Source, r.Valid, r.ParentRefs))
+	}
+
+	for _, r := range gr.L4Routes {
+		routes = append(routes, newDebugRoute(kinds.TLSRoute, r.Source, r.Valid, r.ParentRefs))
+	}
+
+	slices.SortFunc(routes, func(a, b debugRoute) int {
+		if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return routes
+}
+
+func newDebugRoute(kind string, source client.Object, valid bool, parentRefs []graph.ParentRef) debugRoute {
+	route := debugRoute{
+		Kind:       kind,
+		Namespace:  source.GetNamespace(),
+		Name:       source.GetName(),
+		Valid:      valid,
+		ParentRefs: make([]debugParentRef, 0, len(parentRefs)),
+	}
+
+	for _, ref := range parentRefs {
+		debugRef := debugParentRef{
+			Gateway: ref.Gateway.String(),
+		}
+
+		if ref.SectionName != nil {
+			debugRef.SectionName = string(*ref.SectionName)
+		}
+
+		if ref.Attachment != nil {
+			debugRef.Attached = ref.Attachment.Attached
+			debugRef.AcceptedHostnames = ref.Attachment.AcceptedHostnames
+
+			if !ref.Attachment.Attached {
+				debugRef.FailedReason = ref.Attachment.FailedCondition.Reason
+				debugRef.FailedMessage = ref.Attachment.FailedCondition.Message
+			}
+		}
+
+		route.ParentRefs = append(route.ParentRefs, debugRef)
+	}
+
+	return route
+}
+
+// debugReloadResult is the result of the latest NGINX configuration update.
+type debugReloadResult struct {
+	Error         string `json:"error,omitempty"`
+	ConfigVersion int    `json:"configVersion"`
+	Succeeded     bool   `json:"succeeded"`
+}
+
+func (s *debugServer) serveReloadResult(w http.ResponseWriter, _ *http.Request) {
+	result := debugReloadResult{Succeeded: true}
+
+	if conf := s.cfg.stateGetter.GetLatestConfiguration(); conf != nil {
+		result.ConfigVersion = conf.Version
+	}
+
+	if res := s.cfg.stateGetter.GetLatestReloadResult(); res.Error != nil {
+		result.Succeeded = false
+		result.Error = res.Error.Error()
+	}
+
+	s.writeJSON(w, result)
+}
+
+func (s *debugServer) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(v); err != nil {
+		s.cfg.logger.Error(err, "Failed to write debug response")
+	}
+}
+
+// redactConfiguration returns a copy of the Configuration without private keys and other secret data.
+// The Configuration is shared with the event handler, so it is not modified.
+func redactConfiguration(conf dataplane.Configuration) dataplane.Configuration {
+	if conf.SSLKeyPairs != nil {
+		keyPairs := make(map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair, len(conf.SSLKeyPairs))
+		for id, pair := range conf.SSLKeyPairs {
+			keyPairs[id] = dataplane.SSLKeyPair{Cert: pair.Cert, Key: []byte(redactedValue)}
+		}
+		conf.SSLKeyPairs = keyPairs
+	}
+
+	if conf.AuxiliarySecrets != nil {
+		secrets := make(map[graph.SecretFileType][]byte, len(conf.AuxiliarySecrets))
+		for fileType := range conf.AuxiliarySecrets {
+			secrets[fileType] = []byte(redactedValue)
+		}
+		conf.AuxiliarySecrets = secrets
+	}
+
+	return conf
+}
+
+// readDebugToken reads the bearer token of the debug server from a file.
+func readDebugToken(readFile func(string) ([]byte, error), path string) (string, error) {
+	content, err := readFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read debug server token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("debug server token file %q is empty", path)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,427 @@
+package static
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/configfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/staticfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/status"
+)
+
+const testDebugToken = "test-token"
+
+func newTestDebugServer() (
+	*debugServer,
+	*staticfakes.FakeDebugStateGetter,
+	*staticfakes.FakeDebugGraphGetter,
+	*configfakes.FakeGenerator,
+) {
+	stateGetter := &staticfakes.FakeDebugStateGetter{}
+	graphGetter := &staticfakes.FakeDebugGraphGetter{}
+	generator := &configfakes.FakeGenerator{}
+
+	srv := newDebugServer(debugServerConfig{
+		stateGetter: stateGetter,
+		graphGetter: graphGetter,
+		generator:   generator,
+		logger:      logr.Discard(),
+		token:       testDebugToken,
+		port:        8082,
+	})
+
+	return srv, stateGetter, graphGetter, generator
+}
+
+func sendDebugRequest(srv *debugServer, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	srv.handler().ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestDebugServer_Authentication(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		token      string
+		authHeader string
+		expStatus  int
+	}{
+		{
+			name:      "no token",
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name:      "wrong token",
+			token:     "wrong-token",
+			expStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "not a bearer token",
+			authHeader: "Basic " + testDebugToken,
+			expStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:      "valid token",
+			token:     testDebugToken,
+			expStatus: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			srv, _, _, _ := newTestDebugServer()
+
+			req := httptest.NewRequest(http.MethodGet, debugReloadPath, nil)
+			if test.token != "" {
+				req.Header.Set("Authorization", "Bearer "+test.token)
+			}
+			if test.authHeader != "" {
+				req.Header.Set("Authorization", test.authHeader)
+			}
+
+			rec := httptest.NewRecorder()
+			srv.handler().ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(test.expStatus))
+		})
+	}
+}
+
+func TestDebugServer_NotFound(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	srv, _, _, _ := newTestDebugServer()
+
+	g.Expect(sendDebugRequest(srv, "/debug/unknown", testDebugToken).Code).To(Equal(http.StatusNotFound))
+	// unauthenticated requests don't reveal which paths exist
+	g.Expect(sendDebugRequest(srv, "/debug/unknown", "").Code).To(Equal(http.StatusUnauthorized))
+}
+
+func TestDebugServer_NoStateYet(t *testing.T) {
+	t.Parallel()
+
+	paths := []string{debugConfigPath, debugNginxFilesPath, debugRoutesPath}
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			srv, _, _, _ := newTestDebugServer()
+
+			g.Expect(sendDebugRequest(srv, path, testDebugToken).Code).To(Equal(http.StatusServiceUnavailable))
+		})
+	}
+}
+
+func TestDebugServer_Configuration(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	srv, stateGetter, _, _ := newTestDebugServer()
+
+	conf := &dataplane.Configuration{
+		Version: 3,
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"ssl_keypair_test_secret": {
+				Cert: []byte("cert"),
+				Key:  []byte("private-key"),
+			},
+		},
+		AuxiliarySecrets: map[graph.SecretFileType][]byte{
+			graph.PlusReportJWTToken: []byte("jwt"),
+		},
+		Upstreams: []dataplane.Upstream{
+			{Name: "test_foo_80"},
+		},
+	}
+	stateGetter.GetLatestConfigurationReturns(conf)
+
+	rec := sendDebugRequest(srv, debugConfigPath, testDebugToken)
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	var result dataplane.Configuration
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+
+	g.Expect(result.Version).To(Equal(3))
+	g.Expect(result.Upstreams).To(HaveLen(1))
+	g.Expect(result.SSLKeyPairs["ssl_keypair_test_secret"].Cert).To(Equal([]byte("cert")))
+	g.Expect(result.SSLKeyPairs["ssl_keypair_test_secret"].Key).To(Equal([]byte(redactedValue)))
+	g.Expect(result.AuxiliarySecrets[graph.PlusReportJWTToken]).To(Equal([]byte(redactedValue)))
+
+	// the configuration of the event handler must not be modified
+	g.Expect(conf.SSLKeyPairs["ssl_keypair_test_secret"].Key).To(Equal([]byte("private-key")))
+	g.Expect(conf.AuxiliarySecrets[graph.PlusReportJWTToken]).To(Equal([]byte("jwt")))
+}
+
+func TestDebugServer_NginxFiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	srv, stateGetter, _, generator := newTestDebugServer()
+
+	conf := &dataplane.Configuration{Version: 1}
+	stateGetter.GetLatestConfigurationReturns(conf)
+	generator.GenerateReturns([]file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("http {}"),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+			Content: []byte("private-key"),
+			Type:    file.TypeSecret,
+		},
+	})
+
+	rec := sendDebugRequest(srv, debugNginxFilesPath, testDebugToken)
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var result []debugNginxFile
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+
+	g.Expect(result).To(Equal([]debugNginxFile{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: "http {}",
+		},
+		{
+			Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+			Content: redactedValue,
+		},
+	}))
+
+	g.Expect(generator.GenerateCallCount()).To(Equal(1))
+	g.Expect(generator.GenerateArgsForCall(0)).To(Equal(*conf))
+}
+
+func TestDebugServer_Routes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	srv, _, graphGetter, _ := newTestDebugServer()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	graphGetter.GetLatestGraphReturns(&graph.Graph{
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr"}, RouteType: graph.RouteTypeHTTP}: {
+				Source:    &v1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"}},
+				RouteType: graph.RouteTypeHTTP,
+				Valid:     true,
+				ParentRefs: []graph.ParentRef{
+					{
+						Gateway:     gwNsName,
+						SectionName: helpers.GetPointer[v1.SectionName]("listener-80"),
+						Attachment: &graph.ParentRefAttachmentStatus{
+							AcceptedHostnames: map[string][]string{"listener-80": {"foo.example.com"}},
+							Attached:          true,
+						},
+					},
+				},
+			},
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr"}, RouteType: graph.RouteTypeGRPC}: {
+				Source:    &v1.GRPCRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gr"}},
+				RouteType: graph.RouteTypeGRPC,
+				Valid:     true,
+				ParentRefs: []graph.ParentRef{
+					{
+						Gateway: gwNsName,
+						Attachment: &graph.ParentRefAttachmentStatus{
+							FailedCondition: conditions.NewRouteNotAllowedByListeners(),
+						},
+					},
+				},
+			},
+		},
+		L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tr"}}: {
+				Source: &v1alpha2.TLSRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tr"}},
+				ParentRefs: []graph.ParentRef{
+					{
+						Gateway: gwNsName,
+					},
+				},
+			},
+		},
+	})
+
+	rec := sendDebugRequest(srv, debugRoutesPath, testDebugToken)
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var result []debugRoute
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+
+	notAllowedCond := conditions.NewRouteNotAllowedByListeners()
+
+	g.Expect(result).To(Equal([]debugRoute{
+		{
+			Kind:      "GRPCRoute",
+			Namespace: "test",
+			Name:      "gr",
+			Valid:     true,
+			ParentRefs: []debugParentRef{
+				{
+					Gateway:       "test/gateway",
+					FailedReason:  notAllowedCond.Reason,
+					FailedMessage: notAllowedCond.Message,
+				},
+			},
+		},
+		{
+			Kind:      "HTTPRoute",
+			Namespace: "test",
+			Name:      "hr",
+			Valid:     true,
+			ParentRefs: []debugParentRef{
+				{
+					Gateway:           "test/gateway",
+					SectionName:       "listener-80",
+					AcceptedHostnames: map[string][]string{"listener-80": {"foo.example.com"}},
+					Attached:          true,
+				},
+			},
+		},
+		{
+			Kind:      "TLSRoute",
+			Namespace: "test",
+			Name:      "tr",
+			ParentRefs: []debugParentRef{
+				{
+					Gateway: "test/gateway",
+				},
+			},
+		},
+	}))
+}
+
+func TestDebugServer_ReloadResult(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		conf      *dataplane.Configuration
+		name      string
+		reloadRes status.NginxReloadResult
+		expResult debugReloadResult
+	}{
+		{
+			name: "no configuration yet",
+			expResult: debugReloadResult{
+				Succeeded: true,
+			},
+		},
+		{
+			name: "reload succeeded",
+			conf: &dataplane.Configuration{Version: 2},
+			expResult: debugReloadResult{
+				ConfigVersion: 2,
+				Succeeded:     true,
+			},
+		},
+		{
+			name:      "reload failed",
+			conf:      &dataplane.Configuration{Version: 3},
+			reloadRes: status.NginxReloadResult{Error: errors.New("reload failed")},
+			expResult: debugReloadResult{
+				ConfigVersion: 3,
+				Error:         "reload failed",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			srv, stateGetter, _, _ := newTestDebugServer()
+			stateGetter.GetLatestConfigurationReturns(test.conf)
+			stateGetter.GetLatestReloadResultReturns(test.reloadRes)
+
+			rec := sendDebugRequest(srv, debugReloadPath, testDebugToken)
+			g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+			var result debugReloadResult
+			g.Expect(json.Unmarshal(rec.Body.Bytes(), &result)).To(Succeed())
+			g.Expect(result).To(Equal(test.expResult))
+		})
+	}
+}
+
+func TestReadDebugToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		readFile  func(string) ([]byte, error)
+		name      string
+		expToken  string
+		expectErr bool
+	}{
+		{
+			name: "token is read and trimmed",
+			readFile: func(string) ([]byte, error) {
+				return []byte("my-token\n"), nil
+			},
+			expToken: "my-token",
+		},
+		{
+			name: "file is empty",
+			readFile: func(string) ([]byte, error) {
+				return []byte(" \n"), nil
+			},
+			expectErr: true,
+		},
+		{
+			name: "file cannot be read",
+			readFile: func(string) ([]byte, error) {
+				return nil, errors.New("read error")
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			token, err := readDebugToken(test.readFile, "/token")
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(token).To(Equal(test.expToken))
+		})
+	}
+}
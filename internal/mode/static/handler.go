@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
@@ -60,6 +62,8 @@ type eventHandlerConfig struct {
 	logLevelSetter logLevelSetter
 	// eventRecorder records events for Kubernetes resources.
 	eventRecorder record.EventRecorder
+	// eventCh is the channel of the event loop. The handler sends events to it to process them in a later batch.
+	eventCh chan<- interface{}
 	// deployCtxCollector collects the deployment context for N+ licensing
 	deployCtxCollector licensing.Collector
 	// nginxConfiguredOnStartChecker sets the health of the Pod to Ready once we've written out our initial config.
@@ -70,12 +74,26 @@ type eventHandlerConfig struct {
 	controlConfigNSName types.NamespacedName
 	// gatewayCtlrName is the name of the NGF controller.
 	gatewayCtlrName string
+	// endpointDrainPeriod is the maximum time the connections to terminating endpoints are drained before
+	// the endpoints are removed from NGINX Plus. Draining is disabled if it is 0.
+	endpointDrainPeriod time.Duration
 	// updateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	updateGatewayClassStatus bool
 	// plus is whether or not we are running NGINX Plus.
 	plus bool
+	// dynamicUpstreams is whether or not the servers of the upstreams are updated without reloading NGINX OSS.
+	dynamicUpstreams bool
 }
 
+const (
+	// peerStateDraining is the state of an NGINX Plus upstream peer that is draining.
+	peerStateDraining = "draining"
+	// drainingServerSuffix distinguishes a draining server from a server that is not draining when comparing servers.
+	drainingServerSuffix = " drain"
+	// backupServerSuffix distinguishes a backup server from a server that is not a backup when comparing servers.
+	backupServerSuffix = " backup"
+)
+
 const (
 	// groups for GroupStatusUpdater.
 	groupAllExceptGateways = "all-graphs-except-gateways"
@@ -83,6 +101,10 @@ const (
 	groupControlPlane      = "control-plane"
 )
 
+// endpointDrainExpiredEvent is sent to the event loop when the drain period of a draining server passes,
+// so that the server is removed from NGINX Plus even if no other event occurs.
+type endpointDrainExpiredEvent struct{}
+
 // filterKey is the `kind_namespace_name" of an object being filtered.
 type filterKey string
 
@@ -104,11 +126,27 @@ type eventHandlerImpl struct {
 	// latestConfiguration is the latest Configuration generation.
 	latestConfiguration *dataplane.Configuration
 
+	// appliedConfiguration is the latest Configuration that NGINX was successfully reloaded with.
+	appliedConfiguration *dataplane.Configuration
+
 	// objectFilters contains all created objectFilters, with the key being a filterKey
 	objectFilters map[filterKey]objectFilter
 
 	latestReloadResult status.NginxReloadResult
 
+	// drainStartTimes are the times when NGINX Plus started draining the upstream servers,
+	// with the key being the upstream name and the server joined by a slash.
+	drainStartTimes map[string]time.Time
+
+	// drainExpiryTimer sends an endpointDrainExpiredEvent when the drain period of the first draining server passes.
+	drainExpiryTimer *time.Timer
+
+	// appliedFiles are the files generated from appliedConfiguration.
+	appliedFiles []file.File
+
+	// appliedGatewayScopes are the parts of appliedConfiguration that belong to each Gateway.
+	appliedGatewayScopes map[types.NamespacedName]configScope
+
 	cfg  eventHandlerConfig
 	lock sync.Mutex
 
@@ -171,6 +209,13 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 		if !h.cfg.nginxConfiguredOnStartChecker.ready && h.cfg.nginxConfiguredOnStartChecker.firstBatchError == nil {
 			h.cfg.nginxConfiguredOnStartChecker.setAsReady()
 		}
+
+		if h.appliedConfiguration != nil && slices.ContainsFunc(batch, isEndpointDrainExpiredEvent) {
+			if err := h.updateUpstreamServers(*h.appliedConfiguration); err != nil {
+				logger.Error(err, "Failed to remove the upstream servers whose drain period passed")
+			}
+			h.scheduleEndpointDrainExpiry(ctx)
+		}
 		return
 	case state.EndpointsOnlyChange:
 		h.version++
@@ -183,10 +228,12 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 
 		h.setLatestConfiguration(&cfg)
 
-		if h.cfg.plus {
-			err = h.updateUpstreamServers(cfg)
-		} else {
-			err = h.updateNginxConf(ctx, cfg)
+		switch {
+		case h.cfg.plus && !backupServersChanged(h.appliedConfiguration, cfg),
+			h.cfg.dynamicUpstreams && canUpdateUpstreamsWithoutReload(h.appliedConfiguration, cfg):
+			err = h.updateUpstreamsWithoutReload(logger, gr, cfg)
+		default:
+			err = h.updateNginxConf(ctx, logger, gr, cfg)
 		}
 	case state.ClusterStateChange:
 		h.version++
@@ -199,9 +246,11 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 
 		h.setLatestConfiguration(&cfg)
 
-		err = h.updateNginxConf(ctx, cfg)
+		err = h.updateNginxConf(ctx, logger, gr, cfg)
 	}
 
+	h.scheduleEndpointDrainExpiry(ctx)
+
 	var nginxReloadRes status.NginxReloadResult
 	if err != nil {
 		logger.Error(err, "Failed to update NGINX configuration")
@@ -216,7 +265,7 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 		}
 	}
 
-	h.latestReloadResult = nginxReloadRes
+	h.setLatestReloadResult(nginxReloadRes)
 
 	h.updateStatuses(ctx, logger, gr)
 }
@@ -243,6 +292,7 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 
 	polReqs := status.PrepareBackendTLSPolicyRequests(gr.BackendTLSPolicies, transitionTime, h.cfg.gatewayCtlrName)
 	ngfPolReqs := status.PrepareNGFPolicyRequests(gr.NGFPolicies, transitionTime, h.cfg.gatewayCtlrName)
+	lbPolReqs := status.PrepareBackendLBPolicyRequests(gr.BackendLBPolicies, transitionTime, h.cfg.gatewayCtlrName)
 	snippetsFilterReqs := status.PrepareSnippetsFilterRequests(
 		gr.SnippetsFilters,
 		transitionTime,
@@ -252,12 +302,13 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 	reqs := make(
 		[]frameworkStatus.UpdateRequest,
 		0,
-		len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs)+len(snippetsFilterReqs),
+		len(gcReqs)+len(routeReqs)+len(polReqs)+len(ngfPolReqs)+len(lbPolReqs)+len(snippetsFilterReqs),
 	)
 	reqs = append(reqs, gcReqs...)
 	reqs = append(reqs, routeReqs...)
 	reqs = append(reqs, polReqs...)
 	reqs = append(reqs, ngfPolReqs...)
+	reqs = append(reqs, lbPolReqs...)
 	reqs = append(reqs, snippetsFilterReqs...)
 
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, reqs...)
@@ -265,8 +316,7 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, logger logr.Logge
 	// We put Gateway status updates separately from the rest of the statuses because we want to be able
 	// to update them separately from the rest of the graph whenever the public IP of NGF changes.
 	gwReqs := status.PrepareGatewayRequests(
-		gr.Gateway,
-		gr.IgnoredGateways,
+		gr.Gateways,
 		transitionTime,
 		gwAddresses,
 		h.latestReloadResult,
@@ -298,6 +348,8 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 		}
 
 		h.cfg.processor.CaptureDeleteChange(e.Type, e.NamespacedName)
+	case *endpointDrainExpiredEvent:
+		// The draining servers are updated after the batch is processed.
 	default:
 		panic(fmt.Errorf("unknown event type %T", e))
 	}
@@ -306,6 +358,8 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 // updateNginxConf updates nginx conf files and reloads nginx.
 func (h *eventHandlerImpl) updateNginxConf(
 	ctx context.Context,
+	logger logr.Logger,
+	gr *graph.Graph,
 	conf dataplane.Configuration,
 ) error {
 	files := h.cfg.generator.Generate(conf)
@@ -317,6 +371,8 @@ func (h *eventHandlerImpl) updateNginxConf(
 		return fmt.Errorf("failed to reload NGINX: %w", err)
 	}
 
+	h.reportConfigChanges(logger, gr, conf, files)
+
 	// If using NGINX Plus, update upstream servers using the API.
 	if err := h.updateUpstreamServers(conf); err != nil {
 		return fmt.Errorf("failed to update upstream servers: %w", err)
@@ -325,6 +381,202 @@ func (h *eventHandlerImpl) updateNginxConf(
 	return nil
 }
 
+// updateUpstreamsWithoutReload updates the servers of the upstreams without reloading nginx.
+//
+// NGINX Plus servers are updated using the API. NGINX Plus saves the API changes to the upstream state files
+// itself, so the files are only written by NGF when nginx is reloaded. Rewriting them here would race with
+// NGINX Plus.
+//
+// NGINX OSS with dynamic upstreams enabled picks up the servers from the dynamic upstreams state file at runtime,
+// so the nginx conf files are written with the new servers.
+func (h *eventHandlerImpl) updateUpstreamsWithoutReload(
+	logger logr.Logger,
+	gr *graph.Graph,
+	conf dataplane.Configuration,
+) error {
+	if h.cfg.plus {
+		if err := h.updateUpstreamServers(conf); err != nil {
+			return fmt.Errorf("failed to update upstream servers: %w", err)
+		}
+
+		logger.V(1).Info("Updated upstream servers without reloading NGINX")
+
+		// The files on disk haven't changed.
+		h.reportConfigChanges(logger, gr, conf, h.appliedFiles)
+
+		return nil
+	}
+
+	files := h.cfg.generator.Generate(conf)
+	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
+		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
+	}
+
+	logger.V(1).Info("Updated upstream servers without reloading NGINX")
+
+	h.reportConfigChanges(logger, gr, conf, files)
+
+	return nil
+}
+
+// canUpdateUpstreamsWithoutReload returns true if the only difference between the applied and the new
+// Configuration are the servers of the HTTP upstreams, and all upstreams that had servers still have them.
+// An upstream without servers proxies to a static error server, which can only be changed with a reload.
+func canUpdateUpstreamsWithoutReload(applied *dataplane.Configuration, conf dataplane.Configuration) bool {
+	if applied == nil || len(applied.Upstreams) != len(conf.Upstreams) {
+		return false
+	}
+
+	appliedUpstreams := make(map[string]dataplane.Upstream, len(applied.Upstreams))
+	for _, u := range applied.Upstreams {
+		appliedUpstreams[u.Name] = u
+	}
+
+	for _, u := range conf.Upstreams {
+		appliedUpstream, exists := appliedUpstreams[u.Name]
+		if !exists || (len(appliedUpstream.Endpoints) > 0) != (len(u.Endpoints) > 0) {
+			return false
+		}
+
+		// Upstreams with session persistence are not dynamic, so any change to their servers requires a reload.
+		if u.SessionPersistence != nil && !endpointsEqual(appliedUpstream.Endpoints, u.Endpoints) {
+			return false
+		}
+	}
+
+	// Stream upstreams are not dynamic, so any change to their servers requires a reload.
+	if len(applied.StreamUpstreams) != len(conf.StreamUpstreams) {
+		return false
+	}
+
+	streamEndpoints := make(map[string][]resolver.Endpoint, len(applied.StreamUpstreams))
+	for _, u := range applied.StreamUpstreams {
+		streamEndpoints[u.Name] = u.Endpoints
+	}
+
+	for _, u := range conf.StreamUpstreams {
+		endpoints, exists := streamEndpoints[u.Name]
+		if !exists || !endpointsEqual(endpoints, u.Endpoints) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// endpointsEqual returns true if both lists contain the same endpoints, regardless of their order.
+func endpointsEqual(a, b []resolver.Endpoint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	endpoints := make(map[resolver.Endpoint]struct{}, len(a))
+	for _, ep := range a {
+		endpoints[ep] = struct{}{}
+	}
+
+	for _, ep := range b {
+		if _, exists := endpoints[ep]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+// backupServersChanged returns true if an upstream has backup servers in the new Configuration but not in the
+// applied Configuration, or vice versa. The load balancing method of such an upstream changes, which requires a reload.
+func backupServersChanged(applied *dataplane.Configuration, conf dataplane.Configuration) bool {
+	if applied == nil {
+		return false
+	}
+
+	getUpstreamsWithBackups := func(upstreams []dataplane.Upstream) map[string]bool {
+		withBackups := make(map[string]bool)
+		for _, u := range upstreams {
+			if slices.ContainsFunc(u.Endpoints, func(ep resolver.Endpoint) bool { return ep.Backup }) {
+				withBackups[u.Name] = true
+			}
+		}
+		return withBackups
+	}
+
+	return !maps.Equal(getUpstreamsWithBackups(applied.Upstreams), getUpstreamsWithBackups(conf.Upstreams)) ||
+		!maps.Equal(getUpstreamsWithBackups(applied.StreamUpstreams), getUpstreamsWithBackups(conf.StreamUpstreams))
+}
+
+// reportConfigChanges logs the changes between the previously applied and the new NGINX configuration
+// and records the changes of each Gateway as an Event on that Gateway.
+// The changes of a Gateway are the changes of the servers of its Listeners (see configScope),
+// and of the upstreams and files that those servers reference.
+func (h *eventHandlerImpl) reportConfigChanges(
+	logger logr.Logger,
+	gr *graph.Graph,
+	conf dataplane.Configuration,
+	files []file.File,
+) {
+	prevConf, prevFiles, prevGatewayScopes := h.appliedConfiguration, h.appliedFiles, h.appliedGatewayScopes
+
+	gatewayScopes := getGatewayScopes(gr)
+
+	h.appliedConfiguration = &conf
+	h.appliedFiles = files
+	h.appliedGatewayScopes = gatewayScopes
+
+	diff := diffConfigurations(prevConf, prevFiles, conf, files, nil)
+	if diff.isEmpty() {
+		return
+	}
+
+	logger.V(1).Info("NGINX configuration changed", diff.logValues()...)
+
+	for nsname, gw := range gr.Gateways {
+		// Include the servers the Gateway had before, so that their removal is reported as well.
+		scope := gatewayScopes[nsname].merge(prevGatewayScopes[nsname])
+
+		gwDiff := diffConfigurations(prevConf, prevFiles, conf, files, &scope)
+		if gwDiff.isEmpty() {
+			continue
+		}
+
+		h.cfg.eventRecorder.Event(gw.Source, v1.EventTypeNormal, "ConfigurationUpdated", gwDiff.eventMessage())
+	}
+}
+
+// getGatewayScopes returns the configScope of each Gateway, based on the valid Listeners of the Gateways.
+func getGatewayScopes(gr *graph.Graph) map[types.NamespacedName]configScope {
+	scopes := make(map[types.NamespacedName]configScope, len(gr.Gateways))
+	claimedDefaultServerPorts := make(map[int32]struct{})
+
+	for _, gw := range gr.GetSortedGateways() {
+		scope := newConfigScope()
+
+		for _, l := range gw.Listeners {
+			if !l.Valid {
+				continue
+			}
+
+			port := int32(l.Source.Port)
+
+			var hostname string
+			if l.Source.Hostname != nil {
+				hostname = string(*l.Source.Hostname)
+			}
+
+			scope.hostnames[port] = append(scope.hostnames[port], hostname)
+
+			if _, claimed := claimedDefaultServerPorts[port]; !claimed {
+				claimedDefaultServerPorts[port] = struct{}{}
+				scope.defaultServerPorts[port] = struct{}{}
+			}
+		}
+
+		scopes[client.ObjectKeyFromObject(gw.Source)] = scope
+	}
+
+	return scopes
+}
+
 // updateUpstreamServers determines which servers have changed and uses the NGINX Plus API to update them.
 // Only applicable when using NGINX Plus.
 func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) error {
@@ -343,19 +595,34 @@ func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) e
 	}
 	var upstreams []upstream
 
+	now := time.Now()
+	drainStartTimes := make(map[string]time.Time)
+
 	for _, u := range conf.Upstreams {
+		// NGINX Plus resolves the hostnames of the servers itself, so the servers are not updated through the API.
+		if u.ResolvesHostnames() {
+			continue
+		}
+
 		confUpstream := upstream{
 			name:    u.Name,
-			servers: ngxConfig.ConvertEndpoints(u.Endpoints),
+			servers: convertUpstreamEndpoints(u, u.Endpoints),
 		}
 
-		if u, ok := prevUpstreams[confUpstream.name]; ok {
-			if !serversEqual(confUpstream.servers, u.Peers) {
+		if prevUpstream, ok := prevUpstreams[confUpstream.name]; ok {
+			if h.cfg.endpointDrainPeriod > 0 {
+				drainingServers := h.getDrainingServers(u, prevUpstream.Peers, now, drainStartTimes)
+				confUpstream.servers = append(confUpstream.servers, drainingServers...)
+			}
+
+			if !serversEqual(confUpstream.servers, prevUpstream.Peers) {
 				upstreams = append(upstreams, confUpstream)
 			}
 		}
 	}
 
+	h.drainStartTimes = drainStartTimes
+
 	type streamUpstream struct {
 		name    string
 		servers []ngxclient.StreamUpstreamServer
@@ -363,6 +630,10 @@ func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) e
 	var streamUpstreams []streamUpstream
 
 	for _, u := range conf.StreamUpstreams {
+		if u.ResolvesHostnames() {
+			continue
+		}
+
 		confUpstream := streamUpstream{
 			name:    u.Name,
 			servers: ngxConfig.ConvertStreamEndpoints(u.Endpoints),
@@ -393,8 +664,97 @@ func (h *eventHandlerImpl) updateUpstreamServers(conf dataplane.Configuration) e
 	return updateErr
 }
 
+// scheduleEndpointDrainExpiry schedules an endpointDrainExpiredEvent for when the drain period of the first
+// draining server passes. A previously scheduled event is canceled.
+func (h *eventHandlerImpl) scheduleEndpointDrainExpiry(ctx context.Context) {
+	if h.drainExpiryTimer != nil {
+		h.drainExpiryTimer.Stop()
+		h.drainExpiryTimer = nil
+	}
+
+	if len(h.drainStartTimes) == 0 || h.cfg.eventCh == nil {
+		return
+	}
+
+	var firstStart time.Time
+	for _, start := range h.drainStartTimes {
+		if firstStart.IsZero() || start.Before(firstStart) {
+			firstStart = start
+		}
+	}
+
+	eventCh := h.cfg.eventCh
+	h.drainExpiryTimer = time.AfterFunc(time.Until(firstStart.Add(h.cfg.endpointDrainPeriod)), func() {
+		select {
+		case eventCh <- &endpointDrainExpiredEvent{}:
+		case <-ctx.Done():
+		}
+	})
+}
+
+func isEndpointDrainExpiredEvent(event interface{}) bool {
+	_, ok := event.(*endpointDrainExpiredEvent)
+	return ok
+}
+
+// getDrainingServers returns the servers of the terminating endpoints of the upstream marked as drain,
+// so that NGINX Plus doesn't send new requests to them but keeps the existing connections.
+// Only the servers that NGINX Plus currently has are drained, until the endpoint drain period passes.
+// The drain start times of the returned servers are added to drainStartTimes.
+func (h *eventHandlerImpl) getDrainingServers(
+	u dataplane.Upstream,
+	peers []ngxclient.Peer,
+	now time.Time,
+	drainStartTimes map[string]time.Time,
+) []ngxclient.UpstreamServer {
+	peerServers := make(map[string]struct{}, len(peers))
+	for _, p := range peers {
+		peerServers[p.Server] = struct{}{}
+	}
+
+	var servers []ngxclient.UpstreamServer
+	for _, server := range convertUpstreamEndpoints(u, u.TerminatingEndpoints) {
+		if _, exists := peerServers[server.Server]; !exists {
+			continue
+		}
+
+		key := u.Name + "/" + server.Server
+
+		start, draining := h.drainStartTimes[key]
+		if !draining {
+			start = now
+		}
+
+		if now.Sub(start) >= h.cfg.endpointDrainPeriod {
+			continue
+		}
+
+		drainStartTimes[key] = start
+
+		server.Drain = true
+		servers = append(servers, server)
+	}
+
+	return servers
+}
+
+// convertUpstreamEndpoints converts the endpoints of the upstream into NGINX Plus API servers.
+// Like the upstream config, the servers are not marked as backup if the session persistence of the upstream
+// doesn't allow backup servers.
+func convertUpstreamEndpoints(u dataplane.Upstream, eps []resolver.Endpoint) []ngxclient.UpstreamServer {
+	servers := ngxConfig.ConvertEndpoints(eps)
+	if !ngxConfig.BackupServersAllowed(u.SessionPersistence, true) {
+		for i := range servers {
+			servers[i].Backup = nil
+		}
+	}
+
+	return servers
+}
+
 // serversEqual accepts lists of either UpstreamServer/Peer or StreamUpstreamServer/StreamPeer and determines
-// if the server names within these lists are equal.
+// if the server names within these lists are equal, and if the same servers are backups and the same HTTP servers
+// are draining.
 func serversEqual[
 	upstreamServer ngxclient.UpstreamServer | ngxclient.StreamUpstreamServer,
 	peer ngxclient.Peer | ngxclient.StreamPeer,
@@ -408,12 +768,30 @@ func serversEqual[
 		switch t := T.(type) {
 		case ngxclient.UpstreamServer:
 			server = t.Server
+			if t.Backup != nil && *t.Backup {
+				server += backupServerSuffix
+			}
+			if t.Drain {
+				server += drainingServerSuffix
+			}
 		case ngxclient.StreamUpstreamServer:
 			server = t.Server
+			if t.Backup != nil && *t.Backup {
+				server += backupServerSuffix
+			}
 		case ngxclient.Peer:
 			server = t.Server
+			if t.Backup {
+				server += backupServerSuffix
+			}
+			if t.State == peerStateDraining {
+				server += drainingServerSuffix
+			}
 		case ngxclient.StreamPeer:
 			server = t.Server
+			if t.Backup {
+				server += backupServerSuffix
+			}
 		}
 		return server
 	}
@@ -552,6 +930,22 @@ func (h *eventHandlerImpl) setLatestConfiguration(cfg *dataplane.Configuration)
 	h.latestConfiguration = cfg
 }
 
+// GetLatestReloadResult gets the result of the latest NGINX configuration update.
+func (h *eventHandlerImpl) GetLatestReloadResult() status.NginxReloadResult {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.latestReloadResult
+}
+
+// setLatestReloadResult sets the result of the latest NGINX configuration update.
+func (h *eventHandlerImpl) setLatestReloadResult(result status.NginxReloadResult) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.latestReloadResult = result
+}
+
 func objectFilterKey(obj client.Object, nsName types.NamespacedName) filterKey {
 	return filterKey(fmt.Sprintf("%T_%s_%s", obj, nsName.Namespace, nsName.Name))
 }
@@ -600,8 +994,7 @@ func (h *eventHandlerImpl) nginxGatewayServiceUpsert(ctx context.Context, logger
 
 	transitionTime := metav1.Now()
 	gatewayStatuses := status.PrepareGatewayRequests(
-		gr.Gateway,
-		gr.IgnoredGateways,
+		gr.Gateways,
 		transitionTime,
 		gwAddresses,
 		h.latestReloadResult,
@@ -626,8 +1019,7 @@ func (h *eventHandlerImpl) nginxGatewayServiceDelete(
 
 	transitionTime := metav1.Now()
 	gatewayStatuses := status.PrepareGatewayRequests(
-		gr.Gateway,
-		gr.IgnoredGateways,
+		gr.Gateways,
 		transitionTime,
 		gwAddresses,
 		h.latestReloadResult,
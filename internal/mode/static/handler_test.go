@@ -3,6 +3,7 @@ package static
 import (
 	"context"
 	"errors"
+	"time"
 
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	. "github.com/onsi/ginkgo/v2"
@@ -32,6 +33,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/statefakes"
 )
 
@@ -436,7 +438,7 @@ var _ = Describe("eventHandler", func() {
 		})
 
 		When("running NGINX Plus", func() {
-			It("should call the NGINX Plus API", func() {
+			It("should call the NGINX Plus API without writing the files or reloading", func() {
 				handler.cfg.plus = true
 
 				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
@@ -447,6 +449,48 @@ var _ = Describe("eventHandler", func() {
 				Expect(fakeGenerator.GenerateCallCount()).To(Equal(0))
 				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(0))
 				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(0))
+			})
+
+			It("should reload if the backup servers of the upstreams changed", func() {
+				handler.cfg.plus = true
+				handler.appliedConfiguration = &dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{
+						{
+							Name:      "one",
+							Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80, Backup: true}},
+						},
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
+			})
+		})
+
+		When("running NGINX OSS with dynamic upstreams", func() {
+			It("should update the upstream servers without a reload", func() {
+				handler.cfg.dynamicUpstreams = true
+				handler.appliedConfiguration = &dataplane.Configuration{}
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.GetUpstreamsCallCount()).To(Equal(0))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(0))
+				Expect(handler.GetLatestReloadResult().Error).ToNot(HaveOccurred())
+			})
+
+			It("should reload if the upstreams cannot be updated without a reload", func() {
+				handler.cfg.dynamicUpstreams = true
+
+				handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+				Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).To(Equal(1))
+				Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(1))
 			})
 		})
 
@@ -465,6 +509,92 @@ var _ = Describe("eventHandler", func() {
 		})
 	})
 
+	When("NGINX is reloaded", func() {
+		gw := &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gateway",
+				Namespace: "test",
+			},
+		}
+
+		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+		batch := []interface{}{e}
+
+		otherGw := &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other-gateway",
+				Namespace: "test",
+			},
+		}
+
+		BeforeEach(func() {
+			fakeProcessor.ProcessReturns(state.ClusterStateChange, &graph.Graph{
+				GatewayClass: &graph.GatewayClass{Source: &gatewayv1.GatewayClass{}, Valid: true},
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					client.ObjectKeyFromObject(gw): {
+						Source: gw,
+						Listeners: []*graph.Listener{
+							{
+								Source: gatewayv1.Listener{
+									Protocol: gatewayv1.HTTPProtocolType,
+									Port:     80,
+									Hostname: helpers.GetPointer[gatewayv1.Hostname]("foo.example.com"),
+								},
+								Valid: true,
+							},
+						},
+						Valid: true,
+					},
+					// The other Gateway shares the port, but the default server belongs to the first Gateway.
+					client.ObjectKeyFromObject(otherGw): {
+						Source: otherGw,
+						Listeners: []*graph.Listener{
+							{
+								Source: gatewayv1.Listener{
+									Protocol: gatewayv1.HTTPProtocolType,
+									Port:     80,
+									Hostname: helpers.GetPointer[gatewayv1.Hostname]("bar.example.com"),
+								},
+								Valid: true,
+							},
+						},
+						Valid: true,
+					},
+				},
+			})
+			fakeGenerator.GenerateReturns([]file.File{
+				{
+					Type:    file.TypeRegular,
+					Path:    "test.conf",
+					Content: []byte("test"),
+				},
+			})
+		})
+
+		It("should record the configuration changes as an Event on the Gateway they belong to", func() {
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			Expect(fakeEventRecorder.Events).To(HaveLen(1))
+			event := <-fakeEventRecorder.Events
+			Expect(event).To(Equal(
+				"Normal ConfigurationUpdated NGINX configuration was updated; added servers: http://default:80",
+			))
+
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).To(Equal(2))
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+
+		It("should not record an Event if the reload fails", func() {
+			fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+
+			handler.HandleEventBatch(context.Background(), ctlrZap.New(), batch)
+
+			Expect(fakeEventRecorder.Events).To(BeEmpty())
+		})
+	})
+
 	When("updating upstream servers", func() {
 		conf := dataplane.Configuration{
 			Upstreams: []dataplane.Upstream{
@@ -523,6 +653,176 @@ var _ = Describe("eventHandler", func() {
 				fakeNginxRuntimeMgr.UpdateStreamServersReturns(errors.New("error"))
 				Expect(handler.updateUpstreamServers(conf)).ToNot(Succeed())
 			})
+
+			It("should not update the servers that NGINX Plus resolves", func() {
+				externalEndpoints := []resolver.Endpoint{{Address: "api.example.com", Port: 443, Resolve: true}}
+				resolveConf := dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{
+						{
+							Name:      "one",
+							Endpoints: externalEndpoints,
+						},
+					},
+					StreamUpstreams: []dataplane.Upstream{
+						{
+							Name:      "two",
+							Endpoints: externalEndpoints,
+						},
+					},
+				}
+
+				Expect(handler.updateUpstreamServers(resolveConf)).To(Succeed())
+				Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(0))
+				Expect(fakeNginxRuntimeMgr.UpdateStreamServersCallCount()).To(Equal(0))
+			})
+
+			It("should not mark servers as backup for upstreams with header session persistence", func() {
+				hashConf := dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{
+						{
+							Name: "one",
+							Endpoints: []resolver.Endpoint{
+								{Address: "10.0.0.1", Port: 80},
+								{Address: "10.0.0.2", Port: 80, Backup: true},
+							},
+							SessionPersistence: &dataplane.SessionPersistence{
+								Name: "x-session",
+								Type: dataplane.SessionPersistenceHeader,
+							},
+						},
+					},
+				}
+
+				Expect(handler.updateUpstreamServers(hashConf)).To(Succeed())
+
+				Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(1))
+				_, servers := fakeNginxRuntimeMgr.UpdateHTTPServersArgsForCall(0)
+				Expect(servers).To(Equal([]ngxclient.UpstreamServer{
+					{Server: "10.0.0.1:80"},
+					{Server: "10.0.0.2:80"},
+				}))
+			})
+
+			When("endpoints are terminating", func() {
+				const drainPeriod = time.Minute
+
+				drainConf := dataplane.Configuration{
+					Upstreams: []dataplane.Upstream{
+						{
+							Name:      "one",
+							Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+							TerminatingEndpoints: []resolver.Endpoint{
+								{Address: "10.0.0.2", Port: 80, Terminating: true},
+								{Address: "10.0.0.3", Port: 80, Terminating: true},
+							},
+						},
+					},
+				}
+
+				BeforeEach(func() {
+					handler.cfg.endpointDrainPeriod = drainPeriod
+
+					upstreams := ngxclient.Upstreams{
+						"one": ngxclient.Upstream{
+							Peers: []ngxclient.Peer{
+								{Server: "10.0.0.1:80"},
+								{Server: "10.0.0.2:80"},
+							},
+						},
+					}
+					fakeNginxRuntimeMgr.GetUpstreamsReturns(upstreams, ngxclient.StreamUpstreams{}, nil)
+				})
+
+				It("should drain the terminating servers that NGINX Plus has", func() {
+					Expect(handler.updateUpstreamServers(drainConf)).To(Succeed())
+
+					Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(1))
+					name, servers := fakeNginxRuntimeMgr.UpdateHTTPServersArgsForCall(0)
+					Expect(name).To(Equal("one"))
+					Expect(servers).To(Equal([]ngxclient.UpstreamServer{
+						{Server: "10.0.0.1:80"},
+						{Server: "10.0.0.2:80", Drain: true},
+					}))
+					Expect(handler.drainStartTimes).To(HaveKey("one/10.0.0.2:80"))
+				})
+
+				It("should not update the servers if the terminating servers are already draining", func() {
+					upstreams := ngxclient.Upstreams{
+						"one": ngxclient.Upstream{
+							Peers: []ngxclient.Peer{
+								{Server: "10.0.0.1:80"},
+								{Server: "10.0.0.2:80", State: "draining"},
+							},
+						},
+					}
+					fakeNginxRuntimeMgr.GetUpstreamsReturns(upstreams, ngxclient.StreamUpstreams{}, nil)
+					start := time.Now().Add(-drainPeriod / 2)
+					handler.drainStartTimes = map[string]time.Time{"one/10.0.0.2:80": start}
+
+					Expect(handler.updateUpstreamServers(drainConf)).To(Succeed())
+
+					Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(0))
+					Expect(handler.drainStartTimes).To(Equal(map[string]time.Time{"one/10.0.0.2:80": start}))
+				})
+
+				It("should remove the terminating servers after the drain period", func() {
+					handler.drainStartTimes = map[string]time.Time{
+						"one/10.0.0.2:80": time.Now().Add(-2 * drainPeriod),
+					}
+
+					Expect(handler.updateUpstreamServers(drainConf)).To(Succeed())
+
+					Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(1))
+					_, servers := fakeNginxRuntimeMgr.UpdateHTTPServersArgsForCall(0)
+					Expect(servers).To(Equal([]ngxclient.UpstreamServer{{Server: "10.0.0.1:80"}}))
+					Expect(handler.drainStartTimes).To(BeEmpty())
+				})
+
+				It("should remove the terminating servers when the drain period passes without another event", func() {
+					eventCh := make(chan interface{}, 1)
+					handler.cfg.eventCh = eventCh
+					handler.cfg.endpointDrainPeriod = 50 * time.Millisecond
+					handler.appliedConfiguration = &drainConf
+
+					ctx, cancel := context.WithCancel(context.Background())
+					defer cancel()
+
+					Expect(handler.updateUpstreamServers(drainConf)).To(Succeed())
+					handler.scheduleEndpointDrainExpiry(ctx)
+					Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(1))
+
+					var event interface{}
+					Eventually(eventCh).Should(Receive(&event))
+
+					upstreams := ngxclient.Upstreams{
+						"one": ngxclient.Upstream{
+							Peers: []ngxclient.Peer{
+								{Server: "10.0.0.1:80"},
+								{Server: "10.0.0.2:80", State: "draining"},
+							},
+						},
+					}
+					fakeNginxRuntimeMgr.GetUpstreamsReturns(upstreams, ngxclient.StreamUpstreams{}, nil)
+
+					handler.HandleEventBatch(ctx, ctlrZap.New(), []interface{}{event})
+
+					Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(2))
+					_, servers := fakeNginxRuntimeMgr.UpdateHTTPServersArgsForCall(1)
+					Expect(servers).To(Equal([]ngxclient.UpstreamServer{{Server: "10.0.0.1:80"}}))
+					Expect(handler.drainStartTimes).To(BeEmpty())
+					Expect(handler.drainExpiryTimer).To(BeNil())
+				})
+
+				It("should remove the terminating servers if draining is disabled", func() {
+					handler.cfg.endpointDrainPeriod = 0
+
+					Expect(handler.updateUpstreamServers(drainConf)).To(Succeed())
+
+					Expect(fakeNginxRuntimeMgr.UpdateHTTPServersCallCount()).To(Equal(1))
+					_, servers := fakeNginxRuntimeMgr.UpdateHTTPServersArgsForCall(0)
+					Expect(servers).To(Equal([]ngxclient.UpstreamServer{{Server: "10.0.0.1:80"}}))
+				})
+			})
 		})
 
 		When("not running NGINX Plus", func() {
@@ -614,6 +914,161 @@ var _ = Describe("eventHandler", func() {
 	})
 })
 
+var _ = Describe("backupServersChanged", func() {
+	ep := resolver.Endpoint{Address: "10.0.0.1", Port: 80}
+	backupEp := resolver.Endpoint{Address: "10.0.0.2", Port: 80, Backup: true}
+
+	applied := &dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{Name: "up1", Endpoints: []resolver.Endpoint{ep, backupEp}},
+			{Name: "up2", Endpoints: []resolver.Endpoint{ep}},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{Name: "up1", Endpoints: []resolver.Endpoint{ep}},
+		},
+	}
+
+	DescribeTable("determines if the backup servers of the upstreams changed",
+		func(applied *dataplane.Configuration, conf dataplane.Configuration, expected bool) {
+			Expect(backupServersChanged(applied, conf)).To(Equal(expected))
+		},
+		Entry("no configuration applied yet",
+			nil,
+			*applied,
+			false,
+		),
+		Entry("same upstreams with backup servers",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up1", Endpoints: []resolver.Endpoint{backupEp, ep}},
+					{Name: "up2", Endpoints: []resolver.Endpoint{ep, ep}},
+				},
+				StreamUpstreams: applied.StreamUpstreams,
+			},
+			false,
+		),
+		Entry("HTTP upstream no longer has backup servers",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up1", Endpoints: []resolver.Endpoint{ep}},
+					{Name: "up2", Endpoints: []resolver.Endpoint{ep}},
+				},
+				StreamUpstreams: applied.StreamUpstreams,
+			},
+			true,
+		),
+		Entry("stream upstream has backup servers",
+			applied,
+			dataplane.Configuration{
+				Upstreams: applied.Upstreams,
+				StreamUpstreams: []dataplane.Upstream{
+					{Name: "up1", Endpoints: []resolver.Endpoint{ep, backupEp}},
+				},
+			},
+			true,
+		),
+	)
+})
+
+var _ = Describe("canUpdateUpstreamsWithoutReload", func() {
+	ep1 := resolver.Endpoint{Address: "10.0.0.1", Port: 80}
+	ep2 := resolver.Endpoint{Address: "10.0.0.2", Port: 80}
+
+	applied := &dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{Name: "up1", Endpoints: []resolver.Endpoint{ep1}},
+			{Name: "up2"},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{Name: "stream1", Endpoints: []resolver.Endpoint{ep1, ep2}},
+		},
+	}
+
+	DescribeTable("determines if the upstreams can be updated without a reload",
+		func(applied *dataplane.Configuration, conf dataplane.Configuration, expected bool) {
+			Expect(canUpdateUpstreamsWithoutReload(applied, conf)).To(Equal(expected))
+		},
+		Entry("no configuration applied yet",
+			nil,
+			*applied,
+			false,
+		),
+		Entry("servers of an HTTP upstream changed",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up1", Endpoints: []resolver.Endpoint{ep2, ep1}},
+					{Name: "up2"},
+				},
+				StreamUpstreams: []dataplane.Upstream{
+					{Name: "stream1", Endpoints: []resolver.Endpoint{ep2, ep1}},
+				},
+			},
+			true,
+		),
+		Entry("HTTP upstream lost all servers",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up1"},
+					{Name: "up2"},
+				},
+				StreamUpstreams: applied.StreamUpstreams,
+			},
+			false,
+		),
+		Entry("HTTP upstream without servers got servers",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up1", Endpoints: []resolver.Endpoint{ep1}},
+					{Name: "up2", Endpoints: []resolver.Endpoint{ep2}},
+				},
+				StreamUpstreams: applied.StreamUpstreams,
+			},
+			false,
+		),
+		Entry("HTTP upstream added",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{Name: "up1", Endpoints: []resolver.Endpoint{ep1}},
+					{Name: "up3"},
+				},
+				StreamUpstreams: applied.StreamUpstreams,
+			},
+			false,
+		),
+		Entry("servers of a stream upstream changed",
+			applied,
+			dataplane.Configuration{
+				Upstreams: applied.Upstreams,
+				StreamUpstreams: []dataplane.Upstream{
+					{Name: "stream1", Endpoints: []resolver.Endpoint{ep1}},
+				},
+			},
+			false,
+		),
+		Entry("servers of an HTTP upstream with session persistence changed",
+			applied,
+			dataplane.Configuration{
+				Upstreams: []dataplane.Upstream{
+					{
+						Name:               "up1",
+						Endpoints:          []resolver.Endpoint{ep2},
+						SessionPersistence: &dataplane.SessionPersistence{Name: "session"},
+					},
+					{Name: "up2"},
+				},
+				StreamUpstreams: applied.StreamUpstreams,
+			},
+			false,
+		),
+	)
+})
+
 var _ = Describe("serversEqual", func() {
 	DescribeTable("determines if HTTP server lists are equal",
 		func(newServers []ngxclient.UpstreamServer, oldServers []ngxclient.Peer, equal bool) {
@@ -651,6 +1106,50 @@ var _ = Describe("serversEqual", func() {
 			},
 			true,
 		),
+		Entry("differing draining elements",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Drain: true},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2"},
+			},
+			false,
+		),
+		Entry("differing backup elements",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Backup: helpers.GetPointer(true)},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2"},
+			},
+			false,
+		),
+		Entry("same backup elements",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Backup: helpers.GetPointer(true)},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2", Backup: true},
+			},
+			true,
+		),
+		Entry("same draining elements",
+			[]ngxclient.UpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Drain: true},
+			},
+			[]ngxclient.Peer{
+				{Server: "server1"},
+				{Server: "server2", State: "draining"},
+			},
+			true,
+		),
 	)
 	DescribeTable("determines if stream server lists are equal",
 		func(newServers []ngxclient.StreamUpstreamServer, oldServers []ngxclient.StreamPeer, equal bool) {
@@ -688,6 +1187,17 @@ var _ = Describe("serversEqual", func() {
 			},
 			true,
 		),
+		Entry("differing backup elements",
+			[]ngxclient.StreamUpstreamServer{
+				{Server: "server1"},
+				{Server: "server2", Backup: helpers.GetPointer(true)},
+			},
+			[]ngxclient.StreamPeer{
+				{Server: "server1"},
+				{Server: "server2"},
+			},
+			false,
+		),
 	)
 })
 
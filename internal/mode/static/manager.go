@@ -47,13 +47,18 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/runnables"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/status"
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/canary"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/licensing"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/metrics/collectors"
 	ngxcfg "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/compression"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxycache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	ngxvalidation "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
@@ -135,6 +140,8 @@ func StartManager(cfg config.Config) error {
 		return err
 	}
 
+	zone := getNodeZone(mgr.GetAPIReader(), cfg.GatewayPodConfig.NodeName, cfg.Logger)
+
 	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
 		GatewayCtlrName:  cfg.GatewayCtlrName,
 		GatewayClassName: cfg.GatewayClassName,
@@ -148,6 +155,7 @@ func StartManager(cfg config.Config) error {
 		MustExtractGVK: mustExtractGVK,
 		ProtectedPorts: protectedPorts,
 		PlusSecrets:    plusSecrets,
+		Plus:           cfg.Plus,
 	})
 
 	// Clear the configuration folders to ensure that no files are left over in case the control plane was restarted
@@ -172,6 +180,7 @@ func StartManager(cfg config.Config) error {
 	var (
 		ngxruntimeCollector ngxruntime.MetricsCollector = collectors.NewManagerNoopCollector()
 		handlerCollector    handlerMetricsCollector     = collectors.NewControllerNoopCollector()
+		eventLoopCollector  events.MetricsCollector     = collectors.NewControllerNoopCollector()
 	)
 
 	var ngxPlusClient ngxruntime.NginxPlusClient
@@ -195,7 +204,9 @@ func StartManager(cfg config.Config) error {
 		}
 
 		ngxruntimeCollector = collectors.NewManagerMetricsCollector(constLabels)
-		handlerCollector = collectors.NewControllerCollector(constLabels)
+		ctlrCollector := collectors.NewControllerCollector(constLabels)
+		handlerCollector = ctlrCollector
+		eventLoopCollector = ctlrCollector
 
 		ngxruntimeCollector, ok := ngxruntimeCollector.(prometheus.Collector)
 		if !ok {
@@ -225,6 +236,13 @@ func StartManager(cfg config.Config) error {
 		Logger:          cfg.Logger.WithName("deployCtxCollector"),
 	})
 
+	generator := ngxcfg.NewGeneratorImpl(
+		cfg.Plus,
+		cfg.DynamicUpstreams,
+		&cfg.UsageReportConfig,
+		cfg.Logger.WithName("generator"),
+	)
+
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
 		nginxFileMgr: file.NewManagerImpl(
 			cfg.Logger.WithName("nginxFileManager"),
@@ -238,25 +256,24 @@ func StartManager(cfg config.Config) error {
 			processHandler,
 			ngxruntime.NewVerifyClient(ngxruntime.NginxReloadTimeout),
 		),
-		statusUpdater:   groupStatusUpdater,
-		processor:       processor,
-		serviceResolver: resolver.NewServiceResolverImpl(mgr.GetClient()),
-		generator: ngxcfg.NewGeneratorImpl(
-			cfg.Plus,
-			&cfg.UsageReportConfig,
-			cfg.Logger.WithName("generator"),
-		),
+		statusUpdater:                 groupStatusUpdater,
+		processor:                     processor,
+		serviceResolver:               resolver.NewServiceResolverImpl(mgr.GetClient(), zone),
+		generator:                     generator,
 		k8sClient:                     mgr.GetClient(),
 		k8sReader:                     mgr.GetAPIReader(),
 		logLevelSetter:                logLevelSetter,
 		eventRecorder:                 recorder,
+		eventCh:                       eventCh,
 		deployCtxCollector:            deployCtxCollector,
 		nginxConfiguredOnStartChecker: nginxChecker,
 		gatewayPodConfig:              cfg.GatewayPodConfig,
 		controlConfigNSName:           controlConfigNSName,
 		gatewayCtlrName:               cfg.GatewayCtlrName,
+		endpointDrainPeriod:           cfg.EndpointDrainPeriod,
 		updateGatewayClassStatus:      cfg.UpdateGatewayClassStatus,
 		plus:                          cfg.Plus,
+		dynamicUpstreams:              cfg.DynamicUpstreams,
 	})
 
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(cfg)
@@ -267,6 +284,13 @@ func StartManager(cfg config.Config) error {
 		cfg.Logger.WithName("eventLoop"),
 		eventHandler,
 		firstBatchPreparer,
+		events.BatchingConfig{
+			MinDelay:     cfg.EventBatchingConfig.MinDelay,
+			MaxDelay:     cfg.EventBatchingConfig.MaxDelay,
+			MaxBatchSize: cfg.EventBatchingConfig.MaxBatchSize,
+			MinInterval:  cfg.EventBatchingConfig.MinInterval,
+		},
+		eventLoopCollector,
 	)
 
 	if err = mgr.Add(&runnables.LeaderOrNonLeader{Runnable: eventLoop}); err != nil {
@@ -277,6 +301,26 @@ func StartManager(cfg config.Config) error {
 		return fmt.Errorf("cannot register status updater: %w", err)
 	}
 
+	if cfg.DebugConfig.Enabled {
+		token, err := readDebugToken(os.ReadFile, cfg.DebugConfig.TokenFile)
+		if err != nil {
+			return fmt.Errorf("cannot create debug server: %w", err)
+		}
+
+		debugSrv := newDebugServer(debugServerConfig{
+			stateGetter: eventHandler,
+			graphGetter: processor,
+			generator:   generator,
+			logger:      cfg.Logger.WithName("debugServer"),
+			token:       token,
+			port:        cfg.DebugConfig.Port,
+		})
+
+		if err = mgr.Add(debugSrv); err != nil {
+			return fmt.Errorf("cannot register debug server: %w", err)
+		}
+	}
+
 	if cfg.ProductTelemetryConfig.Enabled {
 		dataCollector := telemetry.NewDataCollectorImpl(telemetry.DataCollectorConfig{
 			K8sClientReader:     mgr.GetAPIReader(),
@@ -301,6 +345,11 @@ func StartManager(cfg config.Config) error {
 		}
 	}
 
+	canaryJob := createCanaryJob(cfg, mgr.GetClient(), processor, ngxPlusClient, nginxChecker.getReadyCh())
+	if err = mgr.Add(canaryJob); err != nil {
+		return fmt.Errorf("cannot register canary job: %w", err)
+	}
+
 	cfg.Logger.Info("Starting manager")
 	go func() {
 		<-ctx.Done()
@@ -327,6 +376,22 @@ func createPolicyManager(
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}),
 			Validator: upstreamsettings.NewValidator(validator),
 		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ProxyCachePolicy{}),
+			Validator: proxycache.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.CompressionPolicy{}),
+			Validator: compression.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ErrorPagePolicy{}),
+			Validator: errorpage.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.ProxySettingsPolicy{}),
+			Validator: proxysettings.NewValidator(validator),
+		},
 	}
 
 	return policies.NewManager(mustExtractGVK, cfgs...)
@@ -514,6 +579,41 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.ProxyCachePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.CompressionPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ErrorPagePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.ProxySettingsPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.Canary{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(
+					k8spredicate.Or(
+						k8spredicate.GenerationChangedPredicate{},
+						canary.WeightChangedPredicate{},
+					),
+				),
+			},
+		},
 	}
 
 	if cfg.ExperimentalFeatures {
@@ -535,6 +635,12 @@ func registerControllers(
 					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 				},
 			},
+			{
+				objectType: &gatewayv1alpha2.BackendLBPolicy{},
+				options: []controller.Option{
+					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+				},
+			},
 		}
 		controllerRegCfgs = append(controllerRegCfgs, gwExpFeatures...)
 	}
@@ -656,6 +762,38 @@ func createPlusSecretMetadata(
 	return plusSecrets, nil
 }
 
+// getNodeZone returns the topology zone of the Node where NGINX runs, which is used for topology-aware
+// endpoint selection. If the zone cannot be determined, it returns an empty string, and the selection is disabled.
+func getNodeZone(reader client.Reader, nodeName string, logger logr.Logger) string {
+	if nodeName == "" {
+		logger.Info("Node name is unknown; topology-aware endpoint selection is disabled")
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var node apiv1.Node
+	if err := reader.Get(ctx, types.NamespacedName{Name: nodeName}, &node); err != nil {
+		logger.Error(err, "Failed to get Node; topology-aware endpoint selection is disabled", "node", nodeName)
+		return ""
+	}
+
+	zone := node.Labels[apiv1.LabelTopologyZone]
+	if zone == "" {
+		logger.Info(
+			"Node has no zone label; topology-aware endpoint selection is disabled",
+			"node", nodeName,
+			"label", apiv1.LabelTopologyZone,
+		)
+		return ""
+	}
+
+	logger.Info("Using topology-aware endpoint selection", "zone", zone)
+
+	return zone
+}
+
 func validateSecret(reader client.Reader, nsName types.NamespacedName, fields ...string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -725,6 +863,37 @@ func createTelemetryJob(
 	}, nil
 }
 
+// canaryJobPeriod is the period of progressing Canaries. It bounds the precision of the durations of their steps.
+const canaryJobPeriod = 10 * time.Second
+
+func createCanaryJob(
+	cfg config.Config,
+	k8sClient client.Client,
+	graphGetter canary.GraphGetter,
+	plusClient ngxruntime.NginxPlusClient,
+	readyCh <-chan struct{},
+) *runnables.Leader {
+	logger := cfg.Logger.WithName("canaryJob")
+
+	progressor := canary.NewProgressor(canary.ProgressorConfig{
+		K8sClient:   k8sClient,
+		GraphGetter: graphGetter,
+		PlusClient:  plusClient,
+		Logger:      logger,
+	})
+
+	return &runnables.Leader{
+		Runnable: runnables.NewCronJob(
+			runnables.CronJobConfig{
+				Worker:  progressor.Progress,
+				Logger:  logger,
+				Period:  canaryJobPeriod,
+				ReadyCh: readyCh,
+			},
+		),
+	}
+}
+
 func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []client.ObjectList) {
 	objects := []client.Object{
 		&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: cfg.GatewayClassName}},
@@ -751,6 +920,11 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 		&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 		&ngfAPIv1alpha2.ObservabilityPolicyList{},
 		&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+		&ngfAPIv1alpha1.ProxyCachePolicyList{},
+		&ngfAPIv1alpha1.CompressionPolicyList{},
+		&ngfAPIv1alpha1.ErrorPagePolicyList{},
+		&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+		&ngfAPIv1alpha1.CanaryList{},
 		partialObjectMetadataList,
 	}
 
@@ -760,6 +934,7 @@ func prepareFirstEventBatchPreparerArgs(cfg config.Config) ([]client.Object, []c
 			&gatewayv1alpha3.BackendTLSPolicyList{},
 			&apiv1.ConfigMapList{},
 			&gatewayv1alpha2.TLSRouteList{},
+			&gatewayv1alpha2.BackendLBPolicyList{},
 		)
 	}
 
@@ -3,6 +3,7 @@ package static
 import (
 	"testing"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/gomega"
 	apiv1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
@@ -69,6 +70,11 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.ProxyCachePolicyList{},
+				&ngfAPIv1alpha1.CompressionPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.CanaryList{},
 			},
 		},
 		{
@@ -99,6 +105,11 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.ProxyCachePolicyList{},
+				&ngfAPIv1alpha1.CompressionPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.CanaryList{},
 			},
 		},
 		{
@@ -128,10 +139,16 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				partialObjectMetadataList,
 				&gatewayv1alpha3.BackendTLSPolicyList{},
 				&gatewayv1alpha2.TLSRouteList{},
+				&gatewayv1alpha2.BackendLBPolicyList{},
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.ProxyCachePolicyList{},
+				&ngfAPIv1alpha1.CompressionPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.CanaryList{},
 			},
 		},
 		{
@@ -163,6 +180,11 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.SnippetsFilterList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.ProxyCachePolicyList{},
+				&ngfAPIv1alpha1.CompressionPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.CanaryList{},
 			},
 		},
 		{
@@ -192,11 +214,17 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				partialObjectMetadataList,
 				&gatewayv1alpha3.BackendTLSPolicyList{},
 				&gatewayv1alpha2.TLSRouteList{},
+				&gatewayv1alpha2.BackendLBPolicyList{},
 				&gatewayv1.GRPCRouteList{},
 				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
 				&ngfAPIv1alpha2.ObservabilityPolicyList{},
 				&ngfAPIv1alpha1.SnippetsFilterList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.ProxyCachePolicyList{},
+				&ngfAPIv1alpha1.CompressionPolicyList{},
+				&ngfAPIv1alpha1.ErrorPagePolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.CanaryList{},
 			},
 		},
 	}
@@ -554,3 +582,56 @@ func TestCreatePlusSecretMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNodeZone(t *testing.T) {
+	t.Parallel()
+
+	nodeWithZone := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-with-zone",
+			Labels: map[string]string{
+				apiv1.LabelTopologyZone: "zone-a",
+			},
+		},
+	}
+
+	nodeWithoutZone := &apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-without-zone",
+		},
+	}
+
+	fakeClient := fake.NewFakeClient(nodeWithZone, nodeWithoutZone)
+
+	tests := []struct {
+		name     string
+		nodeName string
+		expZone  string
+	}{
+		{
+			name:     "node has a zone",
+			nodeName: nodeWithZone.Name,
+			expZone:  "zone-a",
+		},
+		{
+			name:     "node has no zone",
+			nodeName: nodeWithoutZone.Name,
+		},
+		{
+			name:     "node does not exist",
+			nodeName: "missing",
+		},
+		{
+			name: "node name is unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getNodeZone(fakeClient, test.nodeName, logr.Discard())).To(Equal(test.expZone))
+		})
+	}
+}
@@ -13,6 +13,8 @@ import (
 type ControllerCollector struct {
 	// Metrics
 	eventBatchProcessDuration prometheus.Histogram
+	eventBatchSize            prometheus.Histogram
+	eventBatchQueueingTime    prometheus.Histogram
 }
 
 // NewControllerCollector creates a new ControllerCollector.
@@ -27,6 +29,24 @@ func NewControllerCollector(constLabels map[string]string) *ControllerCollector
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		eventBatchSize: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "event_batch_size",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of events in an event batch",
+				ConstLabels: constLabels,
+				Buckets:     []float64{1, 5, 10, 50, 100, 500, 1000},
+			},
+		),
+		eventBatchQueueingTime: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "event_batch_queueing_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds the oldest event of an event batch waited to be processed",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 100, 500, 1000, 5000, 10000, 30000},
+			},
+		),
 	}
 	return nc
 }
@@ -36,14 +56,28 @@ func (c *ControllerCollector) ObserveLastEventBatchProcessTime(duration time.Dur
 	c.eventBatchProcessDuration.Observe(float64(duration / time.Millisecond))
 }
 
+// ObserveEventBatchSize adds the size of an event batch to the histogram.
+func (c *ControllerCollector) ObserveEventBatchSize(size int) {
+	c.eventBatchSize.Observe(float64(size))
+}
+
+// ObserveEventBatchQueueingTime adds the queueing time of an event batch to the histogram.
+func (c *ControllerCollector) ObserveEventBatchQueueingTime(duration time.Duration) {
+	c.eventBatchQueueingTime.Observe(float64(duration / time.Millisecond))
+}
+
 // Describe implements prometheus.Collector interface Describe method.
 func (c *ControllerCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.eventBatchProcessDuration.Describe(ch)
+	c.eventBatchSize.Describe(ch)
+	c.eventBatchQueueingTime.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
 func (c *ControllerCollector) Collect(ch chan<- prometheus.Metric) {
 	c.eventBatchProcessDuration.Collect(ch)
+	c.eventBatchSize.Collect(ch)
+	c.eventBatchQueueingTime.Collect(ch)
 }
 
 // ControllerNoopCollector used to initialize the ControllerCollector when metrics are disabled to avoid nil pointer
@@ -56,3 +90,7 @@ func NewControllerNoopCollector() *ControllerNoopCollector {
 }
 
 func (c *ControllerNoopCollector) ObserveLastEventBatchProcessTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveEventBatchSize(_ int) {}
+
+func (c *ControllerNoopCollector) ObserveEventBatchQueueingTime(_ time.Duration) {}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
@@ -11,16 +12,18 @@ import (
 var baseHTTPTemplate = gotemplate.Must(gotemplate.New("baseHttp").Parse(baseHTTPTemplateText))
 
 type httpConfig struct {
-	Includes []shared.Include
-	HTTP2    bool
+	DNSResolver *shared.DNSResolver
+	Includes    []shared.Include
+	HTTP2       bool
 }
 
 func executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
 	includes := createIncludesFromSnippets(conf.BaseHTTPConfig.Snippets)
 
 	hc := httpConfig{
-		HTTP2:    conf.BaseHTTPConfig.HTTP2,
-		Includes: includes,
+		HTTP2:       conf.BaseHTTPConfig.HTTP2,
+		DNSResolver: createDNSResolver(conf),
+		Includes:    includes,
 	}
 
 	results := make([]executeResult, 0, len(includes)+1)
@@ -32,3 +35,30 @@ func executeBaseHTTPConfig(conf dataplane.Configuration) []executeResult {
 
 	return results
 }
+
+// createDNSResolver returns the DNS resolver configuration, or nil if no DNS resolver is configured.
+// NGINX only looks up the addresses of the IP family that it is configured for.
+func createDNSResolver(conf dataplane.Configuration) *shared.DNSResolver {
+	if conf.DNSResolver == nil {
+		return nil
+	}
+
+	addresses := make([]string, 0, len(conf.DNSResolver.Addresses))
+	for _, addr := range conf.DNSResolver.Addresses {
+		// IPv6 addresses must be enclosed in square brackets.
+		if strings.Contains(addr, ":") {
+			addr = "[" + addr + "]"
+		}
+		addresses = append(addresses, addr)
+	}
+
+	ipFamily := getIPFamily(conf.BaseHTTPConfig)
+
+	return &shared.DNSResolver{
+		Addresses:   addresses,
+		Timeout:     conf.DNSResolver.Timeout,
+		Valid:       conf.DNSResolver.CacheTTL,
+		DisableIPv4: !ipFamily.IPv4,
+		DisableIPv6: !ipFamily.IPv6,
+	}
+}
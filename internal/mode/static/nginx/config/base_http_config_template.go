@@ -2,7 +2,13 @@ package config
 
 const baseHTTPTemplateText = `
 {{- if .HTTP2 }}http2 on;{{ end }}
-
+{{ with $r := .DNSResolver }}
+resolver{{ range $a := $r.Addresses }} {{ $a }}{{ end }}{{ if $r.Valid }} valid={{ $r.Valid }}{{ end }}
+    {{- if $r.DisableIPv4 }} ipv4=off{{ end }}{{ if $r.DisableIPv6 }} ipv6=off{{ end }};
+    {{- if $r.Timeout }}
+resolver_timeout {{ $r.Timeout }};
+    {{- end }}
+{{ end }}
 # Set $gw_api_compliant_host variable to the value of $http_host unless $http_host is empty, then set it to the value
 # of $host. We prefer $http_host because it contains the original value of the host header, which is required by the
 # Gateway API. However, in an HTTP/1.0 request, it's possible that $http_host can be empty. In this case, we will use
@@ -19,6 +25,14 @@ map $http_upgrade $connection_upgrade {
     '' close;
 }
 
+# Set $grpc_response_content_type variable to the content type of a gRPC-Web request, otherwise, set it to the gRPC
+# content type. The gRPC error locations respond with it, so that gRPC-Web clients can read the status of the error.
+map $http_content_type $grpc_response_content_type {
+    application/grpc-web application/grpc-web;
+    application/grpc-web+proto application/grpc-web+proto;
+    default application/grpc;
+}
+
 ## Returns just the path from the original request URI.
 map $request_uri $request_uri_path {
   "~^(?P<path>[^?]*)(\?.*)?$"  $path;
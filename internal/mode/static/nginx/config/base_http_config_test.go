@@ -54,6 +54,10 @@ func TestExecuteBaseHttp_HTTP2(t *testing.T) {
 			g.Expect(strings.Count(string(res[0].data), "map $http_host $gw_api_compliant_host {")).To(Equal(1))
 			g.Expect(strings.Count(string(res[0].data), "map $http_upgrade $connection_upgrade {")).To(Equal(1))
 			g.Expect(strings.Count(string(res[0].data), "map $request_uri $request_uri_path {")).To(Equal(1))
+			g.Expect(strings.Count(
+				string(res[0].data),
+				"map $http_content_type $grpc_response_content_type {",
+			)).To(Equal(1))
 		})
 	}
 }
@@ -107,3 +111,84 @@ func TestExecuteBaseHttp_Snippets(t *testing.T) {
 	snippet2IncludeRes := string(res[2].data)
 	g.Expect(snippet2IncludeRes).To(ContainSubstring("contents2"))
 }
+
+func TestExecuteBaseHttp_DNSResolver(t *testing.T) {
+	t.Parallel()
+
+	dnsResolver := &dataplane.DNSResolverConfig{
+		Addresses: []string{"10.96.0.10", "fd00::10", "dns.example.com"},
+		Timeout:   "10s",
+		CacheTTL:  "30s",
+	}
+
+	tests := []struct {
+		name          string
+		conf          dataplane.Configuration
+		expSubStrings map[string]int
+	}{
+		{
+			name: "no DNS resolver",
+			conf: dataplane.Configuration{},
+			expSubStrings: map[string]int{
+				"resolver ":         0,
+				"resolver_timeout ": 0,
+			},
+		},
+		{
+			name: "DNS resolver with all settings",
+			conf: dataplane.Configuration{
+				DNSResolver: dnsResolver,
+			},
+			expSubStrings: map[string]int{
+				"resolver 10.96.0.10 [fd00::10] dns.example.com valid=30s;": 1,
+				"resolver_timeout 10s;": 1,
+			},
+		},
+		{
+			name: "DNS resolver with IPv4 family",
+			conf: dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: dataplane.IPv4},
+				DNSResolver: &dataplane.DNSResolverConfig{
+					Addresses: []string{"10.96.0.10"},
+				},
+			},
+			expSubStrings: map[string]int{
+				"resolver 10.96.0.10 ipv6=off;": 1,
+				"resolver_timeout ":             0,
+			},
+		},
+		{
+			name: "DNS resolver with IPv6 family",
+			conf: dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{IPFamily: dataplane.IPv6},
+				DNSResolver: &dataplane.DNSResolverConfig{
+					Addresses: []string{"fd00::10"},
+				},
+			},
+			expSubStrings: map[string]int{
+				"resolver [fd00::10] ipv4=off;": 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			res := executeBaseHTTPConfig(test.conf)
+			g.Expect(res).To(HaveLen(1))
+
+			httpConf := string(res[0].data)
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(httpConf, expSubStr)).To(Equal(expCount))
+			}
+
+			gen := GeneratorImpl{}
+			streamConf := string(gen.executeStreamServers(test.conf)[0].data)
+			for expSubStr, expCount := range test.expSubStrings {
+				g.Expect(strings.Count(streamConf, expSubStr)).To(Equal(expCount))
+			}
+		})
+	}
+}
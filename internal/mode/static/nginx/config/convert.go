@@ -5,6 +5,7 @@ import (
 
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
@@ -19,6 +20,10 @@ func ConvertEndpoints(eps []resolver.Endpoint) []ngxclient.UpstreamServer {
 			Server: fmt.Sprintf(format, ep.Address, port),
 		}
 
+		if ep.Backup {
+			server.Backup = helpers.GetPointer(true)
+		}
+
 		servers = append(servers, server)
 	}
 
@@ -36,6 +41,10 @@ func ConvertStreamEndpoints(eps []resolver.Endpoint) []ngxclient.StreamUpstreamS
 			Server: fmt.Sprintf(format, ep.Address, port),
 		}
 
+		if ep.Backup {
+			server.Backup = helpers.GetPointer(true)
+		}
+
 		servers = append(servers, server)
 	}
 
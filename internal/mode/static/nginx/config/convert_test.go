@@ -6,6 +6,7 @@ import (
 	ngxclient "github.com/nginxinc/nginx-plus-go-client/client"
 	. "github.com/onsi/gomega"
 
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
@@ -25,6 +26,11 @@ func TestConvertEndpoints(t *testing.T) {
 			Port:    443,
 			IPv6:    true,
 		},
+		{
+			Address: "9.10.11.12",
+			Port:    80,
+			Backup:  true,
+		},
 	}
 
 	expUpstreams := []ngxclient.UpstreamServer{
@@ -37,6 +43,10 @@ func TestConvertEndpoints(t *testing.T) {
 		{
 			Server: "[2001:db8::1]:443",
 		},
+		{
+			Server: "9.10.11.12:80",
+			Backup: helpers.GetPointer(true),
+		},
 	}
 
 	g := NewWithT(t)
@@ -59,6 +69,11 @@ func TestConvertStreamEndpoints(t *testing.T) {
 			Port:    443,
 			IPv6:    true,
 		},
+		{
+			Address: "9.10.11.12",
+			Port:    80,
+			Backup:  true,
+		},
 	}
 
 	expUpstreams := []ngxclient.StreamUpstreamServer{
@@ -71,6 +86,10 @@ func TestConvertStreamEndpoints(t *testing.T) {
 		{
 			Server: "[2001:db8::1]:443",
 		},
+		{
+			Server: "9.10.11.12:80",
+			Backup: helpers.GetPointer(true),
+		},
 	}
 
 	g := NewWithT(t)
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var dynamicUpstreamsTemplate = gotemplate.Must(
+	gotemplate.New("dynamicUpstreams").Parse(dynamicUpstreamsTemplateText),
+)
+
+const (
+	// dynamicUpstreamsStateFile is the path to the file with the servers of the dynamic upstreams.
+	// The NJS upstreams module reads the file at runtime, so that the servers can be updated without a reload.
+	dynamicUpstreamsStateFile = httpFolder + "/dynamic-upstreams.json"
+	// dynamicUpstreamsZoneName is the name of the NJS shared dictionary zone that caches the servers.
+	dynamicUpstreamsZoneName = "ngf_dynamic_upstreams"
+	// dynamicUpstreamsZoneSize is the size of the NJS shared dictionary zone.
+	dynamicUpstreamsZoneSize = "4m"
+	// dynamicUpstreamPeerVariable is the variable that the NJS upstreams module sets to the server
+	// that a request is proxied to.
+	dynamicUpstreamPeerVariable = "ngf_upstream_peer"
+)
+
+// dynamicUpstreamState holds the servers of a dynamic upstream in the state file.
+type dynamicUpstreamState struct {
+	// Servers are the servers that the requests are load balanced across.
+	Servers []string `json:"servers"`
+	// Backups are the backup servers. The NJS upstreams module only uses them if there are no Servers.
+	Backups []string `json:"backups,omitempty"`
+}
+
+type dynamicUpstreamsConfig struct {
+	ZoneName     string
+	ZoneSize     string
+	PeerVariable string
+}
+
+// executeDynamicUpstreams generates the configuration for proxying requests to the servers of the dynamic
+// upstreams and the state file with the servers.
+//
+// Only upstreams with endpoints are dynamic, except for upstreams with hostnames that NGINX resolves at runtime
+// and upstreams with session persistence, which rely on the load balancing method of the upstream block.
+// Requests to the other upstreams are proxied using the upstream blocks.
+//
+// Requests are load balanced randomly across the servers, so the load balancing method, the keepalive connections
+// and the retries of the upstream blocks (proxy_next_upstream) don't apply to the dynamic upstreams. Backup servers
+// are written separately and are only used if the upstream has no other servers.
+func executeDynamicUpstreams(conf dataplane.Configuration) []executeResult {
+	state := make(map[string]dynamicUpstreamState)
+
+	for _, u := range conf.Upstreams {
+		if len(u.Endpoints) == 0 || u.ResolvesHostnames() || u.SessionPersistence != nil {
+			continue
+		}
+
+		upstreamState := dynamicUpstreamState{
+			Servers: make([]string, 0, len(u.Endpoints)),
+		}
+
+		for _, ep := range u.Endpoints {
+			if ep.Backup {
+				upstreamState.Backups = append(upstreamState.Backups, formatEndpointAddress(ep))
+			} else {
+				upstreamState.Servers = append(upstreamState.Servers, formatEndpointAddress(ep))
+			}
+		}
+
+		slices.Sort(upstreamState.Servers)
+		slices.Sort(upstreamState.Backups)
+		state[u.Name] = upstreamState
+	}
+
+	stateBytes, err := json.Marshal(state)
+	if err != nil {
+		// panic is safe here because we should never fail to marshal the state.
+		panic(fmt.Errorf("could not marshal dynamic upstreams state: %w", err))
+	}
+
+	dynamicUpstreamsConf := dynamicUpstreamsConfig{
+		ZoneName:     dynamicUpstreamsZoneName,
+		ZoneSize:     dynamicUpstreamsZoneSize,
+		PeerVariable: dynamicUpstreamPeerVariable,
+	}
+
+	return []executeResult{
+		{
+			dest: httpConfigFile,
+			data: helpers.MustExecuteTemplate(dynamicUpstreamsTemplate, dynamicUpstreamsConf),
+		},
+		{
+			dest: dynamicUpstreamsStateFile,
+			data: stateBytes,
+		},
+	}
+}
+
+// useDynamicUpstreams updates the locations of the servers to proxy requests through the NJS upstreams module.
+// The location sets the $ngf_upstream variable to the upstream (or the variable with the upstream, in case of
+// split backends) that it proxies to, and the module picks one of the servers of the upstream.
+func useDynamicUpstreams(servers []http.Server) {
+	for i := range servers {
+		for j := range servers[i].Locations {
+			loc := &servers[i].Locations[j]
+			if loc.ProxyPass == "" {
+				continue
+			}
+
+			protocol, target, found := strings.Cut(loc.ProxyPass, "://")
+			if !found {
+				continue
+			}
+
+			var requestURI string
+			if t, isCut := strings.CutSuffix(target, "$request_uri"); isCut {
+				target = t
+				requestURI = "$request_uri"
+			}
+
+			loc.DynamicUpstream = target
+			loc.ProxyPass = protocol + "://$" + dynamicUpstreamPeerVariable + requestURI
+		}
+	}
+}
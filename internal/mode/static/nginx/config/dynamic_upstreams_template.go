@@ -0,0 +1,6 @@
+package config
+
+const dynamicUpstreamsTemplateText = `
+js_shared_dict_zone zone={{ .ZoneName }}:{{ .ZoneSize }} type=string;
+js_set ${{ .PeerVariable }} upstreams.peer;
+`
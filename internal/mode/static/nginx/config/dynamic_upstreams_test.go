@@ -0,0 +1,224 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
+)
+
+func TestExecuteDynamicUpstreams(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name: "up1",
+				Endpoints: []resolver.Endpoint{
+					{Address: "10.0.0.2", Port: 80},
+					{Address: "10.0.0.1", Port: 80},
+					{Address: "10.0.0.3", Port: 80, Backup: true},
+				},
+			},
+			{
+				Name: "up2",
+				Endpoints: []resolver.Endpoint{
+					{Address: "fd00::1", Port: 8080, IPv6: true},
+				},
+			},
+			{
+				Name: "up3-only-backups",
+				Endpoints: []resolver.Endpoint{
+					{Address: "10.0.0.6", Port: 80, Backup: true},
+					{Address: "10.0.0.5", Port: 80, Backup: true},
+				},
+			},
+			{
+				Name:     "up4-no-endpoints",
+				ErrorMsg: "no endpoints",
+			},
+			{
+				Name: "up5-external",
+				Endpoints: []resolver.Endpoint{
+					{Address: "api.example.com", Port: 443, Resolve: true},
+				},
+			},
+			{
+				Name: "up6-session-persistence",
+				Endpoints: []resolver.Endpoint{
+					{Address: "10.0.0.4", Port: 80},
+				},
+				SessionPersistence: &dataplane.SessionPersistence{
+					Name: "session",
+					Type: dataplane.SessionPersistenceCookie,
+				},
+			},
+		},
+	}
+
+	results := executeDynamicUpstreams(conf)
+	g.Expect(results).To(HaveLen(2))
+
+	g.Expect(results[0].dest).To(Equal(httpConfigFile))
+	g.Expect(string(results[0].data)).To(ContainSubstring(
+		"js_shared_dict_zone zone=ngf_dynamic_upstreams:4m type=string;",
+	))
+	g.Expect(string(results[0].data)).To(ContainSubstring("js_set $ngf_upstream_peer upstreams.peer;"))
+
+	g.Expect(results[1].dest).To(Equal(dynamicUpstreamsStateFile))
+
+	var state map[string]dynamicUpstreamState
+	g.Expect(json.Unmarshal(results[1].data, &state)).To(Succeed())
+	g.Expect(state).To(Equal(map[string]dynamicUpstreamState{
+		"up1":              {Servers: []string{"10.0.0.1:80", "10.0.0.2:80"}, Backups: []string{"10.0.0.3:80"}},
+		"up2":              {Servers: []string{"[fd00::1]:8080"}},
+		"up3-only-backups": {Servers: []string{}, Backups: []string{"10.0.0.5:80", "10.0.0.6:80"}},
+	}))
+}
+
+func TestUseDynamicUpstreams(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	servers := []http.Server{
+		{
+			Locations: []http.Location{
+				{
+					Path:      "/",
+					ProxyPass: "http://test_foo_80$request_uri",
+				},
+				{
+					Path:      "/split",
+					ProxyPass: "http://$group_test__route1_rule0$request_uri",
+				},
+				{
+					Path:      "/grpc",
+					ProxyPass: "grpc://test_grpc_80",
+					GRPC:      true,
+				},
+				{
+					Path:      "/rewrite",
+					ProxyPass: "https://test_foo_443",
+				},
+				{
+					Path:   "/return",
+					Return: &http.Return{Code: http.StatusNotFound},
+				},
+			},
+		},
+	}
+
+	useDynamicUpstreams(servers)
+
+	g.Expect(servers[0].Locations).To(Equal([]http.Location{
+		{
+			Path:            "/",
+			ProxyPass:       "http://$ngf_upstream_peer$request_uri",
+			DynamicUpstream: "test_foo_80",
+		},
+		{
+			Path:            "/split",
+			ProxyPass:       "http://$ngf_upstream_peer$request_uri",
+			DynamicUpstream: "$group_test__route1_rule0",
+		},
+		{
+			Path:            "/grpc",
+			ProxyPass:       "grpc://$ngf_upstream_peer",
+			DynamicUpstream: "test_grpc_80",
+			GRPC:            true,
+		},
+		{
+			Path:            "/rewrite",
+			ProxyPass:       "https://$ngf_upstream_peer",
+			DynamicUpstream: "test_foo_443",
+		},
+		{
+			Path:   "/return",
+			Return: &http.Return{Code: http.StatusNotFound},
+		},
+	}))
+}
+
+func TestExecuteServers_DynamicUpstreams(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{
+									Backends: []dataplane.Backend{
+										{UpstreamName: "test_foo_80", Valid: true, Weight: 1},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		expSubstrings    []string
+		notExpSubstrings []string
+		dynamicUpstreams bool
+	}{
+		{
+			name:             "dynamic upstreams enabled",
+			dynamicUpstreams: true,
+			expSubstrings: []string{
+				"set $ngf_upstream test_foo_80;",
+				"proxy_pass http://$ngf_upstream_peer$request_uri;",
+			},
+		},
+		{
+			name: "dynamic upstreams disabled",
+			expSubstrings: []string{
+				"proxy_pass http://test_foo_80$request_uri;",
+			},
+			notExpSubstrings: []string{
+				"$ngf_upstream",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{dynamicUpstreams: test.dynamicUpstreams}
+			results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+
+			var httpConf string
+			for _, res := range results {
+				if res.dest == httpConfigFile {
+					httpConf = string(res.data)
+				}
+			}
+
+			for _, s := range test.expSubstrings {
+				g.Expect(httpConf).To(ContainSubstring(s))
+			}
+			for _, s := range test.notExpSubstrings {
+				g.Expect(httpConf).ToNot(ContainSubstring(s))
+			}
+		})
+	}
+}
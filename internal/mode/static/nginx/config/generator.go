@@ -11,7 +11,11 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/clientsettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/compression"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxycache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -47,8 +51,8 @@ const (
 	// streamConfigFile is the path to the configuration file with Stream configuration.
 	streamConfigFile = streamFolder + "/stream.conf"
 
-	// configVersionFile is the path to the config version configuration file.
-	configVersionFile = httpFolder + "/config-version.conf"
+	// ConfigVersionFile is the path to the config version configuration file.
+	ConfigVersionFile = httpFolder + "/config-version.conf"
 
 	// httpMatchVarsFile is the path to the http_match pairs configuration file.
 	httpMatchVarsFile = httpFolder + "/matches.json"
@@ -83,16 +87,21 @@ type GeneratorImpl struct {
 	usageReportConfig *ngfConfig.UsageReportConfig
 	logger            logr.Logger
 	plus              bool
+	dynamicUpstreams  bool
 }
 
 // NewGeneratorImpl creates a new GeneratorImpl.
+// dynamicUpstreams enables updating the servers of the upstreams without a reload for NGINX OSS.
+// It is ignored for NGINX Plus, which updates the servers using the NGINX Plus API.
 func NewGeneratorImpl(
 	plus bool,
+	dynamicUpstreams bool,
 	usageReportConfig *ngfConfig.UsageReportConfig,
 	logger logr.Logger,
 ) GeneratorImpl {
 	return GeneratorImpl{
 		plus:              plus,
+		dynamicUpstreams:  dynamicUpstreams && !plus,
 		usageReportConfig: usageReportConfig,
 		logger:            logger,
 	}
@@ -120,6 +129,10 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
 	policyGenerator := policies.NewCompositeGenerator(
 		clientsettings.NewGenerator(),
 		observability.NewGenerator(conf.Telemetry),
+		proxycache.NewGenerator(),
+		compression.NewGenerator(),
+		errorpage.NewGenerator(),
+		proxysettings.NewGenerator(),
 	)
 
 	files = append(files, g.executeConfigTemplates(conf, policyGenerator)...)
@@ -128,6 +141,10 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
 		files = append(files, generateCertBundle(id, bundle))
 	}
 
+	for _, body := range conf.ErrorPageBodies {
+		files = append(files, generateErrorPageBody(body))
+	}
+
 	return files
 }
 
@@ -187,19 +204,27 @@ func (g GeneratorImpl) getExecuteFuncs(
 	upstreams []http.Upstream,
 	keepAliveCheck keepAliveChecker,
 ) []executeFunc {
-	return []executeFunc{
+	executeFuncs := []executeFunc{
 		executeMainConfig,
 		executeBaseHTTPConfig,
+		executeProxyCacheZones,
 		g.newExecuteServersFunc(generator, keepAliveCheck),
 		newExecuteUpstreamsFunc(upstreams),
 		executeSplitClients,
-		executeMaps,
+		g.executeMaps,
 		executeTelemetry,
 		g.executeStreamServers,
 		g.executeStreamUpstreams,
 		executeStreamMaps,
+		executeStreamSplitClients,
 		executeVersion,
 	}
+
+	if g.dynamicUpstreams {
+		executeFuncs = append(executeFuncs, executeDynamicUpstreams)
+	}
+
+	return executeFuncs
 }
 
 func generatePEM(id dataplane.SSLKeyPairID, cert []byte, key []byte) file.File {
@@ -230,3 +255,11 @@ func generateCertBundle(id dataplane.CertBundleID, cert []byte) file.File {
 func generateCertBundleFileName(id dataplane.CertBundleID) string {
 	return filepath.Join(secretsFolder, string(id)+".crt")
 }
+
+func generateErrorPageBody(body dataplane.ErrorPageBody) file.File {
+	return file.File{
+		Content: body.Content,
+		Path:    errorpage.BodyFilePath(body.ConfigMap.Namespace, body.ConfigMap.Name, body.Key),
+		Type:    file.TypeRegular,
+	}
+}
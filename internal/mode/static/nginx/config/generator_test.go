@@ -55,9 +55,9 @@ func TestGenerate(t *testing.T) {
 		},
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "app.example.com",
-				Port:         443,
-				UpstreamName: "stream_up",
+				Hostname:  "app.example.com",
+				Port:      443,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "stream_up", Weight: 1}},
 			},
 		},
 		Upstreams: []dataplane.Upstream{
@@ -87,6 +87,13 @@ func TestGenerate(t *testing.T) {
 		CertBundles: map[dataplane.CertBundleID]dataplane.CertBundle{
 			"test-certbundle": []byte("test-cert"),
 		},
+		ErrorPageBodies: []dataplane.ErrorPageBody{
+			{
+				ConfigMap: types.NamespacedName{Namespace: "test", Name: "pages"},
+				Key:       "404.html",
+				Content:   []byte("not found"),
+			},
+		},
 		Telemetry: dataplane.Telemetry{
 			Endpoint:    "1.2.3.4:123",
 			ServiceName: "ngf:gw-ns:gw-name:my-name",
@@ -138,13 +145,14 @@ func TestGenerate(t *testing.T) {
 	plus := true
 	generator := config.NewGeneratorImpl(
 		plus,
+		false,
 		&ngfConfig.UsageReportConfig{Endpoint: "test-endpoint"},
 		ctlrZap.New(),
 	)
 
 	files := generator.Generate(conf)
 
-	g.Expect(files).To(HaveLen(17))
+	g.Expect(files).To(HaveLen(20))
 	arrange := func(i, j int) bool {
 		return files[i].Path < files[j].Path
 	}
@@ -155,6 +163,7 @@ func TestGenerate(t *testing.T) {
 		/etc/nginx/conf.d/config-version.conf
 		/etc/nginx/conf.d/http.conf
 		/etc/nginx/conf.d/matches.json
+		/etc/nginx/includes/ErrorPageBody_test_pages_404.html
 		/etc/nginx/includes/http_snippet1.conf
 		/etc/nginx/includes/http_snippet2.conf
 		/etc/nginx/includes/main_snippet1.conf
@@ -169,6 +178,8 @@ func TestGenerate(t *testing.T) {
 		/etc/nginx/secrets/test-certbundle.crt
 		/etc/nginx/secrets/test-keypair.pem
 		/etc/nginx/stream-conf.d/stream.conf
+		/var/lib/nginx/state/stream_stream_up.conf
+		/var/lib/nginx/state/up.conf
 	*/
 
 	g.Expect(files[0].Type).To(Equal(file.TypeRegular))
@@ -201,28 +212,34 @@ func TestGenerate(t *testing.T) {
 	expString := "{}"
 	g.Expect(string(files[2].Content)).To(Equal(expString))
 
+	g.Expect(files[3]).To(Equal(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/includes/ErrorPageBody_test_pages_404.html",
+		Content: []byte("not found"),
+	}))
+
 	// snippet include files
 	// content is not checked in this test.
-	g.Expect(files[3].Path).To(Equal("/etc/nginx/includes/http_snippet1.conf"))
-	g.Expect(files[4].Path).To(Equal("/etc/nginx/includes/http_snippet2.conf"))
-	g.Expect(files[5].Path).To(Equal("/etc/nginx/includes/main_snippet1.conf"))
-	g.Expect(files[6].Path).To(Equal("/etc/nginx/includes/main_snippet2.conf"))
+	g.Expect(files[4].Path).To(Equal("/etc/nginx/includes/http_snippet1.conf"))
+	g.Expect(files[5].Path).To(Equal("/etc/nginx/includes/http_snippet2.conf"))
+	g.Expect(files[6].Path).To(Equal("/etc/nginx/includes/main_snippet1.conf"))
+	g.Expect(files[7].Path).To(Equal("/etc/nginx/includes/main_snippet2.conf"))
 
-	g.Expect(files[7].Path).To(Equal("/etc/nginx/main-includes/deployment_ctx.json"))
-	deploymentCtx := string(files[7].Content)
+	g.Expect(files[8].Path).To(Equal("/etc/nginx/main-includes/deployment_ctx.json"))
+	deploymentCtx := string(files[8].Content)
 	g.Expect(deploymentCtx).To(ContainSubstring("\"integration\":\"ngf\""))
 	g.Expect(deploymentCtx).To(ContainSubstring("\"cluster_id\":\"test-uid\""))
 	g.Expect(deploymentCtx).To(ContainSubstring("\"installation_id\":\"test-uid-replicaSet\""))
 	g.Expect(deploymentCtx).To(ContainSubstring("\"cluster_node_count\":1"))
 
-	g.Expect(files[8].Path).To(Equal("/etc/nginx/main-includes/main.conf"))
-	mainConfStr := string(files[8].Content)
+	g.Expect(files[9].Path).To(Equal("/etc/nginx/main-includes/main.conf"))
+	mainConfStr := string(files[9].Content)
 	g.Expect(mainConfStr).To(ContainSubstring("load_module modules/ngx_otel_module.so;"))
 	g.Expect(mainConfStr).To(ContainSubstring("include /etc/nginx/includes/main_snippet1.conf;"))
 	g.Expect(mainConfStr).To(ContainSubstring("include /etc/nginx/includes/main_snippet2.conf;"))
 
-	g.Expect(files[9].Path).To(Equal("/etc/nginx/main-includes/mgmt.conf"))
-	mgmtConf := string(files[9].Content)
+	g.Expect(files[10].Path).To(Equal("/etc/nginx/main-includes/mgmt.conf"))
+	mgmtConf := string(files[10].Content)
 	g.Expect(mgmtConf).To(ContainSubstring("usage_report endpoint=test-endpoint"))
 	g.Expect(mgmtConf).To(ContainSubstring("license_token /etc/nginx/secrets/license.jwt"))
 	g.Expect(mgmtConf).To(ContainSubstring("deployment_context /etc/nginx/main-includes/deployment_ctx.json"))
@@ -230,33 +247,108 @@ func TestGenerate(t *testing.T) {
 	g.Expect(mgmtConf).To(ContainSubstring("ssl_certificate /etc/nginx/secrets/mgmt-tls.crt"))
 	g.Expect(mgmtConf).To(ContainSubstring("ssl_certificate_key /etc/nginx/secrets/mgmt-tls.key"))
 
-	g.Expect(files[10].Path).To(Equal("/etc/nginx/secrets/license.jwt"))
-	g.Expect(string(files[10].Content)).To(Equal("license"))
+	g.Expect(files[11].Path).To(Equal("/etc/nginx/secrets/license.jwt"))
+	g.Expect(string(files[11].Content)).To(Equal("license"))
 
-	g.Expect(files[11].Path).To(Equal("/etc/nginx/secrets/mgmt-ca.crt"))
-	g.Expect(string(files[11].Content)).To(Equal("ca"))
+	g.Expect(files[12].Path).To(Equal("/etc/nginx/secrets/mgmt-ca.crt"))
+	g.Expect(string(files[12].Content)).To(Equal("ca"))
 
-	g.Expect(files[12].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.crt"))
-	g.Expect(string(files[12].Content)).To(Equal("cert"))
+	g.Expect(files[13].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.crt"))
+	g.Expect(string(files[13].Content)).To(Equal("cert"))
 
-	g.Expect(files[13].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.key"))
-	g.Expect(string(files[13].Content)).To(Equal("key"))
+	g.Expect(files[14].Path).To(Equal("/etc/nginx/secrets/mgmt-tls.key"))
+	g.Expect(string(files[14].Content)).To(Equal("key"))
 
-	g.Expect(files[14].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
-	certBundle := string(files[14].Content)
+	g.Expect(files[15].Path).To(Equal("/etc/nginx/secrets/test-certbundle.crt"))
+	certBundle := string(files[15].Content)
 	g.Expect(certBundle).To(Equal("test-cert"))
 
-	g.Expect(files[15]).To(Equal(file.File{
+	g.Expect(files[16]).To(Equal(file.File{
 		Type:    file.TypeSecret,
 		Path:    "/etc/nginx/secrets/test-keypair.pem",
 		Content: []byte("test-cert\ntest-key"),
 	}))
 
-	g.Expect(files[16].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
-	g.Expect(files[16].Type).To(Equal(file.TypeRegular))
-	streamCfg := string(files[16].Content)
+	g.Expect(files[17].Path).To(Equal("/etc/nginx/stream-conf.d/stream.conf"))
+	g.Expect(files[17].Type).To(Equal(file.TypeRegular))
+	streamCfg := string(files[17].Content)
 	g.Expect(streamCfg).To(ContainSubstring("listen unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("listen 443"))
 	g.Expect(streamCfg).To(ContainSubstring("app.example.com unix:/var/run/nginx/app.example.com-443.sock"))
 	g.Expect(streamCfg).To(ContainSubstring("example.com unix:/var/run/nginx/https443.sock"))
+	g.Expect(streamCfg).To(ContainSubstring("state /var/lib/nginx/state/stream_stream_up.conf;"))
+
+	// upstream state files
+	// content is not checked in this test.
+	g.Expect(files[18].Path).To(Equal("/var/lib/nginx/state/stream_stream_up.conf"))
+	g.Expect(files[18].Type).To(Equal(file.TypeRegular))
+	g.Expect(files[19].Path).To(Equal("/var/lib/nginx/state/up.conf"))
+	g.Expect(files[19].Type).To(Equal(file.TypeRegular))
+}
+
+func TestGenerate_DynamicUpstreams(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name:      "up1",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+			},
+		},
+		AuxiliarySecrets: map[graph.SecretFileType][]byte{
+			graph.PlusReportJWTToken: []byte("license"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		plus             bool
+		dynamicUpstreams bool
+		expStateFile     bool
+	}{
+		{
+			name:             "OSS with dynamic upstreams",
+			dynamicUpstreams: true,
+			expStateFile:     true,
+		},
+		{
+			name: "OSS without dynamic upstreams",
+		},
+		{
+			name:             "dynamic upstreams are ignored for Plus",
+			plus:             true,
+			dynamicUpstreams: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := config.NewGeneratorImpl(
+				test.plus,
+				test.dynamicUpstreams,
+				&ngfConfig.UsageReportConfig{},
+				ctlrZap.New(),
+			)
+
+			var stateFile *file.File
+			for _, f := range generator.Generate(conf) {
+				if f.Path == "/etc/nginx/conf.d/dynamic-upstreams.json" {
+					stateFile = &f
+				}
+			}
+
+			if !test.expStateFile {
+				g.Expect(stateFile).To(BeNil())
+				return
+			}
+
+			g.Expect(stateFile).ToNot(BeNil())
+			g.Expect(stateFile.Type).To(Equal(file.TypeRegular))
+			g.Expect(string(stateFile.Content)).To(Equal(`{"up1":{"servers":["10.0.0.1:80"]}}`))
+		})
+	}
 }
@@ -11,15 +11,18 @@ const (
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Listen        string
-	Locations     []Location
-	Includes      []shared.Include
-	IsDefaultHTTP bool
-	IsDefaultSSL  bool
-	GRPC          bool
-	IsSocket      bool
+	SSL                 *SSL
+	ProxySSLCertificate *SSL
+	ServerName          string
+	Listen              string
+	DefaultType         string
+	Locations           []Location
+	Includes            []shared.Include
+	IsDefaultHTTP       bool
+	IsDefaultSSL        bool
+	GRPC                bool
+	IsSocket            bool
+	GRPCHealthCheck     bool
 }
 
 type LocationType string
@@ -34,6 +37,7 @@ const (
 type Location struct {
 	Path            string
 	ProxyPass       string
+	DynamicUpstream string
 	HTTPMatchKey    string
 	Type            LocationType
 	ProxySetHeaders []Header
@@ -43,6 +47,7 @@ type Location struct {
 	Rewrites        []string
 	Includes        []shared.Include
 	GRPC            bool
+	GRPCWeb         bool
 }
 
 // Header defines an HTTP header to be passed to the proxied server.
@@ -74,21 +79,34 @@ type SSL struct {
 type StatusCode int
 
 const (
+	// StatusMovedPermanently is the HTTP 301 status code.
+	StatusMovedPermanently StatusCode = 301
 	// StatusFound is the HTTP 302 status code.
 	StatusFound StatusCode = 302
 	// StatusNotFound is the HTTP 404 status code.
 	StatusNotFound StatusCode = 404
+	// StatusPermanentRedirect is the HTTP 308 status code.
+	StatusPermanentRedirect StatusCode = 308
 	// StatusInternalServerError is the HTTP 500 status code.
 	StatusInternalServerError StatusCode = 500
 )
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
-	Name      string
-	ZoneSize  string // format: 512k, 1m
-	StateFile string
-	KeepAlive UpstreamKeepAlive
-	Servers   []UpstreamServer
+	StickyCookie     *UpstreamStickyCookie
+	Name             string
+	ZoneSize         string // format: 512k, 1m
+	StateFile        string
+	Hash             string
+	KeepAlive        UpstreamKeepAlive
+	Servers          []UpstreamServer
+	HasBackupServers bool
+}
+
+// UpstreamStickyCookie holds the configuration of the NGINX Plus sticky cookie session affinity.
+type UpstreamStickyCookie struct {
+	Name    string
+	Expires string
 }
 
 // UpstreamKeepAlive holds the keepalive configuration for an HTTP upstream.
@@ -102,18 +120,8 @@ type UpstreamKeepAlive struct {
 // UpstreamServer holds all configuration for an HTTP upstream server.
 type UpstreamServer struct {
 	Address string
-}
-
-// SplitClient holds all configuration for an HTTP split client.
-type SplitClient struct {
-	VariableName  string
-	Distributions []SplitClientDistribution
-}
-
-// SplitClientDistribution maps Percentage to Value in a SplitClient.
-type SplitClientDistribution struct {
-	Percent string
-	Value   string
+	Backup  bool
+	Resolve bool
 }
 
 // ProxySSLVerify holds the proxied HTTPS server verification configuration.
@@ -122,6 +130,15 @@ type ProxySSLVerify struct {
 	Name               string
 }
 
+// ProxyCacheZone holds the configuration of an HTTP proxy cache zone.
+type ProxyCacheZone struct {
+	Name     string
+	Path     string
+	Size     string
+	Inactive string
+	MaxSize  string
+}
+
 // ServerConfig holds configuration for an HTTP server and IP family to be used by NGINX.
 type ServerConfig struct {
 	Servers         []Server
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"slices"
 	"strings"
 	gotemplate "text/template"
 
@@ -24,8 +25,13 @@ const (
 	connectionClosedStreamServerSocket = "unix:/var/run/nginx/connection-closed-server.sock"
 )
 
-func executeMaps(conf dataplane.Configuration) []executeResult {
+func (g GeneratorImpl) executeMaps(conf dataplane.Configuration) []executeResult {
 	maps := buildAddHeaderMaps(append(conf.HTTPServers, conf.SSLServers...))
+	maps = append(maps, buildCanaryMaps(conf.BackendGroups)...)
+	maps = append(maps, buildGRPCWebMaps(conf.BaseHTTPConfig)...)
+	if !g.plus {
+		maps = append(maps, buildSessionCookieMaps(conf.Upstreams)...)
+	}
 	result := executeResult{
 		dest: httpConfigFile,
 		data: helpers.MustExecuteTemplate(mapsTemplate, maps),
@@ -62,7 +68,7 @@ func createStreamMaps(conf dataplane.Configuration) []shared.Map {
 
 		socket := emptyStringSocket
 
-		if u, ok := upstreams[server.UpstreamName]; ok && server.UpstreamName != "" && len(u.Endpoints) > 0 {
+		if layer4ServerHasAvailableUpstream(server, upstreams) {
 			socket = getSocketNameTLS(server.Port, server.Hostname)
 		}
 
@@ -132,13 +138,10 @@ func buildAddHeaderMaps(servers []dataplane.VirtualServer) []shared.Map {
 	for _, s := range servers {
 		for _, pr := range s.PathRules {
 			for _, mr := range pr.MatchRules {
-				if mr.Filters.RequestHeaderModifiers != nil {
-					for _, addHeader := range mr.Filters.RequestHeaderModifiers.Add {
-						lowerName := strings.ToLower(addHeader.Name)
-						if _, ok := addHeaderNames[lowerName]; !ok {
-							addHeaderNames[lowerName] = struct{}{}
-						}
-					}
+				addHeaderMapNames(addHeaderNames, mr.Filters.RequestHeaderModifiers)
+
+				for _, b := range mr.BackendGroup.Backends {
+					addHeaderMapNames(addHeaderNames, b.RequestHeaderModifiers)
 				}
 			}
 		}
@@ -151,6 +154,16 @@ func buildAddHeaderMaps(servers []dataplane.VirtualServer) []shared.Map {
 	return maps
 }
 
+func addHeaderMapNames(addHeaderNames map[string]struct{}, filter *dataplane.HTTPHeaderFilter) {
+	if filter == nil {
+		return
+	}
+
+	for _, addHeader := range filter.Add {
+		addHeaderNames[strings.ToLower(addHeader.Name)] = struct{}{}
+	}
+}
+
 const (
 	// In order to prepend any passed client header values to values specified in the add headers field of request
 	// header modifiers, we need to create a map parameter regex for any string value.
@@ -177,3 +190,121 @@ func createAddHeadersMap(name string) shared.Map {
 		Parameters: params,
 	}
 }
+
+// nginxMapSpecialParameters are the names of the special parameters of the map directive.
+// A source value that matches one of them must be escaped.
+var nginxMapSpecialParameters = map[string]struct{}{
+	"default":   {},
+	"hostnames": {},
+	"include":   {},
+	"volatile":  {},
+}
+
+// buildCanaryMaps builds a map for every BackendGroup with a CanaryMatch. The map sets the variable of the group
+// to the canary backend for the requests that match the header or the cookie, and to the result of the
+// split_clients of the group for all other requests.
+func buildCanaryMaps(groups []dataplane.BackendGroup) []shared.Map {
+	var maps []shared.Map
+
+	for _, group := range groups {
+		match := group.CanaryMatch
+		if match == nil || !backendGroupNeedsSplit(group) {
+			continue
+		}
+
+		source := "$cookie_" + match.Cookie
+		if match.Header != "" {
+			source = requestHeaderVariable(match.Header)
+		}
+
+		value := match.Value
+		if _, special := nginxMapSpecialParameters[value]; special {
+			value = `\` + value
+		}
+
+		variable := "$" + convertStringToSafeVariableName(group.Name())
+
+		maps = append(maps, shared.Map{
+			Source:   source,
+			Variable: variable,
+			Parameters: []shared.MapParameter{
+				{
+					Value:  value,
+					Result: getSplitClientValue(group, match.BackendIdx),
+				},
+				{
+					Value:  "default",
+					Result: variable + canarySplitVariableSuffix,
+				},
+			},
+		})
+	}
+
+	return maps
+}
+
+// buildGRPCWebMaps builds the map that translates the content type of gRPC-Web requests to the content type of
+// native gRPC requests, if gRPC-Web is enabled. Other content types are passed to the backends unchanged.
+func buildGRPCWebMaps(baseConfig dataplane.BaseHTTPConfig) []shared.Map {
+	if !baseConfig.GRPCWeb {
+		return nil
+	}
+
+	return []shared.Map{
+		{
+			Source:   "$http_content_type",
+			Variable: grpcContentTypeVariable,
+			Parameters: []shared.MapParameter{
+				{
+					Value:  "application/grpc-web",
+					Result: "application/grpc",
+				},
+				{
+					Value:  "application/grpc-web+proto",
+					Result: "application/grpc+proto",
+				},
+				{
+					Value:  "default",
+					Result: "$http_content_type",
+				},
+			},
+		},
+	}
+}
+
+// buildSessionCookieMaps builds a map for every cookie name of the Cookie session persistence of the upstreams.
+// NGINX OSS hashes the variable of the map, which falls back to the request ID if the request doesn't have
+// the cookie. Otherwise, all requests without the cookie would hash the empty string and go to the same server.
+func buildSessionCookieMaps(upstreams []dataplane.Upstream) []shared.Map {
+	var names []string
+	for _, u := range upstreams {
+		sp := u.SessionPersistence
+		if sp == nil || sp.Type != dataplane.SessionPersistenceCookie || slices.Contains(names, sp.Name) {
+			continue
+		}
+		names = append(names, sp.Name)
+	}
+	slices.Sort(names)
+
+	maps := make([]shared.Map, 0, len(names))
+	for _, name := range names {
+		cookieVariable := "$cookie_" + name
+
+		maps = append(maps, shared.Map{
+			Source:   cookieVariable,
+			Variable: sessionCookieVariable(name),
+			Parameters: []shared.MapParameter{
+				{
+					Value:  `""`,
+					Result: "$request_id",
+				},
+				{
+					Value:  "default",
+					Result: cookieVariable,
+				},
+			},
+		})
+	}
+
+	return maps
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
@@ -88,7 +89,7 @@ func TestExecuteMaps(t *testing.T) {
 		"map ${http_my_set_header} $my_set_header_header_var {":               0,
 	}
 
-	mapResult := executeMaps(conf)
+	mapResult := GeneratorImpl{}.executeMaps(conf)
 	g.Expect(mapResult).To(HaveLen(1))
 	maps := string(mapResult[0].data)
 	g.Expect(mapResult[0].dest).To(Equal(httpConfigFile))
@@ -147,6 +148,22 @@ func TestBuildAddHeaderMaps(t *testing.T) {
 						},
 					},
 				},
+				{
+					BackendGroup: dataplane.BackendGroup{
+						Backends: []dataplane.Backend{
+							{
+								RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+									Add: []dataplane.HTTPHeader{
+										{
+											Name:  "my-backend-add-header",
+											Value: "some-value-123",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -185,31 +202,117 @@ func TestBuildAddHeaderMaps(t *testing.T) {
 				},
 			},
 		},
+		{
+			Source:   "${http_my_backend_add_header}",
+			Variable: "$my_backend_add_header_header_var",
+			Parameters: []shared.MapParameter{
+				{Value: "default", Result: "''"},
+				{
+					Value:  "~.*",
+					Result: "${http_my_backend_add_header},",
+				},
+			},
+		},
 	}
 	maps := buildAddHeaderMaps(testServers)
 
 	g.Expect(maps).To(ConsistOf(expectedMap))
 }
 
+func TestBuildCanaryMaps(t *testing.T) {
+	t.Parallel()
+
+	createGroup := func(
+		name string,
+		match *dataplane.CanaryMatch,
+		backends ...dataplane.Backend,
+	) dataplane.BackendGroup {
+		return dataplane.BackendGroup{
+			Source:      types.NamespacedName{Namespace: "test", Name: name},
+			Backends:    backends,
+			CanaryMatch: match,
+		}
+	}
+
+	stable := dataplane.Backend{UpstreamName: "stable", Valid: true, Weight: 90}
+	canary := dataplane.Backend{UpstreamName: "canary", Valid: true, Weight: 10}
+	canaryWithFilters := dataplane.Backend{
+		UpstreamName: "canary",
+		Valid:        true,
+		Weight:       10,
+		RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+			Set: []dataplane.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+		},
+	}
+
+	groups := []dataplane.BackendGroup{
+		createGroup("no-match", nil, stable, canary),
+		createGroup("no-split", &dataplane.CanaryMatch{Header: "X-Canary", Value: "always"}, canary),
+		createGroup(
+			"header",
+			&dataplane.CanaryMatch{Header: "X-Canary", Value: "always", BackendIdx: 1},
+			stable,
+			canary,
+		),
+		createGroup("cookie", &dataplane.CanaryMatch{Cookie: "canary", Value: "default"}, canary, stable),
+		createGroup(
+			"locations",
+			&dataplane.CanaryMatch{Header: "X-Canary", Value: "always", BackendIdx: 1},
+			stable,
+			canaryWithFilters,
+		),
+	}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$http_x_canary",
+			Variable: "$group_test__header_rule0",
+			Parameters: []shared.MapParameter{
+				{Value: "always", Result: "canary"},
+				{Value: "default", Result: "$group_test__header_rule0_split"},
+			},
+		},
+		{
+			Source:   "$cookie_canary",
+			Variable: "$group_test__cookie_rule0",
+			Parameters: []shared.MapParameter{
+				{Value: `\default`, Result: "canary"},
+				{Value: "default", Result: "$group_test__cookie_rule0_split"},
+			},
+		},
+		{
+			Source:   "$http_x_canary",
+			Variable: "$group_test__locations_rule0",
+			Parameters: []shared.MapParameter{
+				{Value: "always", Result: "1"},
+				{Value: "default", Result: "$group_test__locations_rule0_split"},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	g.Expect(buildCanaryMaps(groups)).To(Equal(expMaps))
+}
+
 func TestExecuteStreamMaps(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 	conf := dataplane.Configuration{
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "example.com",
-				Port:         8081,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "example.com",
-				Port:         8080,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "cafe.example.com",
-				Port:         8080,
-				UpstreamName: "backend2",
+				Hostname:  "cafe.example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend2", Weight: 1}},
 			},
 		},
 		SSLServers: []dataplane.VirtualServer{
@@ -265,24 +368,24 @@ func TestCreateStreamMaps(t *testing.T) {
 	conf := dataplane.Configuration{
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "example.com",
-				Port:         8081,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "example.com",
-				Port:         8080,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "cafe.example.com",
-				Port:         8080,
-				UpstreamName: "backend2",
+				Hostname:  "cafe.example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend2", Weight: 1}},
 			},
 			{
-				Hostname:     "dne.example.com",
-				Port:         8080,
-				UpstreamName: "backend-dne",
+				Hostname:  "dne.example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend-dne", Weight: 1}},
 			},
 			{
 				Port:     8082,
@@ -294,9 +397,9 @@ func TestCreateStreamMaps(t *testing.T) {
 				IsDefault: true,
 			},
 			{
-				Hostname:     "no-endpoints.example.com",
-				Port:         8080,
-				UpstreamName: "backend3",
+				Hostname:  "no-endpoints.example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend3", Weight: 1}},
 			},
 		},
 		SSLServers: []dataplane.VirtualServer{
@@ -385,3 +488,108 @@ func TestCreateStreamMapsWithEmpty(t *testing.T) {
 
 	g.Expect(maps).To(BeNil())
 }
+
+func TestBuildGRPCWebMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(buildGRPCWebMaps(dataplane.BaseHTTPConfig{})).To(BeEmpty())
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$http_content_type",
+			Variable: "$grpc_content_type",
+			Parameters: []shared.MapParameter{
+				{Value: "application/grpc-web", Result: "application/grpc"},
+				{Value: "application/grpc-web+proto", Result: "application/grpc+proto"},
+				{Value: "default", Result: "$http_content_type"},
+			},
+		},
+	}
+
+	g.Expect(buildGRPCWebMaps(dataplane.BaseHTTPConfig{GRPCWeb: true})).To(Equal(expMaps))
+}
+
+func TestBuildSessionCookieMaps(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	upstreams := []dataplane.Upstream{
+		{Name: "no-session-persistence"},
+		{
+			Name: "header",
+			SessionPersistence: &dataplane.SessionPersistence{
+				Name: "x-session",
+				Type: dataplane.SessionPersistenceHeader,
+			},
+		},
+		{
+			Name: "cookie-1",
+			SessionPersistence: &dataplane.SessionPersistence{
+				Name: "session",
+				Type: dataplane.SessionPersistenceCookie,
+			},
+		},
+		{
+			Name: "cookie-2",
+			SessionPersistence: &dataplane.SessionPersistence{
+				Name: "session",
+				Type: dataplane.SessionPersistenceCookie,
+			},
+		},
+		{
+			Name: "cookie-3",
+			SessionPersistence: &dataplane.SessionPersistence{
+				Name: "other",
+				Type: dataplane.SessionPersistenceCookie,
+			},
+		},
+	}
+
+	expMaps := []shared.Map{
+		{
+			Source:   "$cookie_other",
+			Variable: "$session_cookie_other",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$cookie_other"},
+			},
+		},
+		{
+			Source:   "$cookie_session",
+			Variable: "$session_cookie_session",
+			Parameters: []shared.MapParameter{
+				{Value: `""`, Result: "$request_id"},
+				{Value: "default", Result: "$cookie_session"},
+			},
+		},
+	}
+
+	g.Expect(buildSessionCookieMaps(upstreams)).To(Equal(expMaps))
+	g.Expect(buildSessionCookieMaps(upstreams[:2])).To(BeEmpty())
+}
+
+func TestExecuteMapsSessionCookie(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		Upstreams: []dataplane.Upstream{
+			{
+				Name: "cookie",
+				SessionPersistence: &dataplane.SessionPersistence{
+					Name: "session",
+					Type: dataplane.SessionPersistenceCookie,
+				},
+			},
+		},
+	}
+
+	ossMaps := string(GeneratorImpl{}.executeMaps(conf)[0].data)
+	g.Expect(ossMaps).To(ContainSubstring("map $cookie_session $session_cookie_session {"))
+	g.Expect(ossMaps).To(ContainSubstring(`"" $request_id;`))
+
+	// NGINX Plus sets the session cookie with the sticky directive, so it doesn't hash the cookie.
+	plusMaps := string(GeneratorImpl{plus: true}.executeMaps(conf)[0].data)
+	g.Expect(plusMaps).ToNot(ContainSubstring("$session_cookie_session"))
+}
@@ -0,0 +1,132 @@
+package compression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+var tmpl = template.Must(template.New("compression policy").Parse(compressionTemplate))
+
+const compressionTemplate = `
+{{- if .Enable }}
+gzip {{ .Enable }};
+{{- end }}
+{{- if .Types }}
+gzip_types {{ .Types }};
+{{- end }}
+{{- if .MinLength }}
+gzip_min_length {{ .MinLength }};
+{{- end }}
+{{- if .Level }}
+gzip_comp_level {{ .Level }};
+{{- end }}
+{{- if .Proxied }}
+gzip_proxied {{ .Proxied }};
+{{- end }}
+{{- if .Vary }}
+gzip_vary {{ .Vary }};
+{{- end }}
+`
+
+// gzipSettings contains the gzip directive values. An empty value means the directive is not set.
+type gzipSettings struct {
+	Enable    string
+	Types     string
+	MinLength string
+	Level     string
+	Proxied   string
+	Vary      string
+}
+
+// Generator generates nginx configuration based on a compression policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		cp, ok := pol.(*ngfAPI.CompressionPolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("CompressionPolicy_%s_%s.conf", cp.Namespace, cp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, buildGzipSettings(cp.Spec.Gzip)),
+		})
+	}
+
+	return files
+}
+
+func buildGzipSettings(gzip *ngfAPI.Gzip) gzipSettings {
+	var settings gzipSettings
+	if gzip == nil {
+		return settings
+	}
+
+	if gzip.Enable != nil {
+		settings.Enable = onOff(*gzip.Enable)
+	}
+
+	types := make([]string, 0, len(gzip.Types))
+	for _, t := range gzip.Types {
+		types = append(types, string(t))
+	}
+	settings.Types = strings.Join(types, " ")
+
+	if gzip.MinLength != nil {
+		settings.MinLength = strconv.Itoa(int(*gzip.MinLength))
+	}
+
+	if gzip.Level != nil {
+		settings.Level = strconv.Itoa(int(*gzip.Level))
+	}
+
+	proxied := make([]string, 0, len(gzip.Proxied))
+	for _, p := range gzip.Proxied {
+		proxied = append(proxied, string(p))
+	}
+	settings.Proxied = strings.Join(proxied, " ")
+
+	if gzip.Vary != nil {
+		settings.Vary = onOff(*gzip.Vary)
+	}
+
+	return settings
+}
+
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+
+	return "off"
+}
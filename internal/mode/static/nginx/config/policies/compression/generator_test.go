@@ -0,0 +1,158 @@
+package compression_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/compression"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        policies.Policy
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "gzip not populated",
+			policy: &ngfAPIv1alpha1.CompressionPolicy{
+				Spec: ngfAPIv1alpha1.CompressionPolicySpec{},
+			},
+			notExpStrings: []string{
+				"gzip",
+			},
+		},
+		{
+			name: "gzip enabled",
+			policy: &ngfAPIv1alpha1.CompressionPolicy{
+				Spec: ngfAPIv1alpha1.CompressionPolicySpec{
+					Gzip: &ngfAPIv1alpha1.Gzip{
+						Enable: helpers.GetPointer(true),
+					},
+				},
+			},
+			expStrings: []string{
+				"gzip on;",
+			},
+			notExpStrings: []string{
+				"gzip_",
+			},
+		},
+		{
+			name: "gzip disabled",
+			policy: &ngfAPIv1alpha1.CompressionPolicy{
+				Spec: ngfAPIv1alpha1.CompressionPolicySpec{
+					Gzip: &ngfAPIv1alpha1.Gzip{
+						Enable: helpers.GetPointer(false),
+					},
+				},
+			},
+			expStrings: []string{
+				"gzip off;",
+			},
+		},
+		{
+			name: "types populated",
+			policy: &ngfAPIv1alpha1.CompressionPolicy{
+				Spec: ngfAPIv1alpha1.CompressionPolicySpec{
+					Gzip: &ngfAPIv1alpha1.Gzip{
+						Types: []ngfAPIv1alpha1.MIMEType{"application/json", "text/css"},
+					},
+				},
+			},
+			expStrings: []string{
+				"gzip_types application/json text/css;",
+			},
+		},
+		{
+			name: "all fields populated",
+			policy: &ngfAPIv1alpha1.CompressionPolicy{
+				Spec: ngfAPIv1alpha1.CompressionPolicySpec{
+					Gzip: &ngfAPIv1alpha1.Gzip{
+						Enable:    helpers.GetPointer(true),
+						Types:     []ngfAPIv1alpha1.MIMEType{"*"},
+						MinLength: helpers.GetPointer[int32](0),
+						Level:     helpers.GetPointer[int32](5),
+						Proxied: []ngfAPIv1alpha1.GzipProxiedCondition{
+							ngfAPIv1alpha1.GzipProxiedExpired,
+							ngfAPIv1alpha1.GzipProxiedNoCache,
+						},
+						Vary: helpers.GetPointer(false),
+					},
+				},
+			},
+			expStrings: []string{
+				"gzip on;",
+				"gzip_types *;",
+				"gzip_min_length 0;",
+				"gzip_comp_level 5;",
+				"gzip_proxied expired no-cache;",
+				"gzip_vary off;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			generator := compression.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := compression.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
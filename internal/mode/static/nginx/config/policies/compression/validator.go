@@ -0,0 +1,137 @@
+package compression
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a CompressionPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a CompressionPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CompressionPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	if err := policies.ValidateTargetRef(cp.Spec.TargetRef, targetRefPath, supportedGroups, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := v.validateSettings(cp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two CompressionPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	cpA := helpers.MustCastObject[*ngfAPI.CompressionPolicy](polA)
+	cpB := helpers.MustCastObject[*ngfAPI.CompressionPolicy](polB)
+
+	return conflicts(cpA.Spec, cpB.Spec)
+}
+
+func conflicts(a, b ngfAPI.CompressionPolicySpec) bool {
+	if a.Gzip == nil || b.Gzip == nil {
+		return false
+	}
+
+	if a.Gzip.Enable != nil && b.Gzip.Enable != nil {
+		return true
+	}
+
+	if len(a.Gzip.Types) != 0 && len(b.Gzip.Types) != 0 {
+		return true
+	}
+
+	if a.Gzip.MinLength != nil && b.Gzip.MinLength != nil {
+		return true
+	}
+
+	if a.Gzip.Level != nil && b.Gzip.Level != nil {
+		return true
+	}
+
+	if len(a.Gzip.Proxied) != 0 && len(b.Gzip.Proxied) != 0 {
+		return true
+	}
+
+	return a.Gzip.Vary != nil && b.Gzip.Vary != nil
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.CompressionPolicySpec) error {
+	if spec.Gzip == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("gzip")
+
+	for i, mimeType := range spec.Gzip.Types {
+		if err := v.genericValidator.ValidateMIMEType(string(mimeType)); err != nil {
+			path := fieldPath.Child("types").Index(i)
+
+			allErrs = append(allErrs, field.Invalid(path, mimeType, err.Error()))
+		}
+	}
+
+	proxiedPath := fieldPath.Child("proxied")
+	for i, cond := range spec.Gzip.Proxied {
+		switch cond {
+		case ngfAPI.GzipProxiedOff, ngfAPI.GzipProxiedAny:
+			// "off" and "any" override all other conditions, so they cannot be combined with them.
+			if len(spec.Gzip.Proxied) > 1 {
+				allErrs = append(
+					allErrs,
+					field.Invalid(proxiedPath.Index(i), cond, "cannot be combined with other conditions"),
+				)
+			}
+		case ngfAPI.GzipProxiedExpired, ngfAPI.GzipProxiedNoCache, ngfAPI.GzipProxiedNoStore,
+			ngfAPI.GzipProxiedPrivate, ngfAPI.GzipProxiedNoLastModified, ngfAPI.GzipProxiedNoETag,
+			ngfAPI.GzipProxiedAuth:
+		default:
+			allErrs = append(
+				allErrs,
+				field.NotSupported(
+					proxiedPath.Index(i),
+					cond,
+					[]string{
+						string(ngfAPI.GzipProxiedOff),
+						string(ngfAPI.GzipProxiedExpired),
+						string(ngfAPI.GzipProxiedNoCache),
+						string(ngfAPI.GzipProxiedNoStore),
+						string(ngfAPI.GzipProxiedPrivate),
+						string(ngfAPI.GzipProxiedNoLastModified),
+						string(ngfAPI.GzipProxiedNoETag),
+						string(ngfAPI.GzipProxiedAuth),
+						string(ngfAPI.GzipProxiedAny),
+					},
+				),
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
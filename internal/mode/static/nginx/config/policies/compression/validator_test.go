@@ -0,0 +1,300 @@
+package compression_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/compression"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy
+
+func createValidPolicy() *ngfAPI.CompressionPolicy {
+	return &ngfAPI.CompressionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.CompressionPolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReference{
+				Group: v1.GroupName,
+				Kind:  kinds.Gateway,
+				Name:  "gateway",
+			},
+			Gzip: &ngfAPI.Gzip{
+				Enable:    helpers.GetPointer(true),
+				Types:     []ngfAPI.MIMEType{"application/json", "text/*"},
+				MinLength: helpers.GetPointer[int32](256),
+				Level:     helpers.GetPointer[int32](5),
+				Proxied:   []ngfAPI.GzipProxiedCondition{ngfAPI.GzipProxiedExpired, ngfAPI.GzipProxiedAuth},
+				Vary:      helpers.GetPointer(true),
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.CompressionPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.CompressionPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.TargetRef.Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.TargetRef.Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid types",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.Gzip.Types = []ngfAPI.MIMEType{"application/json", "text/css;"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.gzip.types[1]: Invalid value: \"text/css;\": " +
+					"must be a MIME type in the format 'type/subtype' or '*' (e.g. 'application/json',  " +
+					"or 'text/*',  or '*', regex used for validation is " +
+					"'\\*|[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+*-]+')"),
+			},
+		},
+		{
+			name: "invalid proxied; off combined with other conditions",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.Gzip.Proxied = append(p.Spec.Gzip.Proxied, ngfAPI.GzipProxiedOff)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.gzip.proxied[2]: Invalid value: \"off\": " +
+					"cannot be combined with other conditions"),
+			},
+		},
+		{
+			name: "invalid proxied; unsupported condition",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.Gzip.Proxied = []ngfAPI.GzipProxiedCondition{"invalid"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.gzip.proxied[0]: Unsupported value: \"invalid\": " +
+					"supported values: \"off\", \"expired\", \"no-cache\", \"no-store\", \"private\", " +
+					"\"no_last_modified\", \"no_etag\", \"auth\", \"any\""),
+			},
+		},
+		{
+			name: "valid; only any proxied condition",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.Gzip.Proxied = []ngfAPI.GzipProxiedCondition{ngfAPI.GzipProxiedAny}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; gzip not set",
+			policy: createModifiedPolicy(func(p *ngfAPI.CompressionPolicy) *ngfAPI.CompressionPolicy {
+				p.Spec.Gzip = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := compression.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := compression.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.CompressionPolicy
+		polB      *ngfAPI.CompressionPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						Enable: helpers.GetPointer(true),
+						Types:  []ngfAPI.MIMEType{"application/json"},
+						Level:  helpers.GetPointer[int32](5),
+					},
+				},
+			},
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						MinLength: helpers.GetPointer[int32](256),
+						Proxied:   []ngfAPI.GzipProxiedCondition{ngfAPI.GzipProxiedAny},
+						Vary:      helpers.GetPointer(true),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "no conflicts; gzip not set",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{},
+			},
+			conflicts: false,
+		},
+		{
+			name: "enable conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						Enable: helpers.GetPointer(false),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "types conflict",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						Types: []ngfAPI.MIMEType{"text/css"},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "min length conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						MinLength: helpers.GetPointer[int32](20),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "level conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						Level: helpers.GetPointer[int32](1),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "proxied conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						Proxied: []ngfAPI.GzipProxiedCondition{ngfAPI.GzipProxiedAny},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "vary conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.CompressionPolicy{
+				Spec: ngfAPI.CompressionPolicySpec{
+					Gzip: &ngfAPI.Gzip{
+						Vary: helpers.GetPointer(false),
+					},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := compression.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := compression.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -0,0 +1,234 @@
+package errorpage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
+)
+
+const (
+	// includesFolder is the folder where the error page bodies and the named location configuration are stored.
+	// It must match the includes folder of the NGINX configuration.
+	includesFolder = "/etc/nginx/includes"
+
+	// defaultContentType is the default MIME type of an error page body.
+	defaultContentType = "text/html"
+
+	// defaultRedirectStatusCode is the default status code of an error page redirect.
+	defaultRedirectStatusCode = 302
+)
+
+var (
+	tmpl         = template.Must(template.New("error page policy").Parse(errorPageTemplate))
+	locationTmpl = template.Must(template.New("error page location").Parse(errorPageLocationTemplate))
+)
+
+const errorPageTemplate = `
+{{- range $p := .ErrorPages }}
+error_page {{ $p.Codes }} {{ $p.Target }};
+{{- end }}
+proxy_intercept_errors {{ .InterceptErrors }};
+`
+
+const errorPageLocationTemplate = `
+{{- if .ProxyPass }}
+proxy_set_header Host "$host";
+proxy_pass {{ .ProxyPass }};
+{{- else }}
+default_type {{ .ContentType }};
+root {{ .Root }};
+try_files /{{ .BodyFile }} =404;
+{{- end }}
+`
+
+// errorPageSettings contains the values of the error_page and proxy_intercept_errors directives.
+type errorPageSettings struct {
+	InterceptErrors string
+	ErrorPages      []errorPage
+}
+
+type errorPage struct {
+	Codes  string
+	Target string
+}
+
+// locationSettings contains the directive values of a named error page location.
+type locationSettings struct {
+	ProxyPass   string
+	ContentType string
+	Root        string
+	BodyFile    string
+}
+
+// Generator generates nginx configuration based on an error page policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		epp, ok := pol.(*ngfAPI.ErrorPagePolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ErrorPagePolicy_%s_%s.conf", epp.Namespace, epp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, buildErrorPageSettings(epp)),
+		})
+	}
+
+	return files
+}
+
+func buildErrorPageSettings(epp *ngfAPI.ErrorPagePolicy) errorPageSettings {
+	settings := errorPageSettings{
+		InterceptErrors: "on",
+		ErrorPages:      make([]errorPage, 0, len(epp.Spec.ErrorPages)),
+	}
+
+	if epp.Spec.InterceptErrors != nil && !*epp.Spec.InterceptErrors {
+		settings.InterceptErrors = "off"
+	}
+
+	for i, page := range epp.Spec.ErrorPages {
+		codes := make([]string, 0, len(page.Codes))
+		for _, code := range page.Codes {
+			codes = append(codes, strconv.Itoa(int(code)))
+		}
+
+		var target string
+		switch {
+		case page.Response != nil:
+			target = LocationName(epp.Namespace, epp.Name, i)
+			if page.Response.StatusCode != nil {
+				target = fmt.Sprintf("=%d %s", *page.Response.StatusCode, target)
+			}
+		case page.Redirect != nil:
+			code := int32(defaultRedirectStatusCode)
+			if page.Redirect.StatusCode != nil {
+				code = *page.Redirect.StatusCode
+			}
+			target = fmt.Sprintf("=%d \"%s\"", code, page.Redirect.URL)
+		case page.BackendRef != nil:
+			// "=" makes NGINX return the status code of the response from the backend.
+			target = "= " + LocationName(epp.Namespace, epp.Name, i)
+		default:
+			continue
+		}
+
+		settings.ErrorPages = append(settings.ErrorPages, errorPage{
+			Codes:  strings.Join(codes, " "),
+			Target: target,
+		})
+	}
+
+	return settings
+}
+
+// LocationName returns the name of the named location that serves the error page with the provided index
+// of the ErrorPagePolicy with the provided namespace and name.
+func LocationName(namespace, name string, idx int) string {
+	return fmt.Sprintf("@ngf_error_page_%s_%s_%d", namespace, name, idx)
+}
+
+// BodyFileName returns the name of the file that holds the error page body stored under the provided key
+// of the ConfigMap with the provided namespace and name.
+// Kubernetes names cannot contain '_', so the file name is unique per ConfigMap key.
+func BodyFileName(namespace, configMap, key string) string {
+	return fmt.Sprintf("ErrorPageBody_%s_%s_%s", namespace, configMap, key)
+}
+
+// BodyFilePath returns the path of the file that holds the error page body. See BodyFileName.
+func BodyFilePath(namespace, configMap, key string) string {
+	return includesFolder + "/" + BodyFileName(namespace, configMap, key)
+}
+
+// UpstreamName returns the name of the upstream for the provided Service and port.
+// It matches the name of the upstreams that are created for the backendRefs of Routes,
+// so that the upstream is shared if a Route references the same Service and port.
+func UpstreamName(namespace, service string, port int32) string {
+	return fmt.Sprintf("%s_%s_%d", namespace, service, port)
+}
+
+// CreateLocations returns the named locations that serve the error pages of all ErrorPagePolicies in the list.
+// Named locations can only be defined in the server context, so the locations must be added to every server
+// that the policies apply to, including the servers of the Routes that are targeted by the policies.
+// Redirects don't need a location.
+func CreateLocations(pols []policies.Policy) []http.Location {
+	var locations []http.Location
+
+	for _, pol := range pols {
+		epp, ok := pol.(*ngfAPI.ErrorPagePolicy)
+		if !ok {
+			continue
+		}
+
+		for i, page := range epp.Spec.ErrorPages {
+			var settings locationSettings
+
+			switch {
+			case page.Response != nil:
+				settings.ContentType = defaultContentType
+				if page.Response.ContentType != nil {
+					settings.ContentType = string(*page.Response.ContentType)
+				}
+				settings.Root = includesFolder
+				settings.BodyFile = BodyFileName(
+					epp.Namespace,
+					string(page.Response.ConfigMapRef.Name),
+					page.Response.ConfigMapRef.Key,
+				)
+			case page.BackendRef != nil:
+				settings.ProxyPass = "http://" + UpstreamName(
+					epp.Namespace,
+					string(page.BackendRef.Name),
+					page.BackendRef.Port,
+				)
+			default:
+				continue
+			}
+
+			name := LocationName(epp.Namespace, epp.Name, i)
+
+			locations = append(locations, http.Location{
+				Path: name,
+				Includes: []shared.Include{
+					{
+						Name:    fmt.Sprintf("%s/ErrorPagePolicy_%s_%s_%d.conf", includesFolder, epp.Namespace, epp.Name, i),
+						Content: helpers.MustExecuteTemplate(locationTmpl, settings),
+					},
+				},
+			})
+		}
+	}
+
+	return locations
+}
@@ -0,0 +1,250 @@
+package errorpage_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	objectMeta := metav1.ObjectMeta{Namespace: "test-ns", Name: "errors"}
+
+	tests := []struct {
+		name          string
+		policy        policies.Policy
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "response error page",
+			policy: &ngfAPIv1alpha1.ErrorPagePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+					ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+						{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404, 410},
+							Response: &ngfAPIv1alpha1.ErrorPageResponse{
+								ConfigMapRef: ngfAPIv1alpha1.ErrorPageConfigMapReference{Name: "pages", Key: "404.html"},
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"error_page 404 410 @ngf_error_page_test-ns_errors_0;",
+				"proxy_intercept_errors on;",
+			},
+		},
+		{
+			name: "response error page with status code override",
+			policy: &ngfAPIv1alpha1.ErrorPagePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+					ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+						{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{500},
+							Response: &ngfAPIv1alpha1.ErrorPageResponse{
+								StatusCode:   helpers.GetPointer[ngfAPIv1alpha1.HTTPStatusCode](200),
+								ConfigMapRef: ngfAPIv1alpha1.ErrorPageConfigMapReference{Name: "pages", Key: "500.html"},
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"error_page 500 =200 @ngf_error_page_test-ns_errors_0;",
+			},
+		},
+		{
+			name: "redirect error pages",
+			policy: &ngfAPIv1alpha1.ErrorPagePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+					ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+						{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{403},
+							Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{
+								URL: "https://example.com/forbidden",
+							},
+						},
+						{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+							Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{
+								StatusCode: helpers.GetPointer[int32](301),
+								URL:        "https://example.com/not-found?from=$host",
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				`error_page 403 =302 "https://example.com/forbidden";`,
+				`error_page 404 =301 "https://example.com/not-found?from=$host";`,
+			},
+		},
+		{
+			name: "backend error page; intercept errors disabled",
+			policy: &ngfAPIv1alpha1.ErrorPagePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+					InterceptErrors: helpers.GetPointer(false),
+					ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+						{
+							Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{502, 503},
+							BackendRef: &ngfAPIv1alpha1.ErrorPageBackendRef{
+								Name: "error-svc",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"error_page 502 503 = @ngf_error_page_test-ns_errors_0;",
+				"proxy_intercept_errors off;",
+			},
+			notExpStrings: []string{
+				"proxy_intercept_errors on;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("ErrorPagePolicy_test-ns_errors.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			generator := errorpage.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := errorpage.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestCreateLocations(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pols := []policies.Policy{
+		&ngfAPIv1alpha1.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "errors"},
+			Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+				ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+					{
+						Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+						Response: &ngfAPIv1alpha1.ErrorPageResponse{
+							ConfigMapRef: ngfAPIv1alpha1.ErrorPageConfigMapReference{Name: "pages", Key: "404.html"},
+						},
+					},
+					{
+						Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{403},
+						Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{
+							URL: "https://example.com/forbidden",
+						},
+					},
+					{
+						Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{500},
+						Response: &ngfAPIv1alpha1.ErrorPageResponse{
+							ContentType:  helpers.GetPointer[ngfAPIv1alpha1.MIMEType]("application/json"),
+							ConfigMapRef: ngfAPIv1alpha1.ErrorPageConfigMapReference{Name: "pages", Key: "500.json"},
+						},
+					},
+					{
+						Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{502},
+						BackendRef: &ngfAPIv1alpha1.ErrorPageBackendRef{
+							Name: "error-svc",
+							Port: 8080,
+						},
+					},
+				},
+			},
+		},
+		&ngfAPIv1alpha2.ObservabilityPolicy{},
+	}
+
+	locations := errorpage.CreateLocations(pols)
+	g.Expect(locations).To(HaveLen(3))
+
+	g.Expect(locations[0].Path).To(Equal("@ngf_error_page_test-ns_errors_0"))
+	g.Expect(locations[0].Includes).To(HaveLen(1))
+	g.Expect(locations[0].Includes[0].Name).To(Equal("/etc/nginx/includes/ErrorPagePolicy_test-ns_errors_0.conf"))
+	g.Expect(string(locations[0].Includes[0].Content)).To(Equal(
+		"\ndefault_type text/html;\nroot /etc/nginx/includes;\ntry_files /ErrorPageBody_test-ns_pages_404.html =404;\n",
+	))
+
+	g.Expect(locations[1].Path).To(Equal("@ngf_error_page_test-ns_errors_2"))
+	g.Expect(string(locations[1].Includes[0].Content)).To(ContainSubstring("default_type application/json;"))
+	g.Expect(string(locations[1].Includes[0].Content)).To(
+		ContainSubstring("try_files /ErrorPageBody_test-ns_pages_500.json =404;"),
+	)
+
+	g.Expect(locations[2].Path).To(Equal("@ngf_error_page_test-ns_errors_3"))
+	g.Expect(string(locations[2].Includes[0].Content)).To(Equal(
+		"\nproxy_set_header Host \"$host\";\nproxy_pass http://test-ns_error-svc_8080;\n",
+	))
+
+	g.Expect(errorpage.CreateLocations(nil)).To(BeEmpty())
+}
+
+func TestBodyFilePath(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(errorpage.BodyFilePath("test-ns", "pages", "404.html")).To(
+		Equal("/etc/nginx/includes/ErrorPageBody_test-ns_pages_404.html"),
+	)
+}
@@ -0,0 +1,96 @@
+package errorpage
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates an ErrorPagePolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of an ErrorPagePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	epp := helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range epp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(epp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ErrorPagePolicies conflict.
+// NGINX doesn't merge the error_page directives of different policies that apply to the same context,
+// so any two ErrorPagePolicies that target the same object conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	_ = helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](polA)
+	_ = helpers.MustCastObject[*ngfAPI.ErrorPagePolicy](polB)
+
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// It also ensures that a status code is only used by a single error page.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ErrorPagePolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec").Child("errorPages")
+
+	codes := make(map[ngfAPI.ErrorPageStatusCode]struct{})
+
+	for i, page := range spec.ErrorPages {
+		pagePath := fieldPath.Index(i)
+
+		for j, code := range page.Codes {
+			if _, exists := codes[code]; exists {
+				allErrs = append(allErrs, field.Duplicate(pagePath.Child("codes").Index(j), code))
+				continue
+			}
+			codes[code] = struct{}{}
+		}
+
+		if page.Response != nil && page.Response.ContentType != nil {
+			if err := v.genericValidator.ValidateMIMEType(string(*page.Response.ContentType)); err != nil {
+				path := pagePath.Child("response").Child("contentType")
+
+				allErrs = append(allErrs, field.Invalid(path, *page.Response.ContentType, err.Error()))
+			}
+		}
+
+		if page.Redirect != nil {
+			if err := v.genericValidator.ValidateEscapedString(page.Redirect.URL); err != nil {
+				path := pagePath.Child("redirect").Child("url")
+
+				allErrs = append(allErrs, field.Invalid(path, page.Redirect.URL, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
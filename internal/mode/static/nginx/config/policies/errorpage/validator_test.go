@@ -0,0 +1,190 @@
+package errorpage_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy
+
+func createValidPolicy() *ngfAPI.ErrorPagePolicy {
+	return &ngfAPI.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "errors",
+		},
+		Spec: ngfAPI.ErrorPagePolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			InterceptErrors: helpers.GetPointer(true),
+			ErrorPages: []ngfAPI.ErrorPage{
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{404, 410},
+					Response: &ngfAPI.ErrorPageResponse{
+						ContentType:  helpers.GetPointer[ngfAPI.MIMEType]("text/html"),
+						ConfigMapRef: ngfAPI.ErrorPageConfigMapReference{Name: "pages", Key: "404.html"},
+					},
+				},
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{403},
+					Redirect: &ngfAPI.ErrorPageRedirect{
+						URL: "https://example.com/forbidden",
+					},
+				},
+				{
+					Codes: []ngfAPI.ErrorPageStatusCode{502, 503},
+					BackendRef: &ngfAPI.ErrorPageBackendRef{
+						Name: "error-svc",
+						Port: 80,
+					},
+				},
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ErrorPagePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ErrorPagePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.TargetRefs[1].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid duplicate status codes",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[2].Codes = append(p.Spec.ErrorPages[2].Codes, 404)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[2].codes[2]: Duplicate value: 404"),
+			},
+		},
+		{
+			name: "invalid content type",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[0].Response.ContentType = helpers.GetPointer[ngfAPI.MIMEType]("text/html;")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[0].response.contentType: Invalid value: " +
+					"\"text/html;\": must be a MIME type in the format 'type/subtype' or '*' " +
+					"(e.g. 'application/json',  or 'text/*',  or '*', regex used for validation is '\\*|[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+*-]+')"),
+			},
+		},
+		{
+			name: "invalid redirect url",
+			policy: createModifiedPolicy(func(p *ngfAPI.ErrorPagePolicy) *ngfAPI.ErrorPagePolicy {
+				p.Spec.ErrorPages[1].Redirect.URL = `https://example.com/"`
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.errorPages[1].redirect.url: Invalid value: " +
+					"\"https://example.com/\\\"\": must have all '\"' (double quotes) escaped and must not end " +
+					"with an unescaped '\\' (backslash) (regex used for validation is '([^\"\\\\]|\\\\.)*')"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := errorpage.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := errorpage.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := errorpage.NewValidator(nil)
+
+	g.Expect(v.Conflicts(createValidPolicy(), &ngfAPI.ErrorPagePolicy{})).To(BeTrue())
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := errorpage.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -0,0 +1,182 @@
+package proxycache
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+const (
+	// cacheFolder is the folder where the cached data is stored. It is backed by an emptyDir volume.
+	cacheFolder = "/var/cache/nginx"
+
+	// defaultZoneSize is the default size of the shared memory zone of a cache.
+	defaultZoneSize = "10m"
+)
+
+var tmpl = template.Must(template.New("proxy cache policy").Parse(proxyCacheTemplate))
+
+const proxyCacheTemplate = `
+proxy_cache {{ .ZoneName }};
+{{- if .Key }}
+proxy_cache_key "{{ .Key }}";
+{{- end }}
+{{- range $v := .Valid }}
+proxy_cache_valid {{ if $v.Codes }}{{ $v.Codes }} {{ end }}{{ $v.Duration }};
+{{- end }}
+{{- if .Bypass }}
+proxy_cache_bypass {{ .Bypass }};
+{{- end }}
+{{- if .UseStale }}
+proxy_cache_use_stale {{ .UseStale }};
+{{- end }}
+{{- if .CacheStatusHeader }}
+add_header {{ .CacheStatusHeader }} $upstream_cache_status always;
+{{- end }}
+`
+
+type cacheSettings struct {
+	ZoneName          string
+	Key               string
+	Bypass            string
+	UseStale          string
+	CacheStatusHeader string
+	Valid             []cacheValid
+}
+
+type cacheValid struct {
+	Codes    string
+	Duration string
+}
+
+// Generator generates nginx configuration based on a proxycache policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		pcp, ok := pol.(*ngfAPI.ProxyCachePolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ProxyCachePolicy_%s_%s.conf", pcp.Namespace, pcp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, buildCacheSettings(pcp)),
+		})
+	}
+
+	return files
+}
+
+func buildCacheSettings(pcp *ngfAPI.ProxyCachePolicy) cacheSettings {
+	settings := cacheSettings{
+		ZoneName: ZoneName(pcp.Namespace, pcp.Name),
+		Valid:    make([]cacheValid, 0, len(pcp.Spec.Valid)),
+	}
+
+	if pcp.Spec.Key != nil {
+		settings.Key = *pcp.Spec.Key
+	}
+
+	for _, v := range pcp.Spec.Valid {
+		codes := make([]string, 0, len(v.Codes))
+		for _, code := range v.Codes {
+			codes = append(codes, strconv.Itoa(int(code)))
+		}
+
+		settings.Valid = append(settings.Valid, cacheValid{
+			Codes:    strings.Join(codes, " "),
+			Duration: string(v.Duration),
+		})
+	}
+
+	bypass := make([]string, 0, len(pcp.Spec.Bypass))
+	for _, b := range pcp.Spec.Bypass {
+		bypass = append(bypass, string(b))
+	}
+	settings.Bypass = strings.Join(bypass, " ")
+
+	useStale := make([]string, 0, len(pcp.Spec.UseStale))
+	for _, u := range pcp.Spec.UseStale {
+		useStale = append(useStale, string(u))
+	}
+	settings.UseStale = strings.Join(useStale, " ")
+
+	if pcp.Spec.CacheStatusHeader != nil {
+		settings.CacheStatusHeader = *pcp.Spec.CacheStatusHeader
+	}
+
+	return settings
+}
+
+// ZoneName returns the name of the cache zone for the ProxyCachePolicy with the provided namespace and name.
+// Kubernetes names cannot contain '_', so the zone name is unique per policy.
+func ZoneName(namespace, name string) string {
+	return fmt.Sprintf("proxy_cache_%s_%s", namespace, name)
+}
+
+// CreateZones returns the cache zones for all ProxyCachePolicies in the list.
+// The zones need to be defined in the http context for the policies to be usable.
+func CreateZones(pols []policies.Policy) []http.ProxyCacheZone {
+	var zones []http.ProxyCacheZone
+
+	for _, pol := range pols {
+		pcp, ok := pol.(*ngfAPI.ProxyCachePolicy)
+		if !ok {
+			continue
+		}
+
+		name := ZoneName(pcp.Namespace, pcp.Name)
+		zone := http.ProxyCacheZone{
+			Name: name,
+			Path: fmt.Sprintf("%s/%s", cacheFolder, name),
+			Size: defaultZoneSize,
+		}
+
+		if pcp.Spec.Zone != nil {
+			if pcp.Spec.Zone.Size != nil {
+				zone.Size = string(*pcp.Spec.Zone.Size)
+			}
+
+			if pcp.Spec.Zone.Inactive != nil {
+				zone.Inactive = string(*pcp.Spec.Zone.Inactive)
+			}
+
+			if pcp.Spec.Zone.MaxSize != nil {
+				zone.MaxSize = string(*pcp.Spec.Zone.MaxSize)
+			}
+		}
+
+		zones = append(zones, zone)
+	}
+
+	return zones
+}
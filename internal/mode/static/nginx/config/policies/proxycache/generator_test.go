@@ -0,0 +1,218 @@
+package proxycache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxycache"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	objectMeta := metav1.ObjectMeta{Namespace: "test-ns", Name: "my-cache"}
+
+	tests := []struct {
+		name          string
+		policy        policies.Policy
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "only zone populated",
+			policy: &ngfAPIv1alpha1.ProxyCachePolicy{
+				ObjectMeta: objectMeta,
+			},
+			expStrings: []string{
+				"proxy_cache proxy_cache_test-ns_my-cache;",
+			},
+			notExpStrings: []string{
+				"proxy_cache_key",
+				"proxy_cache_valid",
+				"proxy_cache_bypass",
+				"proxy_cache_use_stale",
+				"add_header",
+			},
+		},
+		{
+			name: "key populated",
+			policy: &ngfAPIv1alpha1.ProxyCachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+					Key: helpers.GetPointer("$scheme$host$request_uri"),
+				},
+			},
+			expStrings: []string{
+				`proxy_cache_key "$scheme$host$request_uri";`,
+			},
+		},
+		{
+			name: "valid populated",
+			policy: &ngfAPIv1alpha1.ProxyCachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+					Valid: []ngfAPIv1alpha1.ProxyCacheValid{
+						{
+							Codes:    []ngfAPIv1alpha1.HTTPStatusCode{200, 302},
+							Duration: "10m",
+						},
+						{
+							Codes:    []ngfAPIv1alpha1.HTTPStatusCode{404},
+							Duration: "1m",
+						},
+						{
+							Duration: "5m",
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_cache_valid 200 302 10m;",
+				"proxy_cache_valid 404 1m;",
+				"proxy_cache_valid 5m;",
+			},
+		},
+		{
+			name: "bypass populated",
+			policy: &ngfAPIv1alpha1.ProxyCachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+					Bypass: []ngfAPIv1alpha1.NginxVariable{"$cookie_nocache", "$arg_nocache"},
+				},
+			},
+			expStrings: []string{
+				"proxy_cache_bypass $cookie_nocache $arg_nocache;",
+			},
+		},
+		{
+			name: "use stale populated",
+			policy: &ngfAPIv1alpha1.ProxyCachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+					UseStale: []ngfAPIv1alpha1.ProxyCacheUseStaleCondition{
+						ngfAPIv1alpha1.ProxyCacheUseStaleError,
+						ngfAPIv1alpha1.ProxyCacheUseStaleUpdating,
+						ngfAPIv1alpha1.ProxyCacheUseStaleHTTP503,
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_cache_use_stale error updating http_503;",
+			},
+		},
+		{
+			name: "cache status header populated",
+			policy: &ngfAPIv1alpha1.ProxyCachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+					CacheStatusHeader: helpers.GetPointer("X-Cache-Status"),
+				},
+			},
+			expStrings: []string{
+				"add_header X-Cache-Status $upstream_cache_status always;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal("ProxyCachePolicy_test-ns_my-cache.conf"))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			generator := proxycache.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := proxycache.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestCreateZones(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	pols := []policies.Policy{
+		&ngfAPIv1alpha1.ProxyCachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "default-zone"},
+		},
+		&ngfAPIv1alpha2.ObservabilityPolicy{},
+		&ngfAPIv1alpha1.ProxyCachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "custom-zone"},
+			Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+				Zone: &ngfAPIv1alpha1.ProxyCacheZone{
+					Size:     helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+					Inactive: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+					MaxSize:  helpers.GetPointer[ngfAPIv1alpha1.Size]("1g"),
+				},
+			},
+		},
+	}
+
+	expZones := []http.ProxyCacheZone{
+		{
+			Name: "proxy_cache_test-ns_default-zone",
+			Path: "/var/cache/nginx/proxy_cache_test-ns_default-zone",
+			Size: "10m",
+		},
+		{
+			Name:     "proxy_cache_test-ns_custom-zone",
+			Path:     "/var/cache/nginx/proxy_cache_test-ns_custom-zone",
+			Size:     "20m",
+			Inactive: "1h",
+			MaxSize:  "1g",
+		},
+	}
+
+	g.Expect(proxycache.CreateZones(pols)).To(Equal(expZones))
+	g.Expect(proxycache.CreateZones(nil)).To(BeEmpty())
+}
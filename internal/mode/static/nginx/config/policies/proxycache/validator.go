@@ -0,0 +1,144 @@
+package proxycache
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a ProxyCachePolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ProxyCachePolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	pcp := helpers.MustCastObject[*ngfAPI.ProxyCachePolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range pcp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(pcp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ProxyCachePolicies conflict.
+// A target can only use a single cache, so any two ProxyCachePolicies that target the same object conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	_ = helpers.MustCastObject[*ngfAPI.ProxyCachePolicy](polA)
+	_ = helpers.MustCastObject[*ngfAPI.ProxyCachePolicy](polB)
+
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ProxyCachePolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Zone != nil {
+		allErrs = append(allErrs, v.validateZone(*spec.Zone, fieldPath.Child("zone"))...)
+	}
+
+	if spec.Key != nil {
+		if err := v.genericValidator.ValidateEscapedString(*spec.Key); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("key"), *spec.Key, err.Error()))
+		}
+	}
+
+	for i, valid := range spec.Valid {
+		if err := v.genericValidator.ValidateNginxDuration(string(valid.Duration)); err != nil {
+			path := fieldPath.Child("valid").Index(i).Child("duration")
+
+			allErrs = append(allErrs, field.Invalid(path, valid.Duration, err.Error()))
+		}
+	}
+
+	for i, variable := range spec.Bypass {
+		if err := v.genericValidator.ValidateNginxVariableName(string(variable)); err != nil {
+			path := fieldPath.Child("bypass").Index(i)
+
+			allErrs = append(allErrs, field.Invalid(path, variable, err.Error()))
+		}
+	}
+
+	// "off" disables the use of stale responses, so it cannot be combined with any other condition.
+	if len(spec.UseStale) > 1 {
+		for _, cond := range spec.UseStale {
+			if cond == ngfAPI.ProxyCacheUseStaleOff {
+				path := fieldPath.Child("useStale")
+
+				allErrs = append(
+					allErrs,
+					field.Invalid(path, spec.UseStale, "off cannot be combined with other conditions"),
+				)
+
+				break
+			}
+		}
+	}
+
+	if spec.CacheStatusHeader != nil {
+		if err := v.genericValidator.ValidateHeaderName(*spec.CacheStatusHeader); err != nil {
+			path := fieldPath.Child("cacheStatusHeader")
+
+			allErrs = append(allErrs, field.Invalid(path, *spec.CacheStatusHeader, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateZone(zone ngfAPI.ProxyCacheZone, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if zone.Size != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*zone.Size)); err != nil {
+			path := fieldPath.Child("size")
+
+			allErrs = append(allErrs, field.Invalid(path, *zone.Size, err.Error()))
+		}
+	}
+
+	if zone.Inactive != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*zone.Inactive)); err != nil {
+			path := fieldPath.Child("inactive")
+
+			allErrs = append(allErrs, field.Invalid(path, *zone.Inactive, err.Error()))
+		}
+	}
+
+	if zone.MaxSize != nil {
+		if err := v.genericValidator.ValidateNginxSize(string(*zone.MaxSize)); err != nil {
+			path := fieldPath.Child("maxSize")
+
+			allErrs = append(allErrs, field.Invalid(path, *zone.MaxSize, err.Error()))
+		}
+	}
+
+	return allErrs
+}
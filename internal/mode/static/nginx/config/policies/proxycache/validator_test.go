@@ -0,0 +1,227 @@
+package proxycache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxycache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy
+
+func createValidPolicy() *ngfAPI.ProxyCachePolicy {
+	return &ngfAPI.ProxyCachePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cache",
+		},
+		Spec: ngfAPI.ProxyCachePolicySpec{
+			TargetRefs: []v1alpha2.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			Zone: &ngfAPI.ProxyCacheZone{
+				Size:     helpers.GetPointer[ngfAPI.Size]("20m"),
+				Inactive: helpers.GetPointer[ngfAPI.Duration]("1h"),
+				MaxSize:  helpers.GetPointer[ngfAPI.Size]("1g"),
+			},
+			Key: helpers.GetPointer("$scheme$proxy_host$request_uri"),
+			Valid: []ngfAPI.ProxyCacheValid{
+				{
+					Codes:    []ngfAPI.HTTPStatusCode{200, 302},
+					Duration: "10m",
+				},
+			},
+			Bypass:            []ngfAPI.NginxVariable{"$cookie_nocache", "$arg_nocache"},
+			UseStale:          []ngfAPI.ProxyCacheUseStaleCondition{ngfAPI.ProxyCacheUseStaleError},
+			CacheStatusHeader: helpers.GetPointer("X-Cache-Status"),
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ProxyCachePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ProxyCachePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.TargetRefs[1].Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"GRPCRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\""),
+			},
+		},
+		{
+			name: "invalid zone sizes and durations",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.Zone.Size = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Zone.Inactive = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.Zone.MaxSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.Valid[0].Duration = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.zone.size: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+						"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is 'must contain a " +
+						"number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+						"spec.zone.inactive: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h''), " +
+						"spec.zone.maxSize: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+						"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is 'must contain a " +
+						"number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+						"spec.valid[0].duration: Invalid value: \"invalid\": ^[0-9]{1,4}(ms|s|m|h)? " +
+						"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+						"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')]"),
+			},
+		},
+		{
+			name: "invalid key",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.Key = helpers.GetPointer(`$host"`)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.key: Invalid value: \"$host\\\"\": must have all '\"' " +
+					"(double quotes) escaped and must not end with an unescaped '\\' (backslash) " +
+					"(regex used for validation is '([^\"\\\\]|\\\\.)*')"),
+			},
+		},
+		{
+			name: "invalid bypass variable",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.Bypass = []ngfAPI.NginxVariable{"$cookie_nocache", "nocache;"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.bypass[1]: Invalid value: \"nocache;\": must start with '$' " +
+					"followed by alphanumeric characters or '_' (e.g. '$cookie_nocache',  or '$http_pragma',  " +
+					"or '$arg_nocache', regex used for validation is '\\$[a-zA-Z0-9_]+')"),
+			},
+		},
+		{
+			name: "invalid use stale; off combined with other conditions",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.UseStale = append(p.Spec.UseStale, ngfAPI.ProxyCacheUseStaleOff)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.useStale: Invalid value: []v1alpha1.ProxyCacheUseStaleCondition" +
+					"{\"error\", \"off\"}: off cannot be combined with other conditions"),
+			},
+		},
+		{
+			name: "invalid cache status header",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.CacheStatusHeader = helpers.GetPointer("Host")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.cacheStatusHeader: Invalid value: \"Host\": " +
+					"unsupported header name configured, unsupported names are: connection, host, upgrade"),
+			},
+		},
+		{
+			name: "valid; only off use stale condition",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxyCachePolicy) *ngfAPI.ProxyCachePolicy {
+				p.Spec.UseStale = []ngfAPI.ProxyCacheUseStaleCondition{ngfAPI.ProxyCacheUseStaleOff}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := proxycache.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := proxycache.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := proxycache.NewValidator(nil)
+
+	g.Expect(v.Conflicts(createValidPolicy(), &ngfAPI.ProxyCachePolicy{})).To(BeTrue())
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := proxycache.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -0,0 +1,122 @@
+package proxysettings
+
+import (
+	"fmt"
+	"strconv"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+const (
+	// defaultBufferSize is the default of proxy_buffer_size and of the size of one of the proxy_buffers.
+	// NGINX uses the memory page size, which is 4k on the platforms that NGINX Gateway Fabric runs on.
+	defaultBufferSize ngfAPI.Size = "4k"
+	// defaultBuffersNumber is the default number of proxy_buffers.
+	defaultBuffersNumber = 8
+)
+
+// bufferSizes contains the values of the proxy_buffer_size, proxy_buffers and proxy_busy_buffers_size directives.
+//
+// NGINX validates the directives against each other. Because a location inherits each directive from the server
+// separately, a route policy that sets some of the sizes and a Gateway policy that sets the others could result in
+// an invalid combination. To prevent that, all three directives are generated when a policy sets any of them,
+// using the NGINX defaults for the unset fields, and the validator checks those effective values. For the same
+// reason, two policies that target the same resource conflict if both set any of the sizes.
+type bufferSizes struct {
+	bufferSize      ngfAPI.Size
+	busyBuffersSize ngfAPI.Size
+	buffers         ngfAPI.ProxyBuffers
+}
+
+// setsBufferSizes returns true if the buffering settings set any of the buffer sizes.
+func setsBufferSizes(buffering ngfAPI.ProxyBuffering) bool {
+	return buffering.BufferSize != nil || buffering.Buffers != nil || buffering.BusyBuffersSize != nil
+}
+
+// getBufferSizes returns the effective buffer sizes of the buffering settings.
+func getBufferSizes(buffering ngfAPI.ProxyBuffering) (bufferSizes, error) {
+	sizes := bufferSizes{
+		bufferSize: defaultBufferSize,
+		buffers: ngfAPI.ProxyBuffers{
+			Number: defaultBuffersNumber,
+			Size:   defaultBufferSize,
+		},
+	}
+
+	if buffering.BufferSize != nil {
+		sizes.bufferSize = *buffering.BufferSize
+	}
+
+	if buffering.Buffers != nil {
+		sizes.buffers = *buffering.Buffers
+	}
+
+	if buffering.BusyBuffersSize != nil {
+		sizes.busyBuffersSize = *buffering.BusyBuffersSize
+		return sizes, nil
+	}
+
+	// NGINX defaults proxy_busy_buffers_size to twice the larger of proxy_buffer_size and one of the proxy_buffers.
+	largest, err := sizes.largestBuffer()
+	if err != nil {
+		return bufferSizes{}, err
+	}
+
+	sizes.busyBuffersSize = formatSize(2 * largest)
+
+	return sizes, nil
+}
+
+// largestBuffer returns the larger of the buffer size and the size of one of the buffers, in bytes.
+func (s bufferSizes) largestBuffer() (int64, error) {
+	bufferSize, err := parseSize(s.bufferSize)
+	if err != nil {
+		return 0, err
+	}
+
+	buffersSize, err := parseSize(s.buffers.Size)
+	if err != nil {
+		return 0, err
+	}
+
+	return max(bufferSize, buffersSize), nil
+}
+
+// parseSize returns the number of bytes of an NGINX size, like 8k.
+func parseSize(size ngfAPI.Size) (int64, error) {
+	s := string(size)
+	if s == "" {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+
+	var multiplier int64 = 1
+
+	switch s[len(s)-1] {
+	case 'k':
+		multiplier = 1 << 10
+	case 'm':
+		multiplier = 1 << 20
+	case 'g':
+		multiplier = 1 << 30
+	}
+
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %w", size, err)
+	}
+
+	return n * multiplier, nil
+}
+
+// formatSize returns the NGINX size of a number of bytes.
+func formatSize(bytes int64) ngfAPI.Size {
+	if bytes%(1<<10) == 0 {
+		return ngfAPI.Size(fmt.Sprintf("%dk", bytes>>10))
+	}
+
+	return ngfAPI.Size(strconv.FormatInt(bytes, 10))
+}
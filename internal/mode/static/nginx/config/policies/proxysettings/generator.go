@@ -0,0 +1,157 @@
+package proxysettings
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+)
+
+var tmpl = template.Must(template.New("proxy settings policy").Parse(proxySettingsTemplate))
+
+// The grpc_* directives are generated next to the proxy_* directives, because the policy applies to GRPCRoutes
+// as well, and the includes of internal locations don't know whether the location proxies to a gRPC upstream.
+const proxySettingsTemplate = `
+{{- if .Buffering }}
+proxy_buffering {{ .Buffering }};
+{{- end }}
+{{- if .RequestBuffering }}
+proxy_request_buffering {{ .RequestBuffering }};
+{{- end }}
+{{- if .BufferSize }}
+proxy_buffer_size {{ .BufferSize }};
+grpc_buffer_size {{ .BufferSize }};
+{{- end }}
+{{- if .Buffers }}
+proxy_buffers {{ .Buffers }};
+{{- end }}
+{{- if .BusyBuffersSize }}
+proxy_busy_buffers_size {{ .BusyBuffersSize }};
+{{- end }}
+{{- if .MaxTempFileSize }}
+proxy_max_temp_file_size {{ .MaxTempFileSize }};
+{{- end }}
+{{- if .ConnectTimeout }}
+proxy_connect_timeout {{ .ConnectTimeout }};
+grpc_connect_timeout {{ .ConnectTimeout }};
+{{- end }}
+{{- if .ReadTimeout }}
+proxy_read_timeout {{ .ReadTimeout }};
+grpc_read_timeout {{ .ReadTimeout }};
+{{- end }}
+{{- if .SendTimeout }}
+proxy_send_timeout {{ .SendTimeout }};
+grpc_send_timeout {{ .SendTimeout }};
+{{- end }}
+`
+
+// proxySettings contains the proxy directive values. An empty value means the directive is not set.
+type proxySettings struct {
+	Buffering        string
+	RequestBuffering string
+	BufferSize       string
+	Buffers          string
+	BusyBuffersSize  string
+	MaxTempFileSize  string
+	ConnectTimeout   string
+	ReadTimeout      string
+	SendTimeout      string
+}
+
+// Generator generates nginx configuration based on a proxy settings policy.
+type Generator struct{}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols)
+}
+
+func generate(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		psp, ok := pol.(*ngfAPI.ProxySettingsPolicy)
+		if !ok {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("ProxySettingsPolicy_%s_%s.conf", psp.Namespace, psp.Name),
+			Content: helpers.MustExecuteTemplate(tmpl, buildProxySettings(psp.Spec)),
+		})
+	}
+
+	return files
+}
+
+func buildProxySettings(spec ngfAPI.ProxySettingsPolicySpec) proxySettings {
+	var settings proxySettings
+
+	if buffering := spec.Buffering; buffering != nil {
+		if buffering.Disable != nil {
+			settings.Buffering = onOff(!*buffering.Disable)
+		}
+
+		if buffering.DisableRequest != nil {
+			settings.RequestBuffering = onOff(!*buffering.DisableRequest)
+		}
+
+		if setsBufferSizes(*buffering) {
+			// The validator has already made sure that the sizes are valid.
+			sizes, err := getBufferSizes(*buffering)
+			if err != nil {
+				panic(err)
+			}
+
+			settings.BufferSize = string(sizes.bufferSize)
+			settings.Buffers = fmt.Sprintf("%d %s", sizes.buffers.Number, sizes.buffers.Size)
+			settings.BusyBuffersSize = string(sizes.busyBuffersSize)
+		}
+
+		if buffering.MaxTempFileSize != nil {
+			settings.MaxTempFileSize = string(*buffering.MaxTempFileSize)
+		}
+	}
+
+	if timeout := spec.Timeout; timeout != nil {
+		if timeout.Connect != nil {
+			settings.ConnectTimeout = string(*timeout.Connect)
+		}
+
+		if timeout.Read != nil {
+			settings.ReadTimeout = string(*timeout.Read)
+		}
+
+		if timeout.Send != nil {
+			settings.SendTimeout = string(*timeout.Send)
+		}
+	}
+
+	return settings
+}
+
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+
+	return "off"
+}
@@ -0,0 +1,240 @@
+package proxysettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        policies.Policy
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "nothing populated",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{},
+			},
+			notExpStrings: []string{
+				"proxy_",
+				"grpc_",
+			},
+		},
+		{
+			name: "buffering disabled",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						Disable:        helpers.GetPointer(true),
+						DisableRequest: helpers.GetPointer(true),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffering off;",
+				"proxy_request_buffering off;",
+			},
+			notExpStrings: []string{
+				"proxy_buffer_size",
+				"proxy_buffers",
+				"_timeout",
+			},
+		},
+		{
+			name: "buffering enabled",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						Disable:        helpers.GetPointer(false),
+						DisableRequest: helpers.GetPointer(false),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffering on;",
+				"proxy_request_buffering on;",
+			},
+		},
+		{
+			name: "buffer size populated; other buffer sizes default",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						BufferSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("8k"),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffer_size 8k;",
+				"grpc_buffer_size 8k;",
+				"proxy_buffers 8 4k;",
+				"proxy_busy_buffers_size 16k;",
+			},
+			notExpStrings: []string{
+				"proxy_buffering",
+				"proxy_max_temp_file_size",
+			},
+		},
+		{
+			name: "buffers populated; other buffer sizes default",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						Buffers: &ngfAPIv1alpha1.ProxyBuffers{
+							Number: 4,
+							Size:   "5000",
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffer_size 4k;",
+				"proxy_buffers 4 5000;",
+				"proxy_busy_buffers_size 10000;",
+			},
+		},
+		{
+			name: "busy buffers size populated; other buffer sizes default",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						BusyBuffersSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("12k"),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffer_size 4k;",
+				"proxy_buffers 8 4k;",
+				"proxy_busy_buffers_size 12k;",
+			},
+		},
+		{
+			name: "timeouts populated",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Timeout: &ngfAPIv1alpha1.ProxyTimeout{
+						Connect: helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+						Read:    helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+						Send:    helpers.GetPointer[ngfAPIv1alpha1.Duration]("30s"),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_connect_timeout 5s;",
+				"grpc_connect_timeout 5s;",
+				"proxy_read_timeout 1h;",
+				"grpc_read_timeout 1h;",
+				"proxy_send_timeout 30s;",
+				"grpc_send_timeout 30s;",
+			},
+			notExpStrings: []string{
+				"buffer",
+			},
+		},
+		{
+			name: "all fields populated",
+			policy: &ngfAPIv1alpha1.ProxySettingsPolicy{
+				Spec: ngfAPIv1alpha1.ProxySettingsPolicySpec{
+					Buffering: &ngfAPIv1alpha1.ProxyBuffering{
+						Disable:        helpers.GetPointer(false),
+						DisableRequest: helpers.GetPointer(true),
+						BufferSize:     helpers.GetPointer[ngfAPIv1alpha1.Size]("8k"),
+						Buffers: &ngfAPIv1alpha1.ProxyBuffers{
+							Number: 16,
+							Size:   "4k",
+						},
+						BusyBuffersSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("16k"),
+						MaxTempFileSize: helpers.GetPointer[ngfAPIv1alpha1.Size]("0"),
+					},
+					Timeout: &ngfAPIv1alpha1.ProxyTimeout{
+						Connect: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+						Read:    helpers.GetPointer[ngfAPIv1alpha1.Duration]("2m"),
+						Send:    helpers.GetPointer[ngfAPIv1alpha1.Duration]("1m"),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_buffering on;",
+				"proxy_request_buffering off;",
+				"proxy_buffer_size 8k;",
+				"grpc_buffer_size 8k;",
+				"proxy_buffers 16 4k;",
+				"proxy_busy_buffers_size 16k;",
+				"proxy_max_temp_file_size 0;",
+				"proxy_connect_timeout 10s;",
+				"grpc_connect_timeout 10s;",
+				"proxy_read_timeout 2m;",
+				"grpc_read_timeout 2m;",
+				"proxy_send_timeout 1m;",
+				"grpc_send_timeout 1m;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+		g.Expect(resFiles).To(HaveLen(1))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			generator := proxysettings.NewGenerator()
+
+			resFiles := generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := proxysettings.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
@@ -0,0 +1,243 @@
+package proxysettings
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// Validator validates a ProxySettingsPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a ProxySettingsPolicy.
+func (v *Validator) Validate(policy policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	psp := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRef")
+	supportedKinds := []gatewayv1.Kind{kinds.Gateway, kinds.HTTPRoute, kinds.GRPCRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	if err := policies.ValidateTargetRef(psp.Spec.TargetRef, targetRefPath, supportedGroups, supportedKinds); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	if err := v.validateSettings(psp.Spec); err != nil {
+		return []conditions.Condition{staticConds.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// Conflicts returns true if the two ProxySettingsPolicies conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	pspA := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](polA)
+	pspB := helpers.MustCastObject[*ngfAPI.ProxySettingsPolicy](polB)
+
+	return conflicts(pspA.Spec, pspB.Spec)
+}
+
+func conflicts(a, b ngfAPI.ProxySettingsPolicySpec) bool {
+	if a.Buffering != nil && b.Buffering != nil {
+		if a.Buffering.Disable != nil && b.Buffering.Disable != nil {
+			return true
+		}
+
+		if a.Buffering.DisableRequest != nil && b.Buffering.DisableRequest != nil {
+			return true
+		}
+
+		// The buffer sizes are generated together, so any two policies that set a buffer size conflict.
+		if setsBufferSizes(*a.Buffering) && setsBufferSizes(*b.Buffering) {
+			return true
+		}
+
+		if a.Buffering.MaxTempFileSize != nil && b.Buffering.MaxTempFileSize != nil {
+			return true
+		}
+	}
+
+	if a.Timeout != nil && b.Timeout != nil {
+		if a.Timeout.Connect != nil && b.Timeout.Connect != nil {
+			return true
+		}
+
+		if a.Timeout.Read != nil && b.Timeout.Read != nil {
+			return true
+		}
+
+		if a.Timeout.Send != nil && b.Timeout.Send != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.ProxySettingsPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Buffering != nil {
+		allErrs = append(allErrs, v.validateBuffering(*spec.Buffering, fieldPath.Child("buffering"))...)
+	}
+
+	if spec.Timeout != nil {
+		allErrs = append(allErrs, v.validateTimeout(*spec.Timeout, fieldPath.Child("timeout"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func (v *Validator) validateBuffering(buffering ngfAPI.ProxyBuffering, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	sizes := []struct {
+		size *ngfAPI.Size
+		path *field.Path
+	}{
+		{size: buffering.BufferSize, path: fieldPath.Child("bufferSize")},
+		{size: buffering.BusyBuffersSize, path: fieldPath.Child("busyBuffersSize")},
+		{size: buffering.MaxTempFileSize, path: fieldPath.Child("maxTempFileSize")},
+	}
+
+	if buffering.Buffers != nil {
+		sizes = append(sizes, struct {
+			size *ngfAPI.Size
+			path *field.Path
+		}{size: &buffering.Buffers.Size, path: fieldPath.Child("buffers").Child("size")})
+	}
+
+	for _, s := range sizes {
+		if s.size == nil {
+			continue
+		}
+
+		if err := v.genericValidator.ValidateNginxSize(string(*s.size)); err != nil {
+			allErrs = append(allErrs, field.Invalid(s.path, *s.size, err.Error()))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs
+	}
+
+	return validateBufferSizes(buffering, fieldPath)
+}
+
+// validateBufferSizes validates the buffer sizes against each other, the same way NGINX validates the
+// proxy_buffer_size, proxy_buffers, proxy_busy_buffers_size and proxy_max_temp_file_size directives.
+// The NGINX defaults are used for the unset fields.
+func validateBufferSizes(buffering ngfAPI.ProxyBuffering, fieldPath *field.Path) field.ErrorList {
+	sizes, err := getBufferSizes(buffering)
+	if err != nil {
+		return field.ErrorList{field.InternalError(fieldPath, err)}
+	}
+
+	largest, err := sizes.largestBuffer()
+	if err != nil {
+		return field.ErrorList{field.InternalError(fieldPath, err)}
+	}
+
+	largestMsg := fmt.Sprintf(
+		"the larger of bufferSize (%s) and the size of one of the buffers (%s)",
+		sizes.bufferSize,
+		sizes.buffers.Size,
+	)
+
+	var allErrs field.ErrorList
+
+	if setsBufferSizes(buffering) {
+		busy, err := parseSize(sizes.busyBuffersSize)
+		if err != nil {
+			return field.ErrorList{field.InternalError(fieldPath, err)}
+		}
+
+		buffersSize, err := parseSize(sizes.buffers.Size)
+		if err != nil {
+			return field.ErrorList{field.InternalError(fieldPath, err)}
+		}
+
+		busyPath := fieldPath.Child("busyBuffersSize")
+
+		if busy < largest {
+			allErrs = append(allErrs, field.Invalid(
+				busyPath,
+				sizes.busyBuffersSize,
+				"must be equal to or greater than "+largestMsg,
+			))
+		}
+
+		if limit := int64(sizes.buffers.Number-1) * buffersSize; busy > limit {
+			allErrs = append(allErrs, field.Invalid(
+				busyPath,
+				sizes.busyBuffersSize,
+				fmt.Sprintf(
+					"must not be greater than the size of all buffers minus one buffer (%s); "+
+						"if unset, it defaults to twice the larger of bufferSize and the size of one of the buffers",
+					formatSize(limit),
+				),
+			))
+		}
+	}
+
+	if buffering.MaxTempFileSize != nil {
+		maxTempFileSize, err := parseSize(*buffering.MaxTempFileSize)
+		if err != nil {
+			return field.ErrorList{field.InternalError(fieldPath, err)}
+		}
+
+		if maxTempFileSize != 0 && maxTempFileSize < largest {
+			allErrs = append(allErrs, field.Invalid(
+				fieldPath.Child("maxTempFileSize"),
+				*buffering.MaxTempFileSize,
+				"must be 0 or equal to or greater than "+largestMsg,
+			))
+		}
+	}
+
+	return allErrs
+}
+
+func (v *Validator) validateTimeout(timeout ngfAPI.ProxyTimeout, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	durations := []struct {
+		duration *ngfAPI.Duration
+		path     *field.Path
+	}{
+		{duration: timeout.Connect, path: fieldPath.Child("connect")},
+		{duration: timeout.Read, path: fieldPath.Child("read")},
+		{duration: timeout.Send, path: fieldPath.Child("send")},
+	}
+
+	for _, d := range durations {
+		if d.duration == nil {
+			continue
+		}
+
+		if err := v.genericValidator.ValidateNginxDuration(string(*d.duration)); err != nil {
+			allErrs = append(allErrs, field.Invalid(d.path, *d.duration, err.Error()))
+		}
+	}
+
+	return allErrs
+}
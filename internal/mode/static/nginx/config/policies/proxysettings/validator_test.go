@@ -0,0 +1,462 @@
+package proxysettings_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxysettings"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+type policyModFunc func(policy *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy
+
+func createValidPolicy() *ngfAPI.ProxySettingsPolicy {
+	return &ngfAPI.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.ProxySettingsPolicySpec{
+			TargetRef: v1alpha2.LocalPolicyTargetReference{
+				Group: v1.GroupName,
+				Kind:  kinds.HTTPRoute,
+				Name:  "route",
+			},
+			Buffering: &ngfAPI.ProxyBuffering{
+				Disable:        helpers.GetPointer(true),
+				DisableRequest: helpers.GetPointer(true),
+				BufferSize:     helpers.GetPointer[ngfAPI.Size]("8k"),
+				Buffers: &ngfAPI.ProxyBuffers{
+					Number: 8,
+					Size:   "4k",
+				},
+				BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("8k"),
+				MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("1m"),
+			},
+			Timeout: &ngfAPI.ProxyTimeout{
+				Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Read:    helpers.GetPointer[ngfAPI.Duration]("1h"),
+				Send:    helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
+		},
+		Status: v1alpha2.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.ProxySettingsPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.ProxySettingsPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.TLSRoute
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.targetRef.kind: Unsupported value: \"TLSRoute\": " +
+					"supported values: \"Gateway\", \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "invalid sizes",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BufferSize = helpers.GetPointer[ngfAPI.Size]("8kb")
+				p.Spec.Buffering.Buffers.Size = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("[spec.buffering.bufferSize: Invalid value: \"8kb\": ^\\d{1,4}(k|m|g)?$ " +
+					"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is " +
+					"'must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed'), " +
+					"spec.buffering.buffers.size: Invalid value: \"invalid\": ^\\d{1,4}(k|m|g)?$ " +
+					"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is " +
+					"'must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed')]"),
+			},
+		},
+		{
+			name: "invalid buffer size; default busy buffers size exceeds default buffers",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					BufferSize: helpers.GetPointer[ngfAPI.Size]("32k"),
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.busyBuffersSize: Invalid value: \"64k\": " +
+					"must not be greater than the size of all buffers minus one buffer (28k); " +
+					"if unset, it defaults to twice the larger of bufferSize and the size of one of the buffers"),
+			},
+		},
+		{
+			name: "invalid buffers; default busy buffers size exceeds buffers",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					Buffers: &ngfAPI.ProxyBuffers{
+						Number: 2,
+						Size:   "4k",
+					},
+				}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.busyBuffersSize: Invalid value: \"8k\": " +
+					"must not be greater than the size of all buffers minus one buffer (4k); " +
+					"if unset, it defaults to twice the larger of bufferSize and the size of one of the buffers"),
+			},
+		},
+		{
+			name: "invalid busy buffers size; smaller than buffer size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BufferSize = helpers.GetPointer[ngfAPI.Size]("16k")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.busyBuffersSize: Invalid value: \"8k\": " +
+					"must be equal to or greater than the larger of bufferSize (16k) " +
+					"and the size of one of the buffers (4k)"),
+			},
+		},
+		{
+			name: "invalid busy buffers size; larger than buffers",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.BusyBuffersSize = helpers.GetPointer[ngfAPI.Size]("1m")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.busyBuffersSize: Invalid value: \"1m\": " +
+					"must not be greater than the size of all buffers minus one buffer (28k); " +
+					"if unset, it defaults to twice the larger of bufferSize and the size of one of the buffers"),
+			},
+		},
+		{
+			name: "invalid max temp file size; smaller than buffer size",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.MaxTempFileSize = helpers.GetPointer[ngfAPI.Size]("4k")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.buffering.maxTempFileSize: Invalid value: \"4k\": " +
+					"must be 0 or equal to or greater than the larger of bufferSize (8k) " +
+					"and the size of one of the buffers (4k)"),
+			},
+		},
+		{
+			name: "invalid timeout",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Timeout.Read = helpers.GetPointer[ngfAPI.Duration]("1d")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid("spec.timeout.read: Invalid value: \"1d\": ^[0-9]{1,4}(ms|s|m|h)? " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h'')"),
+			},
+		},
+		{
+			name: "valid; GRPCRoute target",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.TargetRef.Kind = kinds.GRPCRoute
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; only buffer size set",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering = &ngfAPI.ProxyBuffering{
+					BufferSize: helpers.GetPointer[ngfAPI.Size]("8k"),
+				}
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; max temp file size 0",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering.MaxTempFileSize = helpers.GetPointer[ngfAPI.Size]("0")
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name: "valid; nothing set",
+			policy: createModifiedPolicy(func(p *ngfAPI.ProxySettingsPolicy) *ngfAPI.ProxySettingsPolicy {
+				p.Spec.Buffering = nil
+				p.Spec.Timeout = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := proxysettings.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy, nil)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := proxysettings.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{}, nil)
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		polA      *ngfAPI.ProxySettingsPolicy
+		polB      *ngfAPI.ProxySettingsPolicy
+		name      string
+		conflicts bool
+	}{
+		{
+			name: "no conflicts",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Disable:    helpers.GetPointer(true),
+						BufferSize: helpers.GetPointer[ngfAPI.Size]("8k"),
+					},
+					Timeout: &ngfAPI.ProxyTimeout{
+						Read: helpers.GetPointer[ngfAPI.Duration]("1h"),
+					},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						DisableRequest:  helpers.GetPointer(true),
+						MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("0"),
+					},
+					Timeout: &ngfAPI.ProxyTimeout{
+						Connect: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "no conflicts; nothing set",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{},
+			},
+			conflicts: false,
+		},
+		{
+			name: "disable conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Disable: helpers.GetPointer(false),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "disable request conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						DisableRequest: helpers.GetPointer(false),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffer size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						BufferSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffer size and buffers conflict",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						BufferSize: helpers.GetPointer[ngfAPI.Size]("8k"),
+					},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Buffers: &ngfAPI.ProxyBuffers{Number: 4, Size: "8k"},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffers and busy buffers size conflict",
+			polA: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Buffers: &ngfAPI.ProxyBuffers{Number: 4, Size: "8k"},
+					},
+				},
+			},
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "buffers conflict",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						Buffers: &ngfAPI.ProxyBuffers{Number: 4, Size: "8k"},
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "busy buffers size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						BusyBuffersSize: helpers.GetPointer[ngfAPI.Size]("16k"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "max temp file size conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Buffering: &ngfAPI.ProxyBuffering{
+						MaxTempFileSize: helpers.GetPointer[ngfAPI.Size]("0"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "connect timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeout: &ngfAPI.ProxyTimeout{
+						Connect: helpers.GetPointer[ngfAPI.Duration]("1s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "read timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeout: &ngfAPI.ProxyTimeout{
+						Read: helpers.GetPointer[ngfAPI.Duration]("1s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "send timeout conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.ProxySettingsPolicy{
+				Spec: ngfAPI.ProxySettingsPolicySpec{
+					Timeout: &ngfAPI.ProxyTimeout{
+						Send: helpers.GetPointer[ngfAPI.Duration]("1s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+	}
+
+	v := proxysettings.NewValidator(nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(v.Conflicts(test.polA, test.polB)).To(Equal(test.conflicts))
+		})
+	}
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := proxysettings.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
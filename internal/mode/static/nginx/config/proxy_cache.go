@@ -0,0 +1,62 @@
+package config
+
+import (
+	"sort"
+	gotemplate "text/template"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/proxycache"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+var proxyCacheZonesTemplate = gotemplate.Must(
+	gotemplate.New("proxyCacheZones").Parse(proxyCacheZonesTemplateText),
+)
+
+func executeProxyCacheZones(conf dataplane.Configuration) []executeResult {
+	zones := createProxyCacheZones(conf)
+	if len(zones) == 0 {
+		return nil
+	}
+
+	result := executeResult{
+		dest: httpConfigFile,
+		data: helpers.MustExecuteTemplate(proxyCacheZonesTemplate, zones),
+	}
+
+	return []executeResult{result}
+}
+
+// createProxyCacheZones returns the cache zones of all ProxyCachePolicies that are applied to the servers
+// and locations. A policy can be applied to multiple servers and locations, but its zone is only defined once.
+func createProxyCacheZones(conf dataplane.Configuration) []http.ProxyCacheZone {
+	var pols []policies.Policy
+
+	for _, servers := range [][]dataplane.VirtualServer{conf.HTTPServers, conf.SSLServers} {
+		for _, server := range servers {
+			pols = append(pols, server.Policies...)
+
+			for _, rule := range server.PathRules {
+				pols = append(pols, rule.Policies...)
+			}
+		}
+	}
+
+	uniqueZones := make(map[string]http.ProxyCacheZone)
+	for _, zone := range proxycache.CreateZones(pols) {
+		uniqueZones[zone.Name] = zone
+	}
+
+	zones := make([]http.ProxyCacheZone, 0, len(uniqueZones))
+	for _, zone := range uniqueZones {
+		zones = append(zones, zone)
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
+}
@@ -0,0 +1,9 @@
+package config
+
+const proxyCacheZonesTemplateText = `
+{{- range $z := . }}
+proxy_cache_path {{ $z.Path }} levels=1:2 keys_zone={{ $z.Name }}:{{ $z.Size }}
+    {{- if $z.Inactive }} inactive={{ $z.Inactive }}{{ end }}
+    {{- if $z.MaxSize }} max_size={{ $z.MaxSize }}{{ end }};
+{{- end }}
+`
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteProxyCacheZones(t *testing.T) {
+	t.Parallel()
+
+	gatewayPolicy := &ngfAPIv1alpha1.ProxyCachePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway-cache"},
+	}
+	routePolicy := &ngfAPIv1alpha1.ProxyCachePolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route-cache"},
+		Spec: ngfAPIv1alpha1.ProxyCachePolicySpec{
+			Zone: &ngfAPIv1alpha1.ProxyCacheZone{
+				Size:     helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+				Inactive: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+				MaxSize:  helpers.GetPointer[ngfAPIv1alpha1.Size]("1g"),
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Policies: []policies.Policy{gatewayPolicy},
+				PathRules: []dataplane.PathRule{
+					{Policies: []policies.Policy{routePolicy}},
+				},
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				Policies: []policies.Policy{gatewayPolicy},
+				PathRules: []dataplane.PathRule{
+					{Policies: []policies.Policy{routePolicy}},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+	expSubStrings := map[string]int{
+		"proxy_cache_path /var/cache/nginx/proxy_cache_test_gateway-cache levels=1:2 " +
+			"keys_zone=proxy_cache_test_gateway-cache:10m;": 1,
+		"proxy_cache_path /var/cache/nginx/proxy_cache_test_route-cache levels=1:2 " +
+			"keys_zone=proxy_cache_test_route-cache:20m inactive=1h max_size=1g;": 1,
+	}
+
+	res := executeProxyCacheZones(conf)
+	g.Expect(res).To(HaveLen(1))
+	g.Expect(res[0].dest).To(Equal(httpConfigFile))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(res[0].data), expSubStr)).To(Equal(expCount))
+	}
+}
+
+func TestExecuteProxyCacheZonesNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{{}},
+			},
+		},
+	}
+
+	g.Expect(executeProxyCacheZones(conf)).To(BeEmpty())
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	gotemplate "text/template"
@@ -11,6 +12,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/errorpage"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
@@ -21,6 +23,14 @@ const (
 	// HeaderMatchSeparator is the separator for constructing header-based match for NJS.
 	HeaderMatchSeparator = ":"
 	rootPath             = "/"
+
+	// defaultServerContentType is the default MIME type of the responses returned by a default server.
+	defaultServerContentType = "text/html"
+
+	// grpcHealthServicePath is the path prefix of the methods of the gRPC health service.
+	grpcHealthServicePath = "/grpc.health.v1.Health"
+	// grpcContentTypeVariable holds the content type of a request translated from gRPC-Web to gRPC.
+	grpcContentTypeVariable = "$grpc_content_type"
 )
 
 var grpcAuthorityHeader = http.Header{
@@ -38,6 +48,11 @@ var unsetHTTPConnectionHeader = http.Header{
 	Value: "",
 }
 
+var grpcContentTypeHeader = http.Header{
+	Name:  "Content-Type",
+	Value: grpcContentTypeVariable,
+}
+
 var httpUpgradeHeader = http.Header{
 	Name:  "Upgrade",
 	Value: "$http_upgrade",
@@ -59,6 +74,10 @@ func (g GeneratorImpl) executeServers(
 ) []executeResult {
 	servers, httpMatchPairs := createServers(conf, generator, keepAliveCheck)
 
+	if g.dynamicUpstreams {
+		useDynamicUpstreams(servers)
+	}
+
 	serverConfig := http.ServerConfig{
 		Servers:         servers,
 		IPFamily:        getIPFamily(conf.BaseHTTPConfig),
@@ -120,6 +139,7 @@ func createServers(
 	for idx, s := range conf.HTTPServers {
 		serverID := fmt.Sprintf("%d", idx)
 		httpServer, matchPairs := createServer(s, serverID, generator, keepAliveCheck)
+		configureGRPC(&httpServer, s, conf.BaseHTTPConfig)
 		servers = append(servers, httpServer)
 		maps.Copy(finalMatchPairs, matchPairs)
 	}
@@ -128,6 +148,7 @@ func createServers(
 		serverID := fmt.Sprintf("SSL_%d", idx)
 
 		sslServer, matchPairs := createSSLServer(s, serverID, generator, keepAliveCheck)
+		configureGRPC(&sslServer, s, conf.BaseHTTPConfig)
 		if _, portInUse := sharedTLSPorts[s.Port]; portInUse {
 			sslServer.Listen = getSocketNameHTTPS(s.Port)
 			sslServer.IsSocket = true
@@ -147,13 +168,27 @@ func createSSLServer(
 ) (http.Server, httpMatchPairs) {
 	listen := fmt.Sprint(virtualServer.Port)
 	if virtualServer.IsDefault {
-		return http.Server{
+		server := http.Server{
 			IsDefaultSSL: true,
 			Listen:       listen,
-		}, nil
+		}
+
+		if virtualServer.DefaultAction != nil && virtualServer.SSL != nil {
+			server.SSL = &http.SSL{
+				Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
+				CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
+			}
+			server.DefaultType = getDefaultServerContentType(*virtualServer.DefaultAction)
+			server.Locations = []http.Location{
+				createDefaultServerLocation(*virtualServer.DefaultAction, keepAliveCheck),
+			}
+		}
+
+		return server, nil
 	}
 
 	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck)
+	locs = append(locs, createErrorPageLocations(virtualServer)...)
 
 	server := http.Server{
 		ServerName: virtualServer.Hostname,
@@ -161,9 +196,10 @@ func createSSLServer(
 			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
 			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
 		},
-		Locations: locs,
-		GRPC:      grpc,
-		Listen:    listen,
+		ProxySSLCertificate: createProxySSLCertificate(virtualServer.BackendClientCertificate),
+		Locations:           locs,
+		GRPC:                grpc,
+		Listen:              listen,
 	}
 
 	policyIncludes := createIncludesFromPolicyGenerateResult(
@@ -187,19 +223,38 @@ func createServer(
 	listen := fmt.Sprint(virtualServer.Port)
 
 	if virtualServer.IsDefault {
-		return http.Server{
+		server := http.Server{
 			IsDefaultHTTP: true,
 			Listen:        listen,
+		}
+
+		if virtualServer.DefaultAction != nil {
+			server.DefaultType = getDefaultServerContentType(*virtualServer.DefaultAction)
+			server.Locations = []http.Location{
+				createDefaultServerLocation(*virtualServer.DefaultAction, keepAliveCheck),
+			}
+		}
+
+		return server, nil
+	}
+
+	if virtualServer.HTTPSRedirect != nil {
+		return http.Server{
+			ServerName: virtualServer.Hostname,
+			Listen:     listen,
+			Locations:  []http.Location{createHTTPSRedirectLocation(*virtualServer.HTTPSRedirect)},
 		}, nil
 	}
 
 	locs, matchPairs, grpc := createLocations(&virtualServer, serverID, generator, keepAliveCheck)
+	locs = append(locs, createErrorPageLocations(virtualServer)...)
 
 	server := http.Server{
-		ServerName: virtualServer.Hostname,
-		Locations:  locs,
-		Listen:     listen,
-		GRPC:       grpc,
+		ServerName:          virtualServer.Hostname,
+		ProxySSLCertificate: createProxySSLCertificate(virtualServer.BackendClientCertificate),
+		Locations:           locs,
+		Listen:              listen,
+		GRPC:                grpc,
 	}
 
 	policyIncludes := createIncludesFromPolicyGenerateResult(
@@ -214,6 +269,79 @@ func createServer(
 	return server, matchPairs
 }
 
+// configureGRPC enables gRPC-Web on the gRPC locations of the server and the built-in gRPC health check
+// of the server, if they are enabled in the NginxProxy.
+func configureGRPC(server *http.Server, virtualServer dataplane.VirtualServer, baseConfig dataplane.BaseHTTPConfig) {
+	if baseConfig.GRPCWeb {
+		for i := range server.Locations {
+			loc := &server.Locations[i]
+			if !loc.GRPC || loc.ProxyPass == "" {
+				continue
+			}
+
+			loc.GRPCWeb = true
+			// The headers can be shared with other locations, so they are copied before appending.
+			loc.ProxySetHeaders = append(slices.Clip(loc.ProxySetHeaders), grpcContentTypeHeader)
+		}
+	}
+
+	server.GRPCHealthCheck = baseConfig.GRPCHealthCheck && serverHandlesGRPCHealthCheck(*server, virtualServer)
+}
+
+// serverHandlesGRPCHealthCheck returns whether the built-in gRPC health check can be configured in the server.
+// The default server of an HTTPS port can only respond if it has a certificate, and servers with a Route for the
+// gRPC health service, or with a GRPCRoute that matches all methods, proxy the health checks to the Route's
+// backends instead.
+func serverHandlesGRPCHealthCheck(server http.Server, virtualServer dataplane.VirtualServer) bool {
+	if server.IsDefaultSSL {
+		return server.SSL != nil
+	}
+
+	for _, rule := range virtualServer.PathRules {
+		if strings.HasPrefix(rule.Path, grpcHealthServicePath) {
+			return false
+		}
+
+		if rule.GRPC && rule.Path == rootPath && rule.PathType == dataplane.PathTypePrefix {
+			return false
+		}
+	}
+
+	return true
+}
+
+// createErrorPageLocations creates the named locations that serve the error pages of the ErrorPagePolicies
+// that apply to the server or to any of its PathRules.
+// Named locations can only be defined in the server context, so the locations of policies that apply to Routes
+// are added to the server as well. A policy can apply to multiple PathRules, so the locations are de-duplicated.
+func createErrorPageLocations(virtualServer dataplane.VirtualServer) []http.Location {
+	pols := make([]policies.Policy, 0, len(virtualServer.Policies))
+	pols = append(pols, virtualServer.Policies...)
+
+	for _, rule := range virtualServer.PathRules {
+		pols = append(pols, rule.Policies...)
+	}
+
+	locs := errorpage.CreateLocations(pols)
+	if len(locs) == 0 {
+		return nil
+	}
+
+	uniqueLocs := make([]http.Location, 0, len(locs))
+	seen := make(map[string]struct{}, len(locs))
+
+	for _, loc := range locs {
+		if _, exists := seen[loc.Path]; exists {
+			continue
+		}
+
+		seen[loc.Path] = struct{}{}
+		uniqueLocs = append(uniqueLocs, loc)
+	}
+
+	return uniqueLocs
+}
+
 // rewriteConfig contains the configuration for a location to rewrite paths,
 // as specified in a URLRewrite filter.
 type rewriteConfig struct {
@@ -257,7 +385,9 @@ func createLocations(
 		}
 
 		if !needsInternalLocations(rule) {
-			for _, r := range rule.MatchRules {
+			var backendLocations []http.Location
+
+			for matchRuleIdx, r := range rule.MatchRules {
 				extLocations = updateLocations(
 					r.Filters,
 					extLocations,
@@ -267,9 +397,27 @@ func createLocations(
 					rule.GRPC,
 					keepAliveCheck,
 				)
+
+				if matchRuleNeedsBackendLocations(r) {
+					for i := range extLocations {
+						extLocations[i] = rewriteToBackendLocations(extLocations[i], pathRuleIdx, matchRuleIdx, r)
+					}
+
+					backendLocations = append(backendLocations, createBackendLocations(
+						pathRuleIdx,
+						matchRuleIdx,
+						r,
+						createIncludesFromPolicyGenerateResult(generator.GenerateForInternalLocation(rule.Policies)),
+						server.Port,
+						rule.Path,
+						rule.GRPC,
+						keepAliveCheck,
+					)...)
+				}
 			}
 
 			locs = append(locs, extLocations...)
+			locs = append(locs, backendLocations...)
 			continue
 		}
 
@@ -291,7 +439,24 @@ func createLocations(
 				keepAliveCheck,
 			)
 
+			var backendLocations []http.Location
+			if matchRuleNeedsBackendLocations(r) {
+				intLocation = rewriteToBackendLocations(intLocation, pathRuleIdx, matchRuleIdx, r)
+
+				backendLocations = createBackendLocations(
+					pathRuleIdx,
+					matchRuleIdx,
+					r,
+					createIncludesFromPolicyGenerateResult(generator.GenerateForInternalLocation(rule.Policies)),
+					server.Port,
+					rule.Path,
+					rule.GRPC,
+					keepAliveCheck,
+				)
+			}
+
 			internalLocations = append(internalLocations, intLocation)
+			internalLocations = append(internalLocations, backendLocations...)
 			matches = append(matches, match)
 		}
 
@@ -453,8 +618,9 @@ func updateLocation(
 		extraHeaders = append(extraHeaders, getConnectionHeader(keepAliveCheck, matchRule.BackendGroup.Backends))
 	}
 
-	proxySetHeaders := generateProxySetHeaders(&matchRule.Filters, createBaseProxySetHeaders(extraHeaders...))
-	responseHeaders := generateResponseHeaders(&matchRule.Filters)
+	headerFilters := applyBackendHeaderFilters(matchRule)
+	proxySetHeaders := generateProxySetHeaders(&headerFilters, createBaseProxySetHeaders(extraHeaders...))
+	responseHeaders := generateResponseHeaders(&headerFilters)
 
 	if rewrites != nil {
 		if location.Type == http.InternalLocationType && rewrites.InternalRewrite != "" {
@@ -470,7 +636,7 @@ func updateLocation(
 	proxyPass := createProxyPass(
 		matchRule.BackendGroup,
 		matchRule.Filters.RequestURLRewrite,
-		generateProtocolString(location.ProxySSLVerify, grpc),
+		generateProtocolString(location.ProxySSLVerify != nil || backendsUseTLS(matchRule.BackendGroup.Backends), grpc),
 		grpc,
 	)
 
@@ -501,19 +667,31 @@ func updateLocations(
 	return updatedLocations
 }
 
-func generateProtocolString(ssl *http.ProxySSLVerify, grpc bool) string {
+func generateProtocolString(tls bool, grpc bool) string {
 	if !grpc {
-		if ssl != nil {
+		if tls {
 			return "https"
 		}
 		return "http"
 	}
-	if ssl != nil {
+	if tls {
 		return "grpcs"
 	}
 	return "grpc"
 }
 
+// backendsUseTLS returns true if NGINX connects to the backends over TLS because of the appProtocol of their
+// Service ports. Either all or none of the backends in a group use TLS, which is validated in the graph package.
+func backendsUseTLS(backends []dataplane.Backend) bool {
+	for _, b := range backends {
+		if b.Valid && b.TLS {
+			return true
+		}
+	}
+
+	return false
+}
+
 func createProxyTLSFromBackends(backends []dataplane.Backend) *http.ProxySSLVerify {
 	if len(backends) == 0 {
 		return nil
@@ -546,6 +724,17 @@ func createProxySSLVerify(v *dataplane.VerifyTLS) *http.ProxySSLVerify {
 	}
 }
 
+func createProxySSLCertificate(clientCert *dataplane.SSL) *http.SSL {
+	if clientCert == nil {
+		return nil
+	}
+
+	return &http.SSL{
+		Certificate:    generatePEMFileName(clientCert.KeyPairID),
+		CertificateKey: generatePEMFileName(clientCert.KeyPairID),
+	}
+}
+
 func createReturnAndRewriteConfigForRedirectFilter(
 	filter *dataplane.HTTPRequestRedirectFilter,
 	listenerPort int32,
@@ -756,6 +945,84 @@ func createProxyPass(
 	return protocol + "://" + backendName + requestURI
 }
 
+// matchRuleNeedsBackendLocations returns true if the request of the MatchRule is proxied through the internal
+// locations of its backends, so that the filters of the backend chosen by split_clients are applied.
+func matchRuleNeedsBackendLocations(matchRule dataplane.MatchRule) bool {
+	if matchRule.Filters.InvalidFilter != nil || matchRule.Filters.RequestRedirect != nil {
+		return false
+	}
+
+	return backendGroupNeedsBackendLocations(matchRule.BackendGroup)
+}
+
+// backendLocationPathPrefix returns the path prefix of the internal locations of the backends of a MatchRule.
+// The path of the location of a backend is the prefix followed by the index of the backend.
+func backendLocationPathPrefix(pathRuleIdx, matchRuleIdx int) string {
+	return fmt.Sprintf("%s-rule%d-route%d-backend", http.InternalRoutePathPrefix, pathRuleIdx, matchRuleIdx)
+}
+
+// rewriteToBackendLocations updates the location to rewrite the request to the internal location of the backend
+// that is chosen by split_clients, instead of proxying the request itself.
+func rewriteToBackendLocations(
+	location http.Location,
+	pathRuleIdx,
+	matchRuleIdx int,
+	matchRule dataplane.MatchRule,
+) http.Location {
+	splitClientsVar := convertStringToSafeVariableName(matchRule.BackendGroup.Name())
+
+	location.Rewrites = []string{
+		fmt.Sprintf("^ %s$%s last", backendLocationPathPrefix(pathRuleIdx, matchRuleIdx), splitClientsVar),
+	}
+	location.ProxyPass = ""
+	location.ProxySetHeaders = nil
+	location.ProxySSLVerify = nil
+	location.ResponseHeaders = http.ResponseHeaders{}
+
+	return location
+}
+
+// createBackendLocations creates an internal location for every backend of the MatchRule. Each location proxies
+// requests to a single backend, with the filters of both the MatchRule and the backend.
+func createBackendLocations(
+	pathRuleIdx,
+	matchRuleIdx int,
+	matchRule dataplane.MatchRule,
+	includes []shared.Include,
+	listenerPort int32,
+	path string,
+	grpc bool,
+	keepAliveCheck keepAliveChecker,
+) []http.Location {
+	backends := matchRule.BackendGroup.Backends
+	locations := make([]http.Location, 0, len(backends))
+	pathPrefix := backendLocationPathPrefix(pathRuleIdx, matchRuleIdx)
+
+	for i, b := range backends {
+		backendRule := matchRule
+		backendRule.BackendGroup = dataplane.BackendGroup{
+			Source:   matchRule.BackendGroup.Source,
+			RuleIdx:  matchRule.BackendGroup.RuleIdx,
+			Backends: []dataplane.Backend{b},
+		}
+
+		location := createMatchLocation(pathPrefix+strconv.Itoa(i), grpc)
+		location.Includes = slices.Clone(includes)
+
+		locations = append(locations, updateLocation(
+			backendRule.Filters,
+			location,
+			backendRule,
+			listenerPort,
+			path,
+			grpc,
+			keepAliveCheck,
+		))
+	}
+
+	return locations
+}
+
 func createMatchLocation(path string, grpc bool) http.Location {
 	var rewrites []string
 	if grpc {
@@ -771,17 +1038,83 @@ func createMatchLocation(path string, grpc bool) http.Location {
 	return loc
 }
 
+// applyBackendHeaderFilters returns the filters of the MatchRule with the header filters of its backend merged in,
+// if the MatchRule proxies requests to a single backend. Requests to multiple backends are proxied through the
+// internal locations of the backends, which only have a single backend each.
+func applyBackendHeaderFilters(matchRule dataplane.MatchRule) dataplane.HTTPFilters {
+	filters := matchRule.Filters
+
+	if len(matchRule.BackendGroup.Backends) != 1 {
+		return filters
+	}
+
+	backend := matchRule.BackendGroup.Backends[0]
+	filters.RequestHeaderModifiers = mergeHeaderFilters(filters.RequestHeaderModifiers, backend.RequestHeaderModifiers)
+	filters.ResponseHeaderModifiers = mergeHeaderFilters(
+		filters.ResponseHeaderModifiers,
+		backend.ResponseHeaderModifiers,
+	)
+
+	return filters
+}
+
+// mergeHeaderFilters merges the header filter of a backend into the header filter of a MatchRule.
+// The headers of the backend filter take precedence over the headers with the same name in the MatchRule filter.
+func mergeHeaderFilters(ruleFilter, backendFilter *dataplane.HTTPHeaderFilter) *dataplane.HTTPHeaderFilter {
+	if backendFilter == nil {
+		return ruleFilter
+	}
+	if ruleFilter == nil {
+		return backendFilter
+	}
+
+	backendHeaders := make(map[string]struct{})
+	for _, h := range backendFilter.Set {
+		backendHeaders[strings.ToLower(h.Name)] = struct{}{}
+	}
+	for _, h := range backendFilter.Add {
+		backendHeaders[strings.ToLower(h.Name)] = struct{}{}
+	}
+	for _, name := range backendFilter.Remove {
+		backendHeaders[strings.ToLower(name)] = struct{}{}
+	}
+
+	overridden := func(name string) bool {
+		_, exists := backendHeaders[strings.ToLower(name)]
+		return exists
+	}
+
+	merged := &dataplane.HTTPHeaderFilter{}
+
+	for _, h := range ruleFilter.Set {
+		if !overridden(h.Name) {
+			merged.Set = append(merged.Set, h)
+		}
+	}
+	for _, h := range ruleFilter.Add {
+		if !overridden(h.Name) {
+			merged.Add = append(merged.Add, h)
+		}
+	}
+	for _, name := range ruleFilter.Remove {
+		if !overridden(name) {
+			merged.Remove = append(merged.Remove, name)
+		}
+	}
+
+	merged.Set = append(merged.Set, backendFilter.Set...)
+	merged.Add = append(merged.Add, backendFilter.Add...)
+	merged.Remove = append(merged.Remove, backendFilter.Remove...)
+
+	return merged
+}
+
 func generateProxySetHeaders(
 	filters *dataplane.HTTPFilters,
 	baseHeaders []http.Header,
 ) []http.Header {
 	if filters != nil && filters.RequestURLRewrite != nil && filters.RequestURLRewrite.Hostname != nil {
-		for i, header := range baseHeaders {
-			if header.Name == "Host" {
-				baseHeaders[i].Value = *filters.RequestURLRewrite.Hostname
-				break
-			}
-		}
+		setHostHeader(baseHeaders, *filters.RequestURLRewrite.Hostname)
 	}
 
 	if filters == nil || filters.RequestHeaderModifiers == nil {
@@ -796,9 +1129,17 @@ func generateProxySetHeaders(
 		addHeaders := createHeadersWithVarName(headerFilter.Add)
 		proxySetHeaders = append(proxySetHeaders, addHeaders...)
 	}
-	if len(headerFilter.Set) > 0 {
-		setHeaders := createHeaders(headerFilter.Set)
-		proxySetHeaders = append(proxySetHeaders, setHeaders...)
+	for _, h := range headerFilter.Set {
+		// NGINX always sets the Host header, so setting it only replaces the value of the base header.
+		if strings.EqualFold(h.Name, "Host") {
+			setHostHeader(baseHeaders, h.Value)
+			continue
+		}
+
+		proxySetHeaders = append(proxySetHeaders, http.Header{
+			Name:  h.Name,
+			Value: h.Value,
+		})
 	}
 	// If the value of a header field is an empty string then this field will not be passed to a proxied server
 	for _, h := range headerFilter.Remove {
@@ -811,6 +1152,15 @@ func generateProxySetHeaders(
 	return append(proxySetHeaders, baseHeaders...)
 }
 
+func setHostHeader(baseHeaders []http.Header, value string) {
+	for i, header := range baseHeaders {
+		if header.Name == "Host" {
+			baseHeaders[i].Value = value
+			return
+		}
+	}
+}
+
 func generateResponseHeaders(filters *dataplane.HTTPFilters) http.ResponseHeaders {
 	if filters == nil || filters.ResponseHeaderModifiers == nil {
 		return http.ResponseHeaders{}
@@ -866,6 +1216,57 @@ func createPath(rule dataplane.PathRule) string {
 	}
 }
 
+// createHTTPSRedirectLocation creates a location that redirects all requests to the HTTPS port.
+// The port is omitted from the redirect URL if it is the well known HTTPS port.
+func createHTTPSRedirectLocation(redirect dataplane.HTTPSRedirect) http.Location {
+	hostnamePort := "$host"
+	if redirect.Port != 443 {
+		hostnamePort = fmt.Sprintf("$host:%d", redirect.Port)
+	}
+
+	return http.Location{
+		Path: "/",
+		Return: &http.Return{
+			Code: http.StatusCode(redirect.StatusCode),
+			Body: fmt.Sprintf("https://%s$request_uri", hostnamePort),
+		},
+	}
+}
+
+// createDefaultServerLocation creates the location of a default server that handles all unmatched requests
+// according to the action.
+func createDefaultServerLocation(action dataplane.DefaultServerAction, keepAliveCheck keepAliveChecker) http.Location {
+	if action.Return != nil {
+		return http.Location{
+			Path: "/",
+			Return: &http.Return{
+				Code: http.StatusCode(action.Return.Code),
+				Body: action.Return.Body,
+			},
+		}
+	}
+
+	backends := []dataplane.Backend{{UpstreamName: action.UpstreamName}}
+
+	return http.Location{
+		Path:      "/",
+		ProxyPass: "http://" + action.UpstreamName,
+		ProxySetHeaders: createBaseProxySetHeaders(
+			httpUpgradeHeader,
+			getConnectionHeader(keepAliveCheck, backends),
+		),
+	}
+}
+
+// getDefaultServerContentType returns the MIME type of the responses returned by a default server.
+func getDefaultServerContentType(action dataplane.DefaultServerAction) string {
+	if action.Return == nil || action.Return.ContentType == "" {
+		return defaultServerContentType
+	}
+
+	return action.Return.ContentType
+}
+
 func createDefaultRootLocation() http.Location {
 	return http.Location{
 		Path:   "/",
@@ -927,6 +1328,14 @@ func createBaseProxySetHeaders(extraHeaders ...http.Header) []http.Header {
 }
 
 func getConnectionHeader(keepAliveCheck keepAliveChecker, backends []dataplane.Backend) http.Header {
+	for _, backend := range backends {
+		if backend.WebSocket {
+			// WebSocket backends always need the Connection header to upgrade the connection,
+			// even if keep-alive settings are enabled on the upstream.
+			return httpConnectionHeader
+		}
+	}
+
 	for _, backend := range backends {
 		if keepAliveCheck(backend.UpstreamName) {
 			// if keep-alive settings are enabled on any upstream, the connection header value
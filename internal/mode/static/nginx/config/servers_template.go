@@ -12,7 +12,12 @@ server {
         {{- if and ($.IPFamily.IPv6) (not $s.IsSocket) }}
     listen [::]:{{ $s.Listen }} ssl default_server{{ $.RewriteClientIP.ProxyProtocol }};
         {{- end }}
+        {{- if $s.SSL }}
+    ssl_certificate {{ $s.SSL.Certificate }};
+    ssl_certificate_key {{ $s.SSL.CertificateKey }};
+        {{- else }}
     ssl_reject_handshake on;
+        {{- end }}
         {{- range $address := $.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
         {{- end}}
@@ -22,6 +27,13 @@ server {
         {{- if $.RewriteClientIP.Recursive}}
     real_ip_recursive on;
         {{- end }}
+        {{- if $s.GRPCHealthCheck }}
+        {{- template "grpcHealthCheckLocation" }}
+        {{- end }}
+        {{- if $s.Locations }}
+    default_type {{ $s.DefaultType }};
+        {{- template "defaultServerLocations" $s }}
+        {{- end }}
 }
     {{- else if $s.IsDefaultHTTP }}
 server {
@@ -40,8 +52,22 @@ server {
         {{- if $.RewriteClientIP.Recursive}}
     real_ip_recursive on;
         {{- end }}
+        {{- if $s.GRPCHealthCheck }}
+        {{- template "grpcHealthCheckLocation" }}
+        {{- end }}
+        {{- if $s.Locations }}
+    default_type {{ $s.DefaultType }};
+        {{- template "defaultServerLocations" $s }}
+        {{- else if $s.GRPCHealthCheck }}
+    default_type text/html;
+
+    location / {
+        return 404;
+    }
+        {{- else }}
     default_type text/html;
     return 404;
+        {{- end }}
 }
     {{- else }}
 server {
@@ -77,6 +103,15 @@ server {
     include {{ $i.Name }};
         {{- end }}
 
+        {{- if $s.ProxySSLCertificate }}
+    proxy_ssl_certificate {{ $s.ProxySSLCertificate.Certificate }};
+    proxy_ssl_certificate_key {{ $s.ProxySSLCertificate.CertificateKey }};
+          {{- if $s.GRPC }}
+    grpc_ssl_certificate {{ $s.ProxySSLCertificate.Certificate }};
+    grpc_ssl_certificate_key {{ $s.ProxySSLCertificate.CertificateKey }};
+          {{- end }}
+        {{- end }}
+
         {{- range $address := $.RewriteClientIP.RealIPFrom }}
     set_real_ip_from {{ $address }};
         {{- end}}
@@ -97,6 +132,10 @@ server {
         include {{ $i.Name }};
         {{- end -}}
 
+        {{- if $l.DynamicUpstream }}
+        set $ngf_upstream {{ $l.DynamicUpstream }};
+        {{- end }}
+
         {{ range $r := $l.Rewrites }}
         rewrite {{ $r }};
         {{- end }}
@@ -116,6 +155,11 @@ server {
         include /etc/nginx/grpc-error-pages.conf;
         {{- end }}
 
+        {{- if $l.GRPCWeb }}
+        js_header_filter grpc.webHeaders;
+        js_body_filter grpc.webBody buffer_type=buffer;
+        {{- end }}
+
         proxy_http_version 1.1;
         {{- if $l.ProxyPass -}}
             {{ range $h := $l.ProxySetHeaders }}
@@ -142,6 +186,10 @@ server {
     }
         {{- end }}
 
+        {{- if $s.GRPCHealthCheck }}
+        {{- template "grpcHealthCheckLocation" }}
+        {{- end }}
+
         {{- if $s.GRPC }}
         include /etc/nginx/grpc-error-locations.conf;
         {{- end }}
@@ -161,4 +209,27 @@ server {
 
     return 500;
 }
-`
+{{ define "grpcHealthCheckLocation" }}
+
+    location = /grpc.health.v1.Health/Check {
+        add_trailer grpc-status 0 always;
+        js_content grpc.healthCheck;
+    }
+{{- end }}
+{{ define "defaultServerLocations" }}
+    {{- range $l := .Locations }}
+
+    location {{ $l.Path }} {
+        {{- if $l.Return }}
+        return {{ $l.Return.Code }} "{{ $l.Return.Body }}";
+        {{- end }}
+        {{- if $l.ProxyPass }}
+            {{- range $h := $l.ProxySetHeaders }}
+        proxy_set_header {{ $h.Name }} "{{ $h.Value }}";
+            {{- end }}
+        proxy_http_version 1.1;
+        proxy_pass {{ $l.ProxyPass }};
+        {{- end }}
+    }
+    {{- end }}
+{{- end }}`
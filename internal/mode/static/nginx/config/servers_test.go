@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
@@ -97,6 +99,9 @@ func TestExecuteServers(t *testing.T) {
 				SSL: &dataplane.SSL{
 					KeyPairID: "test-keypair",
 				},
+				BackendClientCertificate: &dataplane.SSL{
+					KeyPairID: "client-keypair",
+				},
 				Port: 8443,
 				PathRules: []dataplane.PathRule{
 					{
@@ -132,18 +137,21 @@ func TestExecuteServers(t *testing.T) {
 	}
 
 	expSubStrings := map[string]int{
-		"listen 8080 default_server;":                              1,
-		"listen 8080;":                                             2,
-		"listen 8443 ssl;":                                         2,
-		"listen 8443 ssl default_server;":                          1,
-		"server_name example.com;":                                 2,
-		"server_name cafe.example.com;":                            2,
-		"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":     2,
-		"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 2,
-		"proxy_ssl_server_name on;":                                1,
-		"status_zone":                                              0,
-		"include /etc/nginx/includes/location-snippet.conf":        1,
-		"include /etc/nginx/includes/server-snippet.conf":          1,
+		"listen 8080 default_server;":                                      1,
+		"listen 8080;":                                                     2,
+		"listen 8443 ssl;":                                                 2,
+		"listen 8443 ssl default_server;":                                  1,
+		"server_name example.com;":                                         2,
+		"server_name cafe.example.com;":                                    2,
+		"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":             2,
+		"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;":         2,
+		"proxy_ssl_server_name on;":                                        1,
+		"status_zone":                                                      0,
+		"include /etc/nginx/includes/location-snippet.conf":                1,
+		"include /etc/nginx/includes/server-snippet.conf":                  1,
+		"proxy_ssl_certificate /etc/nginx/secrets/client-keypair.pem;":     1,
+		"proxy_ssl_certificate_key /etc/nginx/secrets/client-keypair.pem;": 1,
+		"grpc_ssl_certificate":                                             0,
 	}
 
 	type assertion func(g *WithT, data string)
@@ -459,6 +467,82 @@ func TestExecuteServers_RewriteClientIP(t *testing.T) {
 	}
 }
 
+func TestExecuteServers_GRPCBackendTLS(t *testing.T) {
+	t.Parallel()
+
+	conf := dataplane.Configuration{
+		SSLServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      8443,
+			},
+			{
+				Hostname: "grpc.example.com",
+				SSL: &dataplane.SSL{
+					KeyPairID: "test-keypair",
+				},
+				BackendClientCertificate: &dataplane.SSL{
+					KeyPairID: "client-keypair",
+				},
+				Port: 8443,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						GRPC:     true,
+						MatchRules: []dataplane.MatchRule{
+							{
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_grpc_443",
+											Valid:        true,
+											Weight:       1,
+											VerifyTLS: &dataplane.VerifyTLS{
+												CertBundleID: "test-grpc",
+												Hostname:     "grpc-backend.example.com",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			IPFamily: dataplane.IPv4,
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"grpc_pass grpcs://test_grpc_443;":                                 1,
+		"grpc_ssl_server_name on;":                                         1,
+		"grpc_ssl_verify on;":                                              1,
+		"grpc_ssl_name grpc-backend.example.com;":                          1,
+		"grpc_ssl_trusted_certificate /etc/nginx/secrets/test-grpc.crt;":   1,
+		"grpc_ssl_certificate /etc/nginx/secrets/client-keypair.pem;":      1,
+		"grpc_ssl_certificate_key /etc/nginx/secrets/client-keypair.pem;":  1,
+		"proxy_ssl_certificate /etc/nginx/secrets/client-keypair.pem;":     1,
+		"proxy_ssl_certificate_key /etc/nginx/secrets/client-keypair.pem;": 1,
+		"proxy_ssl_verify on;":                                             0,
+	}
+
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+	serverConf := string(results[0].data)
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
 func TestExecuteServers_Plus(t *testing.T) {
 	t.Parallel()
 	config := dataplane.Configuration{
@@ -578,13 +662,535 @@ func TestExecuteForDefaultServers(t *testing.T) {
 			httpMatchConf := string(serverResults[1].data)
 			g.Expect(httpMatchConf).To(Equal("{}"))
 
-			for _, expPort := range tc.httpPorts {
-				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(httpDefaultFmt, expPort)))
-			}
+			for _, expPort := range tc.httpPorts {
+				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(httpDefaultFmt, expPort)))
+			}
+
+			for _, expPort := range tc.sslPorts {
+				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(sslDefaultFmt, expPort)))
+			}
+		})
+	}
+}
+
+func TestExecuteServers_HTTPSRedirect(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+			},
+			{
+				Hostname: "cafe.example.com",
+				Port:     80,
+				HTTPSRedirect: &dataplane.HTTPSRedirect{
+					Port:       443,
+					StatusCode: 301,
+				},
+			},
+			{
+				Hostname: "tea.example.com",
+				Port:     80,
+				HTTPSRedirect: &dataplane.HTTPSRedirect{
+					Port:       8443,
+					StatusCode: 308,
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"server_name cafe.example.com;":                  1,
+		"server_name tea.example.com;":                   1,
+		"return 301 \"https://$host$request_uri\";":      1,
+		"return 308 \"https://$host:8443$request_uri\";": 1,
+		"location / {": 2,
+		"proxy_pass":   0,
+	}
+
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+	serverConf := string(results[0].data)
+	httpMatchConf := string(results[1].data)
+	g.Expect(httpMatchConf).To(Equal("{}"))
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServers_DefaultServerActions(t *testing.T) {
+	t.Parallel()
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+				DefaultAction: &dataplane.DefaultServerAction{
+					Return: &dataplane.DefaultServerReturn{
+						Code:        418,
+						Body:        "teapot",
+						ContentType: "text/plain",
+					},
+				},
+			},
+			{
+				IsDefault: true,
+				Port:      8080,
+				DefaultAction: &dataplane.DefaultServerAction{
+					Return: &dataplane.DefaultServerReturn{
+						Code: 302,
+						Body: "https://example.com$request_uri",
+					},
+				},
+			},
+			{
+				IsDefault: true,
+				Port:      8081,
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      443,
+				SSL: &dataplane.SSL{
+					KeyPairID: "test-keypair",
+				},
+				DefaultAction: &dataplane.DefaultServerAction{
+					UpstreamName: "test_catch-all_80",
+				},
+			},
+			{
+				IsDefault: true,
+				Port:      8443,
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"listen 80 default_server;":                                1,
+		"listen 8080 default_server;":                              1,
+		"listen 8081 default_server;":                              1,
+		"listen 443 ssl default_server;":                           1,
+		"listen 8443 ssl default_server;":                          1,
+		"default_type text/plain;":                                 1,
+		"default_type text/html;":                                  3,
+		"return 418 \"teapot\";":                                   1,
+		"return 302 \"https://example.com$request_uri\";":          1,
+		"return 404;":                                              1,
+		"ssl_reject_handshake on;":                                 1,
+		"ssl_certificate /etc/nginx/secrets/test-keypair.pem;":     1,
+		"ssl_certificate_key /etc/nginx/secrets/test-keypair.pem;": 1,
+		"proxy_pass http://test_catch-all_80;":                     1,
+		"proxy_set_header Host \"$gw_api_compliant_host\";":        1,
+		"location / {":                                             3,
+	}
+
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+	serverConf := string(results[0].data)
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestExecuteServers_GRPC(t *testing.T) {
+	t.Parallel()
+
+	createGRPCPathRule := func(path string, pathType dataplane.PathType) dataplane.PathRule {
+		return dataplane.PathRule{
+			Path:     path,
+			PathType: pathType,
+			GRPC:     true,
+			MatchRules: []dataplane.MatchRule{
+				{
+					BackendGroup: dataplane.BackendGroup{
+						Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
+						RuleIdx: 0,
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_grpc_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      80,
+			},
+			{
+				Hostname:  "grpc.example.com",
+				Port:      80,
+				PathRules: []dataplane.PathRule{createGRPCPathRule("/", dataplane.PathTypePrefix)},
+			},
+			{
+				Hostname: "health.example.com",
+				Port:     80,
+				PathRules: []dataplane.PathRule{
+					createGRPCPathRule("/grpc.health.v1.Health/Check", dataplane.PathTypeExact),
+				},
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				IsDefault: true,
+				Port:      443,
+			},
+		},
+		BaseHTTPConfig: dataplane.BaseHTTPConfig{
+			IPFamily:        dataplane.IPv4,
+			GRPCWeb:         true,
+			GRPCHealthCheck: true,
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"location = /grpc.health.v1.Health/Check {":                        2,
+		"add_trailer grpc-status 0 always;":                                1,
+		"js_content grpc.healthCheck;":                                     1,
+		"grpc_pass grpc://test_grpc_80;":                                   2,
+		"grpc_set_header Content-Type \"$grpc_content_type\";":             2,
+		"js_header_filter grpc.webHeaders;":                                2,
+		"js_body_filter grpc.webBody buffer_type=buffer;":                  2,
+		"ssl_reject_handshake on;":                                         1,
+		"default_type text/html;\n\n    location / {\n        return 404;": 1,
+	}
+
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+	serverConf := string(results[0].data)
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestConfigureGRPC(t *testing.T) {
+	t.Parallel()
+
+	createServer := func() http.Server {
+		return http.Server{
+			Locations: []http.Location{
+				{
+					Path:            "/",
+					ProxyPass:       "http://test_foo_80",
+					ProxySetHeaders: []http.Header{{Name: "Host", Value: "$gw_api_compliant_host"}},
+				},
+				{
+					Path:            "/grpc.Service/",
+					ProxyPass:       "grpc://test_grpc_80",
+					ProxySetHeaders: []http.Header{{Name: "Host", Value: "$gw_api_compliant_host"}},
+					GRPC:            true,
+				},
+				{
+					Path:         "= /grpc.Other/Method",
+					HTTPMatchKey: "1_0",
+					Type:         http.RedirectLocationType,
+					GRPC:         true,
+				},
+			},
+			GRPC: true,
+		}
+	}
+
+	tests := []struct {
+		expServer     func() http.Server
+		name          string
+		virtualServer dataplane.VirtualServer
+		server        http.Server
+		baseConfig    dataplane.BaseHTTPConfig
+	}{
+		{
+			name:      "gRPC features disabled",
+			server:    createServer(),
+			expServer: createServer,
+		},
+		{
+			name:       "gRPC-Web enabled",
+			server:     createServer(),
+			baseConfig: dataplane.BaseHTTPConfig{GRPCWeb: true},
+			expServer: func() http.Server {
+				server := createServer()
+				server.Locations[1].GRPCWeb = true
+				server.Locations[1].ProxySetHeaders = append(server.Locations[1].ProxySetHeaders, http.Header{
+					Name:  "Content-Type",
+					Value: "$grpc_content_type",
+				})
+				return server
+			},
+		},
+		{
+			name:       "health check enabled",
+			server:     createServer(),
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer: func() http.Server {
+				server := createServer()
+				server.GRPCHealthCheck = true
+				return server
+			},
+		},
+		{
+			name:   "health check enabled; server has a Route for the health service",
+			server: createServer(),
+			virtualServer: dataplane.VirtualServer{
+				PathRules: []dataplane.PathRule{{Path: "/grpc.health.v1.Health/", GRPC: true}},
+			},
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer:  createServer,
+		},
+		{
+			name:   "health check enabled; server has a GRPCRoute that matches all methods",
+			server: createServer(),
+			virtualServer: dataplane.VirtualServer{
+				PathRules: []dataplane.PathRule{{Path: "/", PathType: dataplane.PathTypePrefix, GRPC: true}},
+			},
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer:  createServer,
+		},
+		{
+			name:   "health check enabled; server has an HTTPRoute that matches all paths",
+			server: createServer(),
+			virtualServer: dataplane.VirtualServer{
+				PathRules: []dataplane.PathRule{{Path: "/", PathType: dataplane.PathTypePrefix}},
+			},
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer: func() http.Server {
+				server := createServer()
+				server.GRPCHealthCheck = true
+				return server
+			},
+		},
+		{
+			name:       "health check enabled; default HTTP server",
+			server:     http.Server{IsDefaultHTTP: true},
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer: func() http.Server {
+				return http.Server{IsDefaultHTTP: true, GRPCHealthCheck: true}
+			},
+		},
+		{
+			name:       "health check enabled; default SSL server with a certificate",
+			server:     http.Server{IsDefaultSSL: true, SSL: &http.SSL{Certificate: "cert"}},
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer: func() http.Server {
+				return http.Server{IsDefaultSSL: true, SSL: &http.SSL{Certificate: "cert"}, GRPCHealthCheck: true}
+			},
+		},
+		{
+			name:       "health check enabled; default SSL server without a certificate",
+			server:     http.Server{IsDefaultSSL: true},
+			baseConfig: dataplane.BaseHTTPConfig{GRPCHealthCheck: true},
+			expServer: func() http.Server {
+				return http.Server{IsDefaultSSL: true}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			server := test.server
+			configureGRPC(&server, test.virtualServer, test.baseConfig)
+			g.Expect(server).To(Equal(test.expServer()))
+		})
+	}
+}
+
+func TestCreateDefaultServerLocation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		msg            string
+		action         dataplane.DefaultServerAction
+		keepAliveCheck keepAliveChecker
+		expected       http.Location
+	}{
+		{
+			msg: "return",
+			action: dataplane.DefaultServerAction{
+				Return: &dataplane.DefaultServerReturn{Code: 418, Body: "teapot"},
+			},
+			keepAliveCheck: alwaysFalseKeepAliveChecker,
+			expected: http.Location{
+				Path:   "/",
+				Return: &http.Return{Code: 418, Body: "teapot"},
+			},
+		},
+		{
+			msg:            "proxy",
+			action:         dataplane.DefaultServerAction{UpstreamName: "test_catch-all_80"},
+			keepAliveCheck: alwaysFalseKeepAliveChecker,
+			expected: http.Location{
+				Path:            "/",
+				ProxyPass:       "http://test_catch-all_80",
+				ProxySetHeaders: httpBaseHeaders,
+			},
+		},
+		{
+			msg:            "proxy to upstream with keepalive",
+			action:         dataplane.DefaultServerAction{UpstreamName: "test_catch-all_80"},
+			keepAliveCheck: func(_ string) bool { return true },
+			expected: http.Location{
+				Path:            "/",
+				ProxyPass:       "http://test_catch-all_80",
+				ProxySetHeaders: createBaseProxySetHeaders(httpUpgradeHeader, unsetHTTPConnectionHeader),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createDefaultServerLocation(test.action, test.keepAliveCheck)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateErrorPageLocations(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string) *ngfAPIv1alpha1.ErrorPagePolicy {
+		return &ngfAPIv1alpha1.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec: ngfAPIv1alpha1.ErrorPagePolicySpec{
+				ErrorPages: []ngfAPIv1alpha1.ErrorPage{
+					{
+						BackendRef: &ngfAPIv1alpha1.ErrorPageBackendRef{Name: "errors", Port: 80},
+						Codes:      []ngfAPIv1alpha1.ErrorPageStatusCode{502},
+					},
+					{
+						Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{URL: "https://example.com"},
+						Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{503},
+					},
+				},
+			},
+		}
+	}
+
+	gatewayPolicy := createPolicy("gateway")
+	routePolicy := createPolicy("route")
+
+	tests := []struct {
+		msg      string
+		server   dataplane.VirtualServer
+		expPaths []string
+	}{
+		{
+			msg: "no policies",
+			server: dataplane.VirtualServer{
+				PathRules: []dataplane.PathRule{{Path: "/"}},
+			},
+		},
+		{
+			msg: "policies that don't define error pages",
+			server: dataplane.VirtualServer{
+				Policies:  []policies.Policy{&policiesfakes.FakePolicy{}},
+				PathRules: []dataplane.PathRule{{Path: "/"}},
+			},
+		},
+		{
+			msg: "server and path rule policies",
+			server: dataplane.VirtualServer{
+				Policies: []policies.Policy{gatewayPolicy},
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						Policies: []policies.Policy{routePolicy},
+					},
+					{
+						Path:     "/tea",
+						Policies: []policies.Policy{routePolicy},
+					},
+				},
+			},
+			expPaths: []string{
+				"@ngf_error_page_test_gateway_0",
+				"@ngf_error_page_test_route_0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			locs := createErrorPageLocations(test.server)
+
+			paths := make([]string, 0, len(locs))
+			for _, loc := range locs {
+				paths = append(paths, loc.Path)
+			}
+
+			if test.expPaths == nil {
+				g.Expect(locs).To(BeEmpty())
+				return
+			}
+
+			g.Expect(paths).To(Equal(test.expPaths))
+		})
+	}
+}
+
+func TestCreateHTTPSRedirectLocation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		msg      string
+		redirect dataplane.HTTPSRedirect
+		expected http.Location
+	}{
+		{
+			msg:      "well known https port",
+			redirect: dataplane.HTTPSRedirect{Port: 443, StatusCode: 301},
+			expected: http.Location{
+				Path: "/",
+				Return: &http.Return{
+					Code: http.StatusMovedPermanently,
+					Body: "https://$host$request_uri",
+				},
+			},
+		},
+		{
+			msg:      "custom https port",
+			redirect: dataplane.HTTPSRedirect{Port: 8443, StatusCode: 308},
+			expected: http.Location{
+				Path: "/",
+				Return: &http.Return{
+					Code: http.StatusPermanentRedirect,
+					Body: "https://$host:8443$request_uri",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
 
-			for _, expPort := range tc.sslPorts {
-				g.Expect(serverConf).To(ContainSubstring(fmt.Sprintf(sslDefaultFmt, expPort)))
-			}
+			g.Expect(createHTTPSRedirectLocation(test.redirect)).To(Equal(test.expected))
 		})
 	}
 }
@@ -1055,9 +1661,9 @@ func TestCreateServers(t *testing.T) {
 		},
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "app.example.com",
-				Port:         8443,
-				UpstreamName: "sup",
+				Hostname:  "app.example.com",
+				Port:      8443,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "sup", Weight: 1}},
 			},
 		},
 	}
@@ -3026,7 +3632,7 @@ func TestCreateProxyPass(t *testing.T) {
 		t.Run(tc.expected, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(nil, tc.GRPC), tc.GRPC)
+			result := createProxyPass(tc.grp, tc.rewrite, generateProtocolString(false, tc.GRPC), tc.GRPC)
 			g.Expect(result).To(Equal(tc.expected))
 		})
 	}
@@ -3154,6 +3760,62 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 			},
 			baseHeaders: createBaseProxySetHeaders(httpUpgradeHeader, httpConnectionHeader),
 		},
+		{
+			msg: "header filter that sets host",
+			filters: &dataplane.HTTPFilters{
+				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Set: []dataplane.HTTPHeader{
+						{
+							Name:  "host",
+							Value: "canary.example.com",
+						},
+						{
+							Name:  "X-Canary",
+							Value: "true",
+						},
+					},
+				},
+			},
+			expectedHeaders: []http.Header{
+				{
+					Name:  "X-Canary",
+					Value: "true",
+				},
+				{
+					Name:  "Host",
+					Value: "canary.example.com",
+				},
+				{
+					Name:  "X-Forwarded-For",
+					Value: "$proxy_add_x_forwarded_for",
+				},
+				{
+					Name:  "X-Real-IP",
+					Value: "$remote_addr",
+				},
+				{
+					Name:  "X-Forwarded-Proto",
+					Value: "$scheme",
+				},
+				{
+					Name:  "X-Forwarded-Host",
+					Value: "$host",
+				},
+				{
+					Name:  "X-Forwarded-Port",
+					Value: "$server_port",
+				},
+				{
+					Name:  "Upgrade",
+					Value: "$http_upgrade",
+				},
+				{
+					Name:  "Connection",
+					Value: "$connection_upgrade",
+				},
+			},
+			baseHeaders: createBaseProxySetHeaders(httpUpgradeHeader, httpConnectionHeader),
+		},
 		{
 			msg: "header filter with gRPC",
 			filters: &dataplane.HTTPFilters{
@@ -3202,6 +3864,234 @@ func TestGenerateProxySetHeaders(t *testing.T) {
 	}
 }
 
+func TestMergeHeaderFilters(t *testing.T) {
+	t.Parallel()
+
+	ruleFilter := &dataplane.HTTPHeaderFilter{
+		Set: []dataplane.HTTPHeader{
+			{Name: "X-Env", Value: "stable"},
+			{Name: "X-Rule", Value: "rule"},
+		},
+		Add: []dataplane.HTTPHeader{
+			{Name: "X-Version", Value: "v1"},
+		},
+		Remove: []string{"X-Debug", "X-Internal"},
+	}
+
+	tests := []struct {
+		ruleFilter    *dataplane.HTTPHeaderFilter
+		backendFilter *dataplane.HTTPHeaderFilter
+		expected      *dataplane.HTTPHeaderFilter
+		msg           string
+	}{
+		{
+			msg: "no filters",
+		},
+		{
+			msg:        "rule filter only",
+			ruleFilter: ruleFilter,
+			expected:   ruleFilter,
+		},
+		{
+			msg:           "backend filter only",
+			backendFilter: ruleFilter,
+			expected:      ruleFilter,
+		},
+		{
+			msg:        "backend filter overrides headers of rule filter",
+			ruleFilter: ruleFilter,
+			backendFilter: &dataplane.HTTPHeaderFilter{
+				Set: []dataplane.HTTPHeader{
+					{Name: "x-env", Value: "canary"},
+				},
+				Add: []dataplane.HTTPHeader{
+					{Name: "X-Debug", Value: "true"},
+				},
+				Remove: []string{"X-Version"},
+			},
+			expected: &dataplane.HTTPHeaderFilter{
+				Set: []dataplane.HTTPHeader{
+					{Name: "X-Rule", Value: "rule"},
+					{Name: "x-env", Value: "canary"},
+				},
+				Add: []dataplane.HTTPHeader{
+					{Name: "X-Debug", Value: "true"},
+				},
+				Remove: []string{"X-Internal", "X-Version"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(mergeHeaderFilters(tc.ruleFilter, tc.backendFilter)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCreateLocations_BackendFilters(t *testing.T) {
+	t.Parallel()
+
+	canaryFilter := &dataplane.HTTPHeaderFilter{
+		Set: []dataplane.HTTPHeader{{Name: "Host", Value: "canary.example.com"}},
+	}
+
+	group := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_stable_80",
+				Valid:        true,
+				Weight:       90,
+			},
+			{
+				UpstreamName:            "test_canary_80",
+				Valid:                   true,
+				Weight:                  10,
+				RequestHeaderModifiers:  canaryFilter,
+				ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{Remove: []string{"X-Powered-By"}},
+			},
+		},
+	}
+
+	ruleFilters := dataplane.HTTPFilters{
+		RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+			Set: []dataplane.HTTPHeader{{Name: "X-Env", Value: "prod"}},
+		},
+	}
+
+	httpServer := dataplane.VirtualServer{
+		Hostname: "example.com",
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/coffee",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match:        dataplane.Match{},
+						Filters:      ruleFilters,
+						BackendGroup: group,
+					},
+				},
+			},
+			{
+				Path:     "/tea",
+				PathType: dataplane.PathTypeExact,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{
+							Method: helpers.GetPointer("GET"),
+						},
+						BackendGroup: group,
+					},
+				},
+			},
+			{
+				Path:     "/",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Match: dataplane.Match{},
+						BackendGroup: dataplane.BackendGroup{
+							Source:   types.NamespacedName{Namespace: "test", Name: "route"},
+							RuleIdx:  1,
+							Backends: []dataplane.Backend{group.Backends[1]},
+						},
+					},
+				},
+			},
+		},
+		Port: 80,
+	}
+
+	withHost := func(host string) []http.Header {
+		headers := createBaseProxySetHeaders(httpUpgradeHeader, httpConnectionHeader)
+		headers[0].Value = host
+		return headers
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:     "= /coffee",
+			Type:     http.ExternalLocationType,
+			Rewrites: []string{"^ /_ngf-internal-rule0-route0-backend$group_test__route_rule0 last"},
+		},
+		{
+			Path:            "/_ngf-internal-rule0-route0-backend0",
+			Type:            http.InternalLocationType,
+			ProxyPass:       "http://test_stable_80$request_uri",
+			ProxySetHeaders: append([]http.Header{{Name: "X-Env", Value: "prod"}}, httpBaseHeaders...),
+		},
+		{
+			Path:      "/_ngf-internal-rule0-route0-backend1",
+			Type:      http.InternalLocationType,
+			ProxyPass: "http://test_canary_80$request_uri",
+			ProxySetHeaders: append(
+				[]http.Header{{Name: "X-Env", Value: "prod"}},
+				withHost("canary.example.com")...,
+			),
+			ResponseHeaders: http.ResponseHeaders{
+				Set:    []http.Header{},
+				Add:    []http.Header{},
+				Remove: []string{"X-Powered-By"},
+			},
+		},
+		{
+			Path:         "= /tea",
+			Type:         http.RedirectLocationType,
+			HTTPMatchKey: "1_1",
+		},
+		{
+			Path:     "/_ngf-internal-rule1-route0",
+			Type:     http.InternalLocationType,
+			Rewrites: []string{"^ /_ngf-internal-rule1-route0-backend$group_test__route_rule0 last"},
+		},
+		{
+			Path:            "/_ngf-internal-rule1-route0-backend0",
+			Type:            http.InternalLocationType,
+			ProxyPass:       "http://test_stable_80$request_uri",
+			ProxySetHeaders: httpBaseHeaders,
+		},
+		{
+			Path:            "/_ngf-internal-rule1-route0-backend1",
+			Type:            http.InternalLocationType,
+			ProxyPass:       "http://test_canary_80$request_uri",
+			ProxySetHeaders: withHost("canary.example.com"),
+			ResponseHeaders: http.ResponseHeaders{
+				Set:    []http.Header{},
+				Add:    []http.Header{},
+				Remove: []string{"X-Powered-By"},
+			},
+		},
+		{
+			Path:            "/",
+			Type:            http.ExternalLocationType,
+			ProxyPass:       "http://test_canary_80$request_uri",
+			ProxySetHeaders: withHost("canary.example.com"),
+			ResponseHeaders: http.ResponseHeaders{
+				Set:    []http.Header{},
+				Add:    []http.Header{},
+				Remove: []string{"X-Powered-By"},
+			},
+		},
+	}
+
+	locations, matches, grpc := createLocations(
+		&httpServer,
+		"1",
+		&policiesfakes.FakeGenerator{},
+		alwaysFalseKeepAliveChecker,
+	)
+
+	g := NewWithT(t)
+	g.Expect(grpc).To(BeFalse())
+	g.Expect(matches).To(HaveLen(1))
+	g.Expect(helpers.Diff(expLocations, locations)).To(BeEmpty())
+}
+
 func TestCreateBaseProxySetHeaders(t *testing.T) {
 	t.Parallel()
 
@@ -3390,6 +4280,24 @@ func TestGetConnectionHeader(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg:                 "WebSocket backend of upstream with keepAlive enabled",
+			expConnectionHeader: httpConnectionHeader,
+			upstreams: []http.Upstream{
+				{
+					Name: "upstream1",
+					KeepAlive: http.UpstreamKeepAlive{
+						Connections: 1,
+					},
+				},
+			},
+			backends: []dataplane.Backend{
+				{
+					UpstreamName: "upstream1",
+					WebSocket:    true,
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -3484,6 +4392,24 @@ func TestConvertBackendTLSFromGroup(t *testing.T) {
 				Name:               "my-hostname",
 			},
 		},
+		{
+			msg: "tls enabled, cert bundle",
+			grp: []dataplane.Backend{
+				{
+					UpstreamName: "my-upstream",
+					Valid:        true,
+					Weight:       1,
+					VerifyTLS: &dataplane.VerifyTLS{
+						CertBundleID: "default-my-cert",
+						Hostname:     "my-hostname",
+					},
+				},
+			},
+			expected: &http.ProxySSLVerify{
+				TrustedCertificate: "/etc/nginx/secrets/default-my-cert.crt",
+				Name:               "my-hostname",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -3601,3 +4527,71 @@ func TestGetIPFamily(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateProtocolString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected string
+		tls      bool
+		grpc     bool
+	}{
+		{expected: "http"},
+		{expected: "https", tls: true},
+		{expected: "grpc", grpc: true},
+		{expected: "grpcs", tls: true, grpc: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expected, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(generateProtocolString(tc.tls, tc.grpc)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestBackendsUseTLS(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msg      string
+		backends []dataplane.Backend
+		expected bool
+	}{
+		{
+			msg: "no backends",
+		},
+		{
+			msg: "backends without TLS",
+			backends: []dataplane.Backend{
+				{UpstreamName: "upstream1", Valid: true},
+				{UpstreamName: "upstream2", Valid: true, WebSocket: true},
+			},
+		},
+		{
+			msg: "backends with TLS",
+			backends: []dataplane.Backend{
+				{UpstreamName: "upstream1", Valid: true, TLS: true},
+				{UpstreamName: "upstream2", Valid: true, TLS: true, WebSocket: true},
+			},
+			expected: true,
+		},
+		{
+			msg: "invalid backend with TLS",
+			backends: []dataplane.Backend{
+				{UpstreamName: "upstream1", Valid: false, TLS: true},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(backendsUseTLS(tc.backends)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -14,6 +14,19 @@ type MapParameter struct {
 	Result string
 }
 
+// SplitClient defines an NGINX split_clients block.
+type SplitClient struct {
+	Source        string
+	VariableName  string
+	Distributions []SplitClientDistribution
+}
+
+// SplitClientDistribution maps Percentage to Value in a SplitClient.
+type SplitClientDistribution struct {
+	Percent string
+	Value   string
+}
+
 // IPFamily holds the IP family configuration to be used by NGINX.
 type IPFamily struct {
 	IPv4 bool
@@ -32,6 +45,15 @@ const (
 	ProxyProtocolDirective = " proxy_protocol"
 )
 
+// DNSResolver holds the configuration of the DNS servers that NGINX uses to resolve hostnames.
+type DNSResolver struct {
+	Timeout     string
+	Valid       string
+	Addresses   []string
+	DisableIPv4 bool
+	DisableIPv6 bool
+}
+
 // Include defines a file that's included via the include directive.
 type Include struct {
 	Name    string
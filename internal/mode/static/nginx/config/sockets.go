@@ -2,8 +2,13 @@ package config
 
 import (
 	"fmt"
+	"strings"
 )
 
+// hostnameVariableReplacer makes a hostname safe for a variable name. A label of a hostname can't start or end
+// with a hyphen, so the double underscore of a hyphen doesn't collide with the underscore of a dot or a wildcard.
+var hostnameVariableReplacer = strings.NewReplacer("-", "__", ".", "_", "*", "_")
+
 func getSocketNameTLS(port int32, hostname string) string {
 	return fmt.Sprintf("unix:/var/run/nginx/%s-%d.sock", hostname, port)
 }
@@ -15,3 +20,9 @@ func getSocketNameHTTPS(port int32) string {
 func getTLSPassthroughVarName(port int32) string {
 	return fmt.Sprintf("$dest%d", port)
 }
+
+// getTLSPassthroughSplitVarName returns the name of the split_clients variable of a TLS passthrough server,
+// without the leading $.
+func getTLSPassthroughSplitVarName(port int32, hostname string) string {
+	return fmt.Sprintf("split_%s_%d", hostnameVariableReplacer.Replace(hostname), port)
+}
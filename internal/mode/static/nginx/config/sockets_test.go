@@ -29,3 +29,11 @@ func TestGetTLSPassthroughVarName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	g.Expect(res).To(Equal("$dest800"))
 }
+
+func TestGetTLSPassthroughSplitVarName(t *testing.T) {
+	t.Parallel()
+	res := getTLSPassthroughSplitVarName(800, "*.cafe-shop.example.com")
+
+	g := NewGomegaWithT(t)
+	g.Expect(res).To(Equal("split___cafe__shop_example_com_800"))
+}
@@ -3,13 +3,25 @@ package config
 import (
 	"fmt"
 	"math"
+	"strconv"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
-	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 )
 
+const (
+	// canarySplitVariableSuffix is the suffix of the split_clients variable of a BackendGroup with a CanaryMatch.
+	canarySplitVariableSuffix = "_split"
+
+	// httpSplitClientsSource is the source of the HTTP split_clients. Every request is split independently.
+	httpSplitClientsSource = "$request_id"
+
+	// streamSplitClientsSource is the source of the stream split_clients. Every connection is split independently.
+	streamSplitClientsSource = "$remote_addr$remote_port"
+)
+
 var splitClientsTemplate = gotemplate.Must(gotemplate.New("split_clients").Parse(splitClientsTemplateText))
 
 func executeSplitClients(conf dataplane.Configuration) []executeResult {
@@ -23,7 +35,18 @@ func executeSplitClients(conf dataplane.Configuration) []executeResult {
 	return []executeResult{result}
 }
 
-func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClient {
+func executeStreamSplitClients(conf dataplane.Configuration) []executeResult {
+	splitClients := createStreamSplitClients(conf)
+
+	result := executeResult{
+		dest: streamConfigFile,
+		data: helpers.MustExecuteTemplate(splitClientsTemplate, splitClients),
+	}
+
+	return []executeResult{result}
+}
+
+func createSplitClients(backendGroups []dataplane.BackendGroup) []shared.SplitClient {
 	numSplits := 0
 	for _, group := range backendGroups {
 		if backendGroupNeedsSplit(group) {
@@ -35,7 +58,7 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 		return nil
 	}
 
-	splitClients := make([]http.SplitClient, 0, numSplits)
+	splitClients := make([]shared.SplitClient, 0, numSplits)
 
 	for _, group := range backendGroups {
 		distributions := createSplitClientDistributions(group)
@@ -43,8 +66,9 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 			continue
 		}
 
-		splitClients = append(splitClients, http.SplitClient{
-			VariableName:  convertStringToSafeVariableName(group.Name()),
+		splitClients = append(splitClients, shared.SplitClient{
+			Source:        httpSplitClientsSource,
+			VariableName:  splitClientVariableName(group),
 			Distributions: distributions,
 		})
 	}
@@ -52,20 +76,33 @@ func createSplitClients(backendGroups []dataplane.BackendGroup) []http.SplitClie
 	return splitClients
 }
 
-func createSplitClientDistributions(group dataplane.BackendGroup) []http.SplitClientDistribution {
+// splitClientVariableName returns the name of the split_clients variable of the group.
+// If the group has a CanaryMatch, the variable of the group is set by a map that overrides the split
+// for the matching requests, so split_clients sets a separate variable that the map defaults to.
+func splitClientVariableName(group dataplane.BackendGroup) string {
+	name := convertStringToSafeVariableName(group.Name())
+	if group.CanaryMatch != nil {
+		return name + canarySplitVariableSuffix
+	}
+
+	return name
+}
+
+func createSplitClientDistributions(group dataplane.BackendGroup) []shared.SplitClientDistribution {
 	if !backendGroupNeedsSplit(group) {
 		return nil
 	}
 
-	backends := group.Backends
-
+	weights := make([]int32, 0, len(group.Backends))
 	totalWeight := int32(0)
-	for _, b := range backends {
+
+	for _, b := range group.Backends {
+		weights = append(weights, b.Weight)
 		totalWeight += b.Weight
 	}
 
 	if totalWeight == 0 {
-		return []http.SplitClientDistribution{
+		return []shared.SplitClientDistribution{
 			{
 				Percent: "100",
 				Value:   invalidBackendRef,
@@ -73,38 +110,146 @@ func createSplitClientDistributions(group dataplane.BackendGroup) []http.SplitCl
 		}
 	}
 
-	distributions := make([]http.SplitClientDistribution, 0, len(backends))
+	return createWeightedDistributions(weights, func(idx int) string {
+		return getSplitClientValue(group, idx)
+	})
+}
+
+// createWeightedDistributions distributes 100 percent between the weights. The value of the distribution of
+// the weight at idx is returned by getValue. The total of the weights must be greater than 0.
+func createWeightedDistributions(
+	weights []int32,
+	getValue func(idx int) string,
+) []shared.SplitClientDistribution {
+	totalWeight := int32(0)
+	for _, w := range weights {
+		totalWeight += w
+	}
+
+	distributions := make([]shared.SplitClientDistribution, 0, len(weights))
 
 	// The percentage of all backends cannot exceed 100.
 	availablePercentage := float64(100)
 
 	// Iterate over all backends except the last one.
 	// The last backend will get the remaining percentage.
-	for i := range len(backends) - 1 {
-		b := backends[i]
-
-		percentage := percentOf(b.Weight, totalWeight)
+	for i := range len(weights) - 1 {
+		percentage := percentOf(weights[i], totalWeight)
 		availablePercentage -= percentage
 
-		distributions = append(distributions, http.SplitClientDistribution{
+		distributions = append(distributions, shared.SplitClientDistribution{
 			Percent: fmt.Sprintf("%.2f", percentage),
-			Value:   getSplitClientValue(b),
+			Value:   getValue(i),
 		})
 	}
 
 	// The last backend gets the remaining percentage.
 	// This is done to guarantee that the sum of all percentages is 100.
-	lastBackend := backends[len(backends)-1]
-
-	distributions = append(distributions, http.SplitClientDistribution{
+	distributions = append(distributions, shared.SplitClientDistribution{
 		Percent: fmt.Sprintf("%.2f", availablePercentage),
-		Value:   getSplitClientValue(lastBackend),
+		Value:   getValue(len(weights) - 1),
 	})
 
 	return distributions
 }
 
-func getSplitClientValue(b dataplane.Backend) string {
+// createStreamSplitClients creates the split_clients of the TLS passthrough servers with more than one upstream.
+// Every split_clients sets the variable that the proxy_pass of the socket server of the passthrough server uses.
+func createStreamSplitClients(conf dataplane.Configuration) []shared.SplitClient {
+	upstreams := make(map[string]dataplane.Upstream)
+
+	for _, u := range conf.StreamUpstreams {
+		upstreams[u.Name] = u
+	}
+
+	var splitClients []shared.SplitClient
+
+	for _, server := range conf.TLSPassthroughServers {
+		if !layer4ServerNeedsSplit(server) || !layer4ServerHasAvailableUpstream(server, upstreams) {
+			continue
+		}
+
+		weights := make([]int32, 0, len(server.Upstreams))
+		for _, u := range server.Upstreams {
+			weights = append(weights, u.Weight)
+		}
+
+		distributions := createWeightedDistributions(weights, func(idx int) string {
+			u := server.Upstreams[idx]
+			if layer4UpstreamAvailable(u, upstreams) {
+				return u.Name
+			}
+
+			// The proxy_pass to the empty string fails, so NGINX closes the connections that are split to
+			// an unavailable upstream.
+			return emptyStringSocket
+		})
+
+		splitClients = append(splitClients, shared.SplitClient{
+			Source:        streamSplitClientsSource,
+			VariableName:  getTLSPassthroughSplitVarName(server.Port, server.Hostname),
+			Distributions: distributions,
+		})
+	}
+
+	return splitClients
+}
+
+// layer4ServerNeedsSplit returns true if the connections of the server are split between more than one upstream.
+func layer4ServerNeedsSplit(server dataplane.Layer4VirtualServer) bool {
+	return len(server.Upstreams) > 1
+}
+
+// layer4ServerHasAvailableUpstream returns true if any of the upstreams of the server with a weight greater than 0
+// can accept connections.
+func layer4ServerHasAvailableUpstream(
+	server dataplane.Layer4VirtualServer,
+	upstreams map[string]dataplane.Upstream,
+) bool {
+	for _, u := range server.Upstreams {
+		if u.Weight > 0 && layer4UpstreamAvailable(u, upstreams) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// layer4UpstreamAvailable returns true if the upstream is valid and has endpoints.
+func layer4UpstreamAvailable(u dataplane.Layer4Upstream, upstreams map[string]dataplane.Upstream) bool {
+	if u.Name == "" {
+		return false
+	}
+
+	up, ok := upstreams[u.Name]
+
+	return ok && len(up.Endpoints) > 0
+}
+
+// getLayer4ProxyPass returns the proxy_pass of the socket server of the TLS passthrough server.
+// If the server has more than one upstream, it is the variable of the split_clients of the server.
+// It returns an empty string if no upstream of the server can accept connections.
+func getLayer4ProxyPass(server dataplane.Layer4VirtualServer, upstreams map[string]dataplane.Upstream) string {
+	if !layer4ServerHasAvailableUpstream(server, upstreams) {
+		return ""
+	}
+
+	if layer4ServerNeedsSplit(server) {
+		return "$" + getTLSPassthroughSplitVarName(server.Port, server.Hostname)
+	}
+
+	return server.Upstreams[0].Name
+}
+
+// getSplitClientValue returns the value of the split_clients variable for the backend at backendIdx.
+// If the backends of the group need their own locations, the value is the index of the backend, which
+// completes the path of the internal location of the backend. Otherwise, the value is the upstream name.
+func getSplitClientValue(group dataplane.BackendGroup, backendIdx int) string {
+	if backendGroupNeedsBackendLocations(group) {
+		return strconv.Itoa(backendIdx)
+	}
+
+	b := group.Backends[backendIdx]
 	if b.Valid {
 		return b.UpstreamName
 	}
@@ -125,6 +270,28 @@ func backendGroupNeedsSplit(group dataplane.BackendGroup) bool {
 	return len(group.Backends) > 1
 }
 
+// backendGroupNeedsBackendLocations returns true if the group needs to be split and any of its backends has
+// filters. The filters of a backend can only be applied after split_clients has chosen the backend, so every
+// backend of the group gets its own internal location.
+func backendGroupNeedsBackendLocations(group dataplane.BackendGroup) bool {
+	if !backendGroupNeedsSplit(group) {
+		return false
+	}
+
+	var totalWeight int32
+	hasFilters := false
+
+	for _, b := range group.Backends {
+		totalWeight += b.Weight
+		if b.RequestHeaderModifiers != nil || b.ResponseHeaderModifiers != nil {
+			hasFilters = true
+		}
+	}
+
+	// If the total weight is 0, all requests are proxied to the invalid backend.
+	return hasFilters && totalWeight > 0
+}
+
 // backendGroupName returns the name of the backend group.
 // If the group needs to be split, the name returned is the group name.
 // If the group doesn't need to be split, the name returned is the name of the backend if it is valid.
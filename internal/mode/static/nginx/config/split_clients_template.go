@@ -2,7 +2,7 @@ package config
 
 const splitClientsTemplateText = `
 {{ range $sc := . }}
-split_clients $request_id ${{ $sc.VariableName }} {
+split_clients {{ $sc.Source }} ${{ $sc.VariableName }} {
     {{- range $d := $sc.Distributions }}
         {{- if eq $d.Percent "0.00" }}
     # {{ $d.Percent }}% {{ $d.Value }};
@@ -6,8 +6,9 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/types"
 
-	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 func TestExecuteSplitClients(t *testing.T) {
@@ -171,10 +172,18 @@ func TestCreateSplitClients(t *testing.T) {
 		dataplane.Backend{UpstreamName: "two-split-5", Valid: true, Weight: 50},
 	)
 
+	canaryMatch := createBackendGroup(
+		hrOneSplit,
+		0,
+		dataplane.Backend{UpstreamName: "stable", Valid: true, Weight: 90},
+		dataplane.Backend{UpstreamName: "canary", Valid: true, Weight: 10},
+	)
+	canaryMatch.CanaryMatch = &dataplane.CanaryMatch{Header: "X-Canary", Value: "always", BackendIdx: 1}
+
 	tests := []struct {
 		msg             string
 		backendGroups   []dataplane.BackendGroup
-		expSplitClients []http.SplitClient
+		expSplitClients []shared.SplitClient
 	}{
 		{
 			msg: "normal case",
@@ -186,10 +195,11 @@ func TestCreateSplitClients(t *testing.T) {
 				twoSplitGroup0,
 				twoSplitGroup1,
 			},
-			expSplitClients: []http.SplitClient{
+			expSplitClients: []shared.SplitClient{
 				{
+					Source:       "$request_id",
 					VariableName: "group_test__hr_one_split_rule0",
-					Distributions: []http.SplitClientDistribution{
+					Distributions: []shared.SplitClientDistribution{
 						{
 							Percent: "50.00",
 							Value:   "one-split-1",
@@ -201,8 +211,9 @@ func TestCreateSplitClients(t *testing.T) {
 					},
 				},
 				{
+					Source:       "$request_id",
 					VariableName: "group_test__hr_two_splits_rule0",
-					Distributions: []http.SplitClientDistribution{
+					Distributions: []shared.SplitClientDistribution{
 						{
 							Percent: "50.00",
 							Value:   "two-split-1",
@@ -214,8 +225,9 @@ func TestCreateSplitClients(t *testing.T) {
 					},
 				},
 				{
+					Source:       "$request_id",
 					VariableName: "group_test__hr_two_splits_rule1",
-					Distributions: []http.SplitClientDistribution{
+					Distributions: []shared.SplitClientDistribution{
 						{
 							Percent: "33.33",
 							Value:   "two-split-3",
@@ -240,6 +252,26 @@ func TestCreateSplitClients(t *testing.T) {
 			},
 			expSplitClients: nil,
 		},
+		{
+			msg:           "canary match",
+			backendGroups: []dataplane.BackendGroup{canaryMatch},
+			expSplitClients: []shared.SplitClient{
+				{
+					Source:       "$request_id",
+					VariableName: "group_test__hr_one_split_rule0_split",
+					Distributions: []shared.SplitClientDistribution{
+						{
+							Percent: "90.00",
+							Value:   "stable",
+						},
+						{
+							Percent: "10.00",
+							Value:   "canary",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -252,12 +284,208 @@ func TestCreateSplitClients(t *testing.T) {
 	}
 }
 
+func TestExecuteStreamSplitClients(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname: "app.example.com",
+				Port:     443,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "blue", Weight: 1},
+					{Name: "green", Weight: 3},
+				},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{Name: "blue", Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 443}}},
+			{Name: "green", Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 443}}},
+		},
+	}
+
+	results := executeStreamSplitClients(conf)
+	g.Expect(results).To(HaveLen(1))
+	g.Expect(results[0].dest).To(Equal(streamConfigFile))
+
+	sc := string(results[0].data)
+	g.Expect(sc).To(ContainSubstring("split_clients $remote_addr$remote_port $split_app_example_com_443"))
+	g.Expect(sc).To(ContainSubstring("25.00% blue;"))
+	g.Expect(sc).To(ContainSubstring("75.00% green;"))
+}
+
+func TestCreateStreamSplitClients(t *testing.T) {
+	t.Parallel()
+
+	endpoints := []resolver.Endpoint{{Address: "10.0.0.1", Port: 443}}
+	streamUpstreams := []dataplane.Upstream{
+		{Name: "blue", Endpoints: endpoints},
+		{Name: "green", Endpoints: endpoints},
+		{Name: "no-endpoints"},
+	}
+
+	tests := []struct {
+		msg             string
+		servers         []dataplane.Layer4VirtualServer
+		expSplitClients []shared.SplitClient
+	}{
+		{
+			msg: "one upstream",
+			servers: []dataplane.Layer4VirtualServer{
+				{
+					Hostname:  "app.example.com",
+					Port:      443,
+					Upstreams: []dataplane.Layer4Upstream{{Name: "blue", Weight: 1}},
+				},
+			},
+			expSplitClients: nil,
+		},
+		{
+			msg: "weighted upstreams",
+			servers: []dataplane.Layer4VirtualServer{
+				{
+					Hostname: "app.example.com",
+					Port:     443,
+					Upstreams: []dataplane.Layer4Upstream{
+						{Name: "blue", Weight: 80},
+						{Name: "green", Weight: 20},
+					},
+				},
+			},
+			expSplitClients: []shared.SplitClient{
+				{
+					Source:       "$remote_addr$remote_port",
+					VariableName: "split_app_example_com_443",
+					Distributions: []shared.SplitClientDistribution{
+						{Percent: "80.00", Value: "blue"},
+						{Percent: "20.00", Value: "green"},
+					},
+				},
+			},
+		},
+		{
+			msg: "unavailable upstreams",
+			servers: []dataplane.Layer4VirtualServer{
+				{
+					Hostname: "app.example.com",
+					Port:     443,
+					Upstreams: []dataplane.Layer4Upstream{
+						{Name: "blue", Weight: 2},
+						{Name: "no-endpoints", Weight: 1},
+						{Name: "", Weight: 1},
+					},
+				},
+			},
+			expSplitClients: []shared.SplitClient{
+				{
+					Source:       "$remote_addr$remote_port",
+					VariableName: "split_app_example_com_443",
+					Distributions: []shared.SplitClientDistribution{
+						{Percent: "50.00", Value: "blue"},
+						{Percent: "25.00", Value: `""`},
+						{Percent: "25.00", Value: `""`},
+					},
+				},
+			},
+		},
+		{
+			msg: "no available upstream with a weight",
+			servers: []dataplane.Layer4VirtualServer{
+				{
+					Hostname: "app.example.com",
+					Port:     443,
+					Upstreams: []dataplane.Layer4Upstream{
+						{Name: "blue", Weight: 0},
+						{Name: "no-endpoints", Weight: 1},
+					},
+				},
+			},
+			expSplitClients: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conf := dataplane.Configuration{
+				TLSPassthroughServers: test.servers,
+				StreamUpstreams:       streamUpstreams,
+			}
+
+			g.Expect(createStreamSplitClients(conf)).To(Equal(test.expSplitClients))
+		})
+	}
+}
+
+func TestGetLayer4ProxyPass(t *testing.T) {
+	t.Parallel()
+
+	upstreams := map[string]dataplane.Upstream{
+		"blue":         {Name: "blue", Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 443}}},
+		"green":        {Name: "green", Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 443}}},
+		"no-endpoints": {Name: "no-endpoints"},
+	}
+
+	tests := []struct {
+		msg       string
+		expected  string
+		upstreams []dataplane.Layer4Upstream
+	}{
+		{
+			msg:       "no upstreams",
+			upstreams: nil,
+			expected:  "",
+		},
+		{
+			msg:       "one upstream",
+			upstreams: []dataplane.Layer4Upstream{{Name: "blue", Weight: 1}},
+			expected:  "blue",
+		},
+		{
+			msg:       "one upstream with a weight of 0",
+			upstreams: []dataplane.Layer4Upstream{{Name: "blue", Weight: 0}},
+			expected:  "",
+		},
+		{
+			msg:       "one upstream without endpoints",
+			upstreams: []dataplane.Layer4Upstream{{Name: "no-endpoints", Weight: 1}},
+			expected:  "",
+		},
+		{
+			msg: "weighted upstreams",
+			upstreams: []dataplane.Layer4Upstream{
+				{Name: "blue", Weight: 1},
+				{Name: "green", Weight: 1},
+			},
+			expected: "$split_app_example_com_443",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			server := dataplane.Layer4VirtualServer{
+				Hostname:  "app.example.com",
+				Port:      443,
+				Upstreams: test.upstreams,
+			}
+
+			g.Expect(getLayer4ProxyPass(server, upstreams)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestCreateSplitClientDistributions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		msg              string
 		backends         []dataplane.Backend
-		expDistributions []http.SplitClientDistribution
+		expDistributions []shared.SplitClientDistribution
 	}{
 		{
 			msg:              "no backends",
@@ -289,7 +517,7 @@ func TestCreateSplitClientDistributions(t *testing.T) {
 					Weight:       0,
 				},
 			},
-			expDistributions: []http.SplitClientDistribution{
+			expDistributions: []shared.SplitClientDistribution{
 				{
 					Percent: "100",
 					Value:   invalidBackendRef,
@@ -310,7 +538,7 @@ func TestCreateSplitClientDistributions(t *testing.T) {
 					Weight:       1,
 				},
 			},
-			expDistributions: []http.SplitClientDistribution{
+			expDistributions: []shared.SplitClientDistribution{
 				{
 					Percent: "50.00",
 					Value:   "one",
@@ -340,7 +568,7 @@ func TestCreateSplitClientDistributions(t *testing.T) {
 					Weight:       50,
 				},
 			},
-			expDistributions: []http.SplitClientDistribution{
+			expDistributions: []shared.SplitClientDistribution{
 				{
 					Percent: "20.00",
 					Value:   "one",
@@ -374,7 +602,7 @@ func TestCreateSplitClientDistributions(t *testing.T) {
 					Weight:       3,
 				},
 			},
-			expDistributions: []http.SplitClientDistribution{
+			expDistributions: []shared.SplitClientDistribution{
 				{
 					Percent: "33.33",
 					Value:   "one",
@@ -404,33 +632,76 @@ func TestCreateSplitClientDistributions(t *testing.T) {
 func TestGetSplitClientValue(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		msg      string
-		expValue string
-		backend  dataplane.Backend
+		msg        string
+		expValue   string
+		backends   []dataplane.Backend
+		backendIdx int
 	}{
 		{
 			msg: "valid backend",
-			backend: dataplane.Backend{
-				UpstreamName: "valid",
-				Valid:        true,
+			backends: []dataplane.Backend{
+				{
+					UpstreamName: "valid",
+					Valid:        true,
+				},
 			},
 			expValue: "valid",
 		},
 		{
 			msg: "invalid backend",
-			backend: dataplane.Backend{
-				UpstreamName: "invalid",
-				Valid:        false,
+			backends: []dataplane.Backend{
+				{
+					UpstreamName: "invalid",
+					Valid:        false,
+				},
 			},
 			expValue: invalidBackendRef,
 		},
+		{
+			msg: "backend with filters",
+			backends: []dataplane.Backend{
+				{
+					UpstreamName: "stable",
+					Valid:        true,
+					Weight:       90,
+				},
+				{
+					UpstreamName: "canary",
+					Valid:        true,
+					Weight:       10,
+					RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+						Set: []dataplane.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+					},
+				},
+			},
+			backendIdx: 1,
+			expValue:   "1",
+		},
+		{
+			msg: "backends with filters and no weight",
+			backends: []dataplane.Backend{
+				{
+					UpstreamName: "stable",
+					Valid:        true,
+				},
+				{
+					UpstreamName: "canary",
+					Valid:        true,
+					ResponseHeaderModifiers: &dataplane.HTTPHeaderFilter{
+						Remove: []string{"X-Canary"},
+					},
+				},
+			},
+			backendIdx: 1,
+			expValue:   "canary",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.msg, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			result := getSplitClientValue(test.backend)
+			result := getSplitClientValue(dataplane.BackendGroup{Backends: test.backends}, test.backendIdx)
 			g.Expect(result).To(Equal(test.expValue))
 		})
 	}
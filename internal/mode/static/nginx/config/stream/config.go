@@ -4,6 +4,7 @@ import "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/
 
 // Server holds all configuration for a stream server.
 type Server struct {
+	ProxySSLVerify  *ProxySSLVerify
 	Listen          string
 	StatusZone      string
 	ProxyPass       string
@@ -13,22 +14,32 @@ type Server struct {
 	IsSocket        bool
 }
 
+// ProxySSLVerify holds the proxied TLS server verification configuration.
+type ProxySSLVerify struct {
+	TrustedCertificate string
+	Name               string
+}
+
 // Upstream holds all configuration for a stream upstream.
 type Upstream struct {
-	Name      string
-	ZoneSize  string // format: 512k, 1m
-	StateFile string
-	Servers   []UpstreamServer
+	Name             string
+	ZoneSize         string // format: 512k, 1m
+	StateFile        string
+	Servers          []UpstreamServer
+	HasBackupServers bool
 }
 
 // UpstreamServer holds all configuration for a stream upstream server.
 type UpstreamServer struct {
 	Address string
+	Backup  bool
+	Resolve bool
 }
 
 // ServerConfig holds configuration for a stream server and IP family to be used by NGINX.
 type ServerConfig struct {
-	Servers  []Server
-	IPFamily shared.IPFamily
-	Plus     bool
+	DNSResolver *shared.DNSResolver
+	Servers     []Server
+	IPFamily    shared.IPFamily
+	Plus        bool
 }
@@ -16,9 +16,10 @@ func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []exec
 	streamServers := createStreamServers(conf)
 
 	streamServerConfig := stream.ServerConfig{
-		Servers:  streamServers,
-		IPFamily: getIPFamily(conf.BaseHTTPConfig),
-		Plus:     g.plus,
+		Servers:     streamServers,
+		IPFamily:    getIPFamily(conf.BaseHTTPConfig),
+		DNSResolver: createDNSResolver(conf),
+		Plus:        g.plus,
 	}
 
 	streamServerResult := executeResult{
@@ -45,20 +46,19 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 	}
 
 	for _, server := range conf.TLSPassthroughServers {
-		if u, ok := upstreams[server.UpstreamName]; ok && server.UpstreamName != "" {
-			if server.Hostname != "" && len(u.Endpoints) > 0 {
-				streamServer := stream.Server{
-					Listen:     getSocketNameTLS(server.Port, server.Hostname),
-					StatusZone: server.Hostname,
-					ProxyPass:  server.UpstreamName,
-					IsSocket:   true,
-				}
-				// set rewriteClientIP settings as this is a socket stream server
-				streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
-					conf.BaseHTTPConfig.RewriteClientIPSettings,
-				)
-				streamServers = append(streamServers, streamServer)
+		if proxyPass := getLayer4ProxyPass(server, upstreams); proxyPass != "" && server.Hostname != "" {
+			streamServer := stream.Server{
+				Listen:         getSocketNameTLS(server.Port, server.Hostname),
+				StatusZone:     server.Hostname,
+				ProxyPass:      proxyPass,
+				ProxySSLVerify: createStreamProxySSLVerify(server.Upstreams),
+				IsSocket:       true,
 			}
+			// set rewriteClientIP settings as this is a socket stream server
+			streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
+				conf.BaseHTTPConfig.RewriteClientIPSettings,
+			)
+			streamServers = append(streamServers, streamServer)
 		}
 
 		if _, inPortSet := portSet[server.Port]; inPortSet {
@@ -79,6 +79,20 @@ func createStreamServers(conf dataplane.Configuration) []stream.Server {
 	return streamServers
 }
 
+// createStreamProxySSLVerify returns the proxied TLS server verification configuration of the upstreams.
+// All upstreams of a server have the same BackendTLSPolicy, which is verified in the graph package.
+func createStreamProxySSLVerify(upstreams []dataplane.Layer4Upstream) *stream.ProxySSLVerify {
+	for _, u := range upstreams {
+		if proxyVerify := createProxySSLVerify(u.VerifyTLS); proxyVerify != nil {
+			return &stream.ProxySSLVerify{
+				TrustedCertificate: proxyVerify.TrustedCertificate,
+				Name:               proxyVerify.Name,
+			}
+		}
+	}
+	return nil
+}
+
 func getRewriteClientIPSettingsForStream(
 	rewriteConfig dataplane.RewriteClientIPSettings,
 ) shared.RewriteClientIPSettings {
@@ -1,6 +1,13 @@
 package config
 
 const streamServersTemplateText = `
+{{- with $r := .DNSResolver }}
+resolver{{ range $a := $r.Addresses }} {{ $a }}{{ end }}{{ if $r.Valid }} valid={{ $r.Valid }}{{ end }}
+    {{- if $r.DisableIPv4 }} ipv4=off{{ end }}{{ if $r.DisableIPv6 }} ipv6=off{{ end }};
+    {{- if $r.Timeout }}
+resolver_timeout {{ $r.Timeout }};
+    {{- end }}
+{{ end }}
 {{- range $s := .Servers }}
 server {
 	{{- if or ($.IPFamily.IPv4) ($s.IsSocket) }}
@@ -19,6 +26,13 @@ server {
 
 	{{- if $s.ProxyPass }}
     proxy_pass {{ $s.ProxyPass }};
+	{{- end }}
+	{{- if $s.ProxySSLVerify }}
+    proxy_ssl on;
+    proxy_ssl_server_name on;
+    proxy_ssl_verify on;
+    proxy_ssl_name {{ $s.ProxySSLVerify.Name }};
+    proxy_ssl_trusted_certificate {{ $s.ProxySSLVerify.TrustedCertificate }};
 	{{- end }}
 	{{- if $s.Pass }}
     pass {{ $s.Pass }};
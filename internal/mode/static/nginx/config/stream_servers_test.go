@@ -17,19 +17,28 @@ func TestExecuteStreamServers(t *testing.T) {
 	conf := dataplane.Configuration{
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "example.com",
-				Port:         8081,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "example.com",
-				Port:         8080,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "cafe.example.com",
-				Port:         8080,
-				UpstreamName: "backend2",
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				Upstreams: []dataplane.Layer4Upstream{
+					{
+						Name:   "backend2",
+						Weight: 1,
+						VerifyTLS: &dataplane.VerifyTLS{
+							CertBundleID: "test-foo",
+							Hostname:     "backend2.example.com",
+						},
+					},
+				},
 			},
 		},
 		StreamUpstreams: []dataplane.Upstream{
@@ -55,11 +64,16 @@ func TestExecuteStreamServers(t *testing.T) {
 	}
 
 	expSubStrings := map[string]int{
-		"pass $dest8081;": 1,
-		"pass $dest8080;": 1,
-		"ssl_preread on;": 2,
-		"proxy_pass":      3,
-		"status_zone":     0,
+		"pass $dest8081;":                      1,
+		"pass $dest8080;":                      1,
+		"ssl_preread on;":                      2,
+		"proxy_pass":                           3,
+		"status_zone":                          0,
+		"proxy_ssl on;":                        1,
+		"proxy_ssl_server_name on;":            1,
+		"proxy_ssl_verify on;":                 1,
+		"proxy_ssl_name backend2.example.com;": 1,
+		"proxy_ssl_trusted_certificate /etc/nginx/secrets/test-foo.crt;": 1,
 	}
 	g := NewWithT(t)
 
@@ -79,19 +93,19 @@ func TestExecuteStreamServers_Plus(t *testing.T) {
 	config := dataplane.Configuration{
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "example.com",
-				Port:         8081,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "example.com",
-				Port:         8080,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "cafe.example.com",
-				Port:         8082,
-				UpstreamName: "backend2",
+				Hostname:  "cafe.example.com",
+				Port:      8082,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend2", Weight: 1}},
 			},
 		},
 	}
@@ -118,34 +132,43 @@ func TestCreateStreamServers(t *testing.T) {
 	conf := dataplane.Configuration{
 		TLSPassthroughServers: []dataplane.Layer4VirtualServer{
 			{
-				Hostname:     "example.com",
-				Port:         8081,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "example.com",
-				Port:         8080,
-				UpstreamName: "backend1",
+				Hostname:  "example.com",
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
 			},
 			{
-				Hostname:     "cafe.example.com",
-				Port:         8080,
-				UpstreamName: "backend2",
+				Hostname: "cafe.example.com",
+				Port:     8080,
+				Upstreams: []dataplane.Layer4Upstream{
+					{
+						Name:   "backend2",
+						Weight: 1,
+						VerifyTLS: &dataplane.VerifyTLS{
+							Hostname:   "backend2.example.com",
+							RootCAPath: "/etc/ssl/cert.pem",
+						},
+					},
+				},
 			},
 			{
-				Hostname:     "blank-upstream.example.com",
-				Port:         8081,
-				UpstreamName: "",
+				Hostname:  "blank-upstream.example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "", Weight: 1}},
 			},
 			{
-				Hostname:     "dne-upstream.example.com",
-				Port:         8081,
-				UpstreamName: "dne",
+				Hostname:  "dne-upstream.example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "dne", Weight: 1}},
 			},
 			{
-				Hostname:     "no-endpoints.example.com",
-				Port:         8081,
-				UpstreamName: "no-endpoints",
+				Hostname:  "no-endpoints.example.com",
+				Port:      8081,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "no-endpoints", Weight: 1}},
 			},
 		},
 		StreamUpstreams: []dataplane.Upstream{
@@ -181,21 +204,25 @@ func TestCreateStreamServers(t *testing.T) {
 	expectedStreamServers := []stream.Server{
 		{
 			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[0].Port, conf.TLSPassthroughServers[0].Hostname),
-			ProxyPass:  conf.TLSPassthroughServers[0].UpstreamName,
+			ProxyPass:  conf.TLSPassthroughServers[0].Upstreams[0].Name,
 			StatusZone: conf.TLSPassthroughServers[0].Hostname,
 			SSLPreread: false,
 			IsSocket:   true,
 		},
 		{
 			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[1].Port, conf.TLSPassthroughServers[1].Hostname),
-			ProxyPass:  conf.TLSPassthroughServers[1].UpstreamName,
+			ProxyPass:  conf.TLSPassthroughServers[1].Upstreams[0].Name,
 			StatusZone: conf.TLSPassthroughServers[1].Hostname,
 			SSLPreread: false,
 			IsSocket:   true,
 		},
 		{
-			Listen:     getSocketNameTLS(conf.TLSPassthroughServers[2].Port, conf.TLSPassthroughServers[2].Hostname),
-			ProxyPass:  conf.TLSPassthroughServers[2].UpstreamName,
+			Listen:    getSocketNameTLS(conf.TLSPassthroughServers[2].Port, conf.TLSPassthroughServers[2].Hostname),
+			ProxyPass: conf.TLSPassthroughServers[2].Upstreams[0].Name,
+			ProxySSLVerify: &stream.ProxySSLVerify{
+				TrustedCertificate: "/etc/ssl/cert.pem",
+				Name:               "backend2.example.com",
+			},
 			StatusZone: conf.TLSPassthroughServers[2].Hostname,
 			SSLPreread: false,
 			IsSocket:   true,
@@ -220,9 +247,9 @@ func TestExecuteStreamServersForIPFamily(t *testing.T) {
 	t.Parallel()
 	passThroughServers := []dataplane.Layer4VirtualServer{
 		{
-			UpstreamName: "backend1",
-			Hostname:     "cafe.example.com",
-			Port:         8443,
+			Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
+			Hostname:  "cafe.example.com",
+			Port:      8443,
 		},
 	}
 	streamUpstreams := []dataplane.Upstream{
@@ -306,9 +333,9 @@ func TestExecuteStreamServers_RewriteClientIP(t *testing.T) {
 	t.Parallel()
 	passThroughServers := []dataplane.Layer4VirtualServer{
 		{
-			UpstreamName: "backend1",
-			Hostname:     "cafe.example.com",
-			Port:         8443,
+			Upstreams: []dataplane.Layer4Upstream{{Name: "backend1", Weight: 1}},
+			Hostname:  "cafe.example.com",
+			Port:      8443,
 		},
 	}
 	streamUpstreams := []dataplane.Upstream{
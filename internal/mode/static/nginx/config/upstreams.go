@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
@@ -9,11 +10,13 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
 )
 
 var (
 	upstreamsTemplate       = gotemplate.Must(gotemplate.New("upstreams").Parse(upstreamsTemplateText))
 	streamUpstreamsTemplate = gotemplate.Must(gotemplate.New("streamUpstreams").Parse(streamUpstreamsTemplateText))
+	upstreamStateTemplate   = gotemplate.Must(gotemplate.New("upstreamState").Parse(upstreamStateTemplateText))
 )
 
 const (
@@ -33,6 +36,8 @@ const (
 	plusZoneSizeStream = "1m"
 	// stateDir is the directory for storing state files.
 	stateDir = "/var/lib/nginx/state"
+	// StreamStateFilePrefix is the prefix of the names of the state files of the stream upstreams.
+	StreamStateFilePrefix = "stream_"
 )
 
 // keepAliveChecker takes an upstream name and returns if it has keep alive settings enabled.
@@ -61,23 +66,43 @@ func newExecuteUpstreamsFunc(upstreams []http.Upstream) executeFunc {
 }
 
 func executeUpstreams(upstreams []http.Upstream) []executeResult {
-	result := executeResult{
+	results := make([]executeResult, 0, len(upstreams)+1)
+	results = append(results, executeResult{
 		dest: httpConfigFile,
 		data: helpers.MustExecuteTemplate(upstreamsTemplate, upstreams),
+	})
+
+	for _, u := range upstreams {
+		if u.StateFile != "" {
+			results = append(results, executeResult{
+				dest: u.StateFile,
+				data: helpers.MustExecuteTemplate(upstreamStateTemplate, u.Servers),
+			})
+		}
 	}
 
-	return []executeResult{result}
+	return results
 }
 
 func (g GeneratorImpl) executeStreamUpstreams(conf dataplane.Configuration) []executeResult {
 	upstreams := g.createStreamUpstreams(conf.StreamUpstreams)
 
-	result := executeResult{
+	results := make([]executeResult, 0, len(upstreams)+1)
+	results = append(results, executeResult{
 		dest: streamConfigFile,
 		data: helpers.MustExecuteTemplate(streamUpstreamsTemplate, upstreams),
+	})
+
+	for _, u := range upstreams {
+		if u.StateFile != "" {
+			results = append(results, executeResult{
+				dest: u.StateFile,
+				data: helpers.MustExecuteTemplate(upstreamStateTemplate, u.Servers),
+			})
+		}
 	}
 
-	return []executeResult{result}
+	return results
 }
 
 func (g GeneratorImpl) createStreamUpstreams(upstreams []dataplane.Upstream) []stream.Upstream {
@@ -97,25 +122,30 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 	zoneSize := ossZoneSizeStream
 	if g.plus {
 		zoneSize = plusZoneSizeStream
-		stateFile = fmt.Sprintf("%s/%s.conf", stateDir, up.Name)
 	}
 
+	// HTTP and stream upstreams can have the same name, so the stream state files have their own prefix.
+	if g.plus && !up.ResolvesHostnames() {
+		stateFile = fmt.Sprintf("%s/%s%s.conf", stateDir, StreamStateFilePrefix, up.Name)
+	}
+
+	var hasBackupServers bool
 	upstreamServers := make([]stream.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
-		format := "%s:%d"
-		if ep.IPv6 {
-			format = "[%s]:%d"
-		}
 		upstreamServers[idx] = stream.UpstreamServer{
-			Address: fmt.Sprintf(format, ep.Address, ep.Port),
+			Address: formatEndpointAddress(ep),
+			Backup:  ep.Backup,
+			Resolve: ep.Resolve,
 		}
+		hasBackupServers = hasBackupServers || ep.Backup
 	}
 
 	return stream.Upstream{
-		Name:      up.Name,
-		ZoneSize:  zoneSize,
-		StateFile: stateFile,
-		Servers:   upstreamServers,
+		Name:             up.Name,
+		ZoneSize:         zoneSize,
+		StateFile:        stateFile,
+		Servers:          upstreamServers,
+		HasBackupServers: hasBackupServers,
 	}
 }
 
@@ -145,6 +175,11 @@ func (g GeneratorImpl) createUpstream(
 	zoneSize := ossZoneSize
 	if g.plus {
 		zoneSize = plusZoneSize
+	}
+
+	// The servers that NGINX resolves at runtime are not kept in a state file, because they are not updated
+	// through the NGINX Plus API.
+	if g.plus && !up.ResolvesHostnames() {
 		stateFile = fmt.Sprintf("%s/%s.conf", stateDir, up.Name)
 	}
 
@@ -152,6 +187,8 @@ func (g GeneratorImpl) createUpstream(
 		zoneSize = upstreamPolicySettings.ZoneSize
 	}
 
+	hash, stickyCookie := g.createSessionPersistence(up.SessionPersistence)
+
 	if len(up.Endpoints) == 0 {
 		return http.Upstream{
 			Name:      up.Name,
@@ -165,24 +202,97 @@ func (g GeneratorImpl) createUpstream(
 		}
 	}
 
+	var hasBackupServers bool
 	upstreamServers := make([]http.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
-		format := "%s:%d"
-		if ep.IPv6 {
-			format = "[%s]:%d"
-		}
+		backup := ep.Backup && BackupServersAllowed(up.SessionPersistence, g.plus)
+
 		upstreamServers[idx] = http.UpstreamServer{
-			Address: fmt.Sprintf(format, ep.Address, ep.Port),
+			Address: formatEndpointAddress(ep),
+			Backup:  backup,
+			Resolve: ep.Resolve,
 		}
+		hasBackupServers = hasBackupServers || backup
 	}
 
 	return http.Upstream{
-		Name:      up.Name,
-		ZoneSize:  zoneSize,
-		StateFile: stateFile,
-		Servers:   upstreamServers,
-		KeepAlive: upstreamPolicySettings.KeepAlive,
+		Name:             up.Name,
+		ZoneSize:         zoneSize,
+		StateFile:        stateFile,
+		Servers:          upstreamServers,
+		KeepAlive:        upstreamPolicySettings.KeepAlive,
+		HasBackupServers: hasBackupServers,
+		Hash:             hash,
+		StickyCookie:     stickyCookie,
+	}
+}
+
+// BackupServersAllowed returns whether an upstream with the session persistence can have backup servers.
+// The hash load balancing method doesn't support backup servers, so session persistence takes precedence over
+// the preference for the endpoints in the same zone. The NGINX Plus API must follow the same rule as the config.
+func BackupServersAllowed(sp *dataplane.SessionPersistence, plus bool) bool {
+	if sp == nil {
+		return true
 	}
+
+	switch sp.Type {
+	case dataplane.SessionPersistenceHeader:
+		return false
+	case dataplane.SessionPersistenceCookie:
+		return plus
+	default:
+		return true
+	}
+}
+
+// createSessionPersistence returns the key of the hash load balancing method or the sticky cookie configuration
+// for the session persistence of an upstream. NGINX Plus sets the session cookie with the sticky directive.
+// NGINX OSS can't set cookies, so it hashes the value of the cookie that the backend must set. Requests without
+// the cookie hash the request ID instead, so that they are spread across the servers (see buildSessionCookieMaps).
+func (g GeneratorImpl) createSessionPersistence(
+	sp *dataplane.SessionPersistence,
+) (string, *http.UpstreamStickyCookie) {
+	if sp == nil {
+		return "", nil
+	}
+
+	switch sp.Type {
+	case dataplane.SessionPersistenceHeader:
+		return requestHeaderVariable(sp.Name), nil
+	case dataplane.SessionPersistenceCookie:
+		if g.plus {
+			return "", &http.UpstreamStickyCookie{
+				Name:    sp.Name,
+				Expires: sp.Expires,
+			}
+		}
+
+		return sessionCookieVariable(sp.Name), nil
+	default:
+		return "", nil
+	}
+}
+
+// sessionCookieVariable returns the NGINX variable that holds the value of the session cookie, or the request ID
+// if the request doesn't have the cookie.
+func sessionCookieVariable(name string) string {
+	return "$session_cookie_" + name
+}
+
+// requestHeaderVariable returns the NGINX variable that holds the value of a request header.
+// NGINX exposes the request headers as variables with lowercase names and underscores instead of dashes.
+func requestHeaderVariable(name string) string {
+	return "$http_" + strings.ReplaceAll(strings.ToLower(name), "-", "_")
+}
+
+// formatEndpointAddress returns the address of the endpoint in the format of the NGINX server directive.
+func formatEndpointAddress(ep resolver.Endpoint) string {
+	format := "%s:%d"
+	if ep.IPv6 {
+		format = "[%s]:%d"
+	}
+
+	return fmt.Sprintf(format, ep.Address, ep.Port)
 }
 
 func createInvalidBackendRefUpstream() http.Upstream {
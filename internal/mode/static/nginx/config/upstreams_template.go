@@ -7,19 +7,29 @@ package config
 // https://github.com/nginx/nginx-gateway-fabric/issues/483
 //
 // if the keepalive directive is present, it is necessary to activate the load balancing method before the directive.
+//
+// The backup parameter of the server directive is not supported by the random load balancing method,
+// so least_conn is used for upstreams with backup servers.
+//
+// Upstreams with header or cookie based session persistence in NGINX OSS use the hash load balancing method,
+// while upstreams with cookie based session persistence in NGINX Plus use the sticky directive.
 const upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
-    random two least_conn;
+    {{ if $u.Hash }}hash {{ $u.Hash }} consistent
+    {{- else if $u.HasBackupServers }}least_conn{{ else }}random two least_conn{{ end }};
     {{ if $u.ZoneSize -}}
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{ end -}}
+    {{ with $sc := $u.StickyCookie -}}
+    sticky cookie {{ $sc.Name }}{{ if $sc.Expires }} expires={{ $sc.Expires }}{{ end }};
+    {{ end -}}
 
     {{- if $u.StateFile }}
     state {{ $u.StateFile }};
     {{- else }}
         {{ range $server := $u.Servers }}
-    server {{ $server.Address }};
+    server {{ $server.Address }}{{ if $server.Backup }} backup{{ end }}{{ if $server.Resolve }} resolve{{ end }};
         {{- end }}
     {{- end }}
     {{ if $u.KeepAlive.Connections -}}
@@ -41,7 +51,7 @@ upstream {{ $u.Name }} {
 const streamUpstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
-    random two least_conn;
+    {{ if $u.HasBackupServers }}least_conn{{ else }}random two least_conn{{ end }};
     {{ if $u.ZoneSize -}}
     zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{- end }}
@@ -49,9 +59,18 @@ upstream {{ $u.Name }} {
     state {{ $u.StateFile }};
     {{- else }}
         {{ range $server := $u.Servers }}
-    server {{ $server.Address }};
+    server {{ $server.Address }}{{ if $server.Backup }} backup{{ end }}{{ if $server.Resolve }} resolve{{ end }};
         {{- end }}
     {{- end }}
 }
 {{ end -}}
 `
+
+// upstreamStateTemplateText is the template for the NGINX Plus state file of an upstream.
+// The state file uses the same format as NGINX Plus, which rewrites the file when the servers
+// are updated through the API.
+const upstreamStateTemplateText = `
+{{- range $server := . -}}
+server {{ $server.Address }}{{ if $server.Backup }} backup{{ end }};
+{{ end -}}
+`
@@ -526,6 +526,67 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			msg: "upstreamSettingsPolicy with only keep alive settings",
 		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "cookie session persistence",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				SessionPersistence: &dataplane.SessionPersistence{
+					Name:    "session",
+					Expires: "1h",
+					Type:    dataplane.SessionPersistenceCookie,
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:     "cookie session persistence",
+				ZoneSize: ossZoneSize,
+				Hash:     "$session_cookie_session",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+					},
+				},
+			},
+			msg: "cookie session persistence",
+		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "header session persistence",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+					{
+						Address: "10.0.0.2",
+						Port:    80,
+						Backup:  true,
+					},
+				},
+				SessionPersistence: &dataplane.SessionPersistence{
+					Name: "X-Session-ID",
+					Type: dataplane.SessionPersistenceHeader,
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:     "header session persistence",
+				ZoneSize: ossZoneSize,
+				Hash:     "$http_x_session_id",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+					},
+					{
+						Address: "10.0.0.2:80",
+					},
+				},
+			},
+			msg: "header session persistence; backup servers are not supported by hash",
+		},
 	}
 
 	for _, test := range tests {
@@ -586,6 +647,40 @@ func TestCreateUpstreamPlus(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg: "cookie session persistence",
+			stateUpstream: dataplane.Upstream{
+				Name: "cookie",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+						Backup:  true,
+					},
+				},
+				SessionPersistence: &dataplane.SessionPersistence{
+					Name:    "session",
+					Expires: "1h",
+					Type:    dataplane.SessionPersistenceCookie,
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "cookie",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/cookie.conf",
+				StickyCookie: &http.UpstreamStickyCookie{
+					Name:    "session",
+					Expires: "1h",
+				},
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.1:80",
+						Backup:  true,
+					},
+				},
+				HasBackupServers: true,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -644,6 +739,177 @@ func TestExecuteStreamUpstreams(t *testing.T) {
 	}
 }
 
+func TestExecuteUpstreamsBackupServers(t *testing.T) {
+	t.Parallel()
+	gen := GeneratorImpl{}
+
+	stateUpstreams := []dataplane.Upstream{
+		{
+			Name: "up1-backup",
+			Endpoints: []resolver.Endpoint{
+				{Address: "10.0.0.0", Port: 80},
+				{Address: "10.0.0.1", Port: 80, Backup: true},
+			},
+		},
+		{
+			Name: "up2",
+			Endpoints: []resolver.Endpoint{
+				{Address: "11.0.0.0", Port: 80},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	upstreams := gen.createUpstreams(stateUpstreams, upstreamsettings.NewProcessor())
+	nginxUpstreams := string(executeUpstreams(upstreams)[0].data)
+
+	g.Expect(nginxUpstreams).To(ContainSubstring(
+		"upstream up1-backup {\n    least_conn;\n    zone up1-backup 512k;",
+	))
+	g.Expect(nginxUpstreams).To(ContainSubstring("server 10.0.0.0:80;"))
+	g.Expect(nginxUpstreams).To(ContainSubstring("server 10.0.0.1:80 backup;"))
+	g.Expect(nginxUpstreams).To(ContainSubstring("upstream up2 {\n    random two least_conn;"))
+
+	streamUpstreams := string(gen.executeStreamUpstreams(
+		dataplane.Configuration{StreamUpstreams: stateUpstreams},
+	)[0].data)
+
+	g.Expect(streamUpstreams).To(ContainSubstring("upstream up1-backup {\n    least_conn;"))
+	g.Expect(streamUpstreams).To(ContainSubstring("server 10.0.0.1:80 backup;"))
+	g.Expect(streamUpstreams).To(ContainSubstring("upstream up2 {\n    random two least_conn;"))
+}
+
+func TestExecuteUpstreamsSessionPersistence(t *testing.T) {
+	t.Parallel()
+
+	upstreams := []http.Upstream{
+		{
+			Name:     "up1-hash",
+			ZoneSize: ossZoneSize,
+			Hash:     "$session_cookie_session",
+			Servers:  []http.UpstreamServer{{Address: "10.0.0.0:80"}},
+		},
+		{
+			Name:     "up2-sticky",
+			ZoneSize: plusZoneSize,
+			StickyCookie: &http.UpstreamStickyCookie{
+				Name:    "session",
+				Expires: "1h",
+			},
+			Servers: []http.UpstreamServer{{Address: "11.0.0.0:80"}},
+		},
+		{
+			Name:         "up3-session-cookie",
+			ZoneSize:     plusZoneSize,
+			StickyCookie: &http.UpstreamStickyCookie{Name: "ngf_session"},
+			Servers:      []http.UpstreamServer{{Address: "12.0.0.0:80"}},
+		},
+	}
+
+	g := NewWithT(t)
+
+	nginxUpstreams := string(executeUpstreams(upstreams)[0].data)
+
+	g.Expect(nginxUpstreams).To(ContainSubstring(
+		"upstream up1-hash {\n    hash $session_cookie_session consistent;\n    zone up1-hash 512k;",
+	))
+	g.Expect(nginxUpstreams).To(ContainSubstring(
+		"upstream up2-sticky {\n    random two least_conn;\n    zone up2-sticky 1m;\n" +
+			"    sticky cookie session expires=1h;",
+	))
+	g.Expect(nginxUpstreams).To(ContainSubstring("    sticky cookie ngf_session;\n"))
+}
+
+func TestExecuteUpstreamsStateFiles(t *testing.T) {
+	t.Parallel()
+	gen := GeneratorImpl{plus: true}
+
+	stateUpstreams := []dataplane.Upstream{
+		{
+			Name: "up1",
+			Endpoints: []resolver.Endpoint{
+				{Address: "10.0.0.0", Port: 80},
+				{Address: "2001:db8::1", Port: 80, IPv6: true, Backup: true},
+			},
+		},
+		{
+			Name: "up2-no-endpoints",
+		},
+	}
+
+	expStateFiles := map[string]string{
+		stateDir + "/up1.conf":              "server 10.0.0.0:80;\nserver [2001:db8::1]:80 backup;\n",
+		stateDir + "/up2-no-endpoints.conf": "server unix:/var/run/nginx/nginx-503-server.sock;\n",
+	}
+
+	expStreamStateFiles := map[string]string{
+		stateDir + "/stream_up1.conf": "server 10.0.0.0:80;\nserver [2001:db8::1]:80 backup;\n",
+	}
+
+	getStateFiles := func(results []executeResult, configFile string) map[string]string {
+		stateFiles := make(map[string]string)
+		for _, res := range results {
+			if res.dest != configFile {
+				stateFiles[res.dest] = string(res.data)
+			}
+		}
+		return stateFiles
+	}
+
+	g := NewWithT(t)
+
+	upstreams := gen.createUpstreams(stateUpstreams, upstreamsettings.NewProcessor())
+	upstreamResults := executeUpstreams(upstreams)
+	g.Expect(upstreamResults[0].dest).To(Equal(httpConfigFile))
+	g.Expect(string(upstreamResults[0].data)).To(ContainSubstring("state " + stateDir + "/up1.conf;"))
+	g.Expect(getStateFiles(upstreamResults, httpConfigFile)).To(Equal(expStateFiles))
+
+	streamResults := gen.executeStreamUpstreams(dataplane.Configuration{StreamUpstreams: stateUpstreams})
+	g.Expect(streamResults[0].dest).To(Equal(streamConfigFile))
+	g.Expect(getStateFiles(streamResults, streamConfigFile)).To(Equal(expStreamStateFiles))
+}
+
+func TestExecuteUpstreamsResolvedServers(t *testing.T) {
+	t.Parallel()
+	gen := GeneratorImpl{plus: true}
+
+	stateUpstreams := []dataplane.Upstream{
+		{
+			Name: "external",
+			Endpoints: []resolver.Endpoint{
+				{Address: "api.example.com", Port: 443, Resolve: true},
+			},
+		},
+		{
+			Name: "up2",
+			Endpoints: []resolver.Endpoint{
+				{Address: "11.0.0.0", Port: 80},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	upstreams := gen.createUpstreams(stateUpstreams, upstreamsettings.NewProcessor())
+	upstreamResults := executeUpstreams(upstreams)
+	nginxUpstreams := string(upstreamResults[0].data)
+
+	g.Expect(nginxUpstreams).To(ContainSubstring("server api.example.com:443 resolve;"))
+	g.Expect(nginxUpstreams).ToNot(ContainSubstring("state " + stateDir + "/external.conf;"))
+	g.Expect(nginxUpstreams).To(ContainSubstring("state " + stateDir + "/up2.conf;"))
+	g.Expect(upstreamResults).To(HaveLen(2))
+	g.Expect(upstreamResults[1].dest).To(Equal(stateDir + "/up2.conf"))
+
+	streamResults := gen.executeStreamUpstreams(dataplane.Configuration{StreamUpstreams: stateUpstreams})
+	streamUpstreams := string(streamResults[0].data)
+
+	g.Expect(streamUpstreams).To(ContainSubstring("server api.example.com:443 resolve;"))
+	g.Expect(streamUpstreams).ToNot(ContainSubstring("state " + stateDir + "/stream_external.conf;"))
+	g.Expect(streamResults).To(HaveLen(2))
+	g.Expect(streamResults[1].dest).To(Equal(stateDir + "/stream_up2.conf"))
+}
+
 func TestCreateStreamUpstreams(t *testing.T) {
 	t.Parallel()
 	gen := GeneratorImpl{}
@@ -777,7 +1043,7 @@ func TestCreateStreamUpstreamPlus(t *testing.T) {
 	expectedUpstream := stream.Upstream{
 		Name:      "multiple-endpoints",
 		ZoneSize:  plusZoneSize,
-		StateFile: stateDir + "/multiple-endpoints.conf",
+		StateFile: stateDir + "/stream_multiple-endpoints.conf",
 		Servers: []stream.UpstreamServer{
 			{
 				Address: "10.0.0.1:80",
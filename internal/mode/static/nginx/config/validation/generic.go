@@ -16,6 +16,17 @@ func (GenericValidator) ValidateEscapedStringNoVarExpansion(value string) error
 	return validateEscapedStringNoVarExpansion(value, nil)
 }
 
+// ValidateEscapedString ensures that no invalid characters are included in the string value that
+// could lead to unwanted nginx behavior. Unlike ValidateEscapedStringNoVarExpansion, NGINX variables are allowed.
+func (GenericValidator) ValidateEscapedString(value string) error {
+	return validateEscapedString(value, nil)
+}
+
+// ValidateHeaderName validates an HTTP header name.
+func (GenericValidator) ValidateHeaderName(name string) error {
+	return validateHeaderName(name)
+}
+
 const (
 	alphaNumericStringFmt    = `[a-zA-Z0-9_-]+`
 	alphaNumericStringErrMsg = "must contain only alphanumeric characters or '-' or '_'"
@@ -106,3 +117,47 @@ func (GenericValidator) ValidateEndpoint(endpoint string) error {
 
 	return nil
 }
+
+const (
+	variableNameFmt    = `\$[a-zA-Z0-9_]+`
+	variableNameErrMsg = "must start with '$' followed by alphanumeric characters or '_'"
+)
+
+var variableNameFmtRegexp = regexp.MustCompile("^" + variableNameFmt + "$")
+
+// ValidateNginxVariableName validates the name of an NGINX variable, including the leading '$'.
+func (GenericValidator) ValidateNginxVariableName(name string) error {
+	if !variableNameFmtRegexp.MatchString(name) {
+		examples := []string{
+			"$cookie_nocache",
+			"$http_pragma",
+			"$arg_nocache",
+		}
+
+		return errors.New(k8svalidation.RegexError(variableNameErrMsg, variableNameFmt, examples...))
+	}
+
+	return nil
+}
+
+const (
+	mimeTypeFmt    = `\*|[a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+*-]+`
+	mimeTypeErrMsg = "must be a MIME type in the format 'type/subtype' or '*'"
+)
+
+var mimeTypeFmtRegexp = regexp.MustCompile("^(" + mimeTypeFmt + ")$")
+
+// ValidateMIMEType validates a MIME type, for example "application/json". The special value "*" is also allowed.
+func (GenericValidator) ValidateMIMEType(mimeType string) error {
+	if !mimeTypeFmtRegexp.MatchString(mimeType) {
+		examples := []string{
+			"application/json",
+			"text/*",
+			"*",
+		}
+
+		return errors.New(k8svalidation.RegexError(mimeTypeErrMsg, mimeTypeFmt, examples...))
+	}
+
+	return nil
+}
@@ -24,6 +24,91 @@ func TestGenericValidator_ValidateEscapedStringNoVarExpansion(t *testing.T) {
 	)
 }
 
+func TestGenericValidator_ValidateEscapedString(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateEscapedString,
+		`test`,
+		`$scheme$proxy_host$request_uri`,
+		`\"`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateEscapedString,
+		`\`,
+		`test"test`,
+	)
+}
+
+func TestGenericValidator_ValidateHeaderName(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateHeaderName,
+		`X-Cache-Status`,
+		`my-header`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateHeaderName,
+		`my header`,
+		`Host`,
+		`X-Cache:Status`,
+	)
+}
+
+func TestGenericValidator_ValidateNginxVariableName(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateNginxVariableName,
+		`$cookie_nocache`,
+		`$http_pragma`,
+		`$arg_1`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateNginxVariableName,
+		`cookie_nocache`,
+		`$`,
+		`$http_pragma;`,
+		`$var $var`,
+	)
+}
+
+func TestGenericValidator_ValidateMIMEType(t *testing.T) {
+	t.Parallel()
+	validator := GenericValidator{}
+
+	testValidValuesForSimpleValidator(
+		t,
+		validator.ValidateMIMEType,
+		`application/json`,
+		`application/vnd.api+json`,
+		`text/*`,
+		`*`,
+	)
+
+	testInvalidValuesForSimpleValidator(
+		t,
+		validator.ValidateMIMEType,
+		`application`,
+		`application/json;`,
+		`text/html text/css`,
+		`*/`,
+	)
+}
+
 func TestValidateServiceName(t *testing.T) {
 	t.Parallel()
 	validator := GenericValidator{}
@@ -11,7 +11,7 @@ var versionTemplate = gotemplate.Must(gotemplate.New("version").Parse(versionTem
 
 func executeVersion(conf dataplane.Configuration) []executeResult {
 	result := executeResult{
-		dest: configVersionFile,
+		dest: ConfigVersionFile,
 		data: helpers.MustExecuteTemplate(versionTemplate, conf.Version),
 	}
 
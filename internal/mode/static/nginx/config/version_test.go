@@ -15,6 +15,6 @@ func TestExecuteVersion(t *testing.T) {
 	conf := dataplane.Configuration{Version: 42}
 	res := executeVersion(conf)
 	g.Expect(res).To(HaveLen(1))
-	g.Expect(res[0].dest).To(Equal(configVersionFile))
+	g.Expect(res[0].dest).To(Equal(ConfigVersionFile))
 	g.Expect(string(res[0].data)).To(ContainSubstring("return 200 42;"))
 }
@@ -113,7 +113,9 @@ func (m *ManagerImpl) ReplaceFiles(files []File) error {
 
 	// In some cases, NGINX reads files in runtime, like a JWK. If you remove such file, NGINX will fail
 	// any request (return 500 status code) that involves reading the file.
-	// However, we don't have such files yet, so we're not considering this case.
+	// The only such file is the dynamic upstreams state file, and the NJS module that reads it tolerates
+	// the file being temporarily missing or incomplete.
+	// NGINX Plus upstream state files are only read when NGINX starts or reloads, after the files are written.
 
 	m.lastWrittenPaths = make([]string, 0, len(files))
 
@@ -0,0 +1,87 @@
+// The gRPC messages are prefixed with a flag byte and the length of the message as a 4 byte big endian integer.
+// See https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-HTTP2.md#requests.
+const MESSAGE_PREFIX_LENGTH = 5;
+const DATA_FLAG = 0x00;
+// The gRPC-Web protocol encodes the trailers of the response as a message with the most significant bit
+// of the flag byte set. See https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-WEB.md.
+const TRAILERS_FLAG = 0x80;
+
+const GRPC_CONTENT_TYPE = 'application/grpc';
+const GRPC_WEB_CONTENT_TYPES = ['application/grpc-web', 'application/grpc-web+proto'];
+
+// SERVING_RESPONSE is the protobuf encoding of the grpc.health.v1.HealthCheckResponse message with the
+// SERVING status: the first field (status) with the varint type and the value 1.
+const SERVING_RESPONSE = [0x08, 0x01];
+
+// healthCheck is a content handler that responds to grpc.health.v1.Health/Check requests with the SERVING
+// status. The grpc-status trailer of the response is added by the add_trailer directive of the location,
+// because njs can't set trailers.
+function healthCheck(r) {
+	r.headersOut['Content-Type'] = GRPC_CONTENT_TYPE;
+	r.return(200, createMessage(DATA_FLAG, Buffer.from(SERVING_RESPONSE)));
+}
+
+// webHeaders is a header filter that sets the Content-Type of the responses to gRPC-Web requests
+// to the gRPC-Web content type of the request. The Content-Length header is removed, because
+// webBody appends the trailers to the response body.
+function webHeaders(r) {
+	if (!isWebRequest(r)) {
+		return;
+	}
+
+	r.headersOut['Content-Type'] = r.headersIn['Content-Type'];
+	delete r.headersOut['Content-Length'];
+}
+
+// webBody is a body filter that appends the trailers of the gRPC response to the body of the responses
+// to gRPC-Web requests. The trailers are only present if the backend responded with a body. Otherwise, the
+// status is already in the response headers (Trailers-Only response), which is valid for gRPC-Web as well.
+function webBody(r, data, flags) {
+	if (!isWebRequest(r) || !flags.last) {
+		r.sendBuffer(data, flags);
+		return;
+	}
+
+	const status = r.variables.upstream_trailer_grpc_status;
+	if (!status) {
+		r.sendBuffer(data, flags);
+		return;
+	}
+
+	r.sendBuffer(data, { last: false });
+	r.sendBuffer(createTrailers(status, r.variables.upstream_trailer_grpc_message), flags);
+}
+
+// isWebRequest returns whether the request is a gRPC-Web request in the binary format.
+// The text format (application/grpc-web-text) is not supported.
+function isWebRequest(r) {
+	return GRPC_WEB_CONTENT_TYPES.includes(r.headersIn['Content-Type']);
+}
+
+// createTrailers creates the gRPC-Web message that holds the trailers of the response.
+function createTrailers(status, message) {
+	let trailers = `grpc-status:${status}\r\n`;
+	if (message) {
+		trailers += `grpc-message:${message}\r\n`;
+	}
+
+	return createMessage(TRAILERS_FLAG, Buffer.from(trailers));
+}
+
+// createMessage prefixes the payload with the flag and the length of the payload.
+function createMessage(flag, payload) {
+	const prefix = Buffer.alloc(MESSAGE_PREFIX_LENGTH);
+	prefix.writeUInt8(flag, 0);
+	prefix.writeUInt32BE(payload.length, 1);
+
+	return Buffer.concat([prefix, payload]);
+}
+
+export default {
+	healthCheck,
+	webHeaders,
+	webBody,
+	createTrailers,
+	createMessage,
+	isWebRequest,
+};
@@ -0,0 +1,102 @@
+import fs from 'fs';
+
+const STATE_FILE = '/etc/nginx/conf.d/dynamic-upstreams.json';
+const ZONE_NAME = 'ngf_dynamic_upstreams';
+const UPSTREAM_KEY = 'ngf_upstream';
+const STATE_MTIME_KEY = '__state_mtime';
+const STATE_CHECKED_KEY = '__state_checked';
+const SERVER_SEPARATOR = ',';
+// REFRESH_INTERVAL_MS is how often the state file is checked for changes. Checking the file on every request
+// would add a stat system call to every request.
+const REFRESH_INTERVAL_MS = 1000;
+
+// peer returns the server to proxy the request to. The upstream is taken from the ngf_upstream variable.
+// If the upstream is not dynamic, it returns the upstream name, so that NGINX proxies the request
+// using the upstream block.
+//
+// Because the request is proxied to a single server, the load balancing method, the keepalive connections and
+// the retries (proxy_next_upstream) of the upstream block don't apply. The servers are picked randomly.
+function peer(r) {
+	const upstream = r.variables[UPSTREAM_KEY];
+	if (!upstream) {
+		r.error(`cannot select a server; the ${UPSTREAM_KEY} variable is not set`);
+		return '';
+	}
+
+	const dict = ngx.shared[ZONE_NAME];
+
+	try {
+		refreshServers(dict, fs, Date.now());
+	} catch (e) {
+		// The previously loaded servers are used until the state file can be loaded.
+		r.warn(`cannot load dynamic upstream servers from ${STATE_FILE}: ${e.message}`);
+	}
+
+	return selectServer(dict, upstream, Math.random);
+}
+
+// refreshServers loads the servers from the state file into the shared dictionary if the file changed
+// since it was last loaded. The file is checked at most once per REFRESH_INTERVAL_MS across all workers.
+// NGINX Gateway Fabric replaces the file when the servers change, so the file can be temporarily missing
+// or incomplete. In that case, the previously loaded servers are kept.
+// The backup servers of an upstream are only loaded if the upstream has no other servers.
+function refreshServers(dict, fileSystem, now) {
+	const checked = Number(dict.get(STATE_CHECKED_KEY));
+	if (checked && now - checked < REFRESH_INTERVAL_MS) {
+		return;
+	}
+
+	dict.set(STATE_CHECKED_KEY, String(now));
+
+	let mtime;
+	try {
+		mtime = String(fileSystem.statSync(STATE_FILE).mtimeMs);
+	} catch (e) {
+		return;
+	}
+
+	if (dict.get(STATE_MTIME_KEY) === mtime) {
+		return;
+	}
+
+	const state = JSON.parse(fileSystem.readFileSync(STATE_FILE, 'utf8'));
+
+	Object.keys(state).forEach((upstream) => {
+		const upstreamState = state[upstream];
+		const servers =
+			upstreamState.servers.length > 0 ? upstreamState.servers : upstreamState.backups || [];
+		dict.set(upstream, servers.join(SERVER_SEPARATOR));
+	});
+
+	dict.keys().forEach((key) => {
+		if (key !== STATE_MTIME_KEY && key !== STATE_CHECKED_KEY && !state[key]) {
+			dict.delete(key);
+		}
+	});
+
+	dict.set(STATE_MTIME_KEY, mtime);
+}
+
+// selectServer picks a random server of the upstream. If the upstream is not dynamic, it returns the upstream.
+function selectServer(dict, upstream, random) {
+	const servers = dict.get(upstream);
+	if (!servers) {
+		return upstream;
+	}
+
+	const serverList = servers.split(SERVER_SEPARATOR);
+
+	return serverList[Math.floor(random() * serverList.length)];
+}
+
+export default {
+	peer,
+	refreshServers,
+	selectServer,
+	REFRESH_INTERVAL_MS,
+	STATE_FILE,
+	STATE_CHECKED_KEY,
+	STATE_MTIME_KEY,
+	UPSTREAM_KEY,
+	ZONE_NAME,
+};
@@ -0,0 +1,130 @@
+import { default as grpc } from '../src/grpc.js';
+import { describe, expect, it } from 'vitest';
+
+// Creates a NGINX HTTP Request Object for testing.
+// See documentation for all properties available: http://nginx.org/en/docs/njs/reference.html
+function createRequest({ contentType = '', variables = {} } = {}) {
+	let r = {
+		// Test mocks
+		return(statusCode, body) {
+			r.testReturned = statusCode;
+			r.testBody = body;
+		},
+		sendBuffer(data, flags) {
+			r.testSent.push({ data, last: flags.last });
+		},
+		headersIn: {},
+		headersOut: { 'Content-Type': 'application/grpc', 'Content-Length': '7' },
+		variables,
+		testSent: [],
+	};
+
+	if (contentType) {
+		r.headersIn['Content-Type'] = contentType;
+	}
+
+	return r;
+}
+
+describe('healthCheck', () => {
+	it('responds with the SERVING status', () => {
+		const r = createRequest({ contentType: 'application/grpc' });
+
+		grpc.healthCheck(r);
+
+		expect(r.testReturned).to.equal(200);
+		expect(r.headersOut['Content-Type']).to.equal('application/grpc');
+		expect([...r.testBody]).to.deep.equal([0x00, 0x00, 0x00, 0x00, 0x02, 0x08, 0x01]);
+	});
+});
+
+describe('isWebRequest', () => {
+	const tests = [
+		{ contentType: 'application/grpc-web', expected: true },
+		{ contentType: 'application/grpc-web+proto', expected: true },
+		{ contentType: 'application/grpc-web-text', expected: false },
+		{ contentType: 'application/grpc', expected: false },
+		{ contentType: '', expected: false },
+	];
+
+	tests.forEach((test) => {
+		it(`returns ${test.expected} for the content type '${test.contentType}'`, () => {
+			expect(grpc.isWebRequest(createRequest({ contentType: test.contentType }))).to.equal(test.expected);
+		});
+	});
+});
+
+describe('webHeaders', () => {
+	it('sets the gRPC-Web content type for gRPC-Web requests', () => {
+		const r = createRequest({ contentType: 'application/grpc-web+proto' });
+
+		grpc.webHeaders(r);
+
+		expect(r.headersOut['Content-Type']).to.equal('application/grpc-web+proto');
+		expect(r.headersOut['Content-Length']).to.be.undefined;
+	});
+
+	it('does not change the headers of gRPC requests', () => {
+		const r = createRequest({ contentType: 'application/grpc' });
+
+		grpc.webHeaders(r);
+
+		expect(r.headersOut).to.deep.equal({ 'Content-Type': 'application/grpc', 'Content-Length': '7' });
+	});
+});
+
+describe('webBody', () => {
+	const data = Buffer.from([0x00, 0x00, 0x00, 0x00, 0x02, 0x08, 0x01]);
+
+	it('appends the trailers to the response of gRPC-Web requests', () => {
+		const r = createRequest({
+			contentType: 'application/grpc-web',
+			variables: { upstream_trailer_grpc_status: '0' },
+		});
+
+		grpc.webBody(r, data, { last: false });
+		grpc.webBody(r, Buffer.alloc(0), { last: true });
+
+		expect(r.testSent).to.deep.equal([
+			{ data, last: false },
+			{ data: Buffer.alloc(0), last: false },
+			{ data: grpc.createTrailers('0'), last: true },
+		]);
+	});
+
+	it('does not append the trailers if the status is in the response headers', () => {
+		const r = createRequest({ contentType: 'application/grpc-web' });
+
+		grpc.webBody(r, data, { last: true });
+
+		expect(r.testSent).to.deep.equal([{ data, last: true }]);
+	});
+
+	it('does not change the response of gRPC requests', () => {
+		const r = createRequest({
+			contentType: 'application/grpc',
+			variables: { upstream_trailer_grpc_status: '0' },
+		});
+
+		grpc.webBody(r, data, { last: true });
+
+		expect(r.testSent).to.deep.equal([{ data, last: true }]);
+	});
+});
+
+describe('createTrailers', () => {
+	it('encodes the status', () => {
+		const trailers = grpc.createTrailers('0');
+
+		expect(trailers[0]).to.equal(0x80);
+		expect(trailers.readUInt32BE(1)).to.equal(15);
+		expect(trailers.subarray(5).toString()).to.equal('grpc-status:0\r\n');
+	});
+
+	it('encodes the status and the message', () => {
+		const trailers = grpc.createTrailers('14', 'unavailable');
+
+		expect(trailers.readUInt32BE(1)).to.equal(42);
+		expect(trailers.subarray(5).toString()).to.equal('grpc-status:14\r\ngrpc-message:unavailable\r\n');
+	});
+});
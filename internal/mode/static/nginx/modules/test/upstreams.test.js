@@ -0,0 +1,174 @@
+import { default as ups } from '../src/upstreams.js';
+import { describe, expect, it } from 'vitest';
+
+// Creates a mock of an njs shared dictionary.
+// See documentation for all methods available: https://nginx.org/en/docs/njs/reference.html#dict
+function createDict(entries = {}) {
+	const data = new Map(Object.entries(entries));
+
+	return {
+		get(key) {
+			return data.get(key);
+		},
+		set(key, value) {
+			data.set(key, value);
+		},
+		delete(key) {
+			data.delete(key);
+		},
+		keys() {
+			return Array.from(data.keys());
+		},
+		entries() {
+			return Object.fromEntries(data);
+		},
+	};
+}
+
+// Creates a mock of the njs fs module with the state file.
+function createFileSystem({ mtimeMs = 1, content = '', missing = false } = {}) {
+	return {
+		reads: 0,
+		statSync(path) {
+			expect(path).to.equal(ups.STATE_FILE);
+			if (missing) {
+				throw Error('no such file or directory');
+			}
+			return { mtimeMs };
+		},
+		readFileSync(path) {
+			expect(path).to.equal(ups.STATE_FILE);
+			this.reads++;
+			return content;
+		},
+	};
+}
+
+describe('refreshServers', () => {
+	const state = JSON.stringify({
+		upstream1: { servers: ['10.0.0.1:80', '10.0.0.2:80'], backups: ['10.0.0.3:80'] },
+		upstream2: { servers: ['[::1]:8080'] },
+		upstream3: { servers: [], backups: ['10.0.0.4:80', '10.0.0.5:80'] },
+	});
+
+	it('loads the servers from the state file', () => {
+		const dict = createDict();
+
+		ups.refreshServers(dict, createFileSystem({ mtimeMs: 5, content: state }), 1000);
+
+		expect(dict.entries()).to.deep.equal({
+			upstream1: '10.0.0.1:80,10.0.0.2:80',
+			upstream2: '[::1]:8080',
+			upstream3: '10.0.0.4:80,10.0.0.5:80',
+			[ups.STATE_MTIME_KEY]: '5',
+			[ups.STATE_CHECKED_KEY]: '1000',
+		});
+	});
+
+	it('does not read the state file if it has not changed', () => {
+		const dict = createDict({ upstream1: '10.0.0.1:80', [ups.STATE_MTIME_KEY]: '5' });
+		const fileSystem = createFileSystem({ mtimeMs: 5, content: state });
+
+		ups.refreshServers(dict, fileSystem, 1000);
+
+		expect(fileSystem.reads).to.equal(0);
+		expect(dict.get('upstream1')).to.equal('10.0.0.1:80');
+	});
+
+	it('does not check the state file again within the refresh interval', () => {
+		const dict = createDict({ upstream1: '10.0.0.1:80', [ups.STATE_CHECKED_KEY]: '1000' });
+		const fileSystem = createFileSystem({ mtimeMs: 6, content: state });
+		fileSystem.statSync = () => {
+			throw Error('unexpected stat');
+		};
+
+		ups.refreshServers(dict, fileSystem, 1000 + ups.REFRESH_INTERVAL_MS - 1);
+
+		expect(fileSystem.reads).to.equal(0);
+		expect(dict.get('upstream1')).to.equal('10.0.0.1:80');
+	});
+
+	it('checks the state file again after the refresh interval', () => {
+		const dict = createDict({ upstream1: '10.0.0.1:80', [ups.STATE_CHECKED_KEY]: '1000' });
+		const now = 1000 + ups.REFRESH_INTERVAL_MS;
+
+		ups.refreshServers(dict, createFileSystem({ mtimeMs: 6, content: state }), now);
+
+		expect(dict.get('upstream1')).to.equal('10.0.0.1:80,10.0.0.2:80');
+		expect(dict.get(ups.STATE_CHECKED_KEY)).to.equal(String(now));
+	});
+
+	it('removes the upstreams that are not in the state file', () => {
+		const dict = createDict({ upstream1: '10.0.0.1:80', removed: '10.0.0.3:80' });
+
+		ups.refreshServers(dict, createFileSystem({ mtimeMs: 6, content: state }), 1000);
+
+		expect(dict.get('removed')).to.be.undefined;
+		expect(dict.get('upstream1')).to.equal('10.0.0.1:80,10.0.0.2:80');
+	});
+
+	it('keeps the servers if the state file is missing', () => {
+		const dict = createDict({ upstream1: '10.0.0.1:80', [ups.STATE_MTIME_KEY]: '5' });
+
+		ups.refreshServers(dict, createFileSystem({ missing: true }), 1000);
+
+		expect(dict.entries()).to.deep.equal({
+			upstream1: '10.0.0.1:80',
+			[ups.STATE_MTIME_KEY]: '5',
+			[ups.STATE_CHECKED_KEY]: '1000',
+		});
+	});
+
+	it('throws and keeps the servers if the state file is incomplete', () => {
+		const dict = createDict({ upstream1: '10.0.0.1:80', [ups.STATE_MTIME_KEY]: '5' });
+
+		expect(() =>
+			ups.refreshServers(
+				dict,
+				createFileSystem({ mtimeMs: 6, content: '{"upstream1": {"servers": ["10.' }),
+				1000,
+			),
+		).to.throw();
+
+		expect(dict.entries()).to.deep.equal({
+			upstream1: '10.0.0.1:80',
+			[ups.STATE_MTIME_KEY]: '5',
+			[ups.STATE_CHECKED_KEY]: '1000',
+		});
+	});
+});
+
+describe('selectServer', () => {
+	const dict = createDict({ upstream1: '10.0.0.1:80,10.0.0.2:80,10.0.0.3:80' });
+
+	const tests = [
+		{ random: 0, expected: '10.0.0.1:80' },
+		{ random: 0.5, expected: '10.0.0.2:80' },
+		{ random: 0.99, expected: '10.0.0.3:80' },
+	];
+
+	tests.forEach((test) => {
+		it(`selects ${test.expected} for random value ${test.random}`, () => {
+			expect(ups.selectServer(dict, 'upstream1', () => test.random)).to.equal(test.expected);
+		});
+	});
+
+	it('returns the upstream if it is not dynamic', () => {
+		expect(ups.selectServer(dict, 'static-upstream', () => 0)).to.equal('static-upstream');
+	});
+});
+
+describe('peer', () => {
+	it('returns an empty string if the upstream variable is not set', () => {
+		let errorMsg;
+		const r = {
+			variables: {},
+			error(msg) {
+				errorMsg = msg;
+			},
+		};
+
+		expect(ups.peer(r)).to.equal('');
+		expect(errorMsg).to.contain(ups.UPSTREAM_KEY);
+	});
+});
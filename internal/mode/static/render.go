@@ -0,0 +1,380 @@
+package static
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1alpha3 "sigs.k8s.io/gateway-api/apis/v1alpha3"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	frameworkStatus "github.com/nginx/nginx-gateway-fabric/internal/framework/status"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/config"
+	ngxcfg "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies"
+	ngxvalidation "github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/file"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/status"
+)
+
+// StatusReportFileName is the name of the file with the status report written by Render.
+const StatusReportFileName = "status.yaml"
+
+// RenderConfig holds the configuration for rendering the NGINX configuration without a cluster.
+type RenderConfig struct {
+	// Logger is the logger.
+	Logger logr.Logger
+	// GatewayCtlrName is the name of the Gateway controller.
+	GatewayCtlrName string
+	// GatewayClassName is the name of the GatewayClass resource.
+	GatewayClassName string
+	// OutputDir is the directory where the NGINX configuration files and the status report are written.
+	// The NGINX configuration files are written under their absolute paths relative to OutputDir.
+	OutputDir string
+	// InputFiles are the YAML files with the Kubernetes resources.
+	InputFiles []string
+	// Plus indicates whether NGINX Plus is being used.
+	Plus bool
+}
+
+// renderedStatus is the status of a resource in the status report.
+type renderedStatus struct {
+	Status     interface{} `json:"status"`
+	APIVersion string      `json:"apiVersion"`
+	Kind       string      `json:"kind"`
+	Namespace  string      `json:"namespace,omitempty"`
+	Name       string      `json:"name"`
+}
+
+// Render reads Kubernetes resources from YAML files and processes them the same way the control plane does:
+// it builds the graph, the dataplane configuration and the NGINX configuration files. It writes the NGINX
+// configuration files and a report with the statuses the control plane would set on the resources
+// to the output directory.
+//
+// EndpointSlices from the input files are used to resolve the endpoints of Services.
+// Timestamps are not set in the status report, so that the output is the same for the same input.
+func Render(ctx context.Context, cfg RenderConfig) error {
+	objects, err := readObjects(cfg.InputFiles)
+	if err != nil {
+		return err
+	}
+
+	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
+
+	state, endpointSlices := buildClusterState(cfg.Logger, objects, mustExtractGVK)
+
+	genericValidator := ngxvalidation.GenericValidator{}
+
+	g := graph.BuildGraph(
+		state,
+		cfg.GatewayCtlrName,
+		cfg.GatewayClassName,
+		nil,
+		validation.Validators{
+			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			GenericValidator:    genericValidator,
+			PolicyValidator:     createPolicyManager(mustExtractGVK, genericValidator),
+		},
+		nil,
+		cfg.Plus,
+		nil,
+	)
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(endpointSlices...).
+		WithIndex(&discoveryV1.EndpointSlice{}, index.KubernetesServiceNameIndexField, index.ServiceNameIndexFunc).
+		Build()
+
+	conf := dataplane.BuildConfiguration(ctx, g, resolver.NewServiceResolverImpl(k8sClient, ""), 1)
+
+	generator := ngxcfg.NewGeneratorImpl(cfg.Plus, false, &config.UsageReportConfig{}, cfg.Logger.WithName("generator"))
+
+	if err := writeRenderedFiles(cfg.OutputDir, generator.Generate(conf)); err != nil {
+		return err
+	}
+
+	report, err := buildStatusReport(g, objects, cfg.GatewayCtlrName, mustExtractGVK)
+	if err != nil {
+		return err
+	}
+
+	reportPath := filepath.Join(cfg.OutputDir, StatusReportFileName)
+	if err := os.WriteFile(reportPath, report, 0o644); err != nil { //nolint:gosec // report is not sensitive
+		return fmt.Errorf("failed to write status report %q: %w", reportPath, err)
+	}
+
+	return nil
+}
+
+func readObjects(paths []string) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var objects []client.Object
+
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file %q: %w", path, err)
+		}
+
+		reader := k8syaml.NewYAMLReader(bufio.NewReader(f))
+
+		for {
+			doc, readErr := reader.Read()
+			if errors.Is(readErr, io.EOF) {
+				break
+			}
+			if readErr != nil {
+				f.Close()
+				return nil, fmt.Errorf("failed to read file %q: %w", path, readErr)
+			}
+
+			if len(bytes.TrimSpace(doc)) == 0 {
+				continue
+			}
+
+			obj, _, decodeErr := decoder.Decode(doc, nil, nil)
+			if decodeErr != nil {
+				f.Close()
+				return nil, fmt.Errorf("failed to decode resource in file %q: %w", path, decodeErr)
+			}
+
+			clientObj, ok := obj.(client.Object)
+			if !ok {
+				f.Close()
+				return nil, fmt.Errorf("unexpected resource type %T in file %q", obj, path)
+			}
+
+			objects = append(objects, clientObj)
+		}
+
+		f.Close()
+	}
+
+	return objects, nil
+}
+
+// buildClusterState builds the ClusterState from the objects. It also returns the EndpointSlices among the objects.
+// Objects of the types that the control plane doesn't process are ignored.
+func buildClusterState(
+	logger logr.Logger,
+	objects []client.Object,
+	mustExtractGVK kinds.MustExtractGVK,
+) (graph.ClusterState, []client.Object) {
+	state := graph.ClusterState{
+		GatewayClasses:     make(map[types.NamespacedName]*gatewayv1.GatewayClass),
+		Gateways:           make(map[types.NamespacedName]*gatewayv1.Gateway),
+		HTTPRoutes:         make(map[types.NamespacedName]*gatewayv1.HTTPRoute),
+		Services:           make(map[types.NamespacedName]*apiv1.Service),
+		Namespaces:         make(map[types.NamespacedName]*apiv1.Namespace),
+		ReferenceGrants:    make(map[types.NamespacedName]*gatewayv1beta1.ReferenceGrant),
+		Secrets:            make(map[types.NamespacedName]*apiv1.Secret),
+		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		BackendTLSPolicies: make(map[types.NamespacedName]*gatewayv1alpha3.BackendTLSPolicy),
+		BackendLBPolicies:  make(map[types.NamespacedName]*gatewayv1alpha2.BackendLBPolicy),
+		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NginxProxies:       make(map[types.NamespacedName]*ngfAPIv1alpha1.NginxProxy),
+		GRPCRoutes:         make(map[types.NamespacedName]*gatewayv1.GRPCRoute),
+		TLSRoutes:          make(map[types.NamespacedName]*gatewayv1alpha2.TLSRoute),
+		NGFPolicies:        make(map[graph.PolicyKey]policies.Policy),
+		SnippetsFilters:    make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
+	}
+
+	var endpointSlices []client.Object
+
+	for _, obj := range objects {
+		nsname := client.ObjectKeyFromObject(obj)
+
+		switch o := obj.(type) {
+		case *gatewayv1.GatewayClass:
+			state.GatewayClasses[nsname] = o
+		case *gatewayv1.Gateway:
+			state.Gateways[nsname] = o
+		case *gatewayv1.HTTPRoute:
+			state.HTTPRoutes[nsname] = o
+		case *gatewayv1.GRPCRoute:
+			state.GRPCRoutes[nsname] = o
+		case *gatewayv1alpha2.TLSRoute:
+			state.TLSRoutes[nsname] = o
+		case *gatewayv1beta1.ReferenceGrant:
+			state.ReferenceGrants[nsname] = o
+		case *gatewayv1alpha3.BackendTLSPolicy:
+			state.BackendTLSPolicies[nsname] = o
+		case *gatewayv1alpha2.BackendLBPolicy:
+			state.BackendLBPolicies[nsname] = o
+		case *apiv1.Service:
+			state.Services[nsname] = o
+		case *apiv1.Namespace:
+			state.Namespaces[nsname] = o
+		case *apiv1.Secret:
+			state.Secrets[nsname] = o
+		case *apiv1.ConfigMap:
+			state.ConfigMaps[nsname] = o
+		case *ngfAPIv1alpha1.NginxProxy:
+			state.NginxProxies[nsname] = o
+		case *ngfAPIv1alpha1.SnippetsFilter:
+			state.SnippetsFilters[nsname] = o
+		case *apiext.CustomResourceDefinition:
+			state.CRDMetadata[nsname] = &metav1.PartialObjectMetadata{
+				TypeMeta:   o.TypeMeta,
+				ObjectMeta: o.ObjectMeta,
+			}
+		case *discoveryV1.EndpointSlice:
+			endpointSlices = append(endpointSlices, o)
+		case policies.Policy:
+			key := graph.PolicyKey{
+				NsName: nsname,
+				GVK:    mustExtractGVK(o),
+			}
+			state.NGFPolicies[key] = o
+		default:
+			logger.Info(
+				"Ignoring resource of unsupported type",
+				"type", fmt.Sprintf("%T", obj),
+				"namespace", nsname.Namespace,
+				"name", nsname.Name,
+			)
+		}
+	}
+
+	return state, endpointSlices
+}
+
+func writeRenderedFiles(outputDir string, files []file.File) error {
+	osFileMgr := file.NewStdLibOSFileManager()
+
+	for _, f := range files {
+		f.Path = filepath.Join(outputDir, f.Path)
+
+		if err := os.MkdirAll(filepath.Dir(f.Path), 0o755); err != nil { //nolint:gosec // directories are not sensitive
+			return fmt.Errorf("failed to create directory for file %q: %w", f.Path, err)
+		}
+
+		if err := file.WriteFile(osFileMgr, f); err != nil {
+			return fmt.Errorf("failed to write file %q: %w", f.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// buildStatusReport builds a YAML report with the statuses the control plane would set on the objects.
+func buildStatusReport(
+	g *graph.Graph,
+	objects []client.Object,
+	gatewayCtlrName string,
+	mustExtractGVK kinds.MustExtractGVK,
+) ([]byte, error) {
+	type objectKey struct {
+		objType string
+		nsname  types.NamespacedName
+	}
+
+	objectsByKey := make(map[objectKey]client.Object, len(objects))
+	for _, obj := range objects {
+		objectsByKey[objectKey{objType: fmt.Sprintf("%T", obj), nsname: client.ObjectKeyFromObject(obj)}] = obj
+	}
+
+	// the zero time is used to make the report deterministic
+	var transitionTime metav1.Time
+
+	var reqs []frameworkStatus.UpdateRequest
+	reqs = append(reqs, status.PrepareGatewayClassRequests(g.GatewayClass, g.IgnoredGatewayClasses, transitionTime)...)
+	reqs = append(reqs, status.PrepareGatewayRequests(
+		g.Gateways,
+		transitionTime,
+		nil,
+		status.NginxReloadResult{},
+	)...)
+	reqs = append(reqs, status.PrepareRouteRequests(
+		g.L4Routes,
+		g.Routes,
+		transitionTime,
+		status.NginxReloadResult{},
+		gatewayCtlrName,
+	)...)
+	reqs = append(reqs, status.PrepareBackendTLSPolicyRequests(
+		g.BackendTLSPolicies,
+		transitionTime,
+		gatewayCtlrName,
+	)...)
+	reqs = append(reqs, status.PrepareNGFPolicyRequests(g.NGFPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareBackendLBPolicyRequests(g.BackendLBPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareSnippetsFilterRequests(g.SnippetsFilters, transitionTime, gatewayCtlrName)...)
+
+	statuses := make([]renderedStatus, 0, len(reqs))
+
+	for _, req := range reqs {
+		obj, exists := objectsByKey[objectKey{objType: fmt.Sprintf("%T", req.ResourceType), nsname: req.NsName}]
+		if !exists {
+			continue
+		}
+
+		objCopy, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unexpected resource type %T", obj)
+		}
+
+		req.Setter(objCopy)
+
+		unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(objCopy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %T %s: %w", objCopy, req.NsName, err)
+		}
+
+		gvk := mustExtractGVK(objCopy)
+
+		statuses = append(statuses, renderedStatus{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Namespace:  req.NsName.Namespace,
+			Name:       req.NsName.Name,
+			Status:     unstructuredObj["status"],
+		})
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		if statuses[i].Kind != statuses[j].Kind {
+			return statuses[i].Kind < statuses[j].Kind
+		}
+		if statuses[i].Namespace != statuses[j].Namespace {
+			return statuses[i].Namespace < statuses[j].Namespace
+		}
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	report, err := yaml.Marshal(statuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal status report: %w", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,162 @@
+package static
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const renderTestResources = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: gateway.nginx.org/nginx-gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: default
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /coffee
+    backendRefs:
+    - name: coffee
+      port: 80
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: tea
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /tea
+    backendRefs:
+    - name: tea
+      port: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: coffee
+  namespace: default
+spec:
+  ports:
+  - port: 80
+    targetPort: 8080
+---
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: coffee-abc
+  namespace: default
+  labels:
+    kubernetes.io/service-name: coffee
+addressType: IPv4
+ports:
+- name: ""
+  port: 8080
+endpoints:
+- addresses:
+  - 10.0.0.1
+  conditions:
+    ready: true
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coffee
+  namespace: default
+`
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	inputFile := filepath.Join(dir, "resources.yaml")
+	g.Expect(os.WriteFile(inputFile, []byte(renderTestResources), 0o600)).To(Succeed())
+
+	outputDir := filepath.Join(dir, "output")
+	g.Expect(os.Mkdir(outputDir, 0o700)).To(Succeed())
+
+	err := Render(context.Background(), RenderConfig{
+		Logger:           zap.New(),
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+		InputFiles:       []string{inputFile},
+		OutputDir:        outputDir,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	httpConf, err := os.ReadFile(filepath.Join(outputDir, "etc/nginx/conf.d/http.conf"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(httpConf)).To(ContainSubstring("server_name cafe.example.com;"))
+	g.Expect(string(httpConf)).To(ContainSubstring("location /coffee/"))
+	g.Expect(string(httpConf)).To(ContainSubstring("server 10.0.0.1:8080;"))
+
+	report, err := os.ReadFile(filepath.Join(outputDir, StatusReportFileName))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(report)).To(ContainSubstring("kind: GatewayClass"))
+	g.Expect(string(report)).To(ContainSubstring("kind: Gateway\n"))
+	g.Expect(string(report)).To(ContainSubstring("name: coffee"))
+	// the tea Service doesn't exist
+	g.Expect(string(report)).To(ContainSubstring("reason: BackendNotFound"))
+	g.Expect(string(report)).ToNot(ContainSubstring("kind: Deployment"))
+}
+
+func TestRenderInvalidInput(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	inputFile := filepath.Join(dir, "resources.yaml")
+	g.Expect(os.WriteFile(inputFile, []byte("apiVersion: v1\nkind: Unknown\nmetadata:\n  name: test\n"), 0o600)).
+		To(Succeed())
+
+	cfg := RenderConfig{
+		Logger:           zap.New(),
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+		InputFiles:       []string{inputFile},
+		OutputDir:        dir,
+	}
+
+	g.Expect(Render(context.Background(), cfg)).To(MatchError(ContainSubstring("failed to decode resource")))
+
+	cfg.InputFiles = []string{filepath.Join(dir, "missing.yaml")}
+	g.Expect(Render(context.Background(), cfg)).To(MatchError(ContainSubstring("failed to open file")))
+}
@@ -79,12 +79,19 @@ type ChangeProcessorConfig struct {
 	GatewayCtlrName string
 	// GatewayClassName is the name of the GatewayClass resource.
 	GatewayClassName string
+	// Plus indicates whether NGINX Plus is being used.
+	Plus bool
 }
 
 // ChangeProcessorImpl is an implementation of ChangeProcessor.
 type ChangeProcessorImpl struct {
 	latestGraph *graph.Graph
 
+	// routeCache memoizes the per-Route stages of the graph build across batches, so that only the Routes
+	// affected by the changes of a batch are rebuilt.
+	// The rest of the graph and the dataplane configuration are still rebuilt on every batch.
+	routeCache *graph.RouteCache
+
 	// clusterState holds the current state of the cluster
 	clusterState graph.ClusterState
 	// updater acts upon the cluster state.
@@ -108,17 +115,20 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		Secrets:            make(map[types.NamespacedName]*apiv1.Secret),
 		CRDMetadata:        make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
 		BackendTLSPolicies: make(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy),
+		BackendLBPolicies:  make(map[types.NamespacedName]*v1alpha2.BackendLBPolicy),
 		ConfigMaps:         make(map[types.NamespacedName]*apiv1.ConfigMap),
 		NginxProxies:       make(map[types.NamespacedName]*ngfAPIv1alpha1.NginxProxy),
 		GRPCRoutes:         make(map[types.NamespacedName]*v1.GRPCRoute),
 		TLSRoutes:          make(map[types.NamespacedName]*v1alpha2.TLSRoute),
 		NGFPolicies:        make(map[graph.PolicyKey]policies.Policy),
 		SnippetsFilters:    make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
+		Canaries:           make(map[types.NamespacedName]*ngfAPIv1alpha1.Canary),
 	}
 
 	processor := &ChangeProcessorImpl{
 		cfg:          cfg,
 		clusterState: clusterStore,
+		routeCache:   graph.NewRouteCache(),
 	}
 
 	isReferenced := func(obj ngftypes.ObjectType, nsname types.NamespacedName) bool {
@@ -167,6 +177,11 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.BackendTLSPolicies),
 				predicate: nil,
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&v1alpha2.BackendLBPolicy{}),
+				store:     newObjectStoreMapAdapter(clusterStore.BackendLBPolicies),
+				predicate: nil,
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&v1.GRPCRoute{}),
 				store:     newObjectStoreMapAdapter(clusterStore.GRPCRoutes),
@@ -222,6 +237,26 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     commonPolicyObjectStore,
 				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ProxyCachePolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.CompressionPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ErrorPagePolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.ProxySettingsPolicy{}),
+				store:     commonPolicyObjectStore,
+				predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+			},
 			{
 				gvk:       cfg.MustExtractGVK(&v1alpha2.TLSRoute{}),
 				store:     newObjectStoreMapAdapter(clusterStore.TLSRoutes),
@@ -232,6 +267,11 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:     newObjectStoreMapAdapter(clusterStore.SnippetsFilters),
 				predicate: nil, // we always want to write status to SnippetsFilters so we don't filter them out
 			},
+			{
+				gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.Canary{}),
+				store:     newObjectStoreMapAdapter(clusterStore.Canaries),
+				predicate: nil,
+			},
 		},
 	)
 
@@ -286,6 +326,8 @@ func (c *ChangeProcessorImpl) Process() (ChangeType, *graph.Graph) {
 		c.cfg.PlusSecrets,
 		c.cfg.Validators,
 		c.cfg.ProtectedPorts,
+		c.cfg.Plus,
+		c.routeCache,
 	)
 
 	return changeType, c.latestGraph
@@ -1,6 +1,11 @@
 package state_test
 
 import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
@@ -29,7 +34,9 @@ import (
 	ngftypes "github.com/nginx/nginx-gateway-fabric/internal/framework/types"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state"
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/resolver/resolverfakes"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
 	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
 )
@@ -438,6 +445,7 @@ var _ = Describe("ChangeProcessor", func() {
 				httpRouteKey1, httpRouteKey2, grpcRouteKey1, grpcRouteKey2 graph.RouteKey
 				trKey1, trKey2                                             graph.L4RouteKey
 				refSvc, refGRPCSvc, refTLSSvc                              types.NamespacedName
+				gw1NsName, gw2NsName                                       types.NamespacedName
 			)
 
 			processAndValidateGraph := func(expGraph *graph.Graph) {
@@ -624,6 +632,9 @@ var _ = Describe("ChangeProcessor", func() {
 					createTLSListener(tlsListenerName),
 				)
 
+				gw1NsName = client.ObjectKeyFromObject(gw1)
+				gw2NsName = client.ObjectKeyFromObject(gw2)
+
 				gatewayAPICRD = &metav1.PartialObjectMetadata{
 					TypeMeta: metav1.TypeMeta{
 						Kind:       "CustomResourceDefinition",
@@ -845,9 +856,12 @@ var _ = Describe("ChangeProcessor", func() {
 					},
 					Spec: graph.L4RouteSpec{
 						Hostnames: tr1.Spec.Hostnames,
-						BackendRef: graph.BackendRef{
-							SvcNsName: refTLSSvc,
-							Valid:     false,
+						BackendRefs: []graph.BackendRef{
+							{
+								SvcNsName: refTLSSvc,
+								Weight:    1,
+								Valid:     false,
+							},
 						},
 					},
 					Valid:      true,
@@ -873,9 +887,12 @@ var _ = Describe("ChangeProcessor", func() {
 					},
 					Spec: graph.L4RouteSpec{
 						Hostnames: tr2.Spec.Hostnames,
-						BackendRef: graph.BackendRef{
-							SvcNsName: refTLSSvc,
-							Valid:     false,
+						BackendRefs: []graph.BackendRef{
+							{
+								SvcNsName: refTLSSvc,
+								Weight:    1,
+								Valid:     false,
+							},
 						},
 					},
 					Valid:      true,
@@ -894,59 +911,137 @@ var _ = Describe("ChangeProcessor", func() {
 						Source: gc,
 						Valid:  true,
 					},
-					Gateway: &graph.Gateway{
-						Source: gw1,
-						Listeners: []*graph.Listener{
-							{
-								Name:       httpListenerName,
-								Source:     gw1.Spec.Listeners[0],
-								Valid:      true,
-								Attachable: true,
-								Routes:     map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
-								L4Routes:   map[graph.L4RouteKey]*graph.L4Route{},
-								SupportedKinds: []v1.RouteGroupKind{
-									{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
-									{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+					Gateways: map[types.NamespacedName]*graph.Gateway{
+						gw1NsName: {
+							Source: gw1,
+							Listeners: []*graph.Listener{
+								{
+									Name:       httpListenerName,
+									Source:     gw1.Spec.Listeners[0],
+									Valid:      true,
+									Attachable: true,
+									Routes:     map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
+									L4Routes:   map[graph.L4RouteKey]*graph.L4Route{},
+									SupportedKinds: []v1.RouteGroupKind{
+										{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+										{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+									},
 								},
-							},
-							{
-								Name:           httpsListenerName,
-								Source:         gw1.Spec.Listeners[1],
-								Valid:          true,
-								Attachable:     true,
-								Routes:         map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
-								L4Routes:       map[graph.L4RouteKey]*graph.L4Route{},
-								ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(diffNsTLSSecret)),
-								SupportedKinds: []v1.RouteGroupKind{
-									{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
-									{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+								{
+									Name:           httpsListenerName,
+									Source:         gw1.Spec.Listeners[1],
+									Valid:          true,
+									Attachable:     true,
+									Routes:         map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
+									L4Routes:       map[graph.L4RouteKey]*graph.L4Route{},
+									ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(diffNsTLSSecret)),
+									SupportedKinds: []v1.RouteGroupKind{
+										{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+										{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+									},
 								},
-							},
-							{
-								Name:       tlsListenerName,
-								Source:     gw1.Spec.Listeners[2],
-								Valid:      true,
-								Attachable: true,
-								Routes:     map[graph.RouteKey]*graph.L7Route{},
-								L4Routes:   map[graph.L4RouteKey]*graph.L4Route{trKey1: expRouteTR1},
-								SupportedKinds: []v1.RouteGroupKind{
-									{Kind: v1.Kind(kinds.TLSRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+								{
+									Name:       tlsListenerName,
+									Source:     gw1.Spec.Listeners[2],
+									Valid:      true,
+									Attachable: true,
+									Routes:     map[graph.RouteKey]*graph.L7Route{},
+									L4Routes:   map[graph.L4RouteKey]*graph.L4Route{trKey1: expRouteTR1},
+									SupportedKinds: []v1.RouteGroupKind{
+										{Kind: v1.Kind(kinds.TLSRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+									},
 								},
 							},
+							Valid: true,
 						},
-						Valid: true,
 					},
-					IgnoredGateways:   map[types.NamespacedName]*v1.Gateway{},
 					L4Routes:          map[graph.L4RouteKey]*graph.L4Route{trKey1: expRouteTR1},
 					Routes:            map[graph.RouteKey]*graph.L7Route{httpRouteKey1: expRouteHR1, grpcRouteKey1: expRouteGR1},
 					ReferencedSecrets: map[types.NamespacedName]*graph.Secret{},
 					ReferencedServices: map[types.NamespacedName]*graph.ReferencedService{
-						refSvc:     {},
-						refTLSSvc:  {},
-						refGRPCSvc: {},
+						refSvc:     {GatewayNsNames: map[types.NamespacedName]struct{}{gw1NsName: {}}},
+						refTLSSvc:  {GatewayNsNames: map[types.NamespacedName]struct{}{gw1NsName: {}}},
+						refGRPCSvc: {GatewayNsNames: map[types.NamespacedName]struct{}{gw1NsName: {}}},
 					},
 				}
 			})
+
+			// addSecondGateway adds the second Gateway to the expected graph. All its Listeners conflict with
+			// the Listeners of the first Gateway, so they are not valid.
+			addSecondGateway := func() *graph.Gateway {
+				createConds := func(port int) []conditions.Condition {
+					return staticConds.NewListenerHostnameConflict(fmt.Sprintf(
+						"Listener port %d and hostname \"\" overlap with a Listener of Gateway test/gateway-1; "+
+							"ensure Listeners of different Gateways use non-overlapping hostnames for the same port",
+						port,
+					))
+				}
+
+				gw := &graph.Gateway{
+					Source: gw2,
+					Listeners: []*graph.Listener{
+						{
+							Name:       httpListenerName,
+							Source:     gw2.Spec.Listeners[0],
+							Attachable: true,
+							Routes:     map[graph.RouteKey]*graph.L7Route{},
+							L4Routes:   map[graph.L4RouteKey]*graph.L4Route{},
+							SupportedKinds: []v1.RouteGroupKind{
+								{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+								{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+							},
+							Conditions: createConds(80),
+						},
+						{
+							Name:           httpsListenerName,
+							Source:         gw2.Spec.Listeners[1],
+							Attachable:     true,
+							Routes:         map[graph.RouteKey]*graph.L7Route{},
+							L4Routes:       map[graph.L4RouteKey]*graph.L4Route{},
+							ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(sameNsTLSSecret)),
+							SupportedKinds: []v1.RouteGroupKind{
+								{Kind: v1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+								{Kind: v1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+							},
+							Conditions: createConds(443),
+						},
+						{
+							Name:       tlsListenerName,
+							Source:     gw2.Spec.Listeners[2],
+							Attachable: true,
+							Routes:     map[graph.RouteKey]*graph.L7Route{},
+							L4Routes:   map[graph.L4RouteKey]*graph.L4Route{},
+							SupportedKinds: []v1.RouteGroupKind{
+								{Kind: v1.Kind(kinds.TLSRoute), Group: helpers.GetPointer[v1.Group](v1.GroupName)},
+							},
+							Conditions: createConds(8443),
+						},
+					},
+					Valid: true,
+				}
+
+				expGraph.Gateways[gw2NsName] = gw
+				expGraph.ReferencedSecrets[client.ObjectKeyFromObject(sameNsTLSSecret)] = &graph.Secret{
+					Source: sameNsTLSSecret,
+				}
+
+				return gw
+			}
+
+			// takeOverBySecondGateway updates the expected graph for the case when the second Gateway
+			// is the only Gateway left.
+			takeOverBySecondGateway := func() *graph.Gateway {
+				gw := expGraph.Gateways[gw1NsName]
+				gw.Source = gw2
+
+				expGraph.Gateways = map[types.NamespacedName]*graph.Gateway{gw2NsName: gw}
+
+				for _, svc := range expGraph.ReferencedServices {
+					svc.GatewayNsNames = map[types.NamespacedName]struct{}{gw2NsName: {}}
+				}
+
+				return gw
+			}
 			When("no upsert has occurred", func() {
 				It("returns nil graph", func() {
 					changed, graphCfg := processor.Process()
@@ -1001,9 +1096,9 @@ var _ = Describe("ChangeProcessor", func() {
 
 							expGraph.GatewayClass = nil
 
-							expGraph.Gateway.Conditions = staticConds.NewGatewayInvalid("GatewayClass doesn't exist")
-							expGraph.Gateway.Valid = false
-							expGraph.Gateway.Listeners = nil
+							expGraph.Gateways[gw1NsName].Conditions = staticConds.NewGatewayInvalid("GatewayClass doesn't exist")
+							expGraph.Gateways[gw1NsName].Valid = false
+							expGraph.Gateways[gw1NsName].Listeners = nil
 
 							// no ref grant exists yet for the routes
 							expGraph.Routes[httpRouteKey1].Conditions = []conditions.Condition{
@@ -1051,7 +1146,7 @@ var _ = Describe("ChangeProcessor", func() {
 
 							expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 							expRouteGR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
-							expRouteTR1.Spec.BackendRef.SvcNsName = types.NamespacedName{}
+							expRouteTR1.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 							processAndValidateGraph(expGraph)
 						})
@@ -1064,7 +1159,7 @@ var _ = Describe("ChangeProcessor", func() {
 
 					// No ref grant exists yet for gw1
 					// so the listener is not valid, but still attachable
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
+					listener443 := getListenerByName(expGraph.Gateways[gw1NsName], httpsListenerName)
 					listener443.Valid = false
 					listener443.ResolvedSecret = nil
 					listener443.Conditions = staticConds.NewListenerRefNotPermitted(
@@ -1087,7 +1182,7 @@ var _ = Describe("ChangeProcessor", func() {
 						ListenerPort: 443,
 					}
 
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
+					listener80 := getListenerByName(expGraph.Gateways[gw1NsName], httpListenerName)
 					listener80.Routes[httpRouteKey1].ParentRefs[0].Attachment = expAttachment80
 					listener443.Routes[httpRouteKey1].ParentRefs[1].Attachment = expAttachment443
 					listener80.Routes[grpcRouteKey1].ParentRefs[0].Attachment = expAttachment80
@@ -1125,7 +1220,7 @@ var _ = Describe("ChangeProcessor", func() {
 
 					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expRouteGR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
-					expRouteTR1.Spec.BackendRef.SvcNsName = types.NamespacedName{}
+					expRouteTR1.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 					processAndValidateGraph(expGraph)
 				})
@@ -1162,7 +1257,7 @@ var _ = Describe("ChangeProcessor", func() {
 					expGraph.ReferencedServices = nil
 					expRouteHR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
 					expRouteGR1.Spec.Rules[0].BackendRefs[0].SvcNsName = types.NamespacedName{}
-					expRouteTR1.Spec.BackendRef.SvcNsName = types.NamespacedName{}
+					expRouteTR1.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 					processAndValidateGraph(expGraph)
 				})
@@ -1187,7 +1282,7 @@ var _ = Describe("ChangeProcessor", func() {
 						),
 					}
 					delete(expGraph.ReferencedServices, refTLSSvc)
-					expRouteTR1.Spec.BackendRef.SvcNsName = types.NamespacedName{}
+					expRouteTR1.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
@@ -1207,7 +1302,7 @@ var _ = Describe("ChangeProcessor", func() {
 						),
 					}
 					delete(expGraph.ReferencedServices, types.NamespacedName{Namespace: "tls-service-ns", Name: "tls-service"})
-					expRouteTR1.Spec.BackendRef.SvcNsName = types.NamespacedName{}
+					expRouteTR1.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{}
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
@@ -1278,10 +1373,10 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(hr1Updated)
 
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
+					listener443 := getListenerByName(expGraph.Gateways[gw1NsName], httpsListenerName)
 					listener443.Routes[httpRouteKey1].Source.SetGeneration(hr1Updated.Generation)
 
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
+					listener80 := getListenerByName(expGraph.Gateways[gw1NsName], httpListenerName)
 					listener80.Routes[httpRouteKey1].Source.SetGeneration(hr1Updated.Generation)
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
@@ -1295,10 +1390,10 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(gr1Updated)
 
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
+					listener443 := getListenerByName(expGraph.Gateways[gw1NsName], httpsListenerName)
 					listener443.Routes[grpcRouteKey1].Source.SetGeneration(gr1Updated.Generation)
 
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
+					listener80 := getListenerByName(expGraph.Gateways[gw1NsName], httpListenerName)
 					listener80.Routes[grpcRouteKey1].Source.SetGeneration(gr1Updated.Generation)
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
@@ -1311,7 +1406,7 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(tr1Updated)
 
-					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
+					tlsListener := getListenerByName(expGraph.Gateways[gw1NsName], tlsListenerName)
 					tlsListener.L4Routes[trKey1].Source.SetGeneration(tr1Updated.Generation)
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
@@ -1325,7 +1420,7 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(gw1Updated)
 
-					expGraph.Gateway.Source.Generation = gw1Updated.Generation
+					expGraph.Gateways[gw1NsName].Source.Generation = gw1Updated.Generation
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
 					}
@@ -1383,12 +1478,10 @@ var _ = Describe("ChangeProcessor", func() {
 				})
 			})
 			When("the second Gateway is upserted", func() {
-				It("returns populated graph using first gateway", func() {
+				It("returns populated graph with both gateways", func() {
 					processor.CaptureUpsertChange(gw2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
-					}
+					addSecondGateway()
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
 					}
@@ -1400,18 +1493,14 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(hr2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
-					}
+					gw := addSecondGateway()
 					expGraph.Routes[httpRouteKey2] = expRouteHR2
-					expGraph.Routes[httpRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[httpRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
+					expRouteHR2.Conditions = []conditions.Condition{
+						staticConds.NewRouteInvalidListener(),
+						staticConds.NewRouteInvalidListener(),
 					}
+					getListenerByName(gw, httpListenerName).Routes[httpRouteKey2] = expRouteHR2
+					getListenerByName(gw, httpsListenerName).Routes[httpRouteKey2] = expRouteHR2
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
 					}
@@ -1423,28 +1512,22 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(gr2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
-					}
+					gw := addSecondGateway()
 					expGraph.Routes[httpRouteKey2] = expRouteHR2
-					expGraph.Routes[httpRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[httpRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
+					expRouteHR2.Conditions = []conditions.Condition{
+						staticConds.NewRouteInvalidListener(),
+						staticConds.NewRouteInvalidListener(),
 					}
+					getListenerByName(gw, httpListenerName).Routes[httpRouteKey2] = expRouteHR2
+					getListenerByName(gw, httpsListenerName).Routes[httpRouteKey2] = expRouteHR2
 
 					expGraph.Routes[grpcRouteKey2] = expRouteGR2
-					expGraph.Routes[grpcRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[grpcRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
+					expRouteGR2.Conditions = []conditions.Condition{
+						staticConds.NewRouteInvalidListener(),
+						staticConds.NewRouteInvalidListener(),
 					}
+					getListenerByName(gw, httpListenerName).Routes[grpcRouteKey2] = expRouteGR2
+					getListenerByName(gw, httpsListenerName).Routes[grpcRouteKey2] = expRouteGR2
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
@@ -1457,34 +1540,27 @@ var _ = Describe("ChangeProcessor", func() {
 				It("returns populated graph", func() {
 					processor.CaptureUpsertChange(tr2)
 
-					expGraph.IgnoredGateways = map[types.NamespacedName]*v1.Gateway{
-						{Namespace: "test", Name: "gateway-2"}: gw2,
-					}
+					gw := addSecondGateway()
 					expGraph.Routes[httpRouteKey2] = expRouteHR2
-					expGraph.Routes[httpRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[httpRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
+					expRouteHR2.Conditions = []conditions.Condition{
+						staticConds.NewRouteInvalidListener(),
+						staticConds.NewRouteInvalidListener(),
 					}
+					getListenerByName(gw, httpListenerName).Routes[httpRouteKey2] = expRouteHR2
+					getListenerByName(gw, httpsListenerName).Routes[httpRouteKey2] = expRouteHR2
 
 					expGraph.Routes[grpcRouteKey2] = expRouteGR2
-					expGraph.Routes[grpcRouteKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
-					expGraph.Routes[grpcRouteKey2].ParentRefs[1].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
+					expRouteGR2.Conditions = []conditions.Condition{
+						staticConds.NewRouteInvalidListener(),
+						staticConds.NewRouteInvalidListener(),
 					}
+					getListenerByName(gw, httpListenerName).Routes[grpcRouteKey2] = expRouteGR2
+					getListenerByName(gw, httpsListenerName).Routes[grpcRouteKey2] = expRouteGR2
 
 					expGraph.L4Routes[trKey2] = expRouteTR2
-					expGraph.L4Routes[trKey2].ParentRefs[0].Attachment = &graph.ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-					}
+					expRouteTR2.Conditions = append(expRouteTR2.Conditions, staticConds.NewRouteInvalidListener())
+					getListenerByName(gw, tlsListenerName).L4Routes[trKey2] = expRouteTR2
+					expGraph.ReferencedServices[refTLSSvc].GatewayNsNames[gw2NsName] = struct{}{}
 
 					expGraph.ReferencedSecrets[client.ObjectKeyFromObject(diffNsTLSSecret)] = &graph.Secret{
 						Source: diffNsTLSSecret,
@@ -1502,11 +1578,11 @@ var _ = Describe("ChangeProcessor", func() {
 
 					// gateway 2 takes over;
 					// route 1 has been replaced by route 2
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
-					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
+					gw := takeOverBySecondGateway()
+					listener80 := getListenerByName(gw, httpListenerName)
+					listener443 := getListenerByName(gw, httpsListenerName)
+					tlsListener := getListenerByName(gw, tlsListenerName)
 
-					expGraph.Gateway.Source = gw2
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1556,11 +1632,11 @@ var _ = Describe("ChangeProcessor", func() {
 					// no HTTP routes remain
 					// GRPCRoute 2 still exists
 					// TLSRoute 2 still exists
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
-					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
+					gw := takeOverBySecondGateway()
+					listener80 := getListenerByName(gw, httpListenerName)
+					listener443 := getListenerByName(gw, httpsListenerName)
+					tlsListener := getListenerByName(gw, tlsListenerName)
 
-					expGraph.Gateway.Source = gw2
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1605,11 +1681,11 @@ var _ = Describe("ChangeProcessor", func() {
 
 					// gateway 2 still in charge;
 					// no routes remain
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
-					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
+					gw := takeOverBySecondGateway()
+					listener80 := getListenerByName(gw, httpListenerName)
+					listener443 := getListenerByName(gw, httpsListenerName)
+					tlsListener := getListenerByName(gw, tlsListenerName)
 
-					expGraph.Gateway.Source = gw2
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1649,11 +1725,11 @@ var _ = Describe("ChangeProcessor", func() {
 
 					// gateway 2 still in charge;
 					// no HTTP or TLS routes remain
-					listener80 := getListenerByName(expGraph.Gateway, httpListenerName)
-					listener443 := getListenerByName(expGraph.Gateway, httpsListenerName)
-					tlsListener := getListenerByName(expGraph.Gateway, tlsListenerName)
+					gw := takeOverBySecondGateway()
+					listener80 := getListenerByName(gw, httpListenerName)
+					listener443 := getListenerByName(gw, httpsListenerName)
+					tlsListener := getListenerByName(gw, tlsListenerName)
 
-					expGraph.Gateway.Source = gw2
 					listener80.Source = gw2.Spec.Listeners[0]
 					listener443.Source = gw2.Spec.Listeners[1]
 					tlsListener.Source = gw2.Spec.Listeners[2]
@@ -1688,9 +1764,11 @@ var _ = Describe("ChangeProcessor", func() {
 					)
 
 					expGraph.GatewayClass = nil
-					expGraph.Gateway = &graph.Gateway{
-						Source:     gw2,
-						Conditions: staticConds.NewGatewayInvalid("GatewayClass doesn't exist"),
+					expGraph.Gateways = map[types.NamespacedName]*graph.Gateway{
+						gw2NsName: {
+							Source:     gw2,
+							Conditions: staticConds.NewGatewayInvalid("GatewayClass doesn't exist"),
+						},
 					}
 					expGraph.Routes = map[graph.RouteKey]*graph.L7Route{}
 					expGraph.L4Routes = map[graph.L4RouteKey]*graph.L4Route{}
@@ -3176,6 +3254,305 @@ var _ = Describe("ChangeProcessor", func() {
 			)
 		})
 	})
+	Describe("Incremental graph builds", Ordered, func() {
+		var (
+			processor    state.ChangeProcessor
+			clusterState graph.ClusterState
+			gc           *v1.GatewayClass
+			gw           *v1.Gateway
+			hr1, hr2     *v1.HTTPRoute
+			gr           *v1.GRPCRoute
+			refGrant     *v1beta1.ReferenceGrant
+		)
+
+		const serviceNs = "service-ns"
+
+		createService := func(namespace string, port int32) *apiv1.Service {
+			return &apiv1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      "svc",
+				},
+				Spec: apiv1.ServiceSpec{
+					IPFamilies: []apiv1.IPFamily{apiv1.IPv4Protocol},
+					Ports: []apiv1.ServicePort{
+						{
+							Port: port,
+						},
+					},
+				},
+			}
+		}
+
+		createBackendTLSPolicy := func(
+			wellKnownCACerts v1alpha3.WellKnownCACertificatesType,
+		) *v1alpha3.BackendTLSPolicy {
+			return &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "btp",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: []v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+								Kind: kinds.Service,
+								Name: "svc",
+							},
+						},
+					},
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						WellKnownCACertificates: helpers.GetPointer(wellKnownCACerts),
+						Hostname:                "svc.example.com",
+					},
+				},
+			}
+		}
+
+		createNginxProxy := func(ipFamily ngfAPIv1alpha1.IPFamilyType) *ngfAPIv1alpha1.NginxProxy {
+			return &ngfAPIv1alpha1.NginxProxy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "nginx-proxy",
+				},
+				Spec: ngfAPIv1alpha1.NginxProxySpec{
+					IPFamily: helpers.GetPointer(ipFamily),
+				},
+			}
+		}
+
+		// upsert captures the upsert of the object and applies the same change to clusterState, which is used
+		// for the full builds.
+		upsert := func(obj client.Object) {
+			processor.CaptureUpsertChange(obj)
+
+			nsname := client.ObjectKeyFromObject(obj)
+
+			switch o := obj.(type) {
+			case *v1.GatewayClass:
+				clusterState.GatewayClasses[nsname] = o
+			case *v1.Gateway:
+				clusterState.Gateways[nsname] = o
+			case *v1.HTTPRoute:
+				clusterState.HTTPRoutes[nsname] = o
+			case *v1.GRPCRoute:
+				clusterState.GRPCRoutes[nsname] = o
+			case *apiv1.Service:
+				clusterState.Services[nsname] = o
+			case *v1beta1.ReferenceGrant:
+				clusterState.ReferenceGrants[nsname] = o
+			case *v1alpha3.BackendTLSPolicy:
+				clusterState.BackendTLSPolicies[nsname] = o
+			case *ngfAPIv1alpha1.NginxProxy:
+				clusterState.NginxProxies[nsname] = o
+			default:
+				panic(fmt.Errorf("unexpected object type %T", obj))
+			}
+		}
+
+		// remove captures the deletion of the object and applies the same change to clusterState.
+		remove := func(obj client.Object) {
+			nsname := client.ObjectKeyFromObject(obj)
+
+			processor.CaptureDeleteChange(obj, nsname)
+
+			switch obj.(type) {
+			case *v1.Gateway:
+				delete(clusterState.Gateways, nsname)
+			case *v1.HTTPRoute:
+				delete(clusterState.HTTPRoutes, nsname)
+			case *v1beta1.ReferenceGrant:
+				delete(clusterState.ReferenceGrants, nsname)
+			case *v1alpha3.BackendTLSPolicy:
+				delete(clusterState.BackendTLSPolicies, nsname)
+			default:
+				panic(fmt.Errorf("unexpected object type %T", obj))
+			}
+		}
+
+		// expectSameAsFullBuild processes the captured changes and expects the Graph and the dataplane Configuration
+		// to be the same as when they are built from scratch.
+		expectSameAsFullBuild := func() {
+			processor.Process()
+
+			incremental := processor.GetLatestGraph()
+			full := graph.BuildGraph(
+				clusterState,
+				controllerName,
+				gcName,
+				nil,
+				createAlwaysValidValidators(),
+				nil,
+				false,
+				nil,
+			)
+			Expect(helpers.Diff(full, incremental)).To(BeEmpty())
+
+			serviceResolver := &resolverfakes.FakeServiceResolver{}
+			fullCfg := dataplane.BuildConfiguration(context.Background(), full, serviceResolver, 1)
+			incrementalCfg := dataplane.BuildConfiguration(context.Background(), incremental, serviceResolver, 1)
+
+			// the order of the Upstreams and BackendGroups depends on the iteration order of maps, regardless of
+			// how the Graph was built
+			sortConfiguration := func(cfg dataplane.Configuration) {
+				slices.SortFunc(cfg.Upstreams, func(a, b dataplane.Upstream) int {
+					return cmp.Compare(a.Name, b.Name)
+				})
+				slices.SortFunc(cfg.BackendGroups, func(a, b dataplane.BackendGroup) int {
+					return cmp.Compare(a.Name(), b.Name())
+				})
+			}
+			sortConfiguration(fullCfg)
+			sortConfiguration(incrementalCfg)
+
+			Expect(helpers.Diff(fullCfg, incrementalCfg)).To(BeEmpty())
+		}
+
+		BeforeAll(func() {
+			processor = state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
+				GatewayCtlrName:  controllerName,
+				GatewayClassName: gcName,
+				Logger:           zap.New(),
+				Validators:       createAlwaysValidValidators(),
+				MustExtractGVK:   kinds.NewMustExtractGKV(createScheme()),
+			})
+
+			clusterState = graph.ClusterState{
+				GatewayClasses:     map[types.NamespacedName]*v1.GatewayClass{},
+				Gateways:           map[types.NamespacedName]*v1.Gateway{},
+				HTTPRoutes:         map[types.NamespacedName]*v1.HTTPRoute{},
+				GRPCRoutes:         map[types.NamespacedName]*v1.GRPCRoute{},
+				Services:           map[types.NamespacedName]*apiv1.Service{},
+				ReferenceGrants:    map[types.NamespacedName]*v1beta1.ReferenceGrant{},
+				BackendTLSPolicies: map[types.NamespacedName]*v1alpha3.BackendTLSPolicy{},
+				NginxProxies:       map[types.NamespacedName]*ngfAPIv1alpha1.NginxProxy{},
+			}
+
+			gc = &v1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       gcName,
+					Generation: 1,
+				},
+				Spec: v1.GatewayClassSpec{
+					ControllerName: controllerName,
+					ParametersRef: &v1.ParametersReference{
+						Group: ngfAPIv1alpha1.GroupName,
+						Kind:  kinds.NginxProxy,
+						Name:  "nginx-proxy",
+					},
+				},
+			}
+
+			gw = createGateway("gateway-1", createHTTPListener())
+
+			svcKind := helpers.GetPointer[v1.Kind](kinds.Service)
+
+			hr1 = createHTTPRoute("hr-1", gw.Name, "foo.example.com", createHTTPBackendRef(svcKind, "svc", nil))
+			hr2 = createHTTPRoute(
+				"hr-2",
+				gw.Name,
+				"bar.example.com",
+				createHTTPBackendRef(svcKind, "svc", helpers.GetPointer[v1.Namespace](serviceNs)),
+			)
+			gr = createGRPCRoute("gr", gw.Name, "grpc.example.com", v1.GRPCBackendRef{
+				BackendRef: v1.BackendRef{
+					BackendObjectReference: createBackendRefObj(svcKind, "svc", nil),
+				},
+			})
+
+			refGrant = &v1beta1.ReferenceGrant{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: serviceNs,
+					Name:      "ref-grant",
+				},
+				Spec: v1beta1.ReferenceGrantSpec{
+					From: []v1beta1.ReferenceGrantFrom{
+						{
+							Group:     v1.GroupName,
+							Kind:      kinds.HTTPRoute,
+							Namespace: "test",
+						},
+					},
+					To: []v1beta1.ReferenceGrantTo{
+						{
+							Kind: kinds.Service,
+						},
+					},
+				},
+			}
+		})
+
+		It("builds the same graph and configuration for the initial resources", func() {
+			upsert(gc)
+			upsert(gw)
+			upsert(hr1)
+			upsert(hr2)
+			upsert(gr)
+			upsert(createService("test", 80))
+			upsert(createService(serviceNs, 80))
+
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after a backend Service is updated", func() {
+			upsert(createService("test", 8080))
+			expectSameAsFullBuild()
+
+			upsert(createService("test", 80))
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after a ReferenceGrant is created", func() {
+			upsert(refGrant)
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after a BackendTLSPolicy is created", func() {
+			upsert(createBackendTLSPolicy(v1alpha3.WellKnownCACertificatesSystem))
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after the BackendTLSPolicy becomes invalid", func() {
+			upsert(createBackendTLSPolicy("Unsupported"))
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after the NginxProxy is created and updated", func() {
+			upsert(createNginxProxy(ngfAPIv1alpha1.Dual))
+			expectSameAsFullBuild()
+
+			upsert(createNginxProxy(ngfAPIv1alpha1.IPv6))
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after a Route is updated", func() {
+			hr1Updated := hr1.DeepCopy()
+			hr1Updated.Generation++
+			hr1Updated.Spec.Hostnames = []v1.Hostname{"baz.example.com"}
+
+			upsert(hr1Updated)
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after the ReferenceGrant and BackendTLSPolicy are deleted", func() {
+			remove(refGrant)
+			remove(createBackendTLSPolicy(v1alpha3.WellKnownCACertificatesSystem))
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after a Route is deleted", func() {
+			remove(hr2)
+			expectSameAsFullBuild()
+		})
+
+		It("builds the same graph and configuration after the Gateway is deleted and recreated", func() {
+			remove(gw)
+			expectSameAsFullBuild()
+
+			upsert(createGateway("gateway-1", createHTTPListener()))
+			expectSameAsFullBuild()
+		})
+	})
+
 	Describe("Edge cases with panic", func() {
 		var processor state.ChangeProcessor
 
@@ -2,6 +2,7 @@ package conditions
 
 import (
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -53,18 +54,46 @@ const (
 	// invalid. Used with ResolvedRefs (false).
 	RouteReasonInvalidFilter v1.RouteConditionReason = "InvalidFilter"
 
-	// GatewayReasonGatewayConflict indicates there are multiple Gateway resources to choose from,
-	// and we ignored the resource in question and picked another Gateway as the winner.
-	// This reason is used with GatewayConditionAccepted (false).
-	GatewayReasonGatewayConflict v1.GatewayConditionReason = "GatewayConflict"
-
-	// GatewayMessageGatewayConflict is a message that describes GatewayReasonGatewayConflict.
-	GatewayMessageGatewayConflict = "The resource is ignored due to a conflicting Gateway resource"
-
 	// GatewayReasonUnsupportedValue is used with GatewayConditionAccepted (false) when a value of a field in a Gateway
 	// is invalid or not supported.
 	GatewayReasonUnsupportedValue v1.GatewayConditionReason = "UnsupportedValue"
 
+	// GatewayConditionHTTPSRedirect indicates whether HTTP to HTTPS redirects are configured for the Gateway.
+	// The condition is only set when HTTPS redirects are enabled in the NginxProxy resource.
+	GatewayConditionHTTPSRedirect v1.GatewayConditionType = "HTTPSRedirect"
+
+	// GatewayReasonHTTPSRedirectConfigured is used with GatewayConditionHTTPSRedirect (true) when
+	// redirects are configured for at least one hostname.
+	GatewayReasonHTTPSRedirectConfigured v1.GatewayConditionReason = "RedirectConfigured"
+
+	// GatewayReasonNoHostnamesCovered is used with GatewayConditionHTTPSRedirect (false) when
+	// no hostnames are redirected to HTTPS.
+	GatewayReasonNoHostnamesCovered v1.GatewayConditionReason = "NoHostnamesCovered"
+
+	// GatewayConditionDefaultServer indicates whether the default servers for unmatched requests are configured
+	// for the Gateway. The condition is only set when default servers are configured in the NginxProxy resource.
+	GatewayConditionDefaultServer v1.GatewayConditionType = "DefaultServer"
+
+	// GatewayReasonDefaultServerConfigured is used with GatewayConditionDefaultServer (true) when
+	// the default servers are configured.
+	GatewayReasonDefaultServerConfigured v1.GatewayConditionReason = "DefaultServerConfigured"
+
+	// GatewayReasonDefaultServerBackendNotFound is used with GatewayConditionDefaultServer (false) when
+	// the Service or Service port referenced by a default server doesn't exist.
+	GatewayReasonDefaultServerBackendNotFound v1.GatewayConditionReason = "BackendNotFound"
+
+	// GatewayConditionBackendTLS indicates whether the client certificate that NGINX presents to TLS backends
+	// is configured for the Gateway. The condition is only set when the Gateway references a client certificate.
+	GatewayConditionBackendTLS v1.GatewayConditionType = "BackendTLS"
+
+	// GatewayReasonClientCertificateConfigured is used with GatewayConditionBackendTLS (true) when
+	// the client certificate is configured.
+	GatewayReasonClientCertificateConfigured v1.GatewayConditionReason = "ClientCertificateConfigured"
+
+	// GatewayReasonInvalidClientCertificateRef is used with GatewayConditionBackendTLS (false) when
+	// the client certificate reference is invalid or the Secret is invalid or doesn't exist.
+	GatewayReasonInvalidClientCertificateRef v1.GatewayConditionReason = "InvalidClientCertificateRef"
+
 	// GatewayMessageFailedNginxReload is a message used with GatewayConditionProgrammed (false)
 	// when nginx fails to reload.
 	GatewayMessageFailedNginxReload = "The Gateway is not programmed due to a failure to " +
@@ -103,21 +132,15 @@ const (
 	// has an overlapping hostname:port/path combination with another Route.
 	PolicyReasonTargetConflict v1alpha2.PolicyConditionReason = "TargetConflict"
 
-	// GatewayIgnoredReason is used with v1.RouteConditionAccepted when the route references a Gateway that is ignored
-	// by NGF.
-	GatewayIgnoredReason v1.RouteConditionReason = "GatewayIgnored"
-)
+	// BackendTLSPolicyConditionSubjectAltNamesVerified indicates whether NGINX verifies the certificate of the
+	// backend against the SubjectAltNames of the BackendTLSPolicy. The condition is only set when the
+	// BackendTLSPolicy specifies SubjectAltNames.
+	BackendTLSPolicyConditionSubjectAltNamesVerified v1alpha2.PolicyConditionType = "SubjectAltNamesVerified"
 
-// NewRouteNotAcceptedGatewayIgnored returns a Condition that indicates that the Route is not accepted by the Gateway
-// because the Gateway is ignored by NGF.
-func NewRouteNotAcceptedGatewayIgnored() conditions.Condition {
-	return conditions.Condition{
-		Type:    string(v1.RouteConditionAccepted),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(GatewayIgnoredReason),
-		Message: "The Gateway is ignored by the controller",
-	}
-}
+	// PolicyReasonUnsupportedValue is used with BackendTLSPolicyConditionSubjectAltNamesVerified (false) when
+	// a value of a field in the Policy is not supported.
+	PolicyReasonUnsupportedValue v1alpha2.PolicyConditionReason = "UnsupportedValue"
+)
 
 // NewDefaultRouteConditions returns the default conditions that must be present in the status of a Route.
 func NewDefaultRouteConditions() []conditions.Condition {
@@ -238,6 +261,17 @@ func NewRouteBackendRefRefNotPermitted(msg string) conditions.Condition {
 	}
 }
 
+// NewRouteBackendRefUnsupportedProtocol returns a Condition that indicates that the Route has a backendRef to
+// a Service port with an appProtocol that the Route doesn't support.
+func NewRouteBackendRefUnsupportedProtocol(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.RouteReasonUnsupportedProtocol),
+		Message: msg,
+	}
+}
+
 // NewRouteBackendRefRefBackendNotFound returns a Condition that indicates that the Route has a backendRef that
 // points to non-existing backend.
 func NewRouteBackendRefRefBackendNotFound(msg string) conditions.Condition {
@@ -559,19 +593,6 @@ func NewGatewayAccepted() conditions.Condition {
 	}
 }
 
-// NewGatewayConflict returns Conditions that indicate the Gateway has a conflict with another Gateway.
-func NewGatewayConflict() []conditions.Condition {
-	return []conditions.Condition{
-		{
-			Type:    string(v1.GatewayConditionAccepted),
-			Status:  metav1.ConditionFalse,
-			Reason:  string(GatewayReasonGatewayConflict),
-			Message: GatewayMessageGatewayConflict,
-		},
-		NewGatewayConflictNotProgrammed(),
-	}
-}
-
 // NewGatewayAcceptedListenersNotValid returns a Condition that indicates the Gateway is accepted,
 // but has at least one listener that is invalid.
 func NewGatewayAcceptedListenersNotValid() conditions.Condition {
@@ -653,14 +674,81 @@ func NewGatewayNotProgrammedInvalid(msg string) conditions.Condition {
 	}
 }
 
-// NewGatewayConflictNotProgrammed returns a custom Programmed Condition that indicates the Gateway has a
-// conflict with another Gateway.
-func NewGatewayConflictNotProgrammed() conditions.Condition {
+// NewGatewayHTTPSRedirectConfigured returns a Condition that indicates that HTTP requests for the provided
+// hostnames are redirected to HTTPS.
+func NewGatewayHTTPSRedirectConfigured(hostnames []string) conditions.Condition {
 	return conditions.Condition{
-		Type:    string(v1.GatewayConditionProgrammed),
+		Type:    string(GatewayConditionHTTPSRedirect),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonHTTPSRedirectConfigured),
+		Message: fmt.Sprintf("HTTP requests are redirected to HTTPS for hostnames: %s", strings.Join(hostnames, ", ")),
+	}
+}
+
+// NewGatewayHTTPSRedirectNoHostnames returns a Condition that indicates that HTTPS redirects are enabled,
+// but no hostnames are redirected.
+func NewGatewayHTTPSRedirectNoHostnames() conditions.Condition {
+	return conditions.Condition{
+		Type:   string(GatewayConditionHTTPSRedirect),
+		Status: metav1.ConditionFalse,
+		Reason: string(GatewayReasonNoHostnamesCovered),
+		Message: "HTTPS redirects are enabled, but no hostnames are redirected; HTTPS Listeners have no Routes " +
+			"or HTTP Routes already serve all hostnames",
+	}
+}
+
+// NewGatewayDefaultServerConfigured returns a Condition that indicates that the default servers are configured
+// for the provided ports.
+func NewGatewayDefaultServerConfigured(ports []string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionDefaultServer),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonDefaultServerConfigured),
+		Message: fmt.Sprintf("Unmatched requests are handled by the default server on ports: %s", strings.Join(ports, ", ")),
+	}
+}
+
+// NewGatewayDefaultServerBackendNotFound returns a Condition that indicates that the backends of some
+// default servers don't exist. NGINX returns a 500 response for unmatched requests on those ports.
+func NewGatewayDefaultServerBackendNotFound(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionDefaultServer),
 		Status:  metav1.ConditionFalse,
-		Reason:  string(GatewayReasonGatewayConflict),
-		Message: GatewayMessageGatewayConflict,
+		Reason:  string(GatewayReasonDefaultServerBackendNotFound),
+		Message: msg,
+	}
+}
+
+// NewGatewayBackendTLSClientCertificateConfigured returns a Condition that indicates that NGINX presents
+// the client certificate of the Gateway to TLS backends.
+func NewGatewayBackendTLSClientCertificateConfigured() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionBackendTLS),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonClientCertificateConfigured),
+		Message: "The client certificate is presented to backends that require TLS client authentication",
+	}
+}
+
+// NewGatewayBackendTLSInvalidClientCertificateRef returns a Condition that indicates that the client certificate
+// reference of the Gateway is invalid. No client certificate is presented to TLS backends.
+func NewGatewayBackendTLSInvalidClientCertificateRef(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionBackendTLS),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonInvalidClientCertificateRef),
+		Message: msg,
+	}
+}
+
+// NewGatewayBackendTLSRefNotPermitted returns a Condition that indicates that the client certificate reference
+// of the Gateway is not permitted by any ReferenceGrant. No client certificate is presented to TLS backends.
+func NewGatewayBackendTLSRefNotPermitted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(GatewayConditionBackendTLS),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1.ListenerReasonRefNotPermitted),
+		Message: msg,
 	}
 }
 
@@ -749,6 +837,18 @@ func NewPolicyNotAcceptedNginxProxyNotSet(msg string) conditions.Condition {
 	}
 }
 
+// NewBackendTLSPolicySubjectAltNamesUnsupported returns a Condition that indicates that NGINX doesn't verify the
+// certificate of the backend against the SubjectAltNames of the BackendTLSPolicy. The certificate is verified
+// against the Hostname instead, and the Policy is still accepted.
+func NewBackendTLSPolicySubjectAltNamesUnsupported(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(BackendTLSPolicyConditionSubjectAltNamesVerified),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonUnsupportedValue),
+		Message: msg,
+	}
+}
+
 // NewSnippetsFilterInvalid returns a Condition that indicates that the SnippetsFilter is not accepted because it is
 // syntactically or semantically invalid.
 func NewSnippetsFilterInvalid(msg string) conditions.Condition {
@@ -770,3 +870,57 @@ func NewSnippetsFilterAccepted() conditions.Condition {
 		Message: "SnippetsFilter is accepted",
 	}
 }
+
+// NewCanaryAccepted returns a Condition that indicates that the Canary is accepted because it is valid.
+func NewCanaryAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.CanaryConditionTypeAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.CanaryConditionReasonAccepted),
+		Message: "Canary is accepted",
+	}
+}
+
+// NewCanaryInvalid returns a Condition that indicates that the Canary is not accepted because it is
+// invalid or its target HTTPRoute rule can't be progressed.
+func NewCanaryInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.CanaryConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.CanaryConditionReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewCanaryConflicted returns a Condition that indicates that the Canary is not accepted because another
+// Canary targets the same HTTPRoute rule.
+func NewCanaryConflicted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.CanaryConditionTypeAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(ngfAPI.CanaryConditionReasonConflicted),
+		Message: msg,
+	}
+}
+
+// NewCanaryStalled returns a Condition that indicates that the Canary is held at its current step because
+// the canary backend didn't serve enough responses for the analysis.
+func NewCanaryStalled(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.CanaryConditionTypeStalled),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.CanaryConditionReasonInsufficientRequests),
+		Message: msg,
+	}
+}
+
+// NewCanaryRolledBack returns a Condition that indicates that the Canary was rolled back because the error rate
+// of the canary backend exceeded the threshold.
+func NewCanaryRolledBack(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(ngfAPI.CanaryConditionTypeRolledBack),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(ngfAPI.CanaryConditionReasonErrorRateExceeded),
+		Message: msg,
+	}
+}
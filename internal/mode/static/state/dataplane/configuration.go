@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"sort"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -25,6 +26,15 @@ const (
 	wildcardHostname     = "~^"
 	alpineSSLRootCAPath  = "/etc/ssl/cert.pem"
 	defaultErrorLogLevel = "info"
+
+	// defaultServerRedirectStatusCode is the status code of a default server redirect if it is not set.
+	defaultServerRedirectStatusCode = 302
+	// invalidBackendStatusCode is the status code returned when the backend of a default server is invalid.
+	invalidBackendStatusCode = 500
+	// defaultSessionCookieName is the name of the session cookie if a BackendLBPolicy doesn't set one.
+	defaultSessionCookieName = "ngf_session"
+	// maxCanaryWeight is the sum of the weights of the canary and the stable backends of a Canary.
+	maxCanaryWeight = 100
 )
 
 // BuildConfiguration builds the Configuration from the Graph.
@@ -34,17 +44,20 @@ func BuildConfiguration(
 	serviceResolver resolver.ServiceResolver,
 	configVersion int,
 ) Configuration {
-	if g.GatewayClass == nil || !g.GatewayClass.Valid || g.Gateway == nil {
+	if g.GatewayClass == nil || !g.GatewayClass.Valid || len(g.Gateways) == 0 {
 		return GetDefaultConfiguration(g, configVersion)
 	}
 
 	baseHTTPConfig := buildBaseHTTPConfig(g)
+	listeners := getAllListeners(g)
 
 	httpServers, sslServers := buildServers(g)
+	passthroughServers := buildPassthroughServers(g)
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
 	upstreams := buildUpstreams(
 		ctx,
-		g.Gateway.Listeners,
+		listeners,
+		buildAdditionalBackendRefs(g),
 		serviceResolver,
 		g.ReferencedServices,
 		baseHTTPConfig.IPFamily,
@@ -53,23 +66,57 @@ func BuildConfiguration(
 	config := Configuration{
 		HTTPServers:           httpServers,
 		SSLServers:            sslServers,
-		TLSPassthroughServers: buildPassthroughServers(g),
+		TLSPassthroughServers: passthroughServers,
 		Upstreams:             upstreams,
-		StreamUpstreams:       buildStreamUpstreams(ctx, g.Gateway.Listeners, serviceResolver, baseHTTPConfig.IPFamily),
+		StreamUpstreams:       buildStreamUpstreams(ctx, listeners, serviceResolver, baseHTTPConfig.IPFamily),
 		BackendGroups:         backendGroups,
-		SSLKeyPairs:           buildSSLKeyPairs(g.ReferencedSecrets, g.Gateway.Listeners),
+		SSLKeyPairs:           buildSSLKeyPairs(g.ReferencedSecrets, listeners, g.GetSortedGateways()),
 		Version:               configVersion,
-		CertBundles:           buildCertBundles(g.ReferencedCaCertConfigMaps, backendGroups),
-		Telemetry:             buildTelemetry(g),
-		BaseHTTPConfig:        baseHTTPConfig,
-		Logging:               buildLogging(g),
-		MainSnippets:          buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextMain),
-		AuxiliarySecrets:      buildAuxiliarySecrets(g.PlusSecrets),
+		CertBundles: buildCertBundles(
+			g.ReferencedCaCertConfigMaps,
+			g.BackendTLSPolicies,
+			backendGroups,
+			passthroughServers,
+		),
+		ErrorPageBodies:  buildErrorPageBodies(g.NGFPolicies, g.ReferencedErrorPageConfigMaps),
+		Telemetry:        buildTelemetry(g),
+		BaseHTTPConfig:   baseHTTPConfig,
+		DNSResolver:      buildDNSResolver(g),
+		Logging:          buildLogging(g),
+		MainSnippets:     buildSnippetsForContext(g.SnippetsFilters, ngfAPIv1alpha1.NginxContextMain),
+		AuxiliarySecrets: buildAuxiliarySecrets(g.PlusSecrets),
 	}
 
 	return config
 }
 
+// getAllListeners returns the Listeners of all Gateways, ordered by the Gateways.
+func getAllListeners(g *graph.Graph) []*graph.Listener {
+	var listeners []*graph.Listener
+
+	for _, gw := range g.GetSortedGateways() {
+		listeners = append(listeners, gw.Listeners...)
+	}
+
+	return listeners
+}
+
+// getAcceptedHostnames returns the hostnames that the parentRef of the Route for the Listener of the Gateway
+// accepted.
+func getAcceptedHostnames(parentRefs []graph.ParentRef, gwNsName types.NamespacedName, listenerName string) []string {
+	for _, p := range parentRefs {
+		if p.Gateway != gwNsName || p.Attachment == nil {
+			continue
+		}
+
+		if val, exist := p.Attachment.AcceptedHostnames[listenerName]; exist {
+			return val
+		}
+	}
+
+	return nil
+}
+
 // buildPassthroughServers builds TLSPassthroughServers from TLSRoutes attaches to listeners.
 func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 	passthroughServersMap := make(map[graph.L4RouteKey][]Layer4VirtualServer)
@@ -77,66 +124,113 @@ func buildPassthroughServers(g *graph.Graph) []Layer4VirtualServer {
 
 	passthroughServerCount := 0
 
-	for _, l := range g.Gateway.Listeners {
-		if !l.Valid || l.Source.Protocol != v1.TLSProtocolType {
-			continue
-		}
-		foundRouteMatchingListenerHostname := false
-		for key, r := range l.L4Routes {
-			if !r.Valid {
+	for _, gw := range g.GetSortedGateways() {
+		gwNsName := client.ObjectKeyFromObject(gw.Source)
+
+		for _, l := range gw.Listeners {
+			if !l.Valid || l.Source.Protocol != v1.TLSProtocolType {
 				continue
 			}
 
-			var hostnames []string
+			listenerPassthroughServers = append(
+				listenerPassthroughServers,
+				buildListenerPassthroughServers(l, gwNsName, passthroughServersMap, &passthroughServerCount)...,
+			)
+		}
+	}
+
+	passthroughServers := make([]Layer4VirtualServer, 0, passthroughServerCount+len(listenerPassthroughServers))
 
-			for _, p := range r.ParentRefs {
-				if val, exist := p.Attachment.AcceptedHostnames[l.Name]; exist {
-					hostnames = val
-					break
-				}
-			}
+	for _, r := range passthroughServersMap {
+		passthroughServers = append(passthroughServers, r...)
+	}
 
-			if _, ok := passthroughServersMap[key]; !ok {
-				passthroughServersMap[key] = make([]Layer4VirtualServer, 0)
-			}
+	passthroughServers = append(passthroughServers, listenerPassthroughServers...)
 
-			passthroughServerCount += len(hostnames)
+	return passthroughServers
+}
 
-			for _, h := range hostnames {
-				if l.Source.Hostname != nil && h == string(*l.Source.Hostname) {
-					foundRouteMatchingListenerHostname = true
-				}
-				passthroughServersMap[key] = append(passthroughServersMap[key], Layer4VirtualServer{
-					Hostname:     h,
-					UpstreamName: r.Spec.BackendRef.ServicePortReference(),
-					Port:         int32(l.Source.Port),
-				})
-			}
+// buildListenerPassthroughServers adds the passthrough servers of the TLSRoutes attached to the Listener
+// to the passthroughServersMap. It returns the passthrough server of the Listener itself if no Route
+// matches the hostname of the Listener.
+func buildListenerPassthroughServers(
+	l *graph.Listener,
+	gwNsName types.NamespacedName,
+	passthroughServersMap map[graph.L4RouteKey][]Layer4VirtualServer,
+	passthroughServerCount *int,
+) []Layer4VirtualServer {
+	foundRouteMatchingListenerHostname := false
+	for key, r := range l.L4Routes {
+		if !r.Valid {
+			continue
 		}
-		if !foundRouteMatchingListenerHostname {
-			if l.Source.Hostname != nil {
-				listenerPassthroughServers = append(listenerPassthroughServers, Layer4VirtualServer{
-					Hostname:  string(*l.Source.Hostname),
-					IsDefault: true,
-					Port:      int32(l.Source.Port),
-				})
-			} else {
-				listenerPassthroughServers = append(listenerPassthroughServers, Layer4VirtualServer{
-					Hostname: "",
-					Port:     int32(l.Source.Port),
-				})
+
+		hostnames := getAcceptedHostnames(r.ParentRefs, gwNsName, l.Name)
+
+		if _, ok := passthroughServersMap[key]; !ok {
+			passthroughServersMap[key] = make([]Layer4VirtualServer, 0)
+		}
+
+		*passthroughServerCount += len(hostnames)
+
+		upstreams := buildLayer4Upstreams(r.Spec.BackendRefs)
+
+		for _, h := range hostnames {
+			if l.Source.Hostname != nil && h == string(*l.Source.Hostname) {
+				foundRouteMatchingListenerHostname = true
 			}
+			passthroughServersMap[key] = append(passthroughServersMap[key], Layer4VirtualServer{
+				Hostname:  h,
+				Upstreams: upstreams,
+				Port:      int32(l.Source.Port),
+			})
 		}
 	}
-	passthroughServers := make([]Layer4VirtualServer, 0, passthroughServerCount+len(listenerPassthroughServers))
 
-	for _, r := range passthroughServersMap {
-		passthroughServers = append(passthroughServers, r...)
+	if foundRouteMatchingListenerHostname {
+		return nil
 	}
 
-	passthroughServers = append(passthroughServers, listenerPassthroughServers...)
+	if l.Source.Hostname != nil {
+		return []Layer4VirtualServer{
+			{
+				Hostname:  string(*l.Source.Hostname),
+				IsDefault: true,
+				Port:      int32(l.Source.Port),
+			},
+		}
+	}
 
-	return passthroughServers
+	return []Layer4VirtualServer{
+		{
+			Hostname: "",
+			Port:     int32(l.Source.Port),
+		},
+	}
+}
+
+// buildLayer4Upstreams builds the weighted upstreams of a Layer4VirtualServer from the backendRefs of a Route.
+func buildLayer4Upstreams(refs []graph.BackendRef) []Layer4Upstream {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	upstreams := make([]Layer4Upstream, 0, len(refs))
+
+	for _, ref := range refs {
+		upstream := Layer4Upstream{
+			Name:   ref.ServicePortReference(),
+			Weight: ref.Weight,
+		}
+
+		if ref.Valid {
+			upstream.VerifyTLS = convertBackendTLS(ref.BackendTLSPolicy)
+		}
+
+		upstreams = append(upstreams, upstream)
+	}
+
+	return upstreams
 }
 
 // buildStreamUpstreams builds all stream upstreams.
@@ -160,31 +254,29 @@ func buildStreamUpstreams(
 				continue
 			}
 
-			br := route.Spec.BackendRef
-
-			if !br.Valid {
-				continue
-			}
+			for _, br := range route.Spec.BackendRefs {
+				if !br.Valid {
+					continue
+				}
 
-			upstreamName := br.ServicePortReference()
+				upstreamName := br.ServicePortReference()
 
-			if _, exist := uniqueUpstreams[upstreamName]; exist {
-				continue
-			}
+				if _, exist := uniqueUpstreams[upstreamName]; exist {
+					continue
+				}
 
-			var errMsg string
+				allowedAddressType := getAllowedAddressType(ipFamily)
 
-			allowedAddressType := getAllowedAddressType(ipFamily)
+				eps, errMsg := resolveBackendRefEndpoints(ctx, br, serviceResolver, allowedAddressType)
 
-			eps, err := serviceResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
-			if err != nil {
-				errMsg = err.Error()
-			}
+				// Connections to stream upstream servers cannot be drained, so terminating endpoints are not used.
+				eps, _ = splitTerminatingEndpoints(eps)
 
-			uniqueUpstreams[upstreamName] = Upstream{
-				Name:      upstreamName,
-				Endpoints: eps,
-				ErrorMsg:  errMsg,
+				uniqueUpstreams[upstreamName] = Upstream{
+					Name:      upstreamName,
+					Endpoints: eps,
+					ErrorMsg:  errMsg,
+				}
 			}
 		}
 	}
@@ -201,41 +293,85 @@ func buildStreamUpstreams(
 	return upstreams
 }
 
+// splitTerminatingEndpoints splits the endpoints into the ready and the terminating endpoints.
+func splitTerminatingEndpoints(eps []resolver.Endpoint) (ready, terminating []resolver.Endpoint) {
+	if !slices.ContainsFunc(eps, func(ep resolver.Endpoint) bool { return ep.Terminating }) {
+		return eps, nil
+	}
+
+	ready = make([]resolver.Endpoint, 0, len(eps))
+	for _, ep := range eps {
+		if ep.Terminating {
+			terminating = append(terminating, ep)
+		} else {
+			ready = append(ready, ep)
+		}
+	}
+
+	return ready, terminating
+}
+
 // buildSSLKeyPairs builds the SSLKeyPairs from the Secrets. It will only include Secrets that are referenced by
-// valid listeners, so that we don't include unused Secrets in the configuration of the data plane.
+// valid listeners or by Gateways as backend client certificates, so that we don't include unused Secrets
+// in the configuration of the data plane.
 func buildSSLKeyPairs(
 	secrets map[types.NamespacedName]*graph.Secret,
 	listeners []*graph.Listener,
+	gateways []*graph.Gateway,
 ) map[SSLKeyPairID]SSLKeyPair {
 	keyPairs := make(map[SSLKeyPairID]SSLKeyPair)
 
+	addKeyPair := func(nsname types.NamespacedName) {
+		secret := secrets[nsname]
+		// The Data map keys are guaranteed to exist by the graph package.
+		// the Source field is guaranteed to be non-nil by the graph package.
+		keyPairs[generateSSLKeyPairID(nsname)] = SSLKeyPair{
+			Cert: secret.Source.Data[apiv1.TLSCertKey],
+			Key:  secret.Source.Data[apiv1.TLSPrivateKeyKey],
+		}
+	}
+
 	for _, l := range listeners {
 		if l.Valid && l.ResolvedSecret != nil {
-			id := generateSSLKeyPairID(*l.ResolvedSecret)
-			secret := secrets[*l.ResolvedSecret]
-			// The Data map keys are guaranteed to exist by the graph package.
-			// the Source field is guaranteed to be non-nil by the graph package.
-			keyPairs[id] = SSLKeyPair{
-				Cert: secret.Source.Data[apiv1.TLSCertKey],
-				Key:  secret.Source.Data[apiv1.TLSPrivateKeyKey],
-			}
+			addKeyPair(*l.ResolvedSecret)
+		}
+	}
+
+	for _, gw := range gateways {
+		// The graph package only sets the client certificate for valid Gateways.
+		if gw.BackendClientCertificate != nil {
+			addKeyPair(*gw.BackendClientCertificate)
 		}
 	}
 
 	return keyPairs
 }
 
+// buildCertBundles builds the CertBundles of the valid BackendTLSPolicies that are referenced by valid backends
+// or by the upstreams of the TLS passthrough servers.
+// The CA certificates of all ConfigMaps referenced by a BackendTLSPolicy are concatenated into a single bundle.
 func buildCertBundles(
 	caCertConfigMaps map[types.NamespacedName]*graph.CaCertConfigMap,
+	backendTLSPolicies map[types.NamespacedName]*graph.BackendTLSPolicy,
 	backendGroups []BackendGroup,
+	passthroughServers []Layer4VirtualServer,
 ) map[CertBundleID]CertBundle {
 	bundles := make(map[CertBundleID]CertBundle)
 	refByBG := make(map[CertBundleID]struct{})
 
-	// We only need to build the cert bundles if there are valid backend groups that reference them.
-	if len(backendGroups) == 0 {
+	// We only need to build the cert bundles if there are valid backend groups or passthrough servers
+	// that reference them.
+	if len(backendGroups) == 0 && len(passthroughServers) == 0 {
 		return bundles
 	}
+	for _, s := range passthroughServers {
+		for _, u := range s.Upstreams {
+			if u.VerifyTLS == nil || u.VerifyTLS.CertBundleID == "" {
+				continue
+			}
+			refByBG[u.VerifyTLS.CertBundleID] = struct{}{}
+		}
+	}
 	for _, bg := range backendGroups {
 		if bg.Backends == nil {
 			continue
@@ -248,24 +384,55 @@ func buildCertBundles(
 		}
 	}
 
-	for cmName, cm := range caCertConfigMaps {
-		id := generateCertBundleID(cmName)
-		if _, exists := refByBG[id]; exists {
-			if cm.CACert != nil || len(cm.CACert) > 0 {
-				// the cert could be base64 encoded or plaintext
-				data := make([]byte, base64.StdEncoding.DecodedLen(len(cm.CACert)))
-				_, err := base64.StdEncoding.Decode(data, cm.CACert)
-				if err != nil {
-					data = cm.CACert
-				}
-				bundles[id] = data
+	for _, btp := range backendTLSPolicies {
+		if !btp.Valid || len(btp.CaCertRefs) == 0 {
+			continue
+		}
+
+		id := generateCertBundleIDForPolicy(btp)
+		if _, exists := refByBG[id]; !exists {
+			continue
+		}
+
+		var bundle CertBundle
+		for _, ref := range btp.CaCertRefs {
+			cm, exists := caCertConfigMaps[ref]
+			if !exists || len(cm.CACert) == 0 {
+				continue
 			}
+
+			bundle = appendCACert(bundle, decodeCACert(cm.CACert))
+		}
+
+		if len(bundle) > 0 {
+			bundles[id] = bundle
 		}
 	}
 
 	return bundles
 }
 
+// decodeCACert decodes the CA certificate of a ConfigMap. The cert could be base64 encoded or plaintext.
+func decodeCACert(cert []byte) []byte {
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(cert)))
+	n, err := base64.StdEncoding.Decode(data, cert)
+	if err != nil {
+		return cert
+	}
+
+	return data[:n]
+}
+
+// appendCACert appends the CA certificate to the bundle. The certificates are separated by a newline,
+// so that the PEM blocks of different certificates don't run together.
+func appendCACert(bundle CertBundle, cert []byte) CertBundle {
+	if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+		bundle = append(bundle, '\n')
+	}
+
+	return append(bundle, cert...)
+}
+
 func buildBackendGroups(servers []VirtualServer) []BackendGroup {
 	type key struct {
 		nsname  types.NamespacedName
@@ -312,11 +479,18 @@ func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName,
 	}
 
 	for _, ref := range refs {
+		appProtocol := ref.AppProtocol()
+		filters := createHTTPFilters(ref.Filters)
+
 		backends = append(backends, Backend{
-			UpstreamName: ref.ServicePortReference(),
-			Weight:       ref.Weight,
-			Valid:        ref.Valid,
-			VerifyTLS:    convertBackendTLS(ref.BackendTLSPolicy),
+			UpstreamName:            ref.ServicePortReference(),
+			Weight:                  ref.Weight,
+			Valid:                   ref.Valid,
+			VerifyTLS:               convertBackendTLS(ref.BackendTLSPolicy),
+			TLS:                     ref.UsesTLS(),
+			WebSocket:               appProtocol == graph.AppProtocolTypeWS || appProtocol == graph.AppProtocolTypeWSS,
+			RequestHeaderModifiers:  filters.RequestHeaderModifiers,
+			ResponseHeaderModifiers: filters.ResponseHeaderModifiers,
 		})
 	}
 
@@ -327,13 +501,47 @@ func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName,
 	}
 }
 
+// setCanaryWeights sets the weights of the canary and the stable backends of a rule to the weights
+// of the Canary that targets the rule, instead of the weights of the backendRefs in the HTTPRoute.
+func setCanaryWeights(backends []Backend, canary *graph.Canary) {
+	// The graph package ensures that the rule of a valid Canary has exactly two backends.
+	if canary == nil || len(backends) != 2 {
+		return
+	}
+
+	backends[canary.CanaryBackendIdx].Weight = canary.Weight
+	backends[1-canary.CanaryBackendIdx].Weight = maxCanaryWeight - canary.Weight
+}
+
+// buildCanaryMatch returns the CanaryMatch of the Canary that targets a rule, if the Canary has a match.
+func buildCanaryMatch(canary *graph.Canary) *CanaryMatch {
+	if canary == nil || canary.Source.Spec.Match == nil {
+		return nil
+	}
+
+	match := canary.Source.Spec.Match
+
+	canaryMatch := &CanaryMatch{
+		Value:      match.Value,
+		BackendIdx: canary.CanaryBackendIdx,
+	}
+
+	if match.Header != nil {
+		canaryMatch.Header = *match.Header
+	} else if match.Cookie != nil {
+		canaryMatch.Cookie = *match.Cookie
+	}
+
+	return canaryMatch
+}
+
 func convertBackendTLS(btp *graph.BackendTLSPolicy) *VerifyTLS {
 	if btp == nil || !btp.Valid {
 		return nil
 	}
 	verify := &VerifyTLS{}
-	if btp.CaCertRef.Name != "" {
-		verify.CertBundleID = generateCertBundleID(btp.CaCertRef)
+	if len(btp.CaCertRefs) > 0 {
+		verify.CertBundleID = generateCertBundleIDForPolicy(btp)
 	} else {
 		verify.RootCAPath = alpineSSLRootCAPath
 	}
@@ -347,18 +555,22 @@ func buildServers(g *graph.Graph) (http, ssl []VirtualServer) {
 		v1.HTTPSProtocolType: make(portPathRules),
 	}
 
-	for _, l := range g.Gateway.Listeners {
-		if l.Source.Protocol == v1.TLSProtocolType {
-			continue
-		}
-		if l.Valid {
-			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
-			if rules == nil {
-				rules = newHostPathRules()
-				rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
+	gateways := g.GetSortedGateways()
+
+	for _, gw := range gateways {
+		for _, l := range gw.Listeners {
+			if l.Source.Protocol == v1.TLSProtocolType {
+				continue
 			}
+			if l.Valid {
+				rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
+				if rules == nil {
+					rules = newHostPathRules()
+					rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
+				}
 
-			rules.upsertListener(l)
+				rules.upsertListener(l, gw)
+			}
 		}
 	}
 
@@ -367,17 +579,181 @@ func buildServers(g *graph.Graph) (http, ssl []VirtualServer) {
 
 	httpServers, sslServers := httpRules.buildServers(), sslRules.buildServers()
 
-	pols := buildPolicies(g.Gateway.Policies)
+	httpServers = append(httpServers, buildHTTPSRedirectServersForGateways(gateways, httpServers)...)
+
+	setDefaultServerActions(httpServers, sslServers, gateways)
+
+	return httpServers, sslServers
+}
+
+// buildHTTPSRedirectServersForGateways builds the HTTPS redirect servers of all Gateways.
+// A redirect server is skipped if another server already serves its hostname and port.
+func buildHTTPSRedirectServersForGateways(gateways []*graph.Gateway, httpServers []VirtualServer) []VirtualServer {
+	type hostPort struct {
+		hostname string
+		port     int32
+	}
+
+	existing := make(map[hostPort]struct{}, len(httpServers))
+	for _, s := range httpServers {
+		if !s.IsDefault {
+			existing[hostPort{hostname: s.Hostname, port: s.Port}] = struct{}{}
+		}
+	}
+
+	var servers []VirtualServer
+
+	for _, gw := range gateways {
+		for _, s := range buildHTTPSRedirectServers(gw.HTTPSRedirect) {
+			key := hostPort{hostname: s.Hostname, port: s.Port}
+			if _, exists := existing[key]; exists {
+				continue
+			}
+
+			existing[key] = struct{}{}
+			servers = append(servers, s)
+		}
+	}
+
+	return servers
+}
+
+// setDefaultServerActions sets the actions of the default servers that are configured for the Gateways.
+// If default servers of multiple Gateways use the same port, the default server of the first Gateway is used.
+// The default SSL server uses the certificate of the Listener that its action is configured for.
+// If that Listener doesn't have a certificate, the action is not set, so the TLS handshake is rejected.
+func setDefaultServerActions(httpServers, sslServers []VirtualServer, gateways []*graph.Gateway) {
+	type gatewayDefaultServer struct {
+		gw *graph.Gateway
+		ds graph.DefaultServer
+	}
+
+	defaultServers := make(map[int32]gatewayDefaultServer)
+	for _, gw := range gateways {
+		for _, ds := range gw.DefaultServers {
+			if _, exists := defaultServers[int32(ds.Port)]; !exists {
+				defaultServers[int32(ds.Port)] = gatewayDefaultServer{gw: gw, ds: ds}
+			}
+		}
+	}
+
+	if len(defaultServers) == 0 {
+		return
+	}
 
 	for i := range httpServers {
-		httpServers[i].Policies = pols
+		if !httpServers[i].IsDefault {
+			continue
+		}
+
+		if gwds, exists := defaultServers[httpServers[i].Port]; exists {
+			httpServers[i].DefaultAction = buildDefaultServerAction(gwds.ds)
+		}
 	}
 
 	for i := range sslServers {
-		sslServers[i].Policies = pols
+		if !sslServers[i].IsDefault {
+			continue
+		}
+
+		gwds, exists := defaultServers[sslServers[i].Port]
+		if !exists {
+			continue
+		}
+
+		for _, l := range gwds.gw.Listeners {
+			if l.Name != gwds.ds.ListenerName || l.ResolvedSecret == nil {
+				continue
+			}
+
+			sslServers[i].SSL = &SSL{
+				KeyPairID: generateSSLKeyPairID(*l.ResolvedSecret),
+			}
+			sslServers[i].DefaultAction = buildDefaultServerAction(gwds.ds)
+
+			break
+		}
 	}
+}
 
-	return httpServers, sslServers
+func buildDefaultServerAction(ds graph.DefaultServer) *DefaultServerAction {
+	switch {
+	case ds.Action.Return != nil:
+		ret := &DefaultServerReturn{
+			Code: int(ds.Action.Return.StatusCode),
+		}
+
+		if ds.Action.Return.Body != nil {
+			ret.Body = *ds.Action.Return.Body
+		}
+
+		if ds.Action.Return.ContentType != nil {
+			ret.ContentType = string(*ds.Action.Return.ContentType)
+		}
+
+		return &DefaultServerAction{Return: ret}
+	case ds.Action.Redirect != nil:
+		code := defaultServerRedirectStatusCode
+		if ds.Action.Redirect.StatusCode != nil {
+			code = int(*ds.Action.Redirect.StatusCode)
+		}
+
+		return &DefaultServerAction{
+			Return: &DefaultServerReturn{
+				Code: code,
+				Body: ds.Action.Redirect.URL,
+			},
+		}
+	case ds.Action.BackendRef != nil:
+		if ds.BackendRef == nil || !ds.BackendRef.Valid {
+			// same as for Routes, NGINX returns 500 if the backend is invalid
+			return &DefaultServerAction{
+				Return: &DefaultServerReturn{Code: invalidBackendStatusCode},
+			}
+		}
+
+		return &DefaultServerAction{UpstreamName: ds.BackendRef.ServicePortReference()}
+	default:
+		return nil
+	}
+}
+
+// buildAdditionalBackendRefs returns the backends that are not referenced by Routes, but need upstreams.
+func buildAdditionalBackendRefs(g *graph.Graph) []graph.BackendRef {
+	backendRefs := make([]graph.BackendRef, 0, len(g.ErrorPageBackendRefs))
+	backendRefs = append(backendRefs, g.ErrorPageBackendRefs...)
+
+	for _, gw := range g.GetSortedGateways() {
+		for _, ds := range gw.DefaultServers {
+			if ds.BackendRef != nil {
+				backendRefs = append(backendRefs, *ds.BackendRef)
+			}
+		}
+	}
+
+	return backendRefs
+}
+
+// buildHTTPSRedirectServers builds the servers that redirect HTTP requests to HTTPS.
+func buildHTTPSRedirectServers(redirect *graph.HTTPSRedirect) []VirtualServer {
+	if redirect == nil {
+		return nil
+	}
+
+	servers := make([]VirtualServer, 0, len(redirect.Redirects))
+
+	for _, r := range redirect.Redirects {
+		servers = append(servers, VirtualServer{
+			Hostname: r.Hostname,
+			Port:     int32(r.HTTPPort),
+			HTTPSRedirect: &HTTPSRedirect{
+				Port:       int32(r.HTTPSPort),
+				StatusCode: redirect.StatusCode,
+			},
+		})
+	}
+
+	return servers
 }
 
 // portPathRules keeps track of hostPathRules per port.
@@ -406,22 +782,34 @@ type pathAndType struct {
 type hostPathRules struct {
 	rulesPerHost     map[string]map[pathAndType]PathRule
 	listenersForHost map[string]*graph.Listener
-	httpsListeners   []*graph.Listener
-	port             int32
-	listenersExist   bool
+	// gatewaysForListener holds the Gateway of every Listener. The Policies of the Gateway
+	// apply to the servers of its Listeners.
+	gatewaysForListener map[*graph.Listener]*graph.Gateway
+	// defaultServerGateway is the Gateway of the first Listener on the port. Its Policies apply to the
+	// default server.
+	defaultServerGateway *graph.Gateway
+	httpsListeners       []*graph.Listener
+	port                 int32
+	listenersExist       bool
 }
 
 func newHostPathRules() *hostPathRules {
 	return &hostPathRules{
-		rulesPerHost:     make(map[string]map[pathAndType]PathRule),
-		listenersForHost: make(map[string]*graph.Listener),
-		httpsListeners:   make([]*graph.Listener, 0),
+		rulesPerHost:        make(map[string]map[pathAndType]PathRule),
+		listenersForHost:    make(map[string]*graph.Listener),
+		gatewaysForListener: make(map[*graph.Listener]*graph.Gateway),
+		httpsListeners:      make([]*graph.Listener, 0),
 	}
 }
 
-func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
+func (hpr *hostPathRules) upsertListener(l *graph.Listener, gw *graph.Gateway) {
 	hpr.listenersExist = true
 	hpr.port = int32(l.Source.Port)
+	hpr.gatewaysForListener[l] = gw
+
+	if hpr.defaultServerGateway == nil {
+		hpr.defaultServerGateway = gw
+	}
 
 	if l.Source.Protocol == v1.HTTPSProtocolType {
 		hpr.httpsListeners = append(hpr.httpsListeners, l)
@@ -432,15 +820,15 @@ func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
 			continue
 		}
 
-		hpr.upsertRoute(r, l)
+		hpr.upsertRoute(r, l, client.ObjectKeyFromObject(gw.Source))
 	}
 }
 
 func (hpr *hostPathRules) upsertRoute(
 	route *graph.L7Route,
 	listener *graph.Listener,
+	gwNsName types.NamespacedName,
 ) {
-	var hostnames []string
 	GRPC := route.RouteType == graph.RouteTypeGRPC
 
 	var objectSrc *metav1.ObjectMeta
@@ -451,12 +839,7 @@ func (hpr *hostPathRules) upsertRoute(
 		objectSrc = &helpers.MustCastObject[*v1.HTTPRoute](route.Source).ObjectMeta
 	}
 
-	for _, p := range route.ParentRefs {
-		if val, exist := p.Attachment.AcceptedHostnames[string(listener.Source.Name)]; exist {
-			hostnames = val
-			break
-		}
-	}
+	hostnames := getAcceptedHostnames(route.ParentRefs, gwNsName, string(listener.Source.Name))
 
 	for _, h := range hostnames {
 		if prevListener, exists := hpr.listenersForHost[h]; exists {
@@ -488,6 +871,7 @@ func (hpr *hostPathRules) upsertRoute(
 		}
 
 		pols := buildPolicies(route.Policies)
+		canaryMatch := buildCanaryMatch(rule.Canary)
 
 		for _, h := range hostnames {
 			for _, m := range rule.Matches {
@@ -509,9 +893,13 @@ func (hpr *hostPathRules) upsertRoute(
 				hostRule.GRPC = GRPC
 				hostRule.Policies = append(hostRule.Policies, pols...)
 
+				backendGroup := newBackendGroup(rule.BackendRefs, routeNsName, i)
+				backendGroup.CanaryMatch = canaryMatch
+				setCanaryWeights(backendGroup.Backends, rule.Canary)
+
 				hostRule.MatchRules = append(hostRule.MatchRules, MatchRule{
 					Source:       objectSrc,
-					BackendGroup: newBackendGroup(rule.BackendRefs, routeNsName, i),
+					BackendGroup: backendGroup,
 					Filters:      filters,
 					Match:        convertMatch(m),
 				})
@@ -543,6 +931,9 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			}
 		}
 
+		s.Policies = hpr.buildGatewayPolicies(l)
+		s.BackendClientCertificate = hpr.buildBackendClientCertificate(l)
+
 		for _, r := range rules {
 			sortMatchRules(r.MatchRules)
 
@@ -569,6 +960,7 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 			s := VirtualServer{
 				Hostname: hostname,
 				Port:     hpr.port,
+				Policies: hpr.buildGatewayPolicies(l),
 			}
 
 			if l.ResolvedSecret != nil {
@@ -583,9 +975,15 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 
 	// if any listeners exist, we need to generate a default server block.
 	if hpr.listenersExist {
+		var pols []policies.Policy
+		if hpr.defaultServerGateway != nil {
+			pols = buildPolicies(hpr.defaultServerGateway.Policies)
+		}
+
 		servers = append(servers, VirtualServer{
 			IsDefault: true,
 			Port:      hpr.port,
+			Policies:  pols,
 		})
 	}
 
@@ -597,6 +995,29 @@ func (hpr *hostPathRules) buildServers() []VirtualServer {
 	return servers
 }
 
+// buildGatewayPolicies builds the Policies of the Gateway of the Listener.
+func (hpr *hostPathRules) buildGatewayPolicies(l *graph.Listener) []policies.Policy {
+	gw, exists := hpr.gatewaysForListener[l]
+	if !exists {
+		return nil
+	}
+
+	return buildPolicies(gw.Policies)
+}
+
+// buildBackendClientCertificate returns the client certificate that the server presents to TLS backends.
+// The certificate is configured by the Gateway of the Listener.
+func (hpr *hostPathRules) buildBackendClientCertificate(l *graph.Listener) *SSL {
+	gw, exists := hpr.gatewaysForListener[l]
+	if !exists || gw.BackendClientCertificate == nil {
+		return nil
+	}
+
+	return &SSL{
+		KeyPairID: generateSSLKeyPairID(*gw.BackendClientCertificate),
+	}
+}
+
 // maxServerCount returns the maximum number of VirtualServers that can be built from the host path rules.
 func (hpr *hostPathRules) maxServerCount() int {
 	// to calculate max # of servers we add up:
@@ -606,9 +1027,37 @@ func (hpr *hostPathRules) maxServerCount() int {
 	return len(hpr.rulesPerHost) + len(hpr.httpsListeners) + 1
 }
 
+// resolveBackendRefEndpoints returns the endpoints of the Service of the BackendRef, or the error message
+// if the endpoints can't be resolved.
+// The endpoint of an ExternalName Service is its external hostname, which NGINX resolves at runtime.
+func resolveBackendRefEndpoints(
+	ctx context.Context,
+	br graph.BackendRef,
+	svcResolver resolver.ServiceResolver,
+	allowedAddressType []discoveryV1.AddressType,
+) ([]resolver.Endpoint, string) {
+	if br.ExternalName != "" {
+		return []resolver.Endpoint{
+			{
+				Address: br.ExternalName,
+				Port:    br.ServicePort.Port,
+				Resolve: true,
+			},
+		}, ""
+	}
+
+	eps, err := svcResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
+	if err != nil {
+		return eps, err.Error()
+	}
+
+	return eps, ""
+}
+
 func buildUpstreams(
 	ctx context.Context,
 	listeners []*graph.Listener,
+	additionalBackendRefs []graph.BackendRef,
 	svcResolver resolver.ServiceResolver,
 	referencedServices map[types.NamespacedName]*graph.ReferencedService,
 	ipFamily IPFamilyType,
@@ -620,6 +1069,37 @@ func buildUpstreams(
 	// We need to build endpoints based on the IPFamily of NGINX.
 	allowedAddressType := getAllowedAddressType(ipFamily)
 
+	addUpstream := func(br graph.BackendRef) {
+		if !br.Valid {
+			return
+		}
+
+		upstreamName := br.ServicePortReference()
+		if _, exist := uniqueUpstreams[upstreamName]; exist {
+			return
+		}
+
+		eps, errMsg := resolveBackendRefEndpoints(ctx, br, svcResolver, allowedAddressType)
+
+		eps, terminatingEps := splitTerminatingEndpoints(eps)
+
+		var upstreamPolicies []policies.Policy
+		var sessionPersistence *SessionPersistence
+		if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
+			upstreamPolicies = buildPolicies(graphSvc.Policies)
+			sessionPersistence = buildSessionPersistence(graphSvc.BackendLBPolicy)
+		}
+
+		uniqueUpstreams[upstreamName] = Upstream{
+			Name:                 upstreamName,
+			Endpoints:            eps,
+			TerminatingEndpoints: terminatingEps,
+			ErrorMsg:             errMsg,
+			Policies:             upstreamPolicies,
+			SessionPersistence:   sessionPersistence,
+		}
+	}
+
 	for _, l := range listeners {
 		if !l.Valid {
 			continue
@@ -636,38 +1116,17 @@ func buildUpstreams(
 					continue
 				}
 				for _, br := range rule.BackendRefs {
-					if br.Valid {
-						upstreamName := br.ServicePortReference()
-						_, exist := uniqueUpstreams[upstreamName]
-
-						if exist {
-							continue
-						}
-
-						var errMsg string
-
-						eps, err := svcResolver.Resolve(ctx, br.SvcNsName, br.ServicePort, allowedAddressType)
-						if err != nil {
-							errMsg = err.Error()
-						}
-
-						var upstreamPolicies []policies.Policy
-						if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
-							upstreamPolicies = buildPolicies(graphSvc.Policies)
-						}
-
-						uniqueUpstreams[upstreamName] = Upstream{
-							Name:      upstreamName,
-							Endpoints: eps,
-							ErrorMsg:  errMsg,
-							Policies:  upstreamPolicies,
-						}
-					}
+					addUpstream(br)
 				}
 			}
 		}
 	}
 
+	// error pages and default servers can be served by backends that are not referenced by any Route
+	for _, br := range additionalBackendRefs {
+		addUpstream(br)
+	}
+
 	if len(uniqueUpstreams) == 0 {
 		return nil
 	}
@@ -680,6 +1139,40 @@ func buildUpstreams(
 	return upstreams
 }
 
+// buildSessionPersistence builds the SessionPersistence of an Upstream from the BackendLBPolicy that targets
+// the Service of the Upstream.
+func buildSessionPersistence(policy *graph.BackendLBPolicy) *SessionPersistence {
+	if policy == nil || !policy.Valid || policy.Source.Spec.SessionPersistence == nil {
+		return nil
+	}
+
+	sp := policy.Source.Spec.SessionPersistence
+
+	if sp.Type != nil && *sp.Type == v1.HeaderBasedSessionPersistence {
+		return &SessionPersistence{
+			Name: *sp.SessionName,
+			Type: SessionPersistenceHeader,
+		}
+	}
+
+	sessionPersistence := &SessionPersistence{
+		Name: defaultSessionCookieName,
+		Type: SessionPersistenceCookie,
+	}
+
+	if sp.SessionName != nil {
+		sessionPersistence.Name = *sp.SessionName
+	}
+
+	permanent := sp.CookieConfig != nil && sp.CookieConfig.LifetimeType != nil &&
+		*sp.CookieConfig.LifetimeType == v1.PermanentCookieLifetimeType
+	if permanent && sp.AbsoluteTimeout != nil {
+		sessionPersistence.Expires = string(*sp.AbsoluteTimeout)
+	}
+
+	return sessionPersistence
+}
+
 func getAllowedAddressType(ipFamily IPFamilyType) []discoveryV1.AddressType {
 	switch ipFamily {
 	case IPv4:
@@ -767,6 +1260,75 @@ func generateSSLKeyPairID(secret types.NamespacedName) SSLKeyPairID {
 	return SSLKeyPairID(fmt.Sprintf("ssl_keypair_%s_%s", secret.Namespace, secret.Name))
 }
 
+// buildErrorPageBodies builds the error page bodies for all valid ErrorPagePolicies.
+func buildErrorPageBodies(
+	pols map[graph.PolicyKey]*graph.Policy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) []ErrorPageBody {
+	type bodyKey struct {
+		configMap types.NamespacedName
+		key       string
+	}
+
+	uniqueBodies := make(map[bodyKey]ErrorPageBody)
+
+	for _, pol := range pols {
+		epp, ok := pol.Source.(*ngfAPIv1alpha1.ErrorPagePolicy)
+		if !ok || !pol.Valid {
+			continue
+		}
+
+		for _, page := range epp.Spec.ErrorPages {
+			if page.Response == nil {
+				continue
+			}
+
+			key := bodyKey{
+				configMap: types.NamespacedName{
+					Namespace: epp.Namespace,
+					Name:      string(page.Response.ConfigMapRef.Name),
+				},
+				key: page.Response.ConfigMapRef.Key,
+			}
+
+			cm, exists := configMaps[key.configMap]
+			if !exists || cm == nil {
+				continue
+			}
+
+			content, exists := graph.GetErrorPageBody(cm, key.key)
+			if !exists {
+				continue
+			}
+
+			uniqueBodies[key] = ErrorPageBody{
+				ConfigMap: key.configMap,
+				Key:       key.key,
+				Content:   content,
+			}
+		}
+	}
+
+	if len(uniqueBodies) == 0 {
+		return nil
+	}
+
+	bodies := make([]ErrorPageBody, 0, len(uniqueBodies))
+	for _, body := range uniqueBodies {
+		bodies = append(bodies, body)
+	}
+
+	sort.Slice(bodies, func(i, j int) bool {
+		if bodies[i].ConfigMap != bodies[j].ConfigMap {
+			return bodies[i].ConfigMap.String() < bodies[j].ConfigMap.String()
+		}
+
+		return bodies[i].Key < bodies[j].Key
+	})
+
+	return bodies
+}
+
 // generateCertBundleID generates an ID for the certificate bundle based on the ConfigMap namespaced name.
 // It is guaranteed to be unique per unique namespaced name.
 // The ID is safe to use as a file name.
@@ -774,6 +1336,17 @@ func generateCertBundleID(configMap types.NamespacedName) CertBundleID {
 	return CertBundleID(fmt.Sprintf("cert_bundle_%s_%s", configMap.Namespace, configMap.Name))
 }
 
+// generateCertBundleIDForPolicy generates the CertBundleID of a BackendTLSPolicy. A policy with a single
+// CA certificate ref uses the bundle of that ConfigMap, so that policies referencing the same ConfigMap
+// share a bundle. A policy with multiple refs gets its own bundle with the concatenated certificates.
+func generateCertBundleIDForPolicy(btp *graph.BackendTLSPolicy) CertBundleID {
+	if len(btp.CaCertRefs) == 1 {
+		return generateCertBundleID(btp.CaCertRefs[0])
+	}
+
+	return CertBundleID(fmt.Sprintf("cert_bundle_policy_%s_%s", btp.Source.Namespace, btp.Source.Name))
+}
+
 // buildTelemetry generates the Otel configuration.
 func buildTelemetry(g *graph.Graph) Telemetry {
 	if g.NginxProxy == nil || !g.NginxProxy.Valid ||
@@ -782,7 +1355,7 @@ func buildTelemetry(g *graph.Graph) Telemetry {
 		return Telemetry{}
 	}
 
-	serviceName := fmt.Sprintf("ngf:%s:%s", g.Gateway.Source.Namespace, g.Gateway.Source.Name)
+	serviceName := buildTelemetryServiceName(g)
 	telemetry := g.NginxProxy.Source.Spec.Telemetry
 	if telemetry.ServiceName != nil {
 		serviceName = serviceName + ":" + *telemetry.ServiceName
@@ -826,6 +1399,18 @@ func buildTelemetry(g *graph.Graph) Telemetry {
 	return tel
 }
 
+// buildTelemetryServiceName returns the service name prefix of the traces. It identifies the Gateway
+// if NGINX serves a single Gateway, otherwise the GatewayClass of the Gateways.
+func buildTelemetryServiceName(g *graph.Graph) string {
+	if len(g.Gateways) == 1 {
+		for _, gw := range g.Gateways {
+			return fmt.Sprintf("ngf:%s:%s", gw.Source.Namespace, gw.Source.Name)
+		}
+	}
+
+	return fmt.Sprintf("ngf:%s", g.GatewayClass.Source.Name)
+}
+
 func setSpanAttributes(spanAttributes []ngfAPIv1alpha1.SpanAttribute) []SpanAttribute {
 	spanAttrs := make([]SpanAttribute, 0, len(spanAttributes))
 	for _, spanAttr := range spanAttributes {
@@ -861,6 +1446,11 @@ func buildBaseHTTPConfig(g *graph.Graph) BaseHTTPConfig {
 		baseConfig.HTTP2 = false
 	}
 
+	if g.NginxProxy.Source.Spec.GRPC != nil {
+		baseConfig.GRPCWeb = g.NginxProxy.Source.Spec.GRPC.EnableWeb
+		baseConfig.GRPCHealthCheck = g.NginxProxy.Source.Spec.GRPC.EnableHealthCheck
+	}
+
 	if g.NginxProxy.Source.Spec.IPFamily != nil {
 		switch *g.NginxProxy.Source.Spec.IPFamily {
 		case ngfAPIv1alpha1.IPv4:
@@ -972,6 +1562,32 @@ func buildLogging(g *graph.Graph) Logging {
 	return logSettings
 }
 
+func buildDNSResolver(g *graph.Graph) *DNSResolverConfig {
+	if g.NginxProxy == nil || !g.NginxProxy.Valid || g.NginxProxy.Source.Spec.DNSResolver == nil {
+		return nil
+	}
+
+	dnsResolver := g.NginxProxy.Source.Spec.DNSResolver
+
+	resolverConfig := &DNSResolverConfig{
+		Addresses: make([]string, 0, len(dnsResolver.Addresses)),
+	}
+
+	for _, addr := range dnsResolver.Addresses {
+		resolverConfig.Addresses = append(resolverConfig.Addresses, addr.Value)
+	}
+
+	if dnsResolver.Timeout != nil {
+		resolverConfig.Timeout = string(*dnsResolver.Timeout)
+	}
+
+	if dnsResolver.CacheTTL != nil {
+		resolverConfig.CacheTTL = string(*dnsResolver.CacheTTL)
+	}
+
+	return resolverConfig
+}
+
 func buildAuxiliarySecrets(
 	secrets map[types.NamespacedName][]graph.PlusSecretFile,
 ) map[graph.SecretFileType][]byte {
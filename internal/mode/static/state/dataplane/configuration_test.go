@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	apiv1 "k8s.io/api/core/v1"
@@ -34,8 +35,8 @@ func getNormalBackendRef() graph.BackendRef {
 	return graph.BackendRef{
 		SvcNsName:   types.NamespacedName{Name: "foo", Namespace: "test"},
 		ServicePort: apiv1.ServicePort{Port: 80},
-		Valid:       true,
 		Weight:      1,
+		Valid:       true,
 	}
 }
 
@@ -69,15 +70,24 @@ func getExpectedConfiguration() Configuration {
 	}
 }
 
+var gatewayNsName = types.NamespacedName{Namespace: "test", Name: "gateway"}
+
 func getNormalGraph() *graph.Graph {
 	return &graph.Graph{
 		GatewayClass: &graph.GatewayClass{
 			Source: &v1.GatewayClass{},
 			Valid:  true,
 		},
-		Gateway: &graph.Gateway{
-			Source:    &v1.Gateway{},
-			Listeners: []*graph.Listener{},
+		Gateways: map[types.NamespacedName]*graph.Gateway{
+			gatewayNsName: {
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: gatewayNsName.Namespace,
+						Name:      gatewayNsName.Name,
+					},
+				},
+				Listeners: []*graph.Listener{},
+			},
 		},
 		Routes:                     map[graph.RouteKey]*graph.L7Route{},
 		ReferencedSecrets:          map[types.NamespacedName]*graph.Secret{},
@@ -254,6 +264,7 @@ func TestBuildConfiguration(t *testing.T) {
 			Valid: true,
 			ParentRefs: []graph.ParentRef{
 				{
+					Gateway: gatewayNsName,
 					Attachment: &graph.ParentRefAttachmentStatus{
 						AcceptedHostnames: map[string][]string{
 							listenerName: hostnames,
@@ -484,25 +495,29 @@ func TestBuildConfiguration(t *testing.T) {
 	tlsTR1 := graph.L4Route{
 		Spec: graph.L4RouteSpec{
 			Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-			BackendRef: graph.BackendRef{
-				SvcNsName: types.NamespacedName{
-					Namespace: "default",
-					Name:      "secure-app",
-				},
-				ServicePort: apiv1.ServicePort{
-					Name:     "https",
-					Protocol: "TCP",
-					Port:     8443,
-					TargetPort: intstr.IntOrString{
-						Type:   intstr.Int,
-						IntVal: 8443,
+			BackendRefs: []graph.BackendRef{
+				{
+					SvcNsName: types.NamespacedName{
+						Namespace: "default",
+						Name:      "secure-app",
+					},
+					ServicePort: apiv1.ServicePort{
+						Name:     "https",
+						Protocol: "TCP",
+						Port:     8443,
+						TargetPort: intstr.IntOrString{
+							Type:   intstr.Int,
+							IntVal: 8443,
+						},
 					},
+					Weight: 1,
+					Valid:  true,
 				},
-				Valid: true,
 			},
 		},
 		ParentRefs: []graph.ParentRef{
 			{
+				Gateway: gatewayNsName,
 				Attachment: &graph.ParentRefAttachmentStatus{
 					AcceptedHostnames: map[string][]string{
 						"listener-443-2": {"app.example.com"},
@@ -510,6 +525,7 @@ func TestBuildConfiguration(t *testing.T) {
 				},
 			},
 			{
+				Gateway: gatewayNsName,
 				Attachment: &graph.ParentRefAttachmentStatus{
 					AcceptedHostnames: map[string][]string{
 						"listener-444-3": {"app.example.com"},
@@ -522,8 +538,8 @@ func TestBuildConfiguration(t *testing.T) {
 
 	invalidBackendRefTR2 := graph.L4Route{
 		Spec: graph.L4RouteSpec{
-			Hostnames:  []v1.Hostname{"test.example.com"},
-			BackendRef: graph.BackendRef{},
+			Hostnames:   []v1.Hostname{"test.example.com"},
+			BackendRefs: []graph.BackendRef{{}},
 		},
 		Valid: true,
 	}
@@ -580,8 +596,8 @@ func TestBuildConfiguration(t *testing.T) {
 				},
 			},
 		},
-		CaCertRef: types.NamespacedName{Namespace: "test", Name: "configmap-1"},
-		Valid:     true,
+		CaCertRefs: []types.NamespacedName{{Namespace: "test", Name: "configmap-1"}},
+		Valid:      true,
 	}
 
 	expHTTPSHR8Groups[0].Backends[0].VerifyTLS = &VerifyTLS{
@@ -634,8 +650,8 @@ func TestBuildConfiguration(t *testing.T) {
 				},
 			},
 		},
-		CaCertRef: types.NamespacedName{Namespace: "test", Name: "configmap-2"},
-		Valid:     true,
+		CaCertRefs: []types.NamespacedName{{Namespace: "test", Name: "configmap-2"}},
+		Valid:      true,
 	}
 
 	expHTTPSHR9Groups[0].Backends[0].VerifyTLS = &VerifyTLS{
@@ -882,7 +898,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -898,7 +914,42 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+				})
+				g.Gateways[gatewayNsName].HTTPSRedirect = &graph.HTTPSRedirect{
+					Redirects: []graph.HTTPSRedirectHost{
+						{Hostname: "foo.example.com", HTTPPort: 80, HTTPSPort: 443},
+						{Hostname: "bar.example.com", HTTPPort: 80, HTTPSPort: 8443},
+					},
+					StatusCode: 308,
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.HTTPServers = append(conf.HTTPServers, []VirtualServer{
+					{
+						Hostname:      "foo.example.com",
+						Port:          80,
+						HTTPSRedirect: &HTTPSRedirect{Port: 443, StatusCode: 308},
+					},
+					{
+						Hostname:      "bar.example.com",
+						Port:          80,
+						HTTPSRedirect: &HTTPSRedirect{Port: 8443, StatusCode: 308},
+					},
+				}...)
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				return conf
+			}),
+			msg: "http listener with https redirects",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-80-1",
 						Source: listener80,
@@ -937,7 +988,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:           "listener-443-1",
 						Source:         listener443, // nil hostname
@@ -983,7 +1034,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:           "invalid-listener",
 					Source:         invalidListener,
 					Valid:          false,
@@ -1006,7 +1057,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -1067,7 +1118,58 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						graph.CreateRouteKey(hr1): routeHR1,
+					},
+				})
+				g.Gateways[gatewayNsName].BackendClientCertificate = &secret2NsName
+				g.Routes = map[graph.RouteKey]*graph.L7Route{
+					graph.CreateRouteKey(hr1): routeHR1,
+				}
+				g.ReferencedSecrets = map[types.NamespacedName]*graph.Secret{
+					secret2NsName: secret2,
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.HTTPServers = append(conf.HTTPServers, VirtualServer{
+					Hostname: "foo.example.com",
+					PathRules: []PathRule{
+						{
+							Path:     "/",
+							PathType: PathTypePrefix,
+							MatchRules: []MatchRule{
+								{
+									BackendGroup: expHR1Groups[0],
+									Source:       &hr1.ObjectMeta,
+								},
+							},
+						},
+					},
+					BackendClientCertificate: &SSL{KeyPairID: "ssl_keypair_test_secret-2"},
+					Port:                     80,
+				})
+				conf.SSLServers = []VirtualServer{}
+				conf.Upstreams = []Upstream{fooUpstream}
+				conf.BackendGroups = []BackendGroup{expHR1Groups[0]}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{
+					"ssl_keypair_test_secret-2": {
+						Cert: []byte("cert-2"),
+						Key:  []byte("privateKey-2"),
+					},
+				}
+
+				return conf
+			}),
+			msg: "one http listener with a route and a gateway backend client certificate",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -1107,7 +1209,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-443-1",
 						Source: listener443,
@@ -1217,7 +1319,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-80-1",
 						Source: listener80,
@@ -1357,7 +1459,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-80-1",
 						Source: listener80,
@@ -1551,7 +1653,7 @@ func TestBuildConfiguration(t *testing.T) {
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				g.GatewayClass.Valid = false
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -1570,7 +1672,7 @@ func TestBuildConfiguration(t *testing.T) {
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
 				g.GatewayClass.Valid = false
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -1588,7 +1690,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway = nil
+				g.Gateways = nil
 				return g
 			}),
 			expConf: Configuration{Logging: Logging{ErrorLevel: defaultErrorLogLevel}},
@@ -1596,7 +1698,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -1655,7 +1757,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-80-1",
 						Source: listener80,
@@ -1770,27 +1872,25 @@ func TestBuildConfiguration(t *testing.T) {
 				}
 				conf.TLSPassthroughServers = []Layer4VirtualServer{
 					{
-						Hostname:     "app.example.com",
-						UpstreamName: "default_secure-app_8443",
-						Port:         443,
+						Hostname:  "app.example.com",
+						Upstreams: []Layer4Upstream{{Name: "default_secure-app_8443", Weight: 1}},
+						Port:      443,
 					},
 					{
-						Hostname:     "*.example.com",
-						UpstreamName: "",
-						Port:         443,
-						IsDefault:    true,
+						Hostname:  "*.example.com",
+						Port:      443,
+						IsDefault: true,
 					},
 					{
-						Hostname:     "app.example.com",
-						UpstreamName: "default_secure-app_8443",
-						Port:         444,
-						IsDefault:    false,
+						Hostname:  "app.example.com",
+						Upstreams: []Layer4Upstream{{Name: "default_secure-app_8443", Weight: 1}},
+						Port:      444,
+						IsDefault: false,
 					},
 					{
-						Hostname:     "",
-						UpstreamName: "",
-						Port:         443,
-						IsDefault:    false,
+						Hostname:  "",
+						Port:      443,
+						IsDefault: false,
 					},
 				}
 				return conf
@@ -1799,7 +1899,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -1851,7 +1951,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-443-with-hostname",
 						Source: listener443WithHostname,
@@ -1929,7 +2029,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-443",
 					Source: listener443,
 					Valid:  true,
@@ -1945,6 +2045,10 @@ func TestBuildConfiguration(t *testing.T) {
 					secret1NsName: secret1,
 				}
 				g.ReferencedCaCertConfigMaps = referencedConfigMaps
+				btp := httpsRouteHR8.Spec.Rules[0].BackendRefs[0].BackendTLSPolicy
+				g.BackendTLSPolicies = map[types.NamespacedName]*graph.BackendTLSPolicy{
+					client.ObjectKeyFromObject(btp.Source): btp,
+				}
 				return g
 			}),
 			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
@@ -1988,7 +2092,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-443",
 					Source: listener443,
 					Valid:  true,
@@ -2004,6 +2108,10 @@ func TestBuildConfiguration(t *testing.T) {
 					secret1NsName: secret1,
 				}
 				g.ReferencedCaCertConfigMaps = referencedConfigMaps
+				btp := httpsRouteHR9.Spec.Rules[0].BackendRefs[0].BackendTLSPolicy
+				g.BackendTLSPolicies = map[types.NamespacedName]*graph.BackendTLSPolicy{
+					client.ObjectKeyFromObject(btp.Source): btp,
+				}
 				return g
 			}),
 			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
@@ -2047,11 +2155,11 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
 					Name:      "gw",
 					Namespace: "ns",
 				}
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -2079,11 +2187,11 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
 					Name:      "gw",
 					Namespace: "ns",
 				}
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -2114,7 +2222,7 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Listeners = append(g.Gateway.Listeners, []*graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, []*graph.Listener{
 					{
 						Name:   "listener-80-1",
 						Source: listener80,
@@ -2133,7 +2241,7 @@ func TestBuildConfiguration(t *testing.T) {
 						ResolvedSecret: &secret1NsName,
 					},
 				}...)
-				g.Gateway.Policies = []*graph.Policy{gwPolicy1, gwPolicy2}
+				g.Gateways[gatewayNsName].Policies = []*graph.Policy{gwPolicy1, gwPolicy2}
 				g.Routes = map[graph.RouteKey]*graph.L7Route{
 					graph.CreateRouteKey(hrWithPolicy):      l7RouteWithPolicy,
 					graph.CreateRouteKey(httpsHRWithPolicy): l7HTTPSRouteWithPolicy,
@@ -2209,11 +2317,11 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
 					Name:      "gw",
 					Namespace: "ns",
 				}
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -2232,11 +2340,11 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
 					Name:      "gw",
 					Namespace: "ns",
 				}
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -2255,11 +2363,49 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "ns",
+				}
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
+					Name:   "listener-80-1",
+					Source: listener80,
+					Valid:  true,
+					Routes: map[graph.RouteKey]*graph.L7Route{},
+				})
+				g.NginxProxy = &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							GRPC: &ngfAPIv1alpha1.GRPC{
+								EnableWeb:         true,
+								EnableHealthCheck: true,
+							},
+						},
+					},
+				}
+				return g
+			}),
+			expConf: getModifiedExpectedConfiguration(func(conf Configuration) Configuration {
+				conf.SSLServers = []VirtualServer{}
+				conf.SSLKeyPairs = map[SSLKeyPairID]SSLKeyPair{}
+				conf.BaseHTTPConfig = BaseHTTPConfig{
+					HTTP2:           true,
+					IPFamily:        Dual,
+					GRPCWeb:         true,
+					GRPCHealthCheck: true,
+				}
+				return conf
+			}),
+			msg: "NginxProxy with gRPC-Web and gRPC health checks enabled",
+		},
+		{
+			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
 					Name:      "gw",
 					Namespace: "ns",
 				}
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -2302,11 +2448,11 @@ func TestBuildConfiguration(t *testing.T) {
 		},
 		{
 			graph: getModifiedGraph(func(g *graph.Graph) *graph.Graph {
-				g.Gateway.Source.ObjectMeta = metav1.ObjectMeta{
+				g.Gateways[gatewayNsName].Source.ObjectMeta = metav1.ObjectMeta{
 					Name:      "gw",
 					Namespace: "ns",
 				}
-				g.Gateway.Listeners = append(g.Gateway.Listeners, &graph.Listener{
+				g.Gateways[gatewayNsName].Listeners = append(g.Gateways[gatewayNsName].Listeners, &graph.Listener{
 					Name:   "listener-80-1",
 					Source: listener80,
 					Valid:  true,
@@ -2813,6 +2959,13 @@ func TestBuildUpstreams(t *testing.T) {
 		},
 	}
 
+	errorPageEndpoints := []resolver.Endpoint{
+		{
+			Address: "17.0.0.0",
+			Port:    80,
+		},
+	}
+
 	createBackendRefs := func(serviceNames ...string) []graph.BackendRef {
 		var backends []graph.BackendRef
 		for _, name := range serviceNames {
@@ -2843,6 +2996,8 @@ func TestBuildUpstreams(t *testing.T) {
 
 	nonExistingRefs := createBackendRefs("non-existing")
 
+	errorPageRefs := createBackendRefs("error-pages", "foo") // shouldn't duplicate foo upstream
+
 	invalidHRRefs := createBackendRefs("abc")
 
 	refsWithPolicies := createBackendRefs("policies")
@@ -2973,6 +3128,14 @@ func TestBuildUpstreams(t *testing.T) {
 					Source: validPolicy2,
 				},
 			},
+			BackendLBPolicy: &graph.BackendLBPolicy{
+				Valid: true,
+				Source: &v1alpha2.BackendLBPolicy{
+					Spec: v1alpha2.BackendLBPolicySpec{
+						SessionPersistence: &v1.SessionPersistence{},
+					},
+				},
+			},
 		},
 	}
 
@@ -3014,6 +3177,14 @@ func TestBuildUpstreams(t *testing.T) {
 			Name:      "test_policies_80",
 			Endpoints: policyEndpoints,
 			Policies:  []policies.Policy{validPolicy1, validPolicy2},
+			SessionPersistence: &SessionPersistence{
+				Name: defaultSessionCookieName,
+				Type: SessionPersistenceCookie,
+			},
+		},
+		{
+			Name:      "test_error-pages_80",
+			Endpoints: errorPageEndpoints,
 		},
 	}
 
@@ -3043,6 +3214,8 @@ func TestBuildUpstreams(t *testing.T) {
 			return ipv6Endpoints, nil
 		case "policies":
 			return policyEndpoints, nil
+		case "error-pages":
+			return errorPageEndpoints, nil
 		default:
 			return nil, fmt.Errorf("unexpected service %s", svcNsName.Name)
 		}
@@ -3050,10 +3223,213 @@ func TestBuildUpstreams(t *testing.T) {
 
 	g := NewWithT(t)
 
-	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver, referencedServices, Dual)
+	upstreams := buildUpstreams(context.TODO(), listeners, errorPageRefs, fakeResolver, referencedServices, Dual)
 	g.Expect(upstreams).To(ConsistOf(expUpstreams))
 }
 
+func TestBuildSessionPersistence(t *testing.T) {
+	t.Parallel()
+
+	getPolicy := func(valid bool, sp *v1.SessionPersistence) *graph.BackendLBPolicy {
+		return &graph.BackendLBPolicy{
+			Valid: valid,
+			Source: &v1alpha2.BackendLBPolicy{
+				Spec: v1alpha2.BackendLBPolicySpec{
+					SessionPersistence: sp,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		policy   *graph.BackendLBPolicy
+		expected *SessionPersistence
+		name     string
+	}{
+		{
+			name:     "no policy",
+			expected: nil,
+		},
+		{
+			name:     "invalid policy",
+			policy:   getPolicy(false, &v1.SessionPersistence{}),
+			expected: nil,
+		},
+		{
+			name:     "no session persistence",
+			policy:   getPolicy(true, nil),
+			expected: nil,
+		},
+		{
+			name:   "session cookie with default name",
+			policy: getPolicy(true, &v1.SessionPersistence{AbsoluteTimeout: helpers.GetPointer[v1.Duration]("1h")}),
+			expected: &SessionPersistence{
+				Name: defaultSessionCookieName,
+				Type: SessionPersistenceCookie,
+			},
+		},
+		{
+			name: "permanent cookie",
+			policy: getPolicy(true, &v1.SessionPersistence{
+				SessionName:     helpers.GetPointer("session"),
+				Type:            helpers.GetPointer(v1.CookieBasedSessionPersistence),
+				AbsoluteTimeout: helpers.GetPointer[v1.Duration]("1h"),
+				CookieConfig: &v1.CookieConfig{
+					LifetimeType: helpers.GetPointer(v1.PermanentCookieLifetimeType),
+				},
+			}),
+			expected: &SessionPersistence{
+				Name:    "session",
+				Expires: "1h",
+				Type:    SessionPersistenceCookie,
+			},
+		},
+		{
+			name: "header",
+			policy: getPolicy(true, &v1.SessionPersistence{
+				SessionName: helpers.GetPointer("X-Session"),
+				Type:        helpers.GetPointer(v1.HeaderBasedSessionPersistence),
+			}),
+			expected: &SessionPersistence{
+				Name: "X-Session",
+				Type: SessionPersistenceHeader,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildSessionPersistence(test.policy)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildCanaryMatch(t *testing.T) {
+	t.Parallel()
+
+	getCanary := func(match *ngfAPIv1alpha1.CanaryMatch) *graph.Canary {
+		return &graph.Canary{
+			Source: &ngfAPIv1alpha1.Canary{
+				Spec: ngfAPIv1alpha1.CanarySpec{
+					Match: match,
+				},
+			},
+			CanaryBackendIdx: 1,
+			Valid:            true,
+		}
+	}
+
+	tests := []struct {
+		canary   *graph.Canary
+		expected *CanaryMatch
+		name     string
+	}{
+		{
+			name:     "no canary",
+			expected: nil,
+		},
+		{
+			name:     "no match",
+			canary:   getCanary(nil),
+			expected: nil,
+		},
+		{
+			name: "header",
+			canary: getCanary(&ngfAPIv1alpha1.CanaryMatch{
+				Header: helpers.GetPointer("X-Canary"),
+				Value:  "always",
+			}),
+			expected: &CanaryMatch{
+				Header:     "X-Canary",
+				Value:      "always",
+				BackendIdx: 1,
+			},
+		},
+		{
+			name: "cookie",
+			canary: getCanary(&ngfAPIv1alpha1.CanaryMatch{
+				Cookie: helpers.GetPointer("canary"),
+				Value:  "true",
+			}),
+			expected: &CanaryMatch{
+				Cookie:     "canary",
+				Value:      "true",
+				BackendIdx: 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildCanaryMatch(test.canary)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestSetCanaryWeights(t *testing.T) {
+	t.Parallel()
+
+	getBackends := func() []Backend {
+		return []Backend{
+			{UpstreamName: "stable", Weight: 1},
+			{UpstreamName: "canary", Weight: 1},
+		}
+	}
+
+	tests := []struct {
+		canary   *graph.Canary
+		name     string
+		backends []Backend
+		expected []Backend
+	}{
+		{
+			name:     "no canary",
+			backends: getBackends(),
+			expected: getBackends(),
+		},
+		{
+			name:     "canary weight",
+			canary:   &graph.Canary{CanaryBackendIdx: 1, Weight: 30, Valid: true},
+			backends: getBackends(),
+			expected: []Backend{
+				{UpstreamName: "stable", Weight: 70},
+				{UpstreamName: "canary", Weight: 30},
+			},
+		},
+		{
+			name:     "rolled back",
+			canary:   &graph.Canary{CanaryBackendIdx: 1, Weight: 0, Valid: true},
+			backends: getBackends(),
+			expected: []Backend{
+				{UpstreamName: "stable", Weight: 100},
+				{UpstreamName: "canary", Weight: 0},
+			},
+		},
+		{
+			name:     "unexpected number of backends",
+			canary:   &graph.Canary{CanaryBackendIdx: 0, Weight: 30, Valid: true},
+			backends: getBackends()[:1],
+			expected: getBackends()[:1],
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			setCanaryWeights(test.backends, test.canary)
+			g.Expect(test.backends).To(Equal(test.expected))
+		})
+	}
+}
+
 func createBackendGroup(name string, ruleIdx int, backendNames ...string) BackendGroup {
 	backends := make([]Backend, len(backendNames))
 	for i, name := range backendNames {
@@ -3218,8 +3594,8 @@ func TestConvertBackendTLS(t *testing.T) {
 				},
 			},
 		},
-		Valid:     true,
-		CaCertRef: types.NamespacedName{Namespace: "test", Name: "ca-cert"},
+		Valid:      true,
+		CaCertRefs: []types.NamespacedName{{Namespace: "test", Name: "ca-cert"}},
 	}
 
 	btpWellKnownCerts := &graph.BackendTLSPolicy{
@@ -3233,29 +3609,67 @@ func TestConvertBackendTLS(t *testing.T) {
 		Valid: true,
 	}
 
-	expectedWithCertPath := &VerifyTLS{
-		CertBundleID: generateCertBundleID(
-			types.NamespacedName{Namespace: "test", Name: "ca-cert"},
-		),
-		Hostname: "example.com",
-	}
-
-	expectedWithWellKnownCerts := &VerifyTLS{
-		Hostname:   "example.com",
-		RootCAPath: alpineSSLRootCAPath,
-	}
-
-	tests := []struct {
-		btp      *graph.BackendTLSPolicy
-		expected *VerifyTLS
-		msg      string
-	}{
-		{
-			btp:      nil,
-			expected: nil,
-			msg:      "nil backend tls policy",
-		},
-		{
+	btpMultipleCaCertRefs := &graph.BackendTLSPolicy{
+		Source: &v1alpha3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "btp",
+				Namespace: "test",
+			},
+			Spec: v1alpha3.BackendTLSPolicySpec{
+				Validation: v1alpha3.BackendTLSPolicyValidation{
+					CACertificateRefs: []v1.LocalObjectReference{
+						{
+							Name: "ca-cert",
+						},
+						{
+							Name: "ca-cert-2",
+						},
+					},
+					Hostname: "example.com",
+					SubjectAltNames: []v1alpha3.SubjectAltName{
+						{
+							Type:     v1alpha3.HostnameSubjectAltNameType,
+							Hostname: "backend.example.com",
+						},
+					},
+				},
+			},
+		},
+		Valid: true,
+		CaCertRefs: []types.NamespacedName{
+			{Namespace: "test", Name: "ca-cert"},
+			{Namespace: "test", Name: "ca-cert-2"},
+		},
+	}
+
+	expectedWithCertPath := &VerifyTLS{
+		CertBundleID: generateCertBundleID(
+			types.NamespacedName{Namespace: "test", Name: "ca-cert"},
+		),
+		Hostname: "example.com",
+	}
+
+	expectedWithMultipleCerts := &VerifyTLS{
+		CertBundleID: "cert_bundle_policy_test_btp",
+		Hostname:     "example.com",
+	}
+
+	expectedWithWellKnownCerts := &VerifyTLS{
+		Hostname:   "example.com",
+		RootCAPath: alpineSSLRootCAPath,
+	}
+
+	tests := []struct {
+		btp      *graph.BackendTLSPolicy
+		expected *VerifyTLS
+		msg      string
+	}{
+		{
+			btp:      nil,
+			expected: nil,
+			msg:      "nil backend tls policy",
+		},
+		{
 			btp:      btpCaCertRefs,
 			expected: expectedWithCertPath,
 			msg:      "normal case with cert path",
@@ -3265,6 +3679,11 @@ func TestConvertBackendTLS(t *testing.T) {
 			expected: expectedWithWellKnownCerts,
 			msg:      "normal case no cert path",
 		},
+		{
+			btp:      btpMultipleCaCertRefs,
+			expected: expectedWithMultipleCerts,
+			msg:      "multiple cert refs and subject alt name",
+		},
 	}
 
 	for _, tc := range tests {
@@ -3276,6 +3695,82 @@ func TestConvertBackendTLS(t *testing.T) {
 	}
 }
 
+func TestBuildCertBundles(t *testing.T) {
+	t.Parallel()
+
+	cm1 := types.NamespacedName{Namespace: "test", Name: "cm-1"}
+	cm2 := types.NamespacedName{Namespace: "test", Name: "cm-2"}
+
+	caCertConfigMaps := map[types.NamespacedName]*graph.CaCertConfigMap{
+		cm1: {CACert: []byte("cert-1")},
+		// base64 encoded "cert-2\n"
+		cm2: {CACert: []byte("Y2VydC0yCg==")},
+	}
+
+	createPolicy := func(name string, valid bool, refs ...types.NamespacedName) *graph.BackendTLSPolicy {
+		return &graph.BackendTLSPolicy{
+			Source: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "test",
+				},
+			},
+			CaCertRefs: refs,
+			Valid:      valid,
+		}
+	}
+
+	singleRef := createPolicy("single", true, cm1)
+	multipleRefs := createPolicy("multiple", true, cm2, cm1)
+	unreferenced := createPolicy("unreferenced", true, cm1, cm2)
+	invalid := createPolicy("invalid", false, cm1, cm2)
+	passthrough := createPolicy("passthrough", true, cm2)
+
+	backendTLSPolicies := map[types.NamespacedName]*graph.BackendTLSPolicy{
+		{Namespace: "test", Name: "single"}:       singleRef,
+		{Namespace: "test", Name: "multiple"}:     multipleRefs,
+		{Namespace: "test", Name: "unreferenced"}: unreferenced,
+		{Namespace: "test", Name: "invalid"}:      invalid,
+		{Namespace: "test", Name: "passthrough"}:  passthrough,
+	}
+
+	createBackend := func(btp *graph.BackendTLSPolicy) Backend {
+		return Backend{
+			Valid: true,
+			VerifyTLS: &VerifyTLS{
+				CertBundleID: generateCertBundleIDForPolicy(btp),
+			},
+		}
+	}
+
+	backendGroups := []BackendGroup{
+		{
+			Backends: []Backend{createBackend(singleRef), createBackend(multipleRefs), createBackend(invalid)},
+		},
+	}
+
+	passthroughServers := []Layer4VirtualServer{
+		{
+			Upstreams: []Layer4Upstream{
+				{
+					Name:      "test_passthrough_443",
+					VerifyTLS: &VerifyTLS{CertBundleID: generateCertBundleIDForPolicy(passthrough)},
+				},
+			},
+		},
+	}
+
+	expected := map[CertBundleID]CertBundle{
+		"cert_bundle_test_cm-1":            CertBundle("cert-1"),
+		"cert_bundle_policy_test_multiple": CertBundle("cert-2\ncert-1"),
+		"cert_bundle_test_cm-2":            CertBundle("cert-2\n"),
+	}
+
+	g := NewWithT(t)
+	g.Expect(buildCertBundles(caCertConfigMaps, backendTLSPolicies, backendGroups, passthroughServers)).
+		To(Equal(expected))
+}
+
 func TestBuildTelemetry(t *testing.T) {
 	t.Parallel()
 	telemetryConfigured := &graph.NginxProxy{
@@ -3348,11 +3843,13 @@ func TestBuildTelemetry(t *testing.T) {
 		},
 		{
 			g: &graph.Graph{
-				Gateway: &graph.Gateway{
-					Source: &v1.Gateway{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "gw",
-							Namespace: "ns",
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "ns", Name: "gw"}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
 						},
 					},
 				},
@@ -3363,11 +3860,48 @@ func TestBuildTelemetry(t *testing.T) {
 		},
 		{
 			g: &graph.Graph{
-				Gateway: &graph.Gateway{
-					Source: &v1.Gateway{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1.GatewayClass{
 						ObjectMeta: metav1.ObjectMeta{
-							Name:      "gw",
-							Namespace: "ns",
+							Name: "nginx",
+						},
+					},
+				},
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "ns", Name: "gw"}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
+						},
+					},
+					{Namespace: "ns", Name: "gw2"}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw2",
+								Namespace: "ns",
+							},
+						},
+					},
+				},
+				NginxProxy: telemetryConfigured,
+			},
+			expTelemetry: createModifiedTelemetry(func(t Telemetry) Telemetry {
+				t.ServiceName = "ngf:nginx:my-svc"
+				return t
+			}),
+			msg: "Telemetry configured for multiple Gateways",
+		},
+		{
+			g: &graph.Graph{
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "ns", Name: "gw"}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
 						},
 					},
 				},
@@ -3398,11 +3932,13 @@ func TestBuildTelemetry(t *testing.T) {
 		},
 		{
 			g: &graph.Graph{
-				Gateway: &graph.Gateway{
-					Source: &v1.Gateway{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "gw",
-							Namespace: "ns",
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "ns", Name: "gw"}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
 						},
 					},
 				},
@@ -3468,11 +4004,13 @@ func TestBuildTelemetry(t *testing.T) {
 		},
 		{
 			g: &graph.Graph{
-				Gateway: &graph.Gateway{
-					Source: &v1.Gateway{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "gw",
-							Namespace: "ns",
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "ns", Name: "gw"}: {
+						Source: &v1.Gateway{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "gw",
+								Namespace: "ns",
+							},
 						},
 					},
 				},
@@ -3647,89 +4185,98 @@ func TestCreatePassthroughServers(t *testing.T) {
 	secureApp2Key := getL4RouteKey("secure-app2")
 	secureApp3Key := getL4RouteKey("secure-app3")
 	testGraph := graph.Graph{
-		Gateway: &graph.Gateway{
-			Listeners: []*graph.Listener{
-				{
-					Name:  "testingListener",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.TLSProtocolType,
-						Port:     443,
-						Hostname: helpers.GetPointer[v1.Hostname]("*.example.com"),
-					},
-					Routes: make(map[graph.RouteKey]*graph.L7Route),
-					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-						secureAppKey: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
+		Gateways: map[types.NamespacedName]*graph.Gateway{
+			{}: {
+				Source: &v1.Gateway{},
+				Listeners: []*graph.Listener{
+					{
+						Name:  "testingListener",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.TLSProtocolType,
+							Port:     443,
+							Hostname: helpers.GetPointer[v1.Hostname]("*.example.com"),
+						},
+						Routes: make(map[graph.RouteKey]*graph.L7Route),
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							secureAppKey: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
+									BackendRefs: []graph.BackendRef{
+										{
+											Valid:     true,
+											SvcNsName: secureAppKey.NamespacedName,
+											ServicePort: apiv1.ServicePort{
+												Name:     "https",
+												Protocol: "TCP",
+												Port:     8443,
+												TargetPort: intstr.IntOrString{
+													Type:   intstr.Int,
+													IntVal: 8443,
+												},
+											},
+											Weight: 1,
 										},
 									},
 								},
-							},
-							ParentRefs: []graph.ParentRef{
-								{
-									Attachment: &graph.ParentRefAttachmentStatus{
-										AcceptedHostnames: map[string][]string{
-											"testingListener": {"app.example.com", "cafe.example.com"},
+								ParentRefs: []graph.ParentRef{
+									{
+										Attachment: &graph.ParentRefAttachmentStatus{
+											AcceptedHostnames: map[string][]string{
+												"testingListener": {"app.example.com", "cafe.example.com"},
+											},
 										},
+										SectionName: nil,
+										Port:        nil,
+										Gateway:     types.NamespacedName{},
+										Idx:         0,
 									},
-									SectionName: nil,
-									Port:        nil,
-									Gateway:     types.NamespacedName{},
-									Idx:         0,
 								},
 							},
+							secureApp2Key: {},
 						},
-						secureApp2Key: {},
 					},
-				},
-				{
-					Name:  "testingListener2",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.TLSProtocolType,
-						Port:     443,
-						Hostname: helpers.GetPointer[v1.Hostname]("cafe.example.com"),
-					},
-					Routes: make(map[graph.RouteKey]*graph.L7Route),
-					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-						secureApp3Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
+					{
+						Name:  "testingListener2",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.TLSProtocolType,
+							Port:     443,
+							Hostname: helpers.GetPointer[v1.Hostname]("cafe.example.com"),
+						},
+						Routes: make(map[graph.RouteKey]*graph.L7Route),
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							secureApp3Key: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
+									BackendRefs: []graph.BackendRef{
+										{
+											Valid:     true,
+											SvcNsName: secureAppKey.NamespacedName,
+											ServicePort: apiv1.ServicePort{
+												Name:     "https",
+												Protocol: "TCP",
+												Port:     8443,
+												TargetPort: intstr.IntOrString{
+													Type:   intstr.Int,
+													IntVal: 8443,
+												},
+											},
+											Weight: 1,
 										},
 									},
 								},
 							},
 						},
 					},
-				},
-				{
-					Name:  "httpListener",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.HTTPProtocolType,
+					{
+						Name:  "httpListener",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.HTTPProtocolType,
+						},
 					},
 				},
 			},
@@ -3740,28 +4287,26 @@ func TestCreatePassthroughServers(t *testing.T) {
 
 	expectedPassthroughServers := []Layer4VirtualServer{
 		{
-			Hostname:     "app.example.com",
-			UpstreamName: "default_secure-app_8443",
-			Port:         443,
-			IsDefault:    false,
+			Hostname:  "app.example.com",
+			Upstreams: []Layer4Upstream{{Name: "default_secure-app_8443", Weight: 1}},
+			Port:      443,
+			IsDefault: false,
 		},
 		{
-			Hostname:     "cafe.example.com",
-			UpstreamName: "default_secure-app_8443",
-			Port:         443,
-			IsDefault:    false,
+			Hostname:  "cafe.example.com",
+			Upstreams: []Layer4Upstream{{Name: "default_secure-app_8443", Weight: 1}},
+			Port:      443,
+			IsDefault: false,
 		},
 		{
-			Hostname:     "*.example.com",
-			UpstreamName: "",
-			Port:         443,
-			IsDefault:    true,
+			Hostname:  "*.example.com",
+			Port:      443,
+			IsDefault: true,
 		},
 		{
-			Hostname:     "cafe.example.com",
-			UpstreamName: "",
-			Port:         443,
-			IsDefault:    true,
+			Hostname:  "cafe.example.com",
+			Port:      443,
+			IsDefault: true,
 		},
 	}
 
@@ -3785,78 +4330,105 @@ func TestBuildStreamUpstreams(t *testing.T) {
 	secureApp3Key := getL4RouteKey("secure-app3")
 	secureApp4Key := getL4RouteKey("secure-app4")
 	secureApp5Key := getL4RouteKey("secure-app5")
+	secureApp6Key := getL4RouteKey("secure-app6")
 	testGraph := graph.Graph{
-		Gateway: &graph.Gateway{
-			Listeners: []*graph.Listener{
-				{
-					Name:  "testingListener",
-					Valid: true,
-					Source: v1.Listener{
-						Protocol: v1.TLSProtocolType,
-						Port:     443,
-					},
-					Routes: make(map[graph.RouteKey]*graph.L7Route),
-					L4Routes: map[graph.L4RouteKey]*graph.L4Route{
-						secureAppKey: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
+		Gateways: map[types.NamespacedName]*graph.Gateway{
+			{}: {
+				Source: &v1.Gateway{},
+				Listeners: []*graph.Listener{
+					{
+						Name:  "testingListener",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.TLSProtocolType,
+							Port:     443,
+						},
+						Routes: make(map[graph.RouteKey]*graph.L7Route),
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							secureAppKey: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
+									BackendRefs: []graph.BackendRef{
+										{
+											Valid:     true,
+											SvcNsName: secureAppKey.NamespacedName,
+											ServicePort: apiv1.ServicePort{
+												Name:     "https",
+												Protocol: "TCP",
+												Port:     8443,
+												TargetPort: intstr.IntOrString{
+													Type:   intstr.Int,
+													IntVal: 8443,
+												},
+											},
+											Weight: 1,
 										},
 									},
 								},
 							},
-						},
-						secureApp2Key: {},
-						secureApp3Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames:  []v1.Hostname{"test.example.com"},
-								BackendRef: graph.BackendRef{},
+							secureApp2Key: {},
+							secureApp3Key: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									Hostnames:   []v1.Hostname{"test.example.com"},
+									BackendRefs: []graph.BackendRef{{}},
+								},
 							},
-						},
-						secureApp4Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureAppKey.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
+							secureApp4Key: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									Hostnames: []v1.Hostname{"app.example.com", "cafe.example.com"},
+									BackendRefs: []graph.BackendRef{
+										{
+											Valid:     true,
+											SvcNsName: secureAppKey.NamespacedName,
+											ServicePort: apiv1.ServicePort{
+												Name:     "https",
+												Protocol: "TCP",
+												Port:     8443,
+												TargetPort: intstr.IntOrString{
+													Type:   intstr.Int,
+													IntVal: 8443,
+												},
+											},
+											Weight: 1,
 										},
 									},
 								},
 							},
-						},
-						secureApp5Key: {
-							Valid: true,
-							Spec: graph.L4RouteSpec{
-								Hostnames: []v1.Hostname{"app2.example.com"},
-								BackendRef: graph.BackendRef{
-									Valid:     true,
-									SvcNsName: secureApp5Key.NamespacedName,
-									ServicePort: apiv1.ServicePort{
-										Name:     "https",
-										Protocol: "TCP",
-										Port:     8443,
-										TargetPort: intstr.IntOrString{
-											Type:   intstr.Int,
-											IntVal: 8443,
+							secureApp5Key: {
+								Valid: true,
+								Spec: graph.L4RouteSpec{
+									Hostnames: []v1.Hostname{"app2.example.com"},
+									BackendRefs: []graph.BackendRef{
+										{
+											Valid:     true,
+											SvcNsName: secureApp5Key.NamespacedName,
+											ServicePort: apiv1.ServicePort{
+												Name:     "https",
+												Protocol: "TCP",
+												Port:     8443,
+												TargetPort: intstr.IntOrString{
+													Type:   intstr.Int,
+													IntVal: 8443,
+												},
+											},
+											Weight: 1,
+										},
+										{
+											Valid:     true,
+											SvcNsName: secureApp6Key.NamespacedName,
+											ServicePort: apiv1.ServicePort{
+												Name:     "https",
+												Protocol: "TCP",
+												Port:     8443,
+												TargetPort: intstr.IntOrString{
+													Type:   intstr.Int,
+													IntVal: 8443,
+												},
+											},
+											Weight: 1,
 										},
 									},
 								},
@@ -3885,7 +4457,7 @@ func TestBuildStreamUpstreams(t *testing.T) {
 		return fakeEndpoints, nil
 	}
 
-	streamUpstreams := buildStreamUpstreams(context.Background(), testGraph.Gateway.Listeners, &fakeResolver, Dual)
+	streamUpstreams := buildStreamUpstreams(context.Background(), getAllListeners(&testGraph), &fakeResolver, Dual)
 
 	expectedStreamUpstreams := []Upstream{
 		{
@@ -3896,12 +4468,106 @@ func TestBuildStreamUpstreams(t *testing.T) {
 			Name:      "default_secure-app5_8443",
 			Endpoints: fakeEndpoints,
 		},
+		{
+			Name:      "default_secure-app6_8443",
+			Endpoints: fakeEndpoints,
+		},
 	}
 	g := NewWithT(t)
 
 	g.Expect(streamUpstreams).To(ConsistOf(expectedStreamUpstreams))
 }
 
+func TestBuildLayer4Upstreams(t *testing.T) {
+	t.Parallel()
+
+	blueRef := graph.BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "blue"},
+		ServicePort: apiv1.ServicePort{Port: 443},
+		Weight:      90,
+		Valid:       true,
+	}
+
+	greenRef := graph.BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "green"},
+		ServicePort: apiv1.ServicePort{Port: 443},
+		Weight:      10,
+		Valid:       true,
+	}
+
+	btp := &graph.BackendTLSPolicy{
+		Source: &v1alpha3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "btp"},
+			Spec: v1alpha3.BackendTLSPolicySpec{
+				Validation: v1alpha3.BackendTLSPolicyValidation{
+					Hostname:                "blue.example.com",
+					WellKnownCACertificates: helpers.GetPointer(v1alpha3.WellKnownCACertificatesSystem),
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	blueRefWithPolicy := blueRef
+	blueRefWithPolicy.BackendTLSPolicy = btp
+
+	invalidRefWithPolicy := greenRef
+	invalidRefWithPolicy.BackendTLSPolicy = btp
+	invalidRefWithPolicy.Valid = false
+
+	tests := []struct {
+		msg      string
+		refs     []graph.BackendRef
+		expected []Layer4Upstream
+	}{
+		{
+			msg:      "no backendRefs",
+			refs:     nil,
+			expected: nil,
+		},
+		{
+			msg:  "weighted backendRefs",
+			refs: []graph.BackendRef{blueRef, greenRef},
+			expected: []Layer4Upstream{
+				{Name: "test_blue_443", Weight: 90},
+				{Name: "test_green_443", Weight: 10},
+			},
+		},
+		{
+			msg:  "invalid backendRef",
+			refs: []graph.BackendRef{blueRef, {Weight: 10}},
+			expected: []Layer4Upstream{
+				{Name: "test_blue_443", Weight: 90},
+				{Name: "", Weight: 10},
+			},
+		},
+		{
+			msg:  "backendRefs with a BackendTLSPolicy",
+			refs: []graph.BackendRef{blueRefWithPolicy, invalidRefWithPolicy},
+			expected: []Layer4Upstream{
+				{
+					Name:   "test_blue_443",
+					Weight: 90,
+					VerifyTLS: &VerifyTLS{
+						Hostname:   "blue.example.com",
+						RootCAPath: alpineSSLRootCAPath,
+					},
+				},
+				{Name: "", Weight: 10},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildLayer4Upstreams(test.refs)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestBuildRewriteIPSettings(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -4383,3 +5049,785 @@ func TestBuildAuxiliarySecrets(t *testing.T) {
 
 	g.Expect(buildAuxiliarySecrets(secrets)).To(Equal(expSecrets))
 }
+
+func TestBuildErrorPageBodies(t *testing.T) {
+	t.Parallel()
+
+	createErrorPagePolicy := func(name string, keys ...string) *ngfAPIv1alpha1.ErrorPagePolicy {
+		pages := make([]ngfAPIv1alpha1.ErrorPage, 0, len(keys)+1)
+		for _, key := range keys {
+			pages = append(pages, ngfAPIv1alpha1.ErrorPage{
+				Response: &ngfAPIv1alpha1.ErrorPageResponse{
+					ConfigMapRef: ngfAPIv1alpha1.ErrorPageConfigMapReference{
+						Name: "pages",
+						Key:  key,
+					},
+				},
+				Codes: []ngfAPIv1alpha1.ErrorPageStatusCode{404},
+			})
+		}
+
+		pages = append(pages, ngfAPIv1alpha1.ErrorPage{
+			Redirect: &ngfAPIv1alpha1.ErrorPageRedirect{URL: "https://example.com"},
+			Codes:    []ngfAPIv1alpha1.ErrorPageStatusCode{500},
+		})
+
+		return &ngfAPIv1alpha1.ErrorPagePolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec:       ngfAPIv1alpha1.ErrorPagePolicySpec{ErrorPages: pages},
+		}
+	}
+
+	pagesNsName := types.NamespacedName{Namespace: "test", Name: "pages"}
+
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		pagesNsName: {
+			Data: map[string]string{
+				"404.html": "not found",
+			},
+			BinaryData: map[string][]byte{
+				"503.html": []byte("unavailable"),
+			},
+		},
+	}
+
+	pols := map[graph.PolicyKey]*graph.Policy{
+		{NsName: types.NamespacedName{Namespace: "test", Name: "valid"}}: {
+			Valid:  true,
+			Source: createErrorPagePolicy("valid", "503.html", "404.html"),
+		},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "duplicate"}}: {
+			Valid:  true,
+			Source: createErrorPagePolicy("duplicate", "404.html"),
+		},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "invalid"}}: {
+			Valid:  false,
+			Source: createErrorPagePolicy("invalid", "invalid.html"),
+		},
+		{NsName: types.NamespacedName{Namespace: "test", Name: "other"}}: {
+			Valid:  true,
+			Source: &policiesfakes.FakePolicy{},
+		},
+	}
+
+	tests := []struct {
+		pols       map[graph.PolicyKey]*graph.Policy
+		configMaps map[types.NamespacedName]*apiv1.ConfigMap
+		name       string
+		expBodies  []ErrorPageBody
+	}{
+		{
+			name: "no policies",
+		},
+		{
+			name:       "policies reference ConfigMap keys",
+			pols:       pols,
+			configMaps: configMaps,
+			expBodies: []ErrorPageBody{
+				{
+					ConfigMap: pagesNsName,
+					Key:       "404.html",
+					Content:   []byte("not found"),
+				},
+				{
+					ConfigMap: pagesNsName,
+					Key:       "503.html",
+					Content:   []byte("unavailable"),
+				},
+			},
+		},
+		{
+			name: "referenced ConfigMap doesn't exist",
+			pols: pols,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildErrorPageBodies(test.pols, test.configMaps)).To(Equal(test.expBodies))
+		})
+	}
+}
+
+func TestBuildServersMultipleGateways(t *testing.T) {
+	t.Parallel()
+
+	gw1NsName := types.NamespacedName{Namespace: "test", Name: "gw-1"}
+	gw2NsName := types.NamespacedName{Namespace: "test", Name: "gw-2"}
+
+	gw1Policy := &graph.Policy{
+		Source: createFakePolicy("attach-gw-1", "ApplePolicy"),
+		Valid:  true,
+	}
+	gw2Policy := &graph.Policy{
+		Source: createFakePolicy("attach-gw-2", "OrangePolicy"),
+		Valid:  true,
+	}
+
+	hr := &v1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "hr",
+		},
+	}
+
+	pathValue := "/"
+	pathType := v1.PathMatchPathPrefix
+	match := v1.HTTPRouteMatch{
+		Path: &v1.HTTPPathMatch{
+			Value: &pathValue,
+			Type:  &pathType,
+		},
+	}
+
+	// the Route is attached to the Listeners of both Gateways, which have the same name,
+	// but it accepts different hostnames for each Gateway.
+	route := &graph.L7Route{
+		RouteType: graph.RouteTypeHTTP,
+		Source:    hr,
+		Spec: graph.L7RouteSpec{
+			Rules: []graph.RouteRule{
+				{
+					Matches:      []v1.HTTPRouteMatch{match},
+					Filters:      graph.RouteRuleFilters{Valid: true},
+					BackendRefs:  []graph.BackendRef{getNormalBackendRef()},
+					ValidMatches: true,
+				},
+			},
+		},
+		Valid: true,
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway: gw1NsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{
+						"http": {"foo.example.com"},
+					},
+				},
+			},
+			{
+				Gateway: gw2NsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{
+						"http": {"bar.example.com"},
+					},
+				},
+			},
+		},
+	}
+
+	createGateway := func(nsname types.NamespacedName, created time.Time, pol *graph.Policy) *graph.Gateway {
+		return &graph.Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         nsname.Namespace,
+					Name:              nsname.Name,
+					CreationTimestamp: metav1.NewTime(created),
+				},
+			},
+			Listeners: []*graph.Listener{
+				{
+					Name: "http",
+					Source: v1.Listener{
+						Name:     "http",
+						Protocol: v1.HTTPProtocolType,
+						Port:     80,
+					},
+					Valid: true,
+					Routes: map[graph.RouteKey]*graph.L7Route{
+						graph.CreateRouteKey(hr): route,
+					},
+				},
+			},
+			Policies: []*graph.Policy{pol},
+			Valid:    true,
+		}
+	}
+
+	now := time.Now()
+
+	gw1 := createGateway(gw1NsName, now, gw1Policy)
+	gw2 := createGateway(gw2NsName, now.Add(time.Second), gw2Policy)
+	gw2.HTTPSRedirect = &graph.HTTPSRedirect{
+		Redirects: []graph.HTTPSRedirectHost{
+			// conflicts with the server of the Route for the first Gateway
+			{Hostname: "foo.example.com", HTTPPort: 80, HTTPSPort: 443},
+			{Hostname: "baz.example.com", HTTPPort: 80, HTTPSPort: 443},
+		},
+		StatusCode: 301,
+	}
+
+	g := &graph.Graph{
+		Gateways: map[types.NamespacedName]*graph.Gateway{
+			gw1NsName: gw1,
+			gw2NsName: gw2,
+		},
+	}
+
+	createServer := func(hostname string, pol *graph.Policy) VirtualServer {
+		return VirtualServer{
+			Hostname: hostname,
+			PathRules: []PathRule{
+				{
+					Path:     "/",
+					PathType: PathTypePrefix,
+					MatchRules: []MatchRule{
+						{
+							Source: &hr.ObjectMeta,
+							BackendGroup: BackendGroup{
+								Source:   client.ObjectKeyFromObject(hr),
+								RuleIdx:  0,
+								Backends: []Backend{{UpstreamName: "test_foo_80", Weight: 1, Valid: true}},
+							},
+							Match: convertMatch(match),
+						},
+					},
+				},
+			},
+			Port:     80,
+			Policies: []policies.Policy{pol.Source},
+		}
+	}
+
+	expHTTPServers := []VirtualServer{
+		{
+			IsDefault: true,
+			Port:      80,
+			Policies:  []policies.Policy{gw1Policy.Source},
+		},
+		createServer("bar.example.com", gw2Policy),
+		createServer("foo.example.com", gw1Policy),
+		{
+			Hostname: "baz.example.com",
+			Port:     80,
+			HTTPSRedirect: &HTTPSRedirect{
+				Port:       443,
+				StatusCode: 301,
+			},
+		},
+	}
+
+	httpServers, sslServers := buildServers(g)
+
+	gomega := NewWithT(t)
+
+	gomega.Expect(httpServers).To(Equal(expHTTPServers))
+	gomega.Expect(sslServers).To(BeEmpty())
+}
+
+func TestSetDefaultServerActions(t *testing.T) {
+	t.Parallel()
+
+	secretNsName := types.NamespacedName{Namespace: "test", Name: "secret"}
+
+	gw := &graph.Gateway{
+		Listeners: []*graph.Listener{
+			{
+				Name:   "http",
+				Source: v1.Listener{Protocol: v1.HTTPProtocolType, Port: 80},
+				Valid:  true,
+			},
+			{
+				Name:           "https",
+				Source:         v1.Listener{Protocol: v1.HTTPSProtocolType, Port: 443},
+				ResolvedSecret: &secretNsName,
+				Valid:          true,
+			},
+			{
+				Name:   "https-no-secret",
+				Source: v1.Listener{Protocol: v1.HTTPSProtocolType, Port: 8443},
+				Valid:  true,
+			},
+		},
+		DefaultServers: []graph.DefaultServer{
+			{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					Return: &ngfAPIv1alpha1.DefaultServerReturn{
+						StatusCode:  418,
+						Body:        helpers.GetPointer("teapot"),
+						ContentType: helpers.GetPointer[ngfAPIv1alpha1.MIMEType]("text/plain"),
+					},
+				},
+				ListenerName: "http",
+				Port:         80,
+			},
+			{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					BackendRef: &ngfAPIv1alpha1.DefaultServerBackendRef{
+						Namespace: "test",
+						Name:      "catch-all",
+						Port:      80,
+					},
+				},
+				BackendRef: &graph.BackendRef{
+					SvcNsName:   types.NamespacedName{Namespace: "test", Name: "catch-all"},
+					ServicePort: apiv1.ServicePort{Port: 80},
+					Weight:      1,
+					Valid:       true,
+				},
+				ListenerName: "https",
+				Port:         443,
+			},
+			{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					Redirect: &ngfAPIv1alpha1.DefaultServerRedirect{
+						URL: "https://example.com",
+					},
+				},
+				ListenerName: "https-no-secret",
+				Port:         8443,
+			},
+		},
+	}
+
+	httpServers := []VirtualServer{
+		{
+			Hostname: "foo.example.com",
+			Port:     80,
+		},
+		{
+			IsDefault: true,
+			Port:      80,
+		},
+		{
+			IsDefault: true,
+			Port:      8080,
+		},
+	}
+
+	sslServers := []VirtualServer{
+		{
+			IsDefault: true,
+			Port:      443,
+		},
+		{
+			IsDefault: true,
+			Port:      8443,
+		},
+	}
+
+	expHTTPServers := []VirtualServer{
+		{
+			Hostname: "foo.example.com",
+			Port:     80,
+		},
+		{
+			IsDefault: true,
+			Port:      80,
+			DefaultAction: &DefaultServerAction{
+				Return: &DefaultServerReturn{
+					Code:        418,
+					Body:        "teapot",
+					ContentType: "text/plain",
+				},
+			},
+		},
+		{
+			IsDefault: true,
+			Port:      8080,
+		},
+	}
+
+	expSSLServers := []VirtualServer{
+		{
+			IsDefault: true,
+			Port:      443,
+			SSL: &SSL{
+				KeyPairID: "ssl_keypair_test_secret",
+			},
+			DefaultAction: &DefaultServerAction{
+				UpstreamName: "test_catch-all_80",
+			},
+		},
+		{
+			IsDefault: true,
+			Port:      8443,
+		},
+	}
+
+	g := NewWithT(t)
+
+	setDefaultServerActions(httpServers, sslServers, []*graph.Gateway{gw})
+	g.Expect(httpServers).To(Equal(expHTTPServers))
+	g.Expect(sslServers).To(Equal(expSSLServers))
+}
+
+func TestBuildDefaultServerAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected *DefaultServerAction
+		msg      string
+		ds       graph.DefaultServer
+	}{
+		{
+			msg: "return without body",
+			ds: graph.DefaultServer{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					Return: &ngfAPIv1alpha1.DefaultServerReturn{StatusCode: 404},
+				},
+			},
+			expected: &DefaultServerAction{
+				Return: &DefaultServerReturn{Code: 404},
+			},
+		},
+		{
+			msg: "redirect with default status code",
+			ds: graph.DefaultServer{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					Redirect: &ngfAPIv1alpha1.DefaultServerRedirect{
+						URL: "https://example.com$request_uri",
+					},
+				},
+			},
+			expected: &DefaultServerAction{
+				Return: &DefaultServerReturn{
+					Code: 302,
+					Body: "https://example.com$request_uri",
+				},
+			},
+		},
+		{
+			msg: "redirect with status code",
+			ds: graph.DefaultServer{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					Redirect: &ngfAPIv1alpha1.DefaultServerRedirect{
+						StatusCode: helpers.GetPointer[int32](308),
+						URL:        "https://example.com",
+					},
+				},
+			},
+			expected: &DefaultServerAction{
+				Return: &DefaultServerReturn{
+					Code: 308,
+					Body: "https://example.com",
+				},
+			},
+		},
+		{
+			msg: "invalid backend",
+			ds: graph.DefaultServer{
+				Action: ngfAPIv1alpha1.DefaultServerAction{
+					BackendRef: &ngfAPIv1alpha1.DefaultServerBackendRef{
+						Namespace: "test",
+						Name:      "missing",
+						Port:      80,
+					},
+				},
+				BackendRef: &graph.BackendRef{
+					SvcNsName: types.NamespacedName{Namespace: "test", Name: "missing"},
+				},
+			},
+			expected: &DefaultServerAction{
+				Return: &DefaultServerReturn{Code: 500},
+			},
+		},
+		{
+			msg:      "no action",
+			ds:       graph.DefaultServer{},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildDefaultServerAction(test.ds)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestSplitTerminatingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ready1 := resolver.Endpoint{Address: "10.0.0.1", Port: 80}
+	ready2 := resolver.Endpoint{Address: "10.0.0.2", Port: 80}
+	terminating := resolver.Endpoint{Address: "10.0.0.3", Port: 80, Terminating: true}
+
+	tests := []struct {
+		name           string
+		eps            []resolver.Endpoint
+		expReady       []resolver.Endpoint
+		expTerminating []resolver.Endpoint
+	}{
+		{
+			name: "no endpoints",
+		},
+		{
+			name:     "only ready endpoints",
+			eps:      []resolver.Endpoint{ready1, ready2},
+			expReady: []resolver.Endpoint{ready1, ready2},
+		},
+		{
+			name:           "ready and terminating endpoints",
+			eps:            []resolver.Endpoint{ready1, terminating, ready2},
+			expReady:       []resolver.Endpoint{ready1, ready2},
+			expTerminating: []resolver.Endpoint{terminating},
+		},
+		{
+			name:           "only terminating endpoints",
+			eps:            []resolver.Endpoint{terminating},
+			expReady:       []resolver.Endpoint{},
+			expTerminating: []resolver.Endpoint{terminating},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			ready, terminating := splitTerminatingEndpoints(test.eps)
+			g.Expect(ready).To(Equal(test.expReady))
+			g.Expect(terminating).To(Equal(test.expTerminating))
+		})
+	}
+}
+
+func TestResolveBackendRefEndpoints(t *testing.T) {
+	t.Parallel()
+
+	svcEndpoints := []resolver.Endpoint{{Address: "10.0.0.1", Port: 8080}}
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+	fakeResolver.ResolveCalls(func(
+		_ context.Context,
+		svcNsName types.NamespacedName,
+		_ apiv1.ServicePort,
+		_ []discoveryV1.AddressType,
+	) ([]resolver.Endpoint, error) {
+		if svcNsName.Name == "svc" {
+			return svcEndpoints, nil
+		}
+
+		return nil, errors.New("no endpoints found")
+	})
+
+	tests := []struct {
+		name      string
+		expErrMsg string
+		br        graph.BackendRef
+		expEps    []resolver.Endpoint
+	}{
+		{
+			name: "Service",
+			br: graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "svc"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Valid:       true,
+			},
+			expEps: svcEndpoints,
+		},
+		{
+			name: "Service without endpoints",
+			br: graph.BackendRef{
+				SvcNsName:   types.NamespacedName{Namespace: "test", Name: "no-endpoints"},
+				ServicePort: apiv1.ServicePort{Port: 80},
+				Valid:       true,
+			},
+			expErrMsg: "no endpoints found",
+		},
+		{
+			name: "ExternalName Service",
+			br: graph.BackendRef{
+				SvcNsName:    types.NamespacedName{Namespace: "test", Name: "external"},
+				ExternalName: "api.example.com",
+				ServicePort:  apiv1.ServicePort{Port: 443},
+				Valid:        true,
+			},
+			expEps: []resolver.Endpoint{{Address: "api.example.com", Port: 443, Resolve: true}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			eps, errMsg := resolveBackendRefEndpoints(context.TODO(), test.br, fakeResolver, nil)
+			g.Expect(eps).To(Equal(test.expEps))
+			g.Expect(errMsg).To(Equal(test.expErrMsg))
+		})
+	}
+}
+
+func TestUpstreamResolvesHostnames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	upstream := Upstream{
+		Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+	}
+	g.Expect(upstream.ResolvesHostnames()).To(BeFalse())
+
+	upstream.Endpoints = append(
+		upstream.Endpoints,
+		resolver.Endpoint{Address: "api.example.com", Port: 80, Resolve: true},
+	)
+	g.Expect(upstream.ResolvesHostnames()).To(BeTrue())
+}
+
+func TestBuildDNSResolver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		g              *graph.Graph
+		expDNSResolver *DNSResolverConfig
+		msg            string
+	}{
+		{
+			msg: "NginxProxy is nil",
+			g:   &graph.Graph{},
+		},
+		{
+			msg: "NginxProxy is invalid",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: false,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							DNSResolver: &ngfAPIv1alpha1.DNSResolver{},
+						},
+					},
+				},
+			},
+		},
+		{
+			msg: "NginxProxy does not specify a DNS resolver",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{},
+					},
+				},
+			},
+		},
+		{
+			msg: "NginxProxy specifies a DNS resolver",
+			g: &graph.Graph{
+				NginxProxy: &graph.NginxProxy{
+					Valid: true,
+					Source: &ngfAPIv1alpha1.NginxProxy{
+						Spec: ngfAPIv1alpha1.NginxProxySpec{
+							DNSResolver: &ngfAPIv1alpha1.DNSResolver{
+								Timeout:  helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+								CacheTTL: helpers.GetPointer[ngfAPIv1alpha1.Duration]("30s"),
+								Addresses: []ngfAPIv1alpha1.DNSResolverAddress{
+									{Type: ngfAPIv1alpha1.DNSResolverIPAddressType, Value: "10.96.0.10"},
+									{Type: ngfAPIv1alpha1.DNSResolverHostnameAddressType, Value: "dns.example.com"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expDNSResolver: &DNSResolverConfig{
+				Timeout:   "10s",
+				CacheTTL:  "30s",
+				Addresses: []string{"10.96.0.10", "dns.example.com"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildDNSResolver(tc.g)).To(Equal(tc.expDNSResolver))
+		})
+	}
+}
+
+func TestNewBackendGroupAppProtocol(t *testing.T) {
+	t.Parallel()
+
+	getBackendRef := func(name string, appProtocol *string) graph.BackendRef {
+		return graph.BackendRef{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: name},
+			ServicePort: apiv1.ServicePort{Port: 80, AppProtocol: appProtocol},
+			Weight:      1,
+			Valid:       true,
+		}
+	}
+
+	refs := []graph.BackendRef{
+		getBackendRef("plain", nil),
+		getBackendRef("https", helpers.GetPointer(graph.AppProtocolTypeHTTPS)),
+		getBackendRef("ws", helpers.GetPointer(graph.AppProtocolTypeWS)),
+		getBackendRef("wss", helpers.GetPointer(graph.AppProtocolTypeWSS)),
+	}
+
+	expBackends := []Backend{
+		{UpstreamName: "test_plain_80", Weight: 1, Valid: true},
+		{UpstreamName: "test_https_80", Weight: 1, Valid: true, TLS: true},
+		{UpstreamName: "test_ws_80", Weight: 1, Valid: true, WebSocket: true},
+		{UpstreamName: "test_wss_80", Weight: 1, Valid: true, TLS: true, WebSocket: true},
+	}
+
+	g := NewWithT(t)
+
+	group := newBackendGroup(refs, types.NamespacedName{Namespace: "test", Name: "route"}, 0)
+	g.Expect(group.Backends).To(Equal(expBackends))
+}
+
+func TestNewBackendGroupFilters(t *testing.T) {
+	t.Parallel()
+
+	refs := []graph.BackendRef{
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "stable"},
+			ServicePort: apiv1.ServicePort{Port: 80},
+			Weight:      90,
+			Valid:       true,
+		},
+		{
+			SvcNsName:   types.NamespacedName{Namespace: "test", Name: "canary"},
+			ServicePort: apiv1.ServicePort{Port: 80},
+			Weight:      10,
+			Valid:       true,
+			Filters: []graph.Filter{
+				{
+					RouteType:  graph.RouteTypeHTTP,
+					FilterType: graph.FilterRequestHeaderModifier,
+					RequestHeaderModifier: &v1.HTTPHeaderFilter{
+						Set: []v1.HTTPHeader{{Name: "Host", Value: "canary.example.com"}},
+					},
+				},
+				{
+					RouteType:  graph.RouteTypeHTTP,
+					FilterType: graph.FilterResponseHeaderModifier,
+					ResponseHeaderModifier: &v1.HTTPHeaderFilter{
+						Add: []v1.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+					},
+				},
+			},
+		},
+	}
+
+	expBackends := []Backend{
+		{UpstreamName: "test_stable_80", Weight: 90, Valid: true},
+		{
+			UpstreamName: "test_canary_80",
+			Weight:       10,
+			Valid:        true,
+			RequestHeaderModifiers: &HTTPHeaderFilter{
+				Set: []HTTPHeader{{Name: "Host", Value: "canary.example.com"}},
+			},
+			ResponseHeaderModifiers: &HTTPHeaderFilter{
+				Add: []HTTPHeader{{Name: "X-Canary", Value: "true"}},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	group := newBackendGroup(refs, types.NamespacedName{Namespace: "test", Name: "route"}, 0)
+	g.Expect(group.Backends).To(Equal(expBackends))
+}
@@ -2,6 +2,7 @@ package dataplane
 
 import (
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -27,6 +28,8 @@ type Configuration struct {
 	SSLKeyPairs map[SSLKeyPairID]SSLKeyPair
 	// CertBundles holds all unique Certificate Bundles.
 	CertBundles map[CertBundleID]CertBundle
+	// ErrorPageBodies holds all unique error page bodies referenced by ErrorPagePolicies.
+	ErrorPageBodies []ErrorPageBody
 	// HTTPServers holds all HTTPServers.
 	HTTPServers []VirtualServer
 	// SSLServers holds all SSLServers.
@@ -52,6 +55,8 @@ type Configuration struct {
 	Logging Logging
 	// BaseHTTPConfig holds the configuration options at the http context.
 	BaseHTTPConfig BaseHTTPConfig
+	// DNSResolver holds the DNS resolver configuration. It is nil if no DNS resolver is configured.
+	DNSResolver *DNSResolverConfig
 	// Version represents the version of the generated configuration.
 	Version int
 }
@@ -67,6 +72,16 @@ type CertBundleID string
 // CertBundle is a Certificate bundle.
 type CertBundle []byte
 
+// ErrorPageBody is the body of an error page stored in a ConfigMap.
+type ErrorPageBody struct {
+	// ConfigMap is the NamespacedName of the ConfigMap that holds the body.
+	ConfigMap types.NamespacedName
+	// Key is the key of the ConfigMap that holds the body.
+	Key string
+	// Content is the body.
+	Content []byte
+}
+
 // SSLKeyPair is an SSL private/public key pair.
 type SSLKeyPair struct {
 	// Cert is the certificate.
@@ -79,6 +94,16 @@ type SSLKeyPair struct {
 type VirtualServer struct {
 	// SSL holds the SSL configuration for the server.
 	SSL *SSL
+	// HTTPSRedirect holds the HTTPS redirect configuration for the server.
+	// If set, the server redirects all requests to HTTPS and PathRules are empty.
+	HTTPSRedirect *HTTPSRedirect
+	// DefaultAction holds the action of the default server for requests that don't match the hostname
+	// of any server. It is only set for default servers. If nil, the default server returns 404 for HTTP
+	// and rejects the TLS handshake for HTTPS.
+	DefaultAction *DefaultServerAction
+	// BackendClientCertificate holds the client certificate that the server presents to TLS backends.
+	// If nil, no client certificate is presented.
+	BackendClientCertificate *SSL
 	// Hostname is the hostname of the server.
 	Hostname string
 	// PathRules is a collection of routing rules.
@@ -91,28 +116,101 @@ type VirtualServer struct {
 	IsDefault bool
 }
 
+// HTTPSRedirect holds the configuration for redirecting requests to HTTPS.
+type HTTPSRedirect struct {
+	// Port is the HTTPS port that requests are redirected to.
+	Port int32
+	// StatusCode is the status code of the redirect response.
+	StatusCode int
+}
+
+// DefaultServerAction holds the action of a default server.
+type DefaultServerAction struct {
+	// Return holds the response that is returned. It is nil if requests are proxied to an upstream.
+	Return *DefaultServerReturn
+	// UpstreamName is the name of the upstream that requests are proxied to.
+	// It is empty if a response is returned.
+	UpstreamName string
+}
+
+// DefaultServerReturn holds the response of a default server.
+type DefaultServerReturn struct {
+	// Body is the body of the response. For redirects, it is the URL that the client is redirected to.
+	Body string
+	// ContentType is the MIME type of the body. If empty, the default MIME type is used.
+	ContentType string
+	// Code is the status code of the response.
+	Code int
+}
+
 // Layer4VirtualServer is a virtual server for Layer 4 traffic.
 type Layer4VirtualServer struct {
 	// Hostname is the hostname of the server.
 	Hostname string
-	// UpstreamName refers to the name of the upstream that is used.
-	UpstreamName string
+	// Upstreams are the weighted upstreams that the connections are split between.
+	Upstreams []Layer4Upstream
 	// Port is the port of the server.
 	Port int32
 	// IsDefault refers to whether this server is created for the default listener hostname.
 	IsDefault bool
 }
 
+// Layer4Upstream is a weighted upstream of a Layer4VirtualServer.
+type Layer4Upstream struct {
+	// VerifyTLS holds the backend TLS verification configuration of the upstream.
+	// If it is set, NGINX connects to the upstream over TLS.
+	VerifyTLS *VerifyTLS
+	// Name is the name of the upstream. It is empty if the backendRef of the upstream is invalid.
+	Name string
+	// Weight is the weight of the upstream.
+	Weight int32
+}
+
 // Upstream is a pool of endpoints to be load balanced.
 type Upstream struct {
 	// Name is the name of the Upstream. Will be unique for each service/port combination.
 	Name string
 	// ErrorMsg contains the error message if the Upstream is invalid.
 	ErrorMsg string
-	// Endpoints are the endpoints of the Upstream.
+	// Endpoints are the ready endpoints of the Upstream.
 	Endpoints []resolver.Endpoint
+	// TerminatingEndpoints are the endpoints of the Upstream that are terminating.
+	// They are only used by NGINX Plus to drain the connections to the endpoints.
+	TerminatingEndpoints []resolver.Endpoint
 	// Policies holds all the valid policies that apply to the Upstream.
 	Policies []policies.Policy
+	// SessionPersistence holds the session persistence configuration of the Upstream.
+	// It is set from the BackendLBPolicy that targets the Service of the Upstream.
+	SessionPersistence *SessionPersistence
+}
+
+// SessionPersistenceType is the type of session persistence.
+type SessionPersistenceType string
+
+const (
+	// SessionPersistenceCookie identifies a session by a cookie.
+	SessionPersistenceCookie SessionPersistenceType = "cookie"
+	// SessionPersistenceHeader identifies a session by a request header.
+	SessionPersistenceHeader SessionPersistenceType = "header"
+)
+
+// SessionPersistence holds the session persistence configuration of an Upstream.
+type SessionPersistence struct {
+	// Name is the name of the cookie or header that identifies the session.
+	Name string
+	// Expires is the lifetime of a permanent session cookie. It is empty for session cookies
+	// and header based session persistence.
+	Expires string
+	// Type is the type of the session persistence.
+	Type SessionPersistenceType
+}
+
+// ResolvesHostnames returns true if NGINX resolves the addresses of the Upstream's endpoints at runtime.
+// The servers of such an Upstream can't be updated without a reload, because NGINX manages them.
+func (u *Upstream) ResolvesHostnames() bool {
+	return slices.ContainsFunc(u.Endpoints, func(ep resolver.Endpoint) bool {
+		return ep.Resolve
+	})
 }
 
 // SSL is the SSL configuration for a server.
@@ -267,6 +365,8 @@ type Match struct {
 
 // BackendGroup represents a group of Backends for a routing rule in an HTTPRoute.
 type BackendGroup struct {
+	// CanaryMatch routes the requests that match a header or a cookie to the canary Backend of the group.
+	CanaryMatch *CanaryMatch
 	// Source is the NamespacedName of the HTTPRoute the group belongs to.
 	Source types.NamespacedName
 	// Backends is a list of Backends in the Group.
@@ -286,10 +386,29 @@ func (bg *BackendGroup) Name() string {
 	return fmt.Sprintf("group_%s__%s_rule%d", bg.Source.Namespace, bg.Source.Name, bg.RuleIdx)
 }
 
+// CanaryMatch routes the requests that match a header or a cookie to the canary Backend of a BackendGroup,
+// regardless of the weights of the Backends.
+type CanaryMatch struct {
+	// Header is the name of the request header. Either Header or Cookie is set.
+	Header string
+	// Cookie is the name of the cookie.
+	Cookie string
+	// Value is the exact value of the header or the cookie.
+	Value string
+	// BackendIdx is the index of the canary Backend in the BackendGroup.
+	BackendIdx int
+}
+
 // Backend represents a Backend for a routing rule.
 type Backend struct {
 	// VerifyTLS holds the backend TLS verification configuration.
 	VerifyTLS *VerifyTLS
+	// RequestHeaderModifiers holds the HTTPHeaderFilter of the BackendRef.
+	// It is applied in addition to the RequestHeaderModifiers of the MatchRule.
+	RequestHeaderModifiers *HTTPHeaderFilter
+	// ResponseHeaderModifiers holds the HTTPHeaderFilter of the BackendRef.
+	// It is applied in addition to the ResponseHeaderModifiers of the MatchRule.
+	ResponseHeaderModifiers *HTTPHeaderFilter
 	// UpstreamName is the name of the upstream for this backend.
 	UpstreamName string
 	// Weight is the weight of the BackendRef.
@@ -298,6 +417,11 @@ type Backend struct {
 	Weight int32
 	// Valid indicates whether the Backend is valid.
 	Valid bool
+	// TLS indicates whether NGINX connects to the Backend over TLS, because the appProtocol of the Service port
+	// is https or kubernetes.io/wss. The connection is only verified if VerifyTLS is set.
+	TLS bool
+	// WebSocket indicates whether the appProtocol of the Service port is kubernetes.io/ws or kubernetes.io/wss.
+	WebSocket bool
 }
 
 // VerifyTLS holds the backend TLS verification configuration.
@@ -343,6 +467,10 @@ type BaseHTTPConfig struct {
 	RewriteClientIPSettings RewriteClientIPSettings
 	// HTTP2 specifies whether http2 should be enabled for all servers.
 	HTTP2 bool
+	// GRPCWeb specifies whether gRPC-Web requests are translated to native gRPC for all gRPC locations.
+	GRPCWeb bool
+	// GRPCHealthCheck specifies whether all servers respond to gRPC health checks.
+	GRPCHealthCheck bool
 }
 
 // Snippet is a snippet of configuration.
@@ -394,6 +522,16 @@ type Ratio struct {
 	Value int32
 }
 
+// DNSResolverConfig holds the configuration of the DNS servers that NGINX uses to resolve hostnames.
+type DNSResolverConfig struct {
+	// Timeout is the timeout for resolving a hostname.
+	Timeout string
+	// CacheTTL overrides the time that NGINX caches the answers of the DNS servers.
+	CacheTTL string
+	// Addresses are the IP addresses or hostnames of the DNS servers.
+	Addresses []string
+}
+
 // Logging defines logging related settings for NGINX.
 type Logging struct {
 	// ErrorLevel defines the error log level.
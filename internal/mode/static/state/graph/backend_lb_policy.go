@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	ngfsort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// BackendLBPolicy represents a Gateway API BackendLBPolicy.
+type BackendLBPolicy struct {
+	// Source is the source resource.
+	Source *v1alpha2.BackendLBPolicy
+	// Ancestors is a list of ancestor objects of the BackendLBPolicy. Used in status.
+	Ancestors []PolicyAncestor
+	// TargetRefs are the Services that the BackendLBPolicy targets.
+	TargetRefs []PolicyTargetRef
+	// Conditions holds the conditions for the BackendLBPolicy.
+	// These conditions apply to the entire BackendLBPolicy.
+	// The conditions in the Ancestor apply only to the BackendLBPolicy in regard to the Ancestor.
+	Conditions []conditions.Condition
+	// Valid indicates whether the BackendLBPolicy is valid.
+	Valid bool
+}
+
+var (
+	// sessionCookieNameRegexp only allows the characters that NGINX supports in the name of a $cookie_ variable.
+	sessionCookieNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	// sessionHeaderNameRegexp only allows the characters that NGINX can map to the name of an $http_ variable.
+	sessionHeaderNameRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+func processBackendLBPolicies(
+	backendLBPolicies map[types.NamespacedName]*v1alpha2.BackendLBPolicy,
+	services map[types.NamespacedName]*ReferencedService,
+) map[types.NamespacedName]*BackendLBPolicy {
+	if len(backendLBPolicies) == 0 || len(services) == 0 {
+		return nil
+	}
+
+	processedPolicies := make(map[types.NamespacedName]*BackendLBPolicy)
+
+	for nsname, policy := range backendLBPolicies {
+		targetRefs := make([]PolicyTargetRef, 0, len(policy.Spec.TargetRefs))
+
+		for _, ref := range policy.Spec.TargetRefs {
+			refNsName := types.NamespacedName{Name: string(ref.Name), Namespace: policy.Namespace}
+
+			if refGroupKind(ref.Group, ref.Kind) != serviceGroupKind {
+				continue
+			}
+
+			if _, exists := services[refNsName]; !exists {
+				continue
+			}
+
+			targetRefs = append(targetRefs,
+				PolicyTargetRef{
+					Kind:   ref.Kind,
+					Group:  ref.Group,
+					Nsname: refNsName,
+				})
+		}
+
+		if len(targetRefs) == 0 {
+			continue
+		}
+
+		conds := validateBackendLBPolicy(policy)
+
+		processedPolicies[nsname] = &BackendLBPolicy{
+			Source:     policy,
+			Valid:      len(conds) == 0,
+			Conditions: conds,
+			TargetRefs: targetRefs,
+			Ancestors:  make([]PolicyAncestor, 0, len(targetRefs)),
+		}
+	}
+
+	markConflictedBackendLBPolicies(processedPolicies)
+
+	return processedPolicies
+}
+
+func validateBackendLBPolicy(policy *v1alpha2.BackendLBPolicy) []conditions.Condition {
+	sp := policy.Spec.SessionPersistence
+	if sp == nil {
+		return nil
+	}
+
+	spPath := field.NewPath("spec").Child("sessionPersistence")
+
+	var allErrs field.ErrorList
+
+	if sp.IdleTimeout != nil {
+		allErrs = append(allErrs, field.Forbidden(spPath.Child("idleTimeout"), "idleTimeout is not supported"))
+	}
+
+	sessionType := v1.CookieBasedSessionPersistence
+	if sp.Type != nil {
+		sessionType = *sp.Type
+	}
+
+	namePath := spPath.Child("sessionName")
+
+	switch sessionType {
+	case v1.CookieBasedSessionPersistence:
+		if sp.SessionName != nil && !sessionCookieNameRegexp.MatchString(*sp.SessionName) {
+			allErrs = append(allErrs, field.Invalid(
+				namePath,
+				*sp.SessionName,
+				"cookie name must only contain alphanumeric characters and underscores",
+			))
+		}
+	case v1.HeaderBasedSessionPersistence:
+		if sp.SessionName == nil {
+			allErrs = append(allErrs, field.Required(namePath, "header name is required for Header session persistence"))
+		} else if !sessionHeaderNameRegexp.MatchString(*sp.SessionName) {
+			allErrs = append(allErrs, field.Invalid(
+				namePath,
+				*sp.SessionName,
+				"header name must only contain alphanumeric characters, hyphens and underscores",
+			))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(
+			spPath.Child("type"),
+			sessionType,
+			[]string{string(v1.CookieBasedSessionPersistence), string(v1.HeaderBasedSessionPersistence)},
+		))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return []conditions.Condition{staticConds.NewPolicyInvalid(allErrs.ToAggregate().Error())}
+}
+
+// markConflictedBackendLBPolicies marks BackendLBPolicies that target the same Service as a policy of greater
+// precedence as invalid. Policies are sorted by timestamp and then alphabetically.
+// Unlike NGF Policies, BackendLBPolicies always conflict when they target the same Service, because the session
+// persistence settings of a Service can't be merged.
+func markConflictedBackendLBPolicies(pols map[types.NamespacedName]*BackendLBPolicy) {
+	possibles := make(map[PolicyTargetRef][]*BackendLBPolicy)
+
+	for _, policy := range pols {
+		// If a policy is invalid, it cannot conflict with another policy.
+		if !policy.Valid {
+			continue
+		}
+
+		for _, ref := range policy.TargetRefs {
+			possibles[ref] = append(possibles[ref], policy)
+		}
+	}
+
+	for _, policyList := range possibles {
+		if len(policyList) == 1 {
+			continue
+		}
+
+		sort.Slice(
+			policyList, func(i, j int) bool {
+				return ngfsort.LessClientObject(policyList[i].Source, policyList[j].Source)
+			},
+		)
+
+		// The first policy in the list takes precedence, so all the following valid policies are conflicted.
+		for _, conflicted := range policyList[1:] {
+			if !conflicted.Valid {
+				continue
+			}
+
+			conflicted.Valid = false
+			conflicted.Conditions = append(
+				conflicted.Conditions,
+				staticConds.NewPolicyConflicted(fmt.Sprintf("Conflicts with another %s", kinds.BackendLBPolicy)),
+			)
+		}
+	}
+}
+
+// attachBackendLBPolicies attaches the graph's BackendLBPolicies to the Services they target.
+func (g *Graph) attachBackendLBPolicies(ctlrName string) {
+	for _, policy := range g.BackendLBPolicies {
+		for _, ref := range policy.TargetRefs {
+			svc, exists := g.ReferencedServices[ref.Nsname]
+			if !exists {
+				continue
+			}
+
+			attachBackendLBPolicyToService(policy, svc, g.Gateways, ctlrName)
+		}
+	}
+}
+
+func attachBackendLBPolicyToService(
+	policy *BackendLBPolicy,
+	svc *ReferencedService,
+	gws map[types.NamespacedName]*Gateway,
+	ctlrName string,
+) {
+	attached := false
+
+	for _, gw := range getSortedGateways(gws) {
+		if _, exists := svc.GatewayNsNames[client.ObjectKeyFromObject(gw.Source)]; !exists {
+			continue
+		}
+
+		if policyAncestorsFull(policy.Source.Status.Ancestors, len(policy.Ancestors), ctlrName) {
+			break
+		}
+
+		ancestor := PolicyAncestor{
+			Ancestor: createParentReference(v1.GroupName, kinds.Gateway, client.ObjectKeyFromObject(gw.Source)),
+		}
+
+		if !gw.Valid {
+			ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")}
+			if !ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
+				policy.Ancestors = append(policy.Ancestors, ancestor)
+			}
+
+			continue
+		}
+
+		if !ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+		}
+
+		attached = true
+	}
+
+	if attached && policy.Valid {
+		svc.BackendLBPolicy = policy
+	}
+}
@@ -0,0 +1,344 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createTestBackendLBPolicy(
+	name string,
+	creationTime time.Time,
+	sp *v1.SessionPersistence,
+	targetRefs ...v1alpha2.LocalPolicyTargetReference,
+) *v1alpha2.BackendLBPolicy {
+	return &v1alpha2.BackendLBPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         testNs,
+			CreationTimestamp: metav1.NewTime(creationTime),
+		},
+		Spec: v1alpha2.BackendLBPolicySpec{
+			TargetRefs:         targetRefs,
+			SessionPersistence: sp,
+		},
+	}
+}
+
+func TestProcessBackendLBPolicies(t *testing.T) {
+	t.Parallel()
+
+	svcNsName := types.NamespacedName{Namespace: testNs, Name: "svc"}
+	svc2NsName := types.NamespacedName{Namespace: testNs, Name: "svc2"}
+
+	services := map[types.NamespacedName]*ReferencedService{
+		svcNsName:  {},
+		svc2NsName: {},
+	}
+
+	svcRef := createTestRef(kinds.Service, "", "svc")
+	svc2Ref := createTestRef(kinds.Service, "core", "svc2")
+
+	svcTargetRef := PolicyTargetRef{Kind: kinds.Service, Group: "", Nsname: svcNsName}
+	svc2TargetRef := PolicyTargetRef{Kind: kinds.Service, Group: "core", Nsname: svc2NsName}
+
+	cookie := &v1.SessionPersistence{SessionName: helpers.GetPointer("session")}
+
+	now := time.Now()
+
+	valid := createTestBackendLBPolicy("valid", now, cookie, svcRef, svc2Ref)
+	invalid := createTestBackendLBPolicy("invalid", now, &v1.SessionPersistence{
+		Type: helpers.GetPointer(v1.HeaderBasedSessionPersistence),
+	}, svcRef)
+	missingTarget := createTestBackendLBPolicy("missing", now, cookie, createTestRef(kinds.Service, "", "missing"))
+	wrongKind := createTestBackendLBPolicy("wrong-kind", now, cookie, createTestRef(kinds.HTTPRoute, v1.GroupName, "svc"))
+	older := createTestBackendLBPolicy("older", now.Add(-time.Hour), cookie, svc2Ref)
+
+	tests := []struct {
+		policies map[types.NamespacedName]*v1alpha2.BackendLBPolicy
+		expected map[types.NamespacedName]*BackendLBPolicy
+		name     string
+	}{
+		{
+			name:     "no policies",
+			expected: nil,
+		},
+		{
+			name: "mix of valid, invalid and not relevant policies",
+			policies: map[types.NamespacedName]*v1alpha2.BackendLBPolicy{
+				{Namespace: testNs, Name: "valid"}:      valid,
+				{Namespace: testNs, Name: "invalid"}:    invalid,
+				{Namespace: testNs, Name: "missing"}:    missingTarget,
+				{Namespace: testNs, Name: "wrong-kind"}: wrongKind,
+			},
+			expected: map[types.NamespacedName]*BackendLBPolicy{
+				{Namespace: testNs, Name: "valid"}: {
+					Source:     valid,
+					TargetRefs: []PolicyTargetRef{svcTargetRef, svc2TargetRef},
+					Ancestors:  []PolicyAncestor{},
+					Valid:      true,
+				},
+				{Namespace: testNs, Name: "invalid"}: {
+					Source:     invalid,
+					TargetRefs: []PolicyTargetRef{svcTargetRef},
+					Ancestors:  []PolicyAncestor{},
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyInvalid(
+							"spec.sessionPersistence.sessionName: Required value: " +
+								"header name is required for Header session persistence",
+						),
+					},
+				},
+			},
+		},
+		{
+			name: "older policy wins a conflict",
+			policies: map[types.NamespacedName]*v1alpha2.BackendLBPolicy{
+				{Namespace: testNs, Name: "valid"}: valid,
+				{Namespace: testNs, Name: "older"}: older,
+			},
+			expected: map[types.NamespacedName]*BackendLBPolicy{
+				{Namespace: testNs, Name: "valid"}: {
+					Source:     valid,
+					TargetRefs: []PolicyTargetRef{svcTargetRef, svc2TargetRef},
+					Ancestors:  []PolicyAncestor{},
+					Conditions: []conditions.Condition{
+						staticConds.NewPolicyConflicted("Conflicts with another BackendLBPolicy"),
+					},
+				},
+				{Namespace: testNs, Name: "older"}: {
+					Source:     older,
+					TargetRefs: []PolicyTargetRef{svc2TargetRef},
+					Ancestors:  []PolicyAncestor{},
+					Valid:      true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(processBackendLBPolicies(test.policies, services)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestValidateBackendLBPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sp       *v1.SessionPersistence
+		name     string
+		expConds []conditions.Condition
+	}{
+		{
+			name: "no session persistence",
+		},
+		{
+			name: "cookie with default name",
+			sp:   &v1.SessionPersistence{},
+		},
+		{
+			name: "permanent cookie",
+			sp: &v1.SessionPersistence{
+				SessionName:     helpers.GetPointer("session_id"),
+				Type:            helpers.GetPointer(v1.CookieBasedSessionPersistence),
+				AbsoluteTimeout: helpers.GetPointer[v1.Duration]("1h"),
+				CookieConfig: &v1.CookieConfig{
+					LifetimeType: helpers.GetPointer(v1.PermanentCookieLifetimeType),
+				},
+			},
+		},
+		{
+			name: "header",
+			sp: &v1.SessionPersistence{
+				SessionName: helpers.GetPointer("X-Session-ID"),
+				Type:        helpers.GetPointer(v1.HeaderBasedSessionPersistence),
+			},
+		},
+		{
+			name: "invalid cookie name and unsupported idle timeout",
+			sp: &v1.SessionPersistence{
+				SessionName: helpers.GetPointer("session-id"),
+				IdleTimeout: helpers.GetPointer[v1.Duration]("10m"),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"[spec.sessionPersistence.idleTimeout: Forbidden: idleTimeout is not supported, " +
+						"spec.sessionPersistence.sessionName: Invalid value: \"session-id\": " +
+						"cookie name must only contain alphanumeric characters and underscores]",
+				),
+			},
+		},
+		{
+			name: "invalid header name",
+			sp: &v1.SessionPersistence{
+				SessionName: helpers.GetPointer("X-Session ID"),
+				Type:        helpers.GetPointer(v1.HeaderBasedSessionPersistence),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.sessionPersistence.sessionName: Invalid value: \"X-Session ID\": " +
+						"header name must only contain alphanumeric characters, hyphens and underscores",
+				),
+			},
+		},
+		{
+			name: "unsupported type",
+			sp: &v1.SessionPersistence{
+				Type: helpers.GetPointer[v1.SessionPersistenceType]("Query"),
+			},
+			expConds: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					"spec.sessionPersistence.type: Unsupported value: \"Query\": " +
+						"supported values: \"Cookie\", \"Header\"",
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := createTestBackendLBPolicy("policy", time.Now(), test.sp)
+
+			g.Expect(validateBackendLBPolicy(policy)).To(Equal(test.expConds))
+		})
+	}
+}
+
+func TestAttachBackendLBPolicies(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+	gw2NsName := types.NamespacedName{Namespace: testNs, Name: "gateway2"}
+	svcNsName := types.NamespacedName{Namespace: testNs, Name: "svc"}
+
+	getGateway := func(valid bool, nsname types.NamespacedName) *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      nsname.Name,
+					Namespace: nsname.Namespace,
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	getPolicy := func(valid bool) *BackendLBPolicy {
+		return &BackendLBPolicy{
+			Source:     createTestBackendLBPolicy("policy", time.Now(), &v1.SessionPersistence{}),
+			TargetRefs: []PolicyTargetRef{{Kind: kinds.Service, Nsname: svcNsName}},
+			Valid:      valid,
+		}
+	}
+
+	tests := []struct {
+		policy       *BackendLBPolicy
+		gws          map[types.NamespacedName]*Gateway
+		name         string
+		expAncestors []PolicyAncestor
+		expAttached  bool
+	}{
+		{
+			name:   "attachment",
+			policy: getPolicy(true),
+			gws: map[types.NamespacedName]*Gateway{
+				gwNsName: getGateway(true, gwNsName),
+			},
+			expAttached: true,
+			expAncestors: []PolicyAncestor{
+				{Ancestor: getGatewayParentRef(gwNsName)},
+			},
+		},
+		{
+			name:   "attachment; one of the gateways is invalid",
+			policy: getPolicy(true),
+			gws: map[types.NamespacedName]*Gateway{
+				gwNsName:  getGateway(true, gwNsName),
+				gw2NsName: getGateway(false, gw2NsName),
+			},
+			expAttached: true,
+			expAncestors: []PolicyAncestor{
+				{Ancestor: getGatewayParentRef(gwNsName)},
+				{
+					Ancestor:   getGatewayParentRef(gw2NsName),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+				},
+			},
+		},
+		{
+			name:   "no attachment; policy is invalid",
+			policy: getPolicy(false),
+			gws: map[types.NamespacedName]*Gateway{
+				gwNsName: getGateway(true, gwNsName),
+			},
+			expAttached: false,
+			expAncestors: []PolicyAncestor{
+				{Ancestor: getGatewayParentRef(gwNsName)},
+			},
+		},
+		{
+			name:   "no attachment; gateway is invalid",
+			policy: getPolicy(true),
+			gws: map[types.NamespacedName]*Gateway{
+				gwNsName: getGateway(false, gwNsName),
+			},
+			expAttached: false,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   getGatewayParentRef(gwNsName),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			svc := &ReferencedService{
+				GatewayNsNames: map[types.NamespacedName]struct{}{
+					gwNsName:  {},
+					gw2NsName: {},
+				},
+			}
+
+			graph := &Graph{
+				Gateways:           test.gws,
+				ReferencedServices: map[types.NamespacedName]*ReferencedService{svcNsName: svc},
+				BackendLBPolicies: map[types.NamespacedName]*BackendLBPolicy{
+					{Namespace: testNs, Name: "policy"}: test.policy,
+				},
+			}
+
+			graph.attachBackendLBPolicies("nginx-gateway")
+
+			if test.expAttached {
+				g.Expect(svc.BackendLBPolicy).To(Equal(test.policy))
+			} else {
+				g.Expect(svc.BackendLBPolicy).To(BeNil())
+			}
+
+			g.Expect(test.policy.Ancestors).To(Equal(test.expAncestors))
+		})
+	}
+}
@@ -19,14 +19,33 @@ import (
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
+// The Kubernetes standard application protocols of Service ports that affect how NGINX proxies to the backends.
+// See https://kubernetes.io/docs/concepts/services-networking/service/#application-protocol.
+const (
+	// AppProtocolTypeH2C is the appProtocol for HTTP/2 over cleartext.
+	AppProtocolTypeH2C string = "kubernetes.io/h2c"
+	// AppProtocolTypeWS is the appProtocol for WebSocket over cleartext.
+	AppProtocolTypeWS string = "kubernetes.io/ws"
+	// AppProtocolTypeWSS is the appProtocol for WebSocket over TLS.
+	AppProtocolTypeWSS string = "kubernetes.io/wss"
+	// AppProtocolTypeHTTPS is the appProtocol for HTTP over TLS.
+	AppProtocolTypeHTTPS string = "https"
+)
+
 // BackendRef is an internal representation of a backendRef in an HTTP/GRPC/TLSRoute.
 type BackendRef struct {
 	// BackendTLSPolicy is the BackendTLSPolicy of the Service which is referenced by the backendRef.
 	BackendTLSPolicy *BackendTLSPolicy
 	// SvcNsName is the NamespacedName of the Service referenced by the backendRef.
 	SvcNsName types.NamespacedName
+	// ExternalName is the external hostname of the Service if the Service is of type ExternalName.
+	// NGINX resolves the hostname at runtime.
+	ExternalName string
 	// ServicePort is the ServicePort of the Service which is referenced by the backendRef.
 	ServicePort v1.ServicePort
+	// Filters are the filters of the backendRef. Only RequestHeaderModifier and ResponseHeaderModifier filters
+	// are supported.
+	Filters []Filter
 	// Weight is the weight of the backendRef.
 	Weight int32
 	// Valid indicates whether the backendRef is valid.
@@ -42,6 +61,20 @@ func (b BackendRef) ServicePortReference() string {
 	return fmt.Sprintf("%s_%s_%d", b.SvcNsName.Namespace, b.SvcNsName.Name, b.ServicePort.Port)
 }
 
+// AppProtocol returns the appProtocol of the Service port which is referenced by the BackendRef.
+func (b BackendRef) AppProtocol() string {
+	if b.ServicePort.AppProtocol == nil {
+		return ""
+	}
+	return *b.ServicePort.AppProtocol
+}
+
+// UsesTLS returns true if NGINX connects to the backend over TLS because of the appProtocol of the Service port.
+func (b BackendRef) UsesTLS() bool {
+	appProtocol := b.AppProtocol()
+	return appProtocol == AppProtocolTypeHTTPS || appProtocol == AppProtocolTypeWSS
+}
+
 func addBackendRefsToRouteRules(
 	routes map[RouteKey]*L7Route,
 	refGrantResolver *referenceGrantResolver,
@@ -94,6 +127,7 @@ func addBackendRefsToRules(
 				refPath,
 				backendTLSPolicies,
 				npCfg,
+				route.RouteType,
 			)
 
 			backendRefs = append(backendRefs, ref)
@@ -104,6 +138,9 @@ func addBackendRefsToRules(
 
 		if len(backendRefs) > 1 {
 			cond := validateBackendTLSPolicyMatchingAllBackends(backendRefs)
+			if cond == nil {
+				cond = validateAppProtocolMatchingAllBackends(backendRefs)
+			}
 			if cond != nil {
 				route.Conditions = append(route.Conditions, *cond)
 				// mark all backendRefs as invalid
@@ -124,6 +161,7 @@ func createBackendRef(
 	refPath *field.Path,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 	npCfg *NginxProxy,
+	routeType RouteType,
 ) (BackendRef, *conditions.Condition) {
 	// Data plane will handle invalid ref by responding with 500.
 	// Because of that, we always need to add a BackendRef to group.Backends, even if the ref is invalid.
@@ -180,6 +218,19 @@ func createBackendRef(
 		return backendRef, &cond
 	}
 
+	externalName, err := getExternalName(services[svcNsName], npCfg)
+	if err != nil {
+		backendRef = BackendRef{
+			SvcNsName:   svcNsName,
+			ServicePort: svcPort,
+			Weight:      weight,
+			Valid:       false,
+		}
+
+		cond := staticConds.NewRouteBackendRefUnsupportedValue(err.Error())
+		return backendRef, &cond
+	}
+
 	backendTLSPolicy, err := findBackendTLSPolicyForService(
 		backendTLSPolicies,
 		ref.Namespace,
@@ -198,10 +249,24 @@ func createBackendRef(
 		return backendRef, &cond
 	}
 
+	if err := validateRouteBackendRefAppProtocol(routeType, svcPort.AppProtocol); err != nil {
+		backendRef = BackendRef{
+			SvcNsName:   svcNsName,
+			ServicePort: svcPort,
+			Weight:      weight,
+			Valid:       false,
+		}
+
+		cond := staticConds.NewRouteBackendRefUnsupportedProtocol(err.Error())
+		return backendRef, &cond
+	}
+
 	backendRef = BackendRef{
 		SvcNsName:        svcNsName,
+		ExternalName:     externalName,
 		BackendTLSPolicy: backendTLSPolicy,
 		ServicePort:      svcPort,
+		Filters:          ref.Filters,
 		Valid:            true,
 		Weight:           weight,
 	}
@@ -222,7 +287,8 @@ func validateBackendTLSPolicyMatchingAllBackends(backendRefs []BackendRef) *cond
 	checkPoliciesEqual := func(p1, p2 *v1alpha3.BackendTLSPolicy) bool {
 		return !slices.Equal(p1.Spec.Validation.CACertificateRefs, p2.Spec.Validation.CACertificateRefs) ||
 			p1.Spec.Validation.WellKnownCACertificates != p2.Spec.Validation.WellKnownCACertificates ||
-			p1.Spec.Validation.Hostname != p2.Spec.Validation.Hostname
+			p1.Spec.Validation.Hostname != p2.Spec.Validation.Hostname ||
+			!slices.Equal(p1.Spec.Validation.SubjectAltNames, p2.Spec.Validation.SubjectAltNames)
 	}
 
 	for _, backendRef := range backendRefs {
@@ -251,6 +317,64 @@ func validateBackendTLSPolicyMatchingAllBackends(backendRefs []BackendRef) *cond
 	return nil
 }
 
+// validateAppProtocolMatchingAllBackends validates that either all or none of the backends in a rule use TLS
+// because of the appProtocol of their Service ports. NGINX proxies to all backends of a rule with the same protocol.
+func validateAppProtocolMatchingAllBackends(backendRefs []BackendRef) *conditions.Condition {
+	usesTLS := backendRefs[0].UsesTLS()
+
+	for _, backendRef := range backendRefs[1:] {
+		if backendRef.UsesTLS() != usesTLS {
+			msg := fmt.Sprintf(
+				"Either all or none of the backends must use a Service port with appProtocol %s or %s",
+				AppProtocolTypeHTTPS,
+				AppProtocolTypeWSS,
+			)
+			return helpers.GetPointer(staticConds.NewRouteBackendRefUnsupportedProtocol(msg))
+		}
+	}
+
+	return nil
+}
+
+// validateRouteBackendRefAppProtocol validates that the Route type supports the appProtocol of the Service port.
+// Only the Kubernetes standard application protocols and https are recognized; other appProtocols are ignored.
+func validateRouteBackendRefAppProtocol(routeType RouteType, appProtocol *string) error {
+	if appProtocol == nil {
+		return nil
+	}
+
+	err := fmt.Errorf("route type %s does not support Service port appProtocol %s", routeType, *appProtocol)
+
+	switch *appProtocol {
+	case AppProtocolTypeH2C:
+		// GRPC Routes are proxied using grpc_pass, which connects to the backends with HTTP/2.
+		if routeType == RouteTypeHTTP {
+			return fmt.Errorf("%w; NGINX does not support proxying HTTP requests to upstreams with HTTP/2", err)
+		}
+	case AppProtocolTypeWS, AppProtocolTypeWSS:
+		if routeType != RouteTypeHTTP {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTLSRouteBackendRefAppProtocol validates that the appProtocol of the Service port is a protocol
+// over TLS, because TLS Routes pass the TLS connections through to the backends.
+func validateTLSRouteBackendRefAppProtocol(appProtocol *string) error {
+	if appProtocol == nil {
+		return nil
+	}
+
+	switch *appProtocol {
+	case AppProtocolTypeH2C, AppProtocolTypeWS:
+		return fmt.Errorf("route type tls does not support Service port appProtocol %s", *appProtocol)
+	}
+
+	return nil
+}
+
 func findBackendTLSPolicyForService(
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 	refNamespace *gatewayv1.Namespace,
@@ -308,7 +432,14 @@ func getIPFamilyAndPortFromRef(
 	}
 
 	// safe to dereference port here because we already validated that the port is not nil in validateBackendRef.
-	svcPort, err := getServicePort(svc, int32(*ref.Port))
+	port := int32(*ref.Port)
+
+	// The ports of an ExternalName Service are optional, because NGINX connects to the external hostname directly.
+	if svc.Spec.Type == v1.ServiceTypeExternalName && len(svc.Spec.Ports) == 0 {
+		return svc.Spec.IPFamilies, v1.ServicePort{Port: port}, nil
+	}
+
+	svcPort, err := getServicePort(svc, port)
 	if err != nil {
 		return []v1.IPFamily{}, v1.ServicePort{}, err
 	}
@@ -316,6 +447,25 @@ func getIPFamilyAndPortFromRef(
 	return svc.Spec.IPFamilies, svcPort, nil
 }
 
+// getExternalName returns the external hostname of an ExternalName Service, or an empty string for other Services.
+// It returns an error for an ExternalName Service if no DNS resolver is configured in the NginxProxy, because
+// NGINX can't resolve the hostname without it.
+func getExternalName(svc *v1.Service, npCfg *NginxProxy) (string, error) {
+	if svc.Spec.Type != v1.ServiceTypeExternalName {
+		return "", nil
+	}
+
+	if npCfg == nil || npCfg.Source == nil || !npCfg.Valid || npCfg.Source.Spec.DNSResolver == nil {
+		// capitalizing error message to match the rest of the error messages associated with a condition
+		//nolint: stylecheck
+		return "", errors.New(
+			"ExternalName Service requires a DNS resolver to be configured in the NginxProxy",
+		)
+	}
+
+	return svc.Spec.ExternalName, nil
+}
+
 func verifyIPFamily(npCfg *NginxProxy, svcIPFamily []v1.IPFamily) error {
 	if npCfg == nil || npCfg.Source == nil || !npCfg.Valid {
 		return nil
@@ -352,9 +502,21 @@ func validateRouteBackendRef(
 	path *field.Path,
 ) (valid bool, cond conditions.Condition) {
 	// Because all errors cause the same condition but different reasons, we return as soon as we find an error
-	if len(ref.Filters) > 0 {
-		valErr := field.TooMany(path.Child("filters"), len(ref.Filters), 0)
-		return false, staticConds.NewRouteBackendRefUnsupportedValue(valErr.Error())
+	for i, f := range ref.Filters {
+		// Only HTTPRoutes support filters on their backendRefs.
+		if f.RouteType != RouteTypeHTTP {
+			valErr := field.TooMany(path.Child("filters"), len(ref.Filters), 0)
+			return false, staticConds.NewRouteBackendRefUnsupportedValue(valErr.Error())
+		}
+
+		if !slices.Contains(supportedHTTPBackendRefFilterTypes, f.FilterType) {
+			valErr := field.NotSupported(
+				path.Child("filters").Index(i).Child("type"),
+				f.FilterType,
+				supportedHTTPBackendRefFilterTypes,
+			)
+			return false, staticConds.NewRouteBackendRefUnsupportedValue(valErr.Error())
+		}
 	}
 
 	return validateBackendRef(ref.BackendRef, routeNs, refGrantResolver, path)
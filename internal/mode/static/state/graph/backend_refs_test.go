@@ -53,15 +53,58 @@ func TestValidateRouteBackendRef(t *testing.T) {
 			expectedValid: true,
 		},
 		{
-			name: "filters not supported",
+			name: "header modifier filters",
 			ref: RouteBackendRef{
 				BackendRef: getNormalRef(),
-				Filters: []any{
-					[]gatewayv1.HTTPRouteFilter{
-						{
-							Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				Filters: []Filter{
+					{
+						RouteType:  RouteTypeHTTP,
+						FilterType: FilterRequestHeaderModifier,
+						RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+							Set: []gatewayv1.HTTPHeader{{Name: "Host", Value: "canary.example.com"}},
 						},
 					},
+					{
+						RouteType:  RouteTypeHTTP,
+						FilterType: FilterResponseHeaderModifier,
+						ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+							Add: []gatewayv1.HTTPHeader{{Name: "X-Canary", Value: "true"}},
+						},
+					},
+				},
+			},
+			expectedValid: true,
+		},
+		{
+			name: "filter type not supported",
+			ref: RouteBackendRef{
+				BackendRef: getNormalRef(),
+				Filters: []Filter{
+					{
+						RouteType:  RouteTypeHTTP,
+						FilterType: FilterRequestHeaderModifier,
+					},
+					{
+						RouteType:  RouteTypeHTTP,
+						FilterType: FilterURLRewrite,
+					},
+				},
+			},
+			expectedValid: false,
+			expectedCondition: staticConds.NewRouteBackendRefUnsupportedValue(
+				`test.filters[1].type: Unsupported value: "URLRewrite": supported values: ` +
+					`"RequestHeaderModifier", "ResponseHeaderModifier"`,
+			),
+		},
+		{
+			name: "filters not supported for GRPCRoute",
+			ref: RouteBackendRef{
+				BackendRef: getNormalRef(),
+				Filters: []Filter{
+					{
+						RouteType:  RouteTypeGRPC,
+						FilterType: FilterRequestHeaderModifier,
+					},
 				},
 			},
 			expectedValid: false,
@@ -259,6 +302,17 @@ func TestGetIPFamilyAndPortFromRef(t *testing.T) {
 		},
 	}
 
+	externalSvc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "external",
+			Namespace: "test",
+		},
+		Spec: v1.ServiceSpec{
+			Type:         v1.ServiceTypeExternalName,
+			ExternalName: "api.example.com",
+		},
+	}
+
 	tests := []struct {
 		ref            gatewayv1.BackendRef
 		svcNsName      types.NamespacedName
@@ -296,11 +350,22 @@ func TestGetIPFamilyAndPortFromRef(t *testing.T) {
 			expSvcIPFamily: []v1.IPFamily{},
 			svcNsName:      types.NamespacedName{Namespace: "test", Name: "service1"},
 		},
+		{
+			name: "ExternalName service without ports",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Name = "external"
+				backend.Port = helpers.GetPointer[gatewayv1.PortNumber](443)
+				return backend
+			}),
+			expServicePort: v1.ServicePort{Port: 443},
+			svcNsName:      types.NamespacedName{Namespace: "test", Name: "external"},
+		},
 	}
 
 	services := map[types.NamespacedName]*v1.Service{
 		{Namespace: "test", Name: "service1"}: svc1,
 		{Namespace: "test", Name: "service2"}: svc2,
+		{Namespace: "test", Name: "external"}: externalSvc,
 	}
 
 	refPath := field.NewPath("test")
@@ -790,6 +855,29 @@ func TestCreateBackend(t *testing.T) {
 	svc2NamespacedName := types.NamespacedName{Namespace: "test", Name: "service2"}
 	svc3NamespacedName := types.NamespacedName{Namespace: "test", Name: "service3"}
 
+	externalSvc := createService("external")
+	externalSvc.Spec.Type = v1.ServiceTypeExternalName
+	externalSvc.Spec.ExternalName = "api.example.com"
+	externalSvcNamespacedName := types.NamespacedName{Namespace: "test", Name: "external"}
+
+	h2cSvc := createService("h2c")
+	h2cSvc.Spec.Ports[0].AppProtocol = helpers.GetPointer(AppProtocolTypeH2C)
+	h2cSvcNamespacedName := types.NamespacedName{Namespace: "test", Name: "h2c"}
+
+	npWithDNSResolver := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{
+			Spec: ngfAPI.NginxProxySpec{
+				IPFamily: helpers.GetPointer(ngfAPI.Dual),
+				DNSResolver: &ngfAPI.DNSResolver{
+					Addresses: []ngfAPI.DNSResolverAddress{
+						{Type: ngfAPI.DNSResolverIPAddressType, Value: "10.96.0.10"},
+					},
+				},
+			},
+		},
+		Valid: true,
+	}
+
 	btp := BackendTLSPolicy{
 		Source: &v1alpha3.BackendTLSPolicy{
 			ObjectMeta: metav1.ObjectMeta{
@@ -848,6 +936,7 @@ func TestCreateBackend(t *testing.T) {
 		nginxProxy                   *NginxProxy
 		name                         string
 		expectedServicePortReference string
+		routeType                    RouteType
 		ref                          gatewayv1.HTTPBackendRef
 		expectedBackend              BackendRef
 	}{
@@ -987,6 +1076,25 @@ func TestCreateBackend(t *testing.T) {
 			expectedCondition:            nil,
 			name:                         "normal case with policy",
 		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "service2"
+					return backend
+				}),
+			},
+			routeType: RouteTypeGRPC,
+			expectedBackend: BackendRef{
+				SvcNsName:        svc2NamespacedName,
+				ServicePort:      svc1.Spec.Ports[0],
+				Weight:           5,
+				Valid:            true,
+				BackendTLSPolicy: &btp,
+			},
+			expectedServicePortReference: "test_service2_80",
+			expectedCondition:            nil,
+			name:                         "GRPCRoute with policy",
+		},
 		{
 			ref: gatewayv1.HTTPBackendRef{
 				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
@@ -1008,12 +1116,76 @@ func TestCreateBackend(t *testing.T) {
 			),
 			name: "invalid policy",
 		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "external"
+					return backend
+				}),
+			},
+			nginxProxy: npWithDNSResolver,
+			expectedBackend: BackendRef{
+				SvcNsName:    externalSvcNamespacedName,
+				ExternalName: "api.example.com",
+				ServicePort:  externalSvc.Spec.Ports[0],
+				Weight:       5,
+				Valid:        true,
+			},
+			expectedServicePortReference: "test_external_80",
+			expectedCondition:            nil,
+			name:                         "ExternalName service",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "external"
+					return backend
+				}),
+			},
+			expectedBackend: BackendRef{
+				SvcNsName:   externalSvcNamespacedName,
+				ServicePort: externalSvc.Spec.Ports[0],
+				Weight:      5,
+				Valid:       false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefUnsupportedValue(
+					"ExternalName Service requires a DNS resolver to be configured in the NginxProxy",
+				),
+			),
+			name: "ExternalName service without DNS resolver",
+		},
+		{
+			ref: gatewayv1.HTTPBackendRef{
+				BackendRef: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+					backend.Name = "h2c"
+					return backend
+				}),
+			},
+			expectedBackend: BackendRef{
+				SvcNsName:   h2cSvcNamespacedName,
+				ServicePort: h2cSvc.Spec.Ports[0],
+				Weight:      5,
+				Valid:       false,
+			},
+			expectedServicePortReference: "",
+			expectedCondition: helpers.GetPointer(
+				staticConds.NewRouteBackendRefUnsupportedProtocol(
+					"route type http does not support Service port appProtocol kubernetes.io/h2c; " +
+						"NGINX does not support proxying HTTP requests to upstreams with HTTP/2",
+				),
+			),
+			name: "unsupported appProtocol",
+		},
 	}
 
 	services := map[types.NamespacedName]*v1.Service{
-		client.ObjectKeyFromObject(svc1): svc1,
-		client.ObjectKeyFromObject(svc2): svc2,
-		client.ObjectKeyFromObject(svc3): svc3,
+		client.ObjectKeyFromObject(svc1):        svc1,
+		client.ObjectKeyFromObject(svc2):        svc2,
+		client.ObjectKeyFromObject(svc3):        svc3,
+		client.ObjectKeyFromObject(externalSvc): externalSvc,
+		client.ObjectKeyFromObject(h2cSvc):      h2cSvc,
 	}
 	policies := map[types.NamespacedName]*BackendTLSPolicy{
 		client.ObjectKeyFromObject(btp.Source):  &btp,
@@ -1032,9 +1204,14 @@ func TestCreateBackend(t *testing.T) {
 			alwaysTrueRefGrantResolver := func(_ toResource) bool { return true }
 
 			rbr := RouteBackendRef{
-				test.ref.BackendRef,
-				[]any{},
+				BackendRef: test.ref.BackendRef,
+			}
+
+			routeType := test.routeType
+			if routeType == "" {
+				routeType = RouteTypeHTTP
 			}
+
 			backend, cond := createBackendRef(
 				rbr,
 				sourceNamespace,
@@ -1043,6 +1220,7 @@ func TestCreateBackend(t *testing.T) {
 				refPath,
 				policies,
 				test.nginxProxy,
+				routeType,
 			)
 
 			g.Expect(helpers.Diff(test.expectedBackend, backend)).To(BeEmpty())
@@ -1307,3 +1485,178 @@ func TestGetRefGrantFromResourceForRoute_Panics(t *testing.T) {
 
 	g.Expect(get).To(Panic())
 }
+
+func TestValidateRouteBackendRefAppProtocol(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		appProtocol *string
+		name        string
+		routeType   RouteType
+		expErr      string
+	}{
+		{
+			name:      "no appProtocol",
+			routeType: RouteTypeHTTP,
+		},
+		{
+			name:        "unknown appProtocol",
+			appProtocol: helpers.GetPointer("example.com/custom"),
+			routeType:   RouteTypeHTTP,
+		},
+		{
+			name:        "h2c with HTTPRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeH2C),
+			routeType:   RouteTypeHTTP,
+			expErr: "route type http does not support Service port appProtocol kubernetes.io/h2c; " +
+				"NGINX does not support proxying HTTP requests to upstreams with HTTP/2",
+		},
+		{
+			name:        "h2c with GRPCRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeH2C),
+			routeType:   RouteTypeGRPC,
+		},
+		{
+			name:        "ws with HTTPRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeWS),
+			routeType:   RouteTypeHTTP,
+		},
+		{
+			name:        "ws with GRPCRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeWS),
+			routeType:   RouteTypeGRPC,
+			expErr:      "route type grpc does not support Service port appProtocol kubernetes.io/ws",
+		},
+		{
+			name:        "wss with HTTPRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeWSS),
+			routeType:   RouteTypeHTTP,
+		},
+		{
+			name:        "wss with GRPCRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeWSS),
+			routeType:   RouteTypeGRPC,
+			expErr:      "route type grpc does not support Service port appProtocol kubernetes.io/wss",
+		},
+		{
+			name:        "https with HTTPRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeHTTPS),
+			routeType:   RouteTypeHTTP,
+		},
+		{
+			name:        "https with GRPCRoute",
+			appProtocol: helpers.GetPointer(AppProtocolTypeHTTPS),
+			routeType:   RouteTypeGRPC,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateRouteBackendRefAppProtocol(test.routeType, test.appProtocol)
+			if test.expErr != "" {
+				g.Expect(err).To(MatchError(test.expErr))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestValidateTLSRouteBackendRefAppProtocol(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		appProtocol *string
+		name        string
+		expErr      bool
+	}{
+		{
+			name: "no appProtocol",
+		},
+		{
+			name:        "h2c",
+			appProtocol: helpers.GetPointer(AppProtocolTypeH2C),
+			expErr:      true,
+		},
+		{
+			name:        "ws",
+			appProtocol: helpers.GetPointer(AppProtocolTypeWS),
+			expErr:      true,
+		},
+		{
+			name:        "wss",
+			appProtocol: helpers.GetPointer(AppProtocolTypeWSS),
+		},
+		{
+			name:        "https",
+			appProtocol: helpers.GetPointer(AppProtocolTypeHTTPS),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateTLSRouteBackendRefAppProtocol(test.appProtocol)
+			g.Expect(err != nil).To(Equal(test.expErr))
+		})
+	}
+}
+
+func TestValidateAppProtocolMatchingAllBackends(t *testing.T) {
+	t.Parallel()
+
+	getBackendRef := func(appProtocol *string) BackendRef {
+		return BackendRef{
+			ServicePort: v1.ServicePort{Port: 80, AppProtocol: appProtocol},
+			Valid:       true,
+		}
+	}
+
+	expCond := staticConds.NewRouteBackendRefUnsupportedProtocol(
+		"Either all or none of the backends must use a Service port with appProtocol https or kubernetes.io/wss",
+	)
+
+	tests := []struct {
+		expectedCondition *conditions.Condition
+		name              string
+		backendRefs       []BackendRef
+	}{
+		{
+			name: "no backends use TLS",
+			backendRefs: []BackendRef{
+				getBackendRef(nil),
+				getBackendRef(helpers.GetPointer(AppProtocolTypeWS)),
+			},
+		},
+		{
+			name: "all backends use TLS",
+			backendRefs: []BackendRef{
+				getBackendRef(helpers.GetPointer(AppProtocolTypeHTTPS)),
+				getBackendRef(helpers.GetPointer(AppProtocolTypeWSS)),
+			},
+		},
+		{
+			name: "some backends use TLS",
+			backendRefs: []BackendRef{
+				getBackendRef(helpers.GetPointer(AppProtocolTypeHTTPS)),
+				getBackendRef(nil),
+			},
+			expectedCondition: &expCond,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cond := validateAppProtocolMatchingAllBackends(test.backendRefs)
+			g.Expect(cond).To(Equal(test.expectedCondition))
+		})
+	}
+}
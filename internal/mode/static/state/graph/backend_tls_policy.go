@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
@@ -14,10 +15,12 @@ import (
 type BackendTLSPolicy struct {
 	// Source is the source resource.
 	Source *v1alpha3.BackendTLSPolicy
-	// CaCertRef is the name of the ConfigMap that contains the CA certificate.
-	CaCertRef types.NamespacedName
-	// Gateway is the name of the Gateway that is being checked for this BackendTLSPolicy.
-	Gateway types.NamespacedName
+	// CaCertRefs are the names of the ConfigMaps that contain the CA certificates, in the order of the
+	// CACertificateRefs of the BackendTLSPolicy. The certificates are concatenated into a single bundle.
+	CaCertRefs []types.NamespacedName
+	// Gateways are the names of the Gateways that are being checked for this BackendTLSPolicy,
+	// sorted by creation timestamp and name.
+	Gateways []types.NamespacedName
 	// Conditions include Conditions for the BackendTLSPolicy.
 	Conditions []conditions.Condition
 	// Valid shows whether the BackendTLSPolicy is valid.
@@ -32,21 +35,30 @@ func processBackendTLSPolicies(
 	backendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy,
 	configMapResolver *configMapResolver,
 	ctlrName string,
-	gateway *Gateway,
+	gateways map[types.NamespacedName]*Gateway,
 ) map[types.NamespacedName]*BackendTLSPolicy {
-	if len(backendTLSPolicies) == 0 || gateway == nil {
+	if len(backendTLSPolicies) == 0 || len(gateways) == 0 {
 		return nil
 	}
 
+	gatewayNsNames := make([]types.NamespacedName, 0, len(gateways))
+	for _, gw := range getSortedGateways(gateways) {
+		gatewayNsNames = append(gatewayNsNames, client.ObjectKeyFromObject(gw.Source))
+	}
+
 	processedBackendTLSPolicies := make(map[types.NamespacedName]*BackendTLSPolicy, len(backendTLSPolicies))
 	for nsname, backendTLSPolicy := range backendTLSPolicies {
-		var caCertRef types.NamespacedName
+		var caCertRefs []types.NamespacedName
 
 		valid, ignored, conds := validateBackendTLSPolicy(backendTLSPolicy, configMapResolver, ctlrName)
 
 		if valid && !ignored && backendTLSPolicy.Spec.Validation.CACertificateRefs != nil {
-			caCertRef = types.NamespacedName{
-				Namespace: backendTLSPolicy.Namespace, Name: string(backendTLSPolicy.Spec.Validation.CACertificateRefs[0].Name),
+			caCertRefs = make([]types.NamespacedName, 0, len(backendTLSPolicy.Spec.Validation.CACertificateRefs))
+			for _, ref := range backendTLSPolicy.Spec.Validation.CACertificateRefs {
+				caCertRefs = append(caCertRefs, types.NamespacedName{
+					Namespace: backendTLSPolicy.Namespace,
+					Name:      string(ref.Name),
+				})
 			}
 		}
 
@@ -54,12 +66,9 @@ func processBackendTLSPolicies(
 			Source:     backendTLSPolicy,
 			Valid:      valid,
 			Conditions: conds,
-			Gateway: types.NamespacedName{
-				Namespace: gateway.Source.Namespace,
-				Name:      gateway.Source.Name,
-			},
-			CaCertRef: caCertRef,
-			Ignored:   ignored,
+			Gateways:   gatewayNsNames,
+			CaCertRefs: caCertRefs,
+			Ignored:    ignored,
 		}
 	}
 	return processedBackendTLSPolicies
@@ -84,6 +93,15 @@ func validateBackendTLSPolicy(
 		conds = append(conds, staticConds.NewPolicyInvalid(fmt.Sprintf("invalid hostname: %s", err.Error())))
 	}
 
+	if err := unsupportedBackendTLSSubjectAltNames(backendTLSPolicy); err != nil {
+		msg := fmt.Sprintf(
+			"%s; the certificate of the backend is verified against the hostname %q only",
+			err.Error(),
+			backendTLSPolicy.Spec.Validation.Hostname,
+		)
+		conds = append(conds, staticConds.NewBackendTLSPolicySubjectAltNamesUnsupported(msg))
+	}
+
 	caCertRefs := backendTLSPolicy.Spec.Validation.CACertificateRefs
 	wellKnownCerts := backendTLSPolicy.Spec.Validation.WellKnownCACertificates
 	switch {
@@ -124,34 +142,51 @@ func validateBackendTLSHostname(btp *v1alpha3.BackendTLSPolicy) error {
 	return nil
 }
 
+// validateBackendTLSCACertRef validates all CACertificateRefs of the BackendTLSPolicy. The CA certificates of
+// all refs are concatenated into a single bundle, so every ref must resolve to a valid ConfigMap.
 func validateBackendTLSCACertRef(btp *v1alpha3.BackendTLSPolicy, configMapResolver *configMapResolver) error {
-	if len(btp.Spec.Validation.CACertificateRefs) != 1 {
-		path := field.NewPath("tls.cacertrefs")
-		valErr := field.TooMany(path, len(btp.Spec.Validation.CACertificateRefs), 1)
-		return valErr
-	}
-	if btp.Spec.Validation.CACertificateRefs[0].Kind != "ConfigMap" {
-		path := field.NewPath("tls.cacertrefs[0].kind")
-		valErr := field.NotSupported(path, btp.Spec.Validation.CACertificateRefs[0].Kind, []string{"ConfigMap"})
-		return valErr
-	}
-	if btp.Spec.Validation.CACertificateRefs[0].Group != "" &&
-		btp.Spec.Validation.CACertificateRefs[0].Group != "core" {
-		path := field.NewPath("tls.cacertrefs[0].group")
-		valErr := field.NotSupported(path, btp.Spec.Validation.CACertificateRefs[0].Group, []string{"", "core"})
-		return valErr
-	}
-	nsName := types.NamespacedName{
-		Namespace: btp.Namespace,
-		Name:      string(btp.Spec.Validation.CACertificateRefs[0].Name),
-	}
-	if err := configMapResolver.resolve(nsName); err != nil {
-		path := field.NewPath("tls.cacertrefs[0]")
-		return field.Invalid(path, btp.Spec.Validation.CACertificateRefs[0], err.Error())
+	for i, ref := range btp.Spec.Validation.CACertificateRefs {
+		path := field.NewPath("tls.cacertrefs").Index(i)
+
+		if ref.Kind != "ConfigMap" {
+			return field.NotSupported(path.Child("kind"), ref.Kind, []string{"ConfigMap"})
+		}
+		if ref.Group != "" && ref.Group != "core" {
+			return field.NotSupported(path.Child("group"), ref.Group, []string{"", "core"})
+		}
+
+		nsName := types.NamespacedName{
+			Namespace: btp.Namespace,
+			Name:      string(ref.Name),
+		}
+		if err := configMapResolver.resolve(nsName); err != nil {
+			return field.Invalid(path, ref, err.Error())
+		}
 	}
 	return nil
 }
 
+// unsupportedBackendTLSSubjectAltNames returns an error for every SubjectAltName of the BackendTLSPolicy.
+// NGINX verifies the certificate of the backend against the name it also sends as the SNI, and that name is
+// always the Hostname, so none of the SubjectAltNames can be verified. The Policy stays valid and fails closed:
+// a certificate that matches one of the SubjectAltNames but not the Hostname is rejected.
+func unsupportedBackendTLSSubjectAltNames(btp *v1alpha3.BackendTLSPolicy) error {
+	var allErrs field.ErrorList
+
+	path := field.NewPath("tls.subjectaltnames")
+	for i, san := range btp.Spec.Validation.SubjectAltNames {
+		var value string
+		if san.Type == v1alpha3.URISubjectAltNameType {
+			value = string(san.URI)
+		} else {
+			value = string(san.Hostname)
+		}
+		allErrs = append(allErrs, field.NotSupported[string](path.Index(i), value, nil))
+	}
+
+	return allErrs.ToAggregate()
+}
+
 func validateBackendTLSWellKnownCACerts(btp *v1alpha3.BackendTLSPolicy) error {
 	if *btp.Spec.Validation.WellKnownCACertificates != v1alpha3.WellKnownCACertificatesSystem {
 		path := field.NewPath("tls.wellknowncacertificates")
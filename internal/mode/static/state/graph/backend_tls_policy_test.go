@@ -13,6 +13,7 @@ import (
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
 func TestProcessBackendTLSPoliciesEmpty(t *testing.T) {
@@ -46,27 +47,29 @@ func TestProcessBackendTLSPoliciesEmpty(t *testing.T) {
 		},
 	}
 
-	gateway := &Gateway{
-		Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "test"}},
+	gateways := map[types.NamespacedName]*Gateway{
+		{Namespace: "test", Name: "gateway"}: {
+			Source: &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "test"}},
+		},
 	}
 
 	tests := []struct {
 		expected           map[types.NamespacedName]*BackendTLSPolicy
-		gateway            *Gateway
+		gateways           map[types.NamespacedName]*Gateway
 		backendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
 		name               string
 	}{
 		{
 			name:               "no policies",
 			expected:           nil,
-			gateway:            gateway,
+			gateways:           gateways,
 			backendTLSPolicies: nil,
 		},
 		{
-			name:               "nil gateway",
+			name:               "no gateways",
 			expected:           nil,
 			backendTLSPolicies: backendTLSPolicies,
-			gateway:            nil,
+			gateways:           nil,
 		},
 	}
 
@@ -75,7 +78,7 @@ func TestProcessBackendTLSPoliciesEmpty(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			processed := processBackendTLSPolicies(test.backendTLSPolicies, nil, "test", test.gateway)
+			processed := processBackendTLSPolicies(test.backendTLSPolicies, nil, "test", test.gateways)
 
 			g.Expect(processed).To(Equal(test.expected))
 		})
@@ -124,7 +127,20 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 		},
 	}
 
-	localObjectRefTooManyCerts := []gatewayv1.LocalObjectReference{
+	localObjectRefMultipleCerts := []gatewayv1.LocalObjectReference{
+		{
+			Kind:  "ConfigMap",
+			Name:  "configmap",
+			Group: "",
+		},
+		{
+			Kind:  "ConfigMap",
+			Name:  "configmap-2",
+			Group: "",
+		},
+	}
+
+	localObjectRefMultipleCertsWithInvalid := []gatewayv1.LocalObjectReference{
 		{
 			Kind:  "ConfigMap",
 			Name:  "configmap",
@@ -173,11 +189,12 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 	ancestorsWithUs[0] = getAncestorRef("test", "gateway")
 
 	tests := []struct {
-		tlsPolicy *v1alpha3.BackendTLSPolicy
-		gateway   *Gateway
-		name      string
-		isValid   bool
-		ignored   bool
+		tlsPolicy       *v1alpha3.BackendTLSPolicy
+		gateway         *Gateway
+		name            string
+		isValid         bool
+		ignored         bool
+		sansUnsupported bool
 	}{
 		{
 			name: "normal case with ca cert refs",
@@ -196,6 +213,44 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 			},
 			isValid: true,
 		},
+		{
+			name: "normal case with multiple ca cert refs",
+			tlsPolicy: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls-policy",
+					Namespace: "test",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: targetRefNormalCase,
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						CACertificateRefs: localObjectRefMultipleCerts,
+						Hostname:          "foo.test.com",
+					},
+				},
+			},
+			isValid: true,
+		},
+		{
+			name: "normal case with hostname subject alt name",
+			tlsPolicy: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls-policy",
+					Namespace: "test",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: targetRefNormalCase,
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						CACertificateRefs: localObjectRefNormalCase,
+						Hostname:          "foo.test.com",
+						SubjectAltNames: []v1alpha3.SubjectAltName{
+							{Type: v1alpha3.HostnameSubjectAltNameType, Hostname: "bar.test.com"},
+						},
+					},
+				},
+			},
+			isValid:         true,
+			sansUnsupported: true,
+		},
 		{
 			name: "normal case with ca cert refs and 16 ancestors including us",
 			tlsPolicy: &v1alpha3.BackendTLSPolicy{
@@ -329,7 +384,7 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid case with too many ca cert refs",
+			name: "invalid case with multiple ca cert refs including an invalid one",
 			tlsPolicy: &v1alpha3.BackendTLSPolicy{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "tls-policy",
@@ -338,12 +393,55 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 				Spec: v1alpha3.BackendTLSPolicySpec{
 					TargetRefs: targetRefNormalCase,
 					Validation: v1alpha3.BackendTLSPolicyValidation{
-						CACertificateRefs: localObjectRefTooManyCerts,
+						CACertificateRefs: localObjectRefMultipleCertsWithInvalid,
 						Hostname:          "foo.test.com",
 					},
 				},
 			},
 		},
+		{
+			name: "normal case with uri subject alt name",
+			tlsPolicy: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls-policy",
+					Namespace: "test",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: targetRefNormalCase,
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						CACertificateRefs: localObjectRefNormalCase,
+						Hostname:          "foo.test.com",
+						SubjectAltNames: []v1alpha3.SubjectAltName{
+							{Type: v1alpha3.URISubjectAltNameType, URI: "spiffe://test.com/foo"},
+						},
+					},
+				},
+			},
+			isValid:         true,
+			sansUnsupported: true,
+		},
+		{
+			name: "normal case with multiple subject alt names",
+			tlsPolicy: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls-policy",
+					Namespace: "test",
+				},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: targetRefNormalCase,
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						CACertificateRefs: localObjectRefNormalCase,
+						Hostname:          "foo.test.com",
+						SubjectAltNames: []v1alpha3.SubjectAltName{
+							{Type: v1alpha3.HostnameSubjectAltNameType, Hostname: "bar.test.com"},
+							{Type: v1alpha3.HostnameSubjectAltNameType, Hostname: "baz.test.com"},
+						},
+					},
+				},
+			},
+			isValid:         true,
+			sansUnsupported: true,
+		},
 		{
 			name: "invalid case with too both ca cert refs and wellknowncerts",
 			tlsPolicy: &v1alpha3.BackendTLSPolicy{
@@ -393,6 +491,15 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 				"ca.crt": caBlock,
 			},
 		},
+		{Namespace: "test", Name: "configmap-2"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "configmap-2",
+				Namespace: "test",
+			},
+			Data: map[string]string{
+				"ca.crt": caBlock,
+			},
+		},
 		{Namespace: "test", Name: "invalid"}: {
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "invalid",
@@ -414,8 +521,12 @@ func TestValidateBackendTLSPolicy(t *testing.T) {
 
 			g.Expect(valid).To(Equal(test.isValid))
 			g.Expect(ignored).To(Equal(test.ignored))
-			if !test.isValid && !test.ignored {
+			if (!test.isValid && !test.ignored) || test.sansUnsupported {
 				g.Expect(conds).To(HaveLen(1))
+				if test.sansUnsupported {
+					expType := string(staticConds.BackendTLSPolicyConditionSubjectAltNamesVerified)
+					g.Expect(conds[0].Type).To(Equal(expType))
+				}
 			} else {
 				g.Expect(conds).To(BeEmpty())
 			}
@@ -0,0 +1,249 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	ngfsort "github.com/nginx/nginx-gateway-fabric/internal/mode/static/sort"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// Canary represents an ngfAPI.Canary that targets a rule of an HTTPRoute in the graph.
+type Canary struct {
+	// Source is the Canary.
+	Source *ngfAPI.Canary
+	// RouteKey is the key of the HTTPRoute that the Canary targets.
+	RouteKey RouteKey
+	// Conditions define the conditions to be reported in the status of the Canary.
+	Conditions []conditions.Condition
+	// RuleIdx is the index of the targeted rule in the HTTPRoute.
+	RuleIdx int
+	// CanaryBackendIdx is the index of the canary backendRef in the targeted rule.
+	// The other backendRef of the rule is the stable backend.
+	CanaryBackendIdx int
+	// Weight is the weight of the canary backend. The stable backend gets the remainder of 100.
+	// The weights replace the weights of the backendRefs of the targeted rule.
+	Weight int32
+	// Valid indicates whether the Canary is valid.
+	Valid bool
+}
+
+// processCanaries processes the Canaries that target HTTPRoutes in the graph. Canaries that target other
+// HTTPRoutes are not included, because they are not relevant to this controller.
+func processCanaries(
+	canaries map[types.NamespacedName]*ngfAPI.Canary,
+	routes map[RouteKey]*L7Route,
+	plus bool,
+) map[types.NamespacedName]*Canary {
+	if len(canaries) == 0 || len(routes) == 0 {
+		return nil
+	}
+
+	processedCanaries := make(map[types.NamespacedName]*Canary)
+
+	for nsname, canary := range canaries {
+		routeKey := RouteKey{
+			NamespacedName: types.NamespacedName{Namespace: canary.Namespace, Name: string(canary.Spec.TargetRef.Name)},
+			RouteType:      RouteTypeHTTP,
+		}
+
+		route, exists := routes[routeKey]
+		if !exists {
+			continue
+		}
+
+		processed := &Canary{
+			Source:   canary,
+			RouteKey: routeKey,
+			Weight:   getCanaryWeight(canary),
+		}
+
+		var err *field.Error
+		processed.RuleIdx, processed.CanaryBackendIdx, err = resolveCanaryTarget(canary, route)
+		if err == nil && canary.Spec.Analysis != nil && !plus {
+			err = field.Forbidden(field.NewPath("spec").Child("analysis"), "analysis requires NGINX Plus")
+		}
+
+		if err != nil {
+			processed.Conditions = []conditions.Condition{staticConds.NewCanaryInvalid(err.Error())}
+		} else {
+			processed.Valid = true
+		}
+
+		processedCanaries[nsname] = processed
+	}
+
+	markConflictedCanaries(processedCanaries)
+
+	return processedCanaries
+}
+
+// getCanaryWeight returns the weight of the canary backend that the Progressor recorded in the status of
+// the Canary. Until the status is written for the current spec, the Canary is at its first step.
+func getCanaryWeight(canary *ngfAPI.Canary) int32 {
+	if canary.Status.Phase != "" && canary.Status.ObservedGeneration == canary.Generation {
+		return canary.Status.CanaryWeight
+	}
+
+	if len(canary.Spec.Steps) == 0 {
+		return 0
+	}
+
+	return canary.Spec.Steps[0].Weight
+}
+
+// resolveCanaryTarget returns the index of the rule of the route that the Canary targets and the index of
+// the canary backendRef in that rule.
+func resolveCanaryTarget(canary *ngfAPI.Canary, route *L7Route) (ruleIdx, backendIdx int, err *field.Error) {
+	targetPath := field.NewPath("spec").Child("targetRef")
+
+	if !route.Valid {
+		return 0, 0, field.Invalid(targetPath.Child("name"), canary.Spec.TargetRef.Name, "HTTPRoute is invalid")
+	}
+
+	hr, ok := route.Source.(*v1.HTTPRoute)
+	if !ok {
+		panic(fmt.Sprintf("expected HTTPRoute, got %T", route.Source))
+	}
+
+	rules := hr.Spec.Rules
+
+	sectionName := canary.Spec.TargetRef.SectionName
+	if sectionName == nil {
+		if len(rules) != 1 {
+			return 0, 0, field.Required(
+				targetPath.Child("sectionName"),
+				"sectionName is required when the HTTPRoute has more than one rule",
+			)
+		}
+	} else {
+		ruleIdx = -1
+		for i, rule := range rules {
+			if rule.Name != nil && *rule.Name == *sectionName {
+				ruleIdx = i
+				break
+			}
+		}
+
+		if ruleIdx == -1 {
+			return 0, 0, field.NotFound(targetPath.Child("sectionName"), *sectionName)
+		}
+	}
+
+	refs := rules[ruleIdx].BackendRefs
+	backendPath := field.NewPath("spec").Child("canaryBackend")
+
+	if len(refs) != 2 {
+		return 0, 0, field.Invalid(
+			backendPath,
+			canary.Spec.CanaryBackend,
+			"the HTTPRoute rule must have exactly two backendRefs: the canary and the stable backend",
+		)
+	}
+
+	backendIdx = -1
+	for i, ref := range refs {
+		if isCanaryBackendRef(ref.BackendRef, canary) {
+			backendIdx = i
+		}
+	}
+
+	if backendIdx == -1 {
+		return 0, 0, field.NotFound(backendPath, canary.Spec.CanaryBackend)
+	}
+
+	if isCanaryBackendRef(refs[1-backendIdx].BackendRef, canary) {
+		return 0, 0, field.Invalid(
+			backendPath,
+			canary.Spec.CanaryBackend,
+			"the stable backend must be a different Service than the canary backend",
+		)
+	}
+
+	return ruleIdx, backendIdx, nil
+}
+
+func isCanaryBackendRef(ref v1.BackendRef, canary *ngfAPI.Canary) bool {
+	var group v1.Group
+	if ref.Group != nil {
+		group = *ref.Group
+	}
+
+	kind := v1.Kind(kinds.Service)
+	if ref.Kind != nil {
+		kind = *ref.Kind
+	}
+
+	if refGroupKind(group, kind) != serviceGroupKind {
+		return false
+	}
+
+	if ref.Namespace != nil && string(*ref.Namespace) != canary.Namespace {
+		return false
+	}
+
+	return ref.Name == canary.Spec.CanaryBackend
+}
+
+// markConflictedCanaries marks Canaries that target the same HTTPRoute rule as a Canary of greater precedence
+// as invalid. Canaries are sorted by timestamp and then alphabetically.
+func markConflictedCanaries(canaries map[types.NamespacedName]*Canary) {
+	type ruleKey struct {
+		routeKey RouteKey
+		ruleIdx  int
+	}
+
+	possibles := make(map[ruleKey][]*Canary)
+
+	for _, canary := range canaries {
+		if !canary.Valid {
+			continue
+		}
+
+		key := ruleKey{routeKey: canary.RouteKey, ruleIdx: canary.RuleIdx}
+		possibles[key] = append(possibles[key], canary)
+	}
+
+	for _, canaryList := range possibles {
+		if len(canaryList) == 1 {
+			continue
+		}
+
+		sort.Slice(
+			canaryList, func(i, j int) bool {
+				return ngfsort.LessClientObject(canaryList[i].Source, canaryList[j].Source)
+			},
+		)
+
+		for _, conflicted := range canaryList[1:] {
+			conflicted.Valid = false
+			conflicted.Conditions = append(
+				conflicted.Conditions,
+				staticConds.NewCanaryConflicted(fmt.Sprintf("Conflicts with another %s", kinds.Canary)),
+			)
+		}
+	}
+}
+
+// attachCanaries attaches the valid Canaries to the HTTPRoute rules they target.
+func (g *Graph) attachCanaries() {
+	for _, canary := range g.Canaries {
+		if !canary.Valid {
+			continue
+		}
+
+		route := g.Routes[canary.RouteKey]
+		if canary.RuleIdx >= len(route.Spec.Rules) {
+			continue
+		}
+
+		route.Spec.Rules[canary.RuleIdx].Canary = canary
+	}
+}
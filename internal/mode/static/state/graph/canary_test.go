@@ -0,0 +1,377 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func createTestCanary(
+	name string,
+	creationTime time.Time,
+	routeName string,
+	sectionName *v1.SectionName,
+) *ngfAPI.Canary {
+	return &ngfAPI.Canary{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         testNs,
+			CreationTimestamp: metav1.NewTime(creationTime),
+		},
+		Spec: ngfAPI.CanarySpec{
+			TargetRef: ngfAPI.CanaryTargetRef{
+				Name:        v1.ObjectName(routeName),
+				SectionName: sectionName,
+			},
+			CanaryBackend: "canary",
+			Steps:         []ngfAPI.CanaryStep{{Weight: 50}},
+		},
+	}
+}
+
+func createCanaryTestRoute(name string, valid bool, rules ...v1.HTTPRouteRule) *L7Route {
+	return &L7Route{
+		Source: &v1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNs,
+			},
+			Spec: v1.HTTPRouteSpec{
+				Rules: rules,
+			},
+		},
+		RouteType: RouteTypeHTTP,
+		Spec: L7RouteSpec{
+			Rules: make([]RouteRule, len(rules)),
+		},
+		Valid: valid,
+	}
+}
+
+func createCanaryTestRule(name string, backends ...string) v1.HTTPRouteRule {
+	rule := v1.HTTPRouteRule{}
+	if name != "" {
+		rule.Name = helpers.GetPointer[v1.SectionName](v1.SectionName(name))
+	}
+
+	for _, b := range backends {
+		rule.BackendRefs = append(rule.BackendRefs, v1.HTTPBackendRef{
+			BackendRef: v1.BackendRef{
+				BackendObjectReference: v1.BackendObjectReference{
+					Name: v1.ObjectName(b),
+				},
+			},
+		})
+	}
+
+	return rule
+}
+
+func TestProcessCanaries(t *testing.T) {
+	t.Parallel()
+
+	routeKey := func(name string) RouteKey {
+		return RouteKey{
+			NamespacedName: types.NamespacedName{Namespace: testNs, Name: name},
+			RouteType:      RouteTypeHTTP,
+		}
+	}
+
+	routes := map[RouteKey]*L7Route{
+		routeKey("single"): createCanaryTestRoute("single", true, createCanaryTestRule("", "stable", "canary")),
+		routeKey("multi"): createCanaryTestRoute(
+			"multi",
+			true,
+			createCanaryTestRule("first", "other"),
+			createCanaryTestRule("second", "canary", "stable"),
+		),
+		routeKey("invalid"):     createCanaryTestRoute("invalid", false, createCanaryTestRule("", "stable", "canary")),
+		routeKey("one-backend"): createCanaryTestRoute("one-backend", true, createCanaryTestRule("", "canary")),
+		routeKey("no-canary"):   createCanaryTestRoute("no-canary", true, createCanaryTestRule("", "stable", "other")),
+		routeKey("same"):        createCanaryTestRoute("same", true, createCanaryTestRule("", "canary", "canary")),
+	}
+
+	now := time.Now()
+
+	single := createTestCanary("single", now, "single", nil)
+	older := createTestCanary("older", now.Add(-time.Hour), "single", nil)
+	section := createTestCanary("section", now, "multi", helpers.GetPointer[v1.SectionName]("second"))
+	noSection := createTestCanary("no-section", now, "multi", nil)
+	missingSection := createTestCanary("missing-section", now, "multi", helpers.GetPointer[v1.SectionName]("third"))
+	invalidRoute := createTestCanary("invalid-route", now, "invalid", nil)
+	oneBackend := createTestCanary("one-backend", now, "one-backend", nil)
+	noCanary := createTestCanary("no-canary", now, "no-canary", nil)
+	same := createTestCanary("same", now, "same", nil)
+	otherRoute := createTestCanary("other-route", now, "other", nil)
+
+	analysis := createTestCanary("analysis", now, "single", nil)
+	analysis.Spec.Analysis = &ngfAPI.CanaryAnalysis{MaxErrorRate: 5}
+
+	progressed := createTestCanary("progressed", now, "single", nil)
+	progressed.Generation = 2
+	progressed.Spec.Steps = []ngfAPI.CanaryStep{{Weight: 10}, {Weight: 30}}
+	progressed.Status = ngfAPI.CanaryStatus{
+		ObservedGeneration: 2,
+		Phase:              ngfAPI.CanaryPhaseProgressing,
+		CurrentStep:        1,
+		CanaryWeight:       30,
+	}
+
+	outdated := createTestCanary("outdated", now, "single", nil)
+	outdated.Generation = 3
+	outdated.Spec.Steps = []ngfAPI.CanaryStep{{Weight: 20}, {Weight: 40}}
+	outdated.Status = progressed.Status
+
+	tests := []struct {
+		canaries map[types.NamespacedName]*ngfAPI.Canary
+		expected map[types.NamespacedName]*Canary
+		name     string
+		plus     bool
+	}{
+		{
+			name:     "no canaries",
+			expected: nil,
+		},
+		{
+			name: "valid canaries",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "single"}:      single,
+				{Namespace: testNs, Name: "section"}:     section,
+				{Namespace: testNs, Name: "other-route"}: otherRoute,
+			},
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "single"}: {
+					Source:           single,
+					RouteKey:         routeKey("single"),
+					Weight:           50,
+					RuleIdx:          0,
+					CanaryBackendIdx: 1,
+					Valid:            true,
+				},
+				{Namespace: testNs, Name: "section"}: {
+					Source:           section,
+					RouteKey:         routeKey("multi"),
+					Weight:           50,
+					RuleIdx:          1,
+					CanaryBackendIdx: 0,
+					Valid:            true,
+				},
+			},
+		},
+		{
+			name: "invalid canaries",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "no-section"}:      noSection,
+				{Namespace: testNs, Name: "missing-section"}: missingSection,
+				{Namespace: testNs, Name: "invalid-route"}:   invalidRoute,
+				{Namespace: testNs, Name: "one-backend"}:     oneBackend,
+				{Namespace: testNs, Name: "no-canary"}:       noCanary,
+				{Namespace: testNs, Name: "same"}:            same,
+			},
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "no-section"}: {
+					Source:   noSection,
+					RouteKey: routeKey("multi"),
+					Weight:   50,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid("spec.targetRef.sectionName: Required value: " +
+							"sectionName is required when the HTTPRoute has more than one rule"),
+					},
+				},
+				{Namespace: testNs, Name: "missing-section"}: {
+					Source:   missingSection,
+					RouteKey: routeKey("multi"),
+					Weight:   50,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid("spec.targetRef.sectionName: Not found: \"third\""),
+					},
+				},
+				{Namespace: testNs, Name: "invalid-route"}: {
+					Source:   invalidRoute,
+					RouteKey: routeKey("invalid"),
+					Weight:   50,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid(
+							"spec.targetRef.name: Invalid value: \"invalid\": HTTPRoute is invalid",
+						),
+					},
+				},
+				{Namespace: testNs, Name: "one-backend"}: {
+					Source:   oneBackend,
+					RouteKey: routeKey("one-backend"),
+					Weight:   50,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid("spec.canaryBackend: Invalid value: \"canary\": " +
+							"the HTTPRoute rule must have exactly two backendRefs: the canary and the stable backend"),
+					},
+				},
+				{Namespace: testNs, Name: "no-canary"}: {
+					Source:   noCanary,
+					RouteKey: routeKey("no-canary"),
+					Weight:   50,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid("spec.canaryBackend: Not found: \"canary\""),
+					},
+				},
+				{Namespace: testNs, Name: "same"}: {
+					Source:   same,
+					RouteKey: routeKey("same"),
+					Weight:   50,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid("spec.canaryBackend: Invalid value: \"canary\": " +
+							"the stable backend must be a different Service than the canary backend"),
+					},
+				},
+			},
+		},
+		{
+			name: "analysis requires NGINX Plus",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "analysis"}: analysis,
+			},
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "analysis"}: {
+					Source:           analysis,
+					RouteKey:         routeKey("single"),
+					Weight:           50,
+					CanaryBackendIdx: 1,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryInvalid("spec.analysis: Forbidden: analysis requires NGINX Plus"),
+					},
+				},
+			},
+		},
+		{
+			name: "analysis with NGINX Plus",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "analysis"}: analysis,
+			},
+			plus: true,
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "analysis"}: {
+					Source:           analysis,
+					RouteKey:         routeKey("single"),
+					Weight:           50,
+					CanaryBackendIdx: 1,
+					Valid:            true,
+				},
+			},
+		},
+		{
+			name: "weight from the status of the current generation",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "progressed"}: progressed,
+			},
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "progressed"}: {
+					Source:           progressed,
+					RouteKey:         routeKey("single"),
+					Weight:           30,
+					CanaryBackendIdx: 1,
+					Valid:            true,
+				},
+			},
+		},
+		{
+			name: "weight of the first step if the status is outdated",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "outdated"}: outdated,
+			},
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "outdated"}: {
+					Source:           outdated,
+					RouteKey:         routeKey("single"),
+					Weight:           20,
+					CanaryBackendIdx: 1,
+					Valid:            true,
+				},
+			},
+		},
+		{
+			name: "older canary wins a conflict",
+			canaries: map[types.NamespacedName]*ngfAPI.Canary{
+				{Namespace: testNs, Name: "single"}: single,
+				{Namespace: testNs, Name: "older"}:  older,
+			},
+			expected: map[types.NamespacedName]*Canary{
+				{Namespace: testNs, Name: "single"}: {
+					Source:           single,
+					RouteKey:         routeKey("single"),
+					Weight:           50,
+					CanaryBackendIdx: 1,
+					Conditions: []conditions.Condition{
+						staticConds.NewCanaryConflicted("Conflicts with another Canary"),
+					},
+				},
+				{Namespace: testNs, Name: "older"}: {
+					Source:           older,
+					RouteKey:         routeKey("single"),
+					Weight:           50,
+					CanaryBackendIdx: 1,
+					Valid:            true,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(processCanaries(test.canaries, routes, test.plus)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestAttachCanaries(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	routeKey := RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: testNs, Name: "route"},
+		RouteType:      RouteTypeHTTP,
+	}
+
+	route := createCanaryTestRoute(
+		"route",
+		true,
+		createCanaryTestRule("first", "other"),
+		createCanaryTestRule("second", "stable", "canary"),
+	)
+
+	valid := &Canary{
+		Source:           createTestCanary("valid", time.Now(), "route", helpers.GetPointer[v1.SectionName]("second")),
+		RouteKey:         routeKey,
+		RuleIdx:          1,
+		CanaryBackendIdx: 1,
+		Valid:            true,
+	}
+
+	invalid := &Canary{
+		Source:   createTestCanary("invalid", time.Now(), "route", nil),
+		RouteKey: routeKey,
+	}
+
+	graph := &Graph{
+		Routes: map[RouteKey]*L7Route{routeKey: route},
+		Canaries: map[types.NamespacedName]*Canary{
+			{Namespace: testNs, Name: "valid"}:   valid,
+			{Namespace: testNs, Name: "invalid"}: invalid,
+		},
+	}
+
+	graph.attachCanaries()
+
+	g.Expect(route.Spec.Rules[0].Canary).To(BeNil())
+	g.Expect(route.Spec.Rules[1].Canary).To(Equal(valid))
+}
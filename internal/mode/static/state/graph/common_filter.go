@@ -171,6 +171,15 @@ var supportedHTTPFilterTypes = []FilterType{
 	FilterURLRewrite,
 }
 
+// hostHeader is the name of the Host header.
+const hostHeader = "Host"
+
+// supportedHTTPBackendRefFilterTypes are the filter types that can be set on the backendRefs of HTTPRoutes.
+var supportedHTTPBackendRefFilterTypes = []FilterType{
+	FilterRequestHeaderModifier,
+	FilterResponseHeaderModifier,
+}
+
 func validateFilterType(filter Filter, filterPath *field.Path) *field.Error {
 	if filter.RouteType == RouteTypeGRPC && !slices.Contains(supportedGRPCFilterTypes, filter.FilterType) {
 		return field.NotSupported(filterPath.Child("type"), filter.FilterType, supportedGRPCFilterTypes)
@@ -219,6 +228,27 @@ func validateFilter(
 	}
 }
 
+// validateBackendRefFilters validates the values of the filters of a backendRef.
+// Filters of unsupported types are skipped, because they invalidate the backendRef itself,
+// which is done in validateRouteBackendRef.
+func validateBackendRefFilters(
+	validator validation.HTTPFieldsValidator,
+	filters []Filter,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, f := range filters {
+		if !slices.Contains(supportedHTTPBackendRefFilterTypes, f.FilterType) {
+			continue
+		}
+
+		allErrs = append(allErrs, validateFilter(validator, f, path.Index(i))...)
+	}
+
+	return allErrs
+}
+
 func validateFilterHeaderModifier(
 	validator validation.HTTPFieldsValidator,
 	headerModifier *v1.HTTPHeaderFilter,
@@ -228,13 +258,17 @@ func validateFilterHeaderModifier(
 		return field.ErrorList{field.Required(filterPath, "cannot be nil")}
 	}
 
-	return validateFilterHeaderModifierFields(validator, headerModifier, filterPath)
+	return validateFilterHeaderModifierFields(validator, headerModifier, filterPath, true /* allowHostSet */)
 }
 
+// validateFilterHeaderModifierFields validates the fields of a header modifier.
+// If allowHostSet is true, the Host header can be set, as long as its value is a valid hostname.
+// NGINX always sets the Host header of a proxied request, so it can never be added or removed.
 func validateFilterHeaderModifierFields(
 	validator validation.HTTPFieldsValidator,
 	headerModifier *v1.HTTPHeaderFilter,
 	headerModifierPath *field.Path,
+	allowHostSet bool,
 ) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -263,6 +297,13 @@ func validateFilterHeaderModifierFields(
 		}
 	}
 	for _, h := range headerModifier.Set {
+		if allowHostSet && strings.EqualFold(string(h.Name), hostHeader) {
+			if err := validator.ValidateHostname(h.Value); err != nil {
+				valErr := field.Invalid(headerModifierPath.Child(set), h, err.Error())
+				allErrs = append(allErrs, valErr)
+			}
+			continue
+		}
 		if err := validator.ValidateFilterHeaderName(string(h.Name)); err != nil {
 			valErr := field.Invalid(headerModifierPath.Child(set), h, err.Error())
 			allErrs = append(allErrs, valErr)
@@ -287,7 +328,17 @@ func validateFilterResponseHeaderModifier(
 	responseHeaderModifier *v1.HTTPHeaderFilter,
 	filterPath *field.Path,
 ) field.ErrorList {
-	if errList := validateFilterHeaderModifier(validator, responseHeaderModifier, filterPath); errList != nil {
+	if responseHeaderModifier == nil {
+		return field.ErrorList{field.Required(filterPath, "cannot be nil")}
+	}
+
+	errList := validateFilterHeaderModifierFields(
+		validator,
+		responseHeaderModifier,
+		filterPath,
+		false, /* allowHostSet */
+	)
+	if errList != nil {
 		return errList
 	}
 	var allErrs field.ErrorList
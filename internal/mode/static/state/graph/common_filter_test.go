@@ -414,7 +414,7 @@ func TestValidateFilterRequestHeaderModifier(t *testing.T) {
 				Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
 				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
 					Set: []gatewayv1.HTTPHeader{
-						{Name: "Host", Value: "my_host"},
+						{Name: "Connection", Value: "my_host"},
 					},
 					Add: []gatewayv1.HTTPHeader{
 						{Name: "}90yh&$", Value: "gzip$"},
@@ -445,6 +445,57 @@ func TestValidateFilterRequestHeaderModifier(t *testing.T) {
 			expectErrCount: 3,
 			name:           "request header modifier filter not unique names",
 		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidateFilterHeaderNameReturns(errors.New("Invalid header"))
+				return v
+			}(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{
+						{Name: "host", Value: "canary.example.com"},
+					},
+				},
+			},
+			expectErrCount: 0,
+			name:           "request header modifier filter with host set",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidateHostnameReturns(errors.New("Invalid hostname"))
+				return v
+			}(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{
+						{Name: "Host", Value: "canary$example"},
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "request header modifier filter with invalid host set",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := createAllValidValidator()
+				v.ValidateFilterHeaderNameReturns(errors.New("Invalid header"))
+				return v
+			}(),
+			filter: gatewayv1.HTTPRouteFilter{
+				Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Add: []gatewayv1.HTTPHeader{
+						{Name: "Host", Value: "canary.example.com"},
+					},
+				},
+			},
+			expectErrCount: 1,
+			name:           "request header modifier filter with host add",
+		},
 	}
 
 	filterPath := field.NewPath("test")
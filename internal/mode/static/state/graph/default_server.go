@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+)
+
+// DefaultServer holds the action for requests on a Listener port that don't match the hostname of any Listener.
+type DefaultServer struct {
+	// BackendRef is the backend that unmatched requests are proxied to.
+	// It is nil if the Action doesn't reference a backend.
+	BackendRef *BackendRef
+	// Action is the action for unmatched requests.
+	Action ngfAPI.DefaultServerAction
+	// ListenerName is the name of the Listener that the default server is configured for. If the Action
+	// is configured for the whole Gateway, it is the name of the first valid Listener on the port.
+	ListenerName string
+	// Port is the port of the default server.
+	Port v1.PortNumber
+}
+
+// buildDefaultServers builds the default servers for the ports of the valid HTTP and HTTPS Listeners
+// of the Gateway if they are configured in the NginxProxy. For every port, the action of the first Listener
+// on that port with its own action is used. If no Listener on the port has its own action,
+// the Gateway-wide action is used.
+func buildDefaultServers(
+	gw *Gateway,
+	npCfg *NginxProxy,
+	services map[types.NamespacedName]*apiv1.Service,
+) []DefaultServer {
+	if gw == nil || !gw.Valid || npCfg == nil || !npCfg.Valid || npCfg.Source.Spec.DefaultServer == nil {
+		return nil
+	}
+
+	cfg := npCfg.Source.Spec.DefaultServer
+
+	listenerActions := make(map[string]ngfAPI.DefaultServerAction, len(cfg.Listeners))
+	for _, l := range cfg.Listeners {
+		listenerActions[string(l.Name)] = l.Action
+	}
+
+	type portAction struct {
+		action       *ngfAPI.DefaultServerAction
+		listenerName string
+		ownAction    bool
+	}
+
+	portActions := make(map[v1.PortNumber]*portAction)
+
+	for _, l := range gw.Listeners {
+		if !l.Valid {
+			continue
+		}
+
+		if l.Source.Protocol != v1.HTTPProtocolType && l.Source.Protocol != v1.HTTPSProtocolType {
+			continue
+		}
+
+		pa, exists := portActions[l.Source.Port]
+		if exists && pa.ownAction {
+			continue
+		}
+
+		if action, ok := listenerActions[l.Name]; ok {
+			portActions[l.Source.Port] = &portAction{
+				action:       &action,
+				listenerName: l.Name,
+				ownAction:    true,
+			}
+
+			continue
+		}
+
+		if !exists {
+			portActions[l.Source.Port] = &portAction{
+				action:       cfg.Action,
+				listenerName: l.Name,
+			}
+		}
+	}
+
+	var defaultServers []DefaultServer
+
+	for port, pa := range portActions {
+		if pa.action == nil {
+			continue
+		}
+
+		ds := DefaultServer{
+			Action:       *pa.action,
+			ListenerName: pa.listenerName,
+			Port:         port,
+		}
+
+		if pa.action.BackendRef != nil {
+			ds.BackendRef = resolveDefaultServerBackendRef(*pa.action.BackendRef, services)
+		}
+
+		defaultServers = append(defaultServers, ds)
+	}
+
+	sort.Slice(defaultServers, func(i, j int) bool {
+		return defaultServers[i].Port < defaultServers[j].Port
+	})
+
+	return defaultServers
+}
+
+// resolveDefaultServerBackendRef resolves the Service referenced by a default server.
+// The BackendRef is invalid if the Service or its port doesn't exist.
+func resolveDefaultServerBackendRef(
+	ref ngfAPI.DefaultServerBackendRef,
+	services map[types.NamespacedName]*apiv1.Service,
+) *BackendRef {
+	br := &BackendRef{
+		SvcNsName: types.NamespacedName{Namespace: string(ref.Namespace), Name: string(ref.Name)},
+		Weight:    1,
+	}
+
+	svc, exists := services[br.SvcNsName]
+	if !exists {
+		return br
+	}
+
+	svcPort, err := getServicePort(svc, ref.Port)
+	if err != nil {
+		return br
+	}
+
+	br.ServicePort = svcPort
+	br.Valid = true
+
+	return br
+}
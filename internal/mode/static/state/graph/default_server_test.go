@@ -0,0 +1,269 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestBuildDefaultServers(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(name string, protocol v1.ProtocolType, port v1.PortNumber, valid bool) *Listener {
+		return &Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+			},
+			Valid: valid,
+		}
+	}
+
+	createNginxProxy := func(defaultServer *ngfAPI.DefaultServer) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DefaultServer: defaultServer,
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	returnAction := ngfAPI.DefaultServerAction{
+		Return: &ngfAPI.DefaultServerReturn{
+			StatusCode: 418,
+			Body:       helpers.GetPointer("teapot"),
+		},
+	}
+
+	redirectAction := ngfAPI.DefaultServerAction{
+		Redirect: &ngfAPI.DefaultServerRedirect{
+			URL: "https://example.com",
+		},
+	}
+
+	backendAction := func(name string, port int32) ngfAPI.DefaultServerAction {
+		return ngfAPI.DefaultServerAction{
+			BackendRef: &ngfAPI.DefaultServerBackendRef{
+				Namespace: "catch-all",
+				Name:      v1.ObjectName(name),
+				Port:      port,
+			},
+		}
+	}
+
+	svcNsName := types.NamespacedName{Namespace: "catch-all", Name: "svc"}
+	svcPort := apiv1.ServicePort{Name: "http", Port: 80}
+
+	services := map[types.NamespacedName]*apiv1.Service{
+		svcNsName: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "catch-all", Name: "svc"},
+			Spec: apiv1.ServiceSpec{
+				Ports: []apiv1.ServicePort{svcPort},
+			},
+		},
+	}
+
+	listeners := []*Listener{
+		createListener("http", v1.HTTPProtocolType, 80, true),
+		createListener("http-other", v1.HTTPProtocolType, 80, true),
+		createListener("https", v1.HTTPSProtocolType, 443, true),
+		createListener("http-8080", v1.HTTPProtocolType, 8080, true),
+		createListener("invalid", v1.HTTPProtocolType, 9090, false),
+		createListener("tls", v1.TLSProtocolType, 8443, true),
+	}
+
+	validGw := &Gateway{
+		Listeners: listeners,
+		Valid:     true,
+	}
+
+	tests := []struct {
+		gw    *Gateway
+		npCfg *NginxProxy
+		name  string
+		exp   []DefaultServer
+	}{
+		{
+			name:  "nil gateway",
+			npCfg: createNginxProxy(&ngfAPI.DefaultServer{Action: &returnAction}),
+		},
+		{
+			name: "invalid gateway",
+			gw: &Gateway{
+				Listeners: listeners,
+				Valid:     false,
+			},
+			npCfg: createNginxProxy(&ngfAPI.DefaultServer{Action: &returnAction}),
+		},
+		{
+			name: "nil nginx proxy",
+			gw:   validGw,
+		},
+		{
+			name: "invalid nginx proxy",
+			gw:   validGw,
+			npCfg: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						DefaultServer: &ngfAPI.DefaultServer{Action: &returnAction},
+					},
+				},
+				Valid: false,
+			},
+		},
+		{
+			name:  "default server not configured",
+			gw:    validGw,
+			npCfg: createNginxProxy(nil),
+		},
+		{
+			name:  "gateway action",
+			gw:    validGw,
+			npCfg: createNginxProxy(&ngfAPI.DefaultServer{Action: &returnAction}),
+			exp: []DefaultServer{
+				{
+					Action:       returnAction,
+					ListenerName: "http",
+					Port:         80,
+				},
+				{
+					Action:       returnAction,
+					ListenerName: "https",
+					Port:         443,
+				},
+				{
+					Action:       returnAction,
+					ListenerName: "http-8080",
+					Port:         8080,
+				},
+			},
+		},
+		{
+			name: "listener actions override gateway action",
+			gw:   validGw,
+			npCfg: createNginxProxy(&ngfAPI.DefaultServer{
+				Action: &returnAction,
+				Listeners: []ngfAPI.ListenerDefaultServer{
+					{
+						Name:   "http-other",
+						Action: redirectAction,
+					},
+					{
+						Name:   "https",
+						Action: backendAction("svc", 80),
+					},
+					{
+						Name:   "invalid",
+						Action: redirectAction,
+					},
+					{
+						Name:   "tls",
+						Action: redirectAction,
+					},
+				},
+			}),
+			exp: []DefaultServer{
+				{
+					Action:       redirectAction,
+					ListenerName: "http-other",
+					Port:         80,
+				},
+				{
+					Action: backendAction("svc", 80),
+					BackendRef: &BackendRef{
+						SvcNsName:   svcNsName,
+						ServicePort: svcPort,
+						Weight:      1,
+						Valid:       true,
+					},
+					ListenerName: "https",
+					Port:         443,
+				},
+				{
+					Action:       returnAction,
+					ListenerName: "http-8080",
+					Port:         8080,
+				},
+			},
+		},
+		{
+			name: "first listener action on a port wins",
+			gw:   validGw,
+			npCfg: createNginxProxy(&ngfAPI.DefaultServer{
+				Listeners: []ngfAPI.ListenerDefaultServer{
+					{
+						Name:   "http-other",
+						Action: redirectAction,
+					},
+					{
+						Name:   "http",
+						Action: returnAction,
+					},
+				},
+			}),
+			exp: []DefaultServer{
+				{
+					Action:       returnAction,
+					ListenerName: "http",
+					Port:         80,
+				},
+			},
+		},
+		{
+			name: "invalid backend refs",
+			gw:   validGw,
+			npCfg: createNginxProxy(&ngfAPI.DefaultServer{
+				Listeners: []ngfAPI.ListenerDefaultServer{
+					{
+						Name:   "http",
+						Action: backendAction("missing", 80),
+					},
+					{
+						Name:   "http-8080",
+						Action: backendAction("svc", 8080),
+					},
+				},
+			}),
+			exp: []DefaultServer{
+				{
+					Action: backendAction("missing", 80),
+					BackendRef: &BackendRef{
+						SvcNsName: types.NamespacedName{Namespace: "catch-all", Name: "missing"},
+						Weight:    1,
+					},
+					ListenerName: "http",
+					Port:         80,
+				},
+				{
+					Action: backendAction("svc", 8080),
+					BackendRef: &BackendRef{
+						SvcNsName: svcNsName,
+						Weight:    1,
+					},
+					ListenerName: "http-8080",
+					Port:         8080,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildDefaultServers(test.gw, test.npCfg, services)).To(Equal(test.exp))
+		})
+	}
+}
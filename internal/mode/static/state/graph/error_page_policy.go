@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"sort"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// errorPagePolicyReferences holds the resources referenced by the ErrorPagePolicies.
+type errorPagePolicyReferences struct {
+	// configMaps holds the referenced ConfigMaps. If a ConfigMap doesn't exist, the value is nil.
+	// It is nil if no ConfigMaps are referenced.
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap
+	// services holds the NamespacedNames of the referenced Services, including Services that don't exist.
+	services map[types.NamespacedName]struct{}
+	// backendRefs holds the valid backends, sorted by their ServicePortReference.
+	// It is nil if there are no valid backends.
+	backendRefs []BackendRef
+}
+
+// processErrorPagePolicyReferences resolves the ConfigMaps and Services referenced by the valid ErrorPagePolicies.
+// If a referenced ConfigMap key or Service port doesn't exist, the ErrorPagePolicy is marked invalid.
+func processErrorPagePolicyReferences(
+	pols map[PolicyKey]*Policy,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+	services map[types.NamespacedName]*apiv1.Service,
+) errorPagePolicyReferences {
+	refs := errorPagePolicyReferences{
+		configMaps: make(map[types.NamespacedName]*apiv1.ConfigMap),
+		services:   make(map[types.NamespacedName]struct{}),
+	}
+
+	uniqueBackendRefs := make(map[string]BackendRef)
+
+	for _, pol := range pols {
+		epp, ok := pol.Source.(*ngfAPI.ErrorPagePolicy)
+		if !ok || !pol.Valid {
+			continue
+		}
+
+		var allErrs field.ErrorList
+		var backendRefs []BackendRef
+
+		for i, page := range epp.Spec.ErrorPages {
+			pagePath := field.NewPath("spec").Child("errorPages").Index(i)
+
+			switch {
+			case page.Response != nil:
+				ref := page.Response.ConfigMapRef
+				nsname := types.NamespacedName{Namespace: epp.Namespace, Name: string(ref.Name)}
+
+				cm := configMaps[nsname]
+				refs.configMaps[nsname] = cm
+
+				if err := validateErrorPageConfigMap(cm, ref, pagePath.Child("response").Child("configMapRef")); err != nil {
+					allErrs = append(allErrs, err)
+				}
+			case page.BackendRef != nil:
+				nsname := types.NamespacedName{Namespace: epp.Namespace, Name: string(page.BackendRef.Name)}
+				refs.services[nsname] = struct{}{}
+
+				refPath := pagePath.Child("backendRef")
+
+				svc, exists := services[nsname]
+				if !exists {
+					allErrs = append(allErrs, field.NotFound(refPath.Child("name"), page.BackendRef.Name))
+					continue
+				}
+
+				svcPort, err := getServicePort(svc, page.BackendRef.Port)
+				if err != nil {
+					allErrs = append(allErrs, field.NotFound(refPath.Child("port"), page.BackendRef.Port))
+					continue
+				}
+
+				backendRefs = append(backendRefs, BackendRef{
+					SvcNsName:   nsname,
+					ServicePort: svcPort,
+					Weight:      1,
+					Valid:       true,
+				})
+			}
+		}
+
+		if len(allErrs) > 0 {
+			pol.Valid = false
+			pol.Conditions = append(pol.Conditions, staticConds.NewPolicyInvalid(allErrs.ToAggregate().Error()))
+
+			continue
+		}
+
+		for _, br := range backendRefs {
+			uniqueBackendRefs[br.ServicePortReference()] = br
+		}
+	}
+
+	if len(refs.configMaps) == 0 {
+		refs.configMaps = nil
+	}
+
+	if len(uniqueBackendRefs) == 0 {
+		return refs
+	}
+
+	refs.backendRefs = make([]BackendRef, 0, len(uniqueBackendRefs))
+	for _, br := range uniqueBackendRefs {
+		refs.backendRefs = append(refs.backendRefs, br)
+	}
+
+	sort.Slice(refs.backendRefs, func(i, j int) bool {
+		return refs.backendRefs[i].ServicePortReference() < refs.backendRefs[j].ServicePortReference()
+	})
+
+	return refs
+}
+
+func validateErrorPageConfigMap(
+	cm *apiv1.ConfigMap,
+	ref ngfAPI.ErrorPageConfigMapReference,
+	refPath *field.Path,
+) *field.Error {
+	if cm == nil {
+		return field.NotFound(refPath.Child("name"), ref.Name)
+	}
+
+	if _, exists := GetErrorPageBody(cm, ref.Key); !exists {
+		return field.Invalid(refPath.Child("key"), ref.Key, "ConfigMap does not have the data or binaryData key")
+	}
+
+	return nil
+}
+
+// GetErrorPageBody returns the error page body stored under the key of the ConfigMap.
+// It returns false if the key doesn't exist.
+func GetErrorPageBody(cm *apiv1.ConfigMap, key string) ([]byte, bool) {
+	if data, exists := cm.Data[key]; exists {
+		return []byte(data), true
+	}
+
+	if data, exists := cm.BinaryData[key]; exists {
+		return data, true
+	}
+
+	return nil, false
+}
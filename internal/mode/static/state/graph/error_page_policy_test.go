@@ -0,0 +1,262 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/nginx/config/policies/policiesfakes"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestProcessErrorPagePolicyReferences(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string, pages ...ngfAPI.ErrorPage) *Policy {
+		return &Policy{
+			Valid: true,
+			Source: &ngfAPI.ErrorPagePolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+				Spec: ngfAPI.ErrorPagePolicySpec{
+					ErrorPages: pages,
+				},
+			},
+		}
+	}
+
+	responsePage := func(cm, key string) ngfAPI.ErrorPage {
+		return ngfAPI.ErrorPage{
+			Response: &ngfAPI.ErrorPageResponse{
+				ConfigMapRef: ngfAPI.ErrorPageConfigMapReference{
+					Name: v1.ObjectName(cm),
+					Key:  key,
+				},
+			},
+			Codes: []ngfAPI.ErrorPageStatusCode{404},
+		}
+	}
+
+	backendPage := func(svc string, port int32) ngfAPI.ErrorPage {
+		return ngfAPI.ErrorPage{
+			BackendRef: &ngfAPI.ErrorPageBackendRef{
+				Name: v1.ObjectName(svc),
+				Port: port,
+			},
+			Codes: []ngfAPI.ErrorPageStatusCode{502},
+		}
+	}
+
+	redirectPage := ngfAPI.ErrorPage{
+		Redirect: &ngfAPI.ErrorPageRedirect{URL: "https://example.com"},
+		Codes:    []ngfAPI.ErrorPageStatusCode{503},
+	}
+
+	pagesNsName := types.NamespacedName{Namespace: "test", Name: "pages"}
+	missingCMNsName := types.NamespacedName{Namespace: "test", Name: "missing"}
+	errorsNsName := types.NamespacedName{Namespace: "test", Name: "errors"}
+	missingSvcNsName := types.NamespacedName{Namespace: "test", Name: "missing"}
+
+	pagesCM := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "pages", Namespace: "test"},
+		Data: map[string]string{
+			"404.html": "not found",
+		},
+		BinaryData: map[string][]byte{
+			"503.html": []byte("unavailable"),
+		},
+	}
+
+	configMaps := map[types.NamespacedName]*apiv1.ConfigMap{
+		pagesNsName: pagesCM,
+	}
+
+	svcPort := apiv1.ServicePort{Name: "http", Port: 80}
+	services := map[types.NamespacedName]*apiv1.Service{
+		errorsNsName: {
+			ObjectMeta: metav1.ObjectMeta{Name: "errors", Namespace: "test"},
+			Spec: apiv1.ServiceSpec{
+				Ports: []apiv1.ServicePort{svcPort},
+			},
+		},
+	}
+
+	expBackendRef := BackendRef{
+		SvcNsName:   errorsNsName,
+		ServicePort: svcPort,
+		Weight:      1,
+		Valid:       true,
+	}
+
+	tests := []struct {
+		pols          map[PolicyKey]*Policy
+		expConditions map[PolicyKey][]conditions.Condition
+		expValid      map[PolicyKey]bool
+		name          string
+		expRefs       errorPagePolicyReferences
+	}{
+		{
+			name: "no policies",
+			expRefs: errorPagePolicyReferences{
+				services: map[types.NamespacedName]struct{}{},
+			},
+		},
+		{
+			name: "valid policies",
+			pols: map[PolicyKey]*Policy{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "pol1"}}: createPolicy(
+					"pol1",
+					responsePage("pages", "404.html"),
+					backendPage("errors", 80),
+					redirectPage,
+				),
+				{NsName: types.NamespacedName{Namespace: "test", Name: "pol2"}}: createPolicy(
+					"pol2",
+					responsePage("pages", "503.html"),
+					backendPage("errors", 80),
+				),
+				{NsName: types.NamespacedName{Namespace: "test", Name: "other"}}: {
+					Valid:  true,
+					Source: &policiesfakes.FakePolicy{},
+				},
+			},
+			expRefs: errorPagePolicyReferences{
+				configMaps: map[types.NamespacedName]*apiv1.ConfigMap{
+					pagesNsName: pagesCM,
+				},
+				services: map[types.NamespacedName]struct{}{
+					errorsNsName: {},
+				},
+				backendRefs: []BackendRef{expBackendRef},
+			},
+			expValid: map[PolicyKey]bool{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "pol1"}}: true,
+				{NsName: types.NamespacedName{Namespace: "test", Name: "pol2"}}: true,
+			},
+		},
+		{
+			name: "invalid references",
+			pols: map[PolicyKey]*Policy{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-cm"}}: createPolicy(
+					"missing-cm",
+					responsePage("missing", "404.html"),
+				),
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-key"}}: createPolicy(
+					"missing-key",
+					responsePage("pages", "500.html"),
+				),
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-svc"}}: createPolicy(
+					"missing-svc",
+					backendPage("missing", 80),
+				),
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-port"}}: createPolicy(
+					"missing-port",
+					backendPage("errors", 8080),
+				),
+			},
+			expRefs: errorPagePolicyReferences{
+				configMaps: map[types.NamespacedName]*apiv1.ConfigMap{
+					pagesNsName:     pagesCM,
+					missingCMNsName: nil,
+				},
+				services: map[types.NamespacedName]struct{}{
+					errorsNsName:     {},
+					missingSvcNsName: {},
+				},
+			},
+			expValid: map[PolicyKey]bool{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-cm"}}:   false,
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-key"}}:  false,
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-svc"}}:  false,
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-port"}}: false,
+			},
+			expConditions: map[PolicyKey][]conditions.Condition{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-cm"}}: {
+					staticConds.NewPolicyInvalid(
+						"spec.errorPages[0].response.configMapRef.name: Not found: \"missing\"",
+					),
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-key"}}: {
+					staticConds.NewPolicyInvalid(
+						"spec.errorPages[0].response.configMapRef.key: Invalid value: \"500.html\": " +
+							"ConfigMap does not have the data or binaryData key",
+					),
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-svc"}}: {
+					staticConds.NewPolicyInvalid("spec.errorPages[0].backendRef.name: Not found: \"missing\""),
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "missing-port"}}: {
+					staticConds.NewPolicyInvalid("spec.errorPages[0].backendRef.port: Not found: 8080"),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			refs := processErrorPagePolicyReferences(test.pols, configMaps, services)
+			g.Expect(refs).To(Equal(test.expRefs))
+
+			for key, valid := range test.expValid {
+				g.Expect(test.pols[key].Valid).To(Equal(valid), key.NsName.String())
+				g.Expect(test.pols[key].Conditions).To(Equal(test.expConditions[key]), key.NsName.String())
+			}
+		})
+	}
+}
+
+func TestGetErrorPageBody(t *testing.T) {
+	t.Parallel()
+
+	cm := &apiv1.ConfigMap{
+		Data: map[string]string{
+			"data": "data body",
+		},
+		BinaryData: map[string][]byte{
+			"binary": []byte("binary body"),
+		},
+	}
+
+	tests := []struct {
+		name      string
+		key       string
+		expBody   []byte
+		expExists bool
+	}{
+		{
+			name:      "data key",
+			key:       "data",
+			expBody:   []byte("data body"),
+			expExists: true,
+		},
+		{
+			name:      "binary data key",
+			key:       "binary",
+			expBody:   []byte("binary body"),
+			expExists: true,
+		},
+		{
+			name: "missing key",
+			key:  "missing",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			body, exists := GetErrorPageBody(cm, test.key)
+			g.Expect(exists).To(Equal(test.expExists))
+			g.Expect(body).To(Equal(test.expBody))
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
@@ -13,7 +12,7 @@ import (
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
-// Gateway represents the winning Gateway resource.
+// Gateway represents a Gateway resource that belongs to NGF.
 type Gateway struct {
 	// Source is the corresponding Gateway resource.
 	Source *v1.Gateway
@@ -23,73 +22,96 @@ type Gateway struct {
 	Conditions []conditions.Condition
 	// Policies holds the policies attached to the Gateway.
 	Policies []*Policy
+	// HTTPSRedirect holds the HTTP to HTTPS redirects for the Gateway.
+	// It is nil if HTTPS redirects are not enabled in the NginxProxy.
+	HTTPSRedirect *HTTPSRedirect
+	// DefaultServers holds the default servers for unmatched requests, sorted by port.
+	// It is nil if default servers are not configured in the NginxProxy.
+	DefaultServers []DefaultServer
+	// BackendClientCertificate is the Secret with the client certificate that NGINX presents to TLS backends.
+	// It is nil if the Gateway doesn't reference a client certificate or the reference is invalid.
+	BackendClientCertificate *types.NamespacedName
+	// BackendTLSConditions holds the conditions that report the result of resolving the client certificate.
+	// It is empty if the Gateway doesn't reference a client certificate.
+	BackendTLSConditions []conditions.Condition
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
 }
 
-// processedGateways holds the resources that belong to NGF.
-type processedGateways struct {
-	Winner  *v1.Gateway
-	Ignored map[types.NamespacedName]*v1.Gateway
-}
+// processGateways returns the Gateway resources that belong to NGF (determined by the Gateway GatewayClassName
+// field).
+func processGateways(
+	gws map[types.NamespacedName]*v1.Gateway,
+	gcName string,
+) map[types.NamespacedName]*v1.Gateway {
+	referencedGws := make(map[types.NamespacedName]*v1.Gateway)
 
-// GetAllNsNames returns all the NamespacedNames of the Gateway resources that belong to NGF.
-func (gws processedGateways) GetAllNsNames() []types.NamespacedName {
-	winnerCnt := 0
-	if gws.Winner != nil {
-		winnerCnt = 1
-	}
+	for nsname, gw := range gws {
+		if string(gw.Spec.GatewayClassName) != gcName {
+			continue
+		}
 
-	length := winnerCnt + len(gws.Ignored)
-	if length == 0 {
-		return nil
+		referencedGws[nsname] = gw
 	}
 
-	allNsNames := make([]types.NamespacedName, 0, length)
-
-	if gws.Winner != nil {
-		allNsNames = append(allNsNames, client.ObjectKeyFromObject(gws.Winner))
-	}
-	for nsName := range gws.Ignored {
-		allNsNames = append(allNsNames, nsName)
+	if len(referencedGws) == 0 {
+		return nil
 	}
 
-	return allNsNames
+	return referencedGws
 }
 
-// processGateways determines which Gateway resource belong to NGF (determined by the Gateway GatewayClassName field).
-func processGateways(
+// buildGateways builds the Gateways that belong to NGF. Because all Gateways are served by the same NGINX,
+// the Listeners of different Gateways can conflict with each other. Such conflicts are resolved in favor of
+// the Gateway that was created first (see resolveListenerConflictsAcrossGateways).
+func buildGateways(
 	gws map[types.NamespacedName]*v1.Gateway,
-	gcName string,
-) processedGateways {
-	referencedGws := make([]*v1.Gateway, 0, len(gws))
+	secretResolver *secretResolver,
+	gc *GatewayClass,
+	refGrantResolver *referenceGrantResolver,
+	protectedPorts ProtectedPorts,
+) map[types.NamespacedName]*Gateway {
+	if len(gws) == 0 {
+		return nil
+	}
 
-	for _, gw := range gws {
-		if string(gw.Spec.GatewayClassName) != gcName {
-			continue
-		}
+	builtGws := make(map[types.NamespacedName]*Gateway, len(gws))
 
-		referencedGws = append(referencedGws, gw)
+	for nsname, gw := range gws {
+		builtGws[nsname] = buildGateway(gw, secretResolver, gc, refGrantResolver, protectedPorts)
 	}
 
-	if len(referencedGws) == 0 {
-		return processedGateways{}
-	}
+	resolveListenerConflictsAcrossGateways(getSortedGateways(builtGws))
+
+	return builtGws
+}
 
-	sort.Slice(referencedGws, func(i, j int) bool {
-		return ngfsort.LessClientObject(referencedGws[i], referencedGws[j])
+// sortGateways sorts the Gateways by creation timestamp and name, so that the Gateway that was created first
+// comes first.
+func sortGateways(gws []*Gateway) {
+	sort.Slice(gws, func(i, j int) bool {
+		return ngfsort.LessClientObject(gws[i].Source, gws[j].Source)
 	})
+}
 
-	ignoredGws := make(map[types.NamespacedName]*v1.Gateway)
+// GetSortedGateways returns the Gateways of the Graph sorted by creation timestamp and name.
+func (g *Graph) GetSortedGateways() []*Gateway {
+	return getSortedGateways(g.Gateways)
+}
 
-	for _, gw := range referencedGws[1:] {
-		ignoredGws[client.ObjectKeyFromObject(gw)] = gw
+func getSortedGateways(gws map[types.NamespacedName]*Gateway) []*Gateway {
+	if len(gws) == 0 {
+		return nil
 	}
 
-	return processedGateways{
-		Winner:  referencedGws[0],
-		Ignored: ignoredGws,
+	sortedGws := make([]*Gateway, 0, len(gws))
+	for _, gw := range gws {
+		sortedGws = append(sortedGws, gw)
 	}
+
+	sortGateways(sortedGws)
+
+	return sortedGws
 }
 
 func buildGateway(
@@ -113,11 +135,19 @@ func buildGateway(
 		}
 	}
 
-	return &Gateway{
+	builtGw := &Gateway{
 		Source:    gw,
 		Listeners: buildListeners(gw, secretResolver, refGrantResolver, protectedPorts),
 		Valid:     true,
 	}
+
+	clientCert, cond := resolveBackendClientCertificate(gw, secretResolver, refGrantResolver)
+	builtGw.BackendClientCertificate = clientCert
+	if cond != nil {
+		builtGw.BackendTLSConditions = append(builtGw.BackendTLSConditions, *cond)
+	}
+
+	return builtGw
 }
 
 func validateGateway(gw *v1.Gateway, gc *GatewayClass) []conditions.Condition {
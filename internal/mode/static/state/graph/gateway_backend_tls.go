@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+// resolveBackendClientCertificate resolves the client certificate that NGINX presents to backends that require
+// TLS client authentication. The certificate is referenced by the backendTLS field of the Gateway.
+// It returns nil if the Gateway doesn't reference a client certificate or the reference is invalid. In both cases,
+// the returned condition reports the result; it is nil if the Gateway doesn't reference a client certificate.
+func resolveBackendClientCertificate(
+	gw *v1.Gateway,
+	secretResolver *secretResolver,
+	refGrantResolver *referenceGrantResolver,
+) (*types.NamespacedName, *conditions.Condition) {
+	if gw.Spec.BackendTLS == nil || gw.Spec.BackendTLS.ClientCertificateRef == nil {
+		return nil, nil
+	}
+
+	ref := gw.Spec.BackendTLS.ClientCertificateRef
+	path := field.NewPath("spec", "backendTLS", "clientCertificateRef")
+
+	if ref.Group != nil && *ref.Group != "" && *ref.Group != "core" {
+		valErr := field.NotSupported(path.Child("group"), *ref.Group, []string{"", "core"})
+		cond := staticConds.NewGatewayBackendTLSInvalidClientCertificateRef(valErr.Error())
+		return nil, &cond
+	}
+
+	if ref.Kind != nil && *ref.Kind != "Secret" {
+		valErr := field.NotSupported(path.Child("kind"), *ref.Kind, []string{"Secret"})
+		cond := staticConds.NewGatewayBackendTLSInvalidClientCertificateRef(valErr.Error())
+		return nil, &cond
+	}
+
+	nsname := types.NamespacedName{
+		Namespace: gw.Namespace,
+		Name:      string(ref.Name),
+	}
+	if ref.Namespace != nil {
+		nsname.Namespace = string(*ref.Namespace)
+	}
+
+	if nsname.Namespace != gw.Namespace {
+		if !refGrantResolver.refAllowed(toSecret(nsname), fromGateway(gw.Namespace)) {
+			msg := fmt.Sprintf("Client certificate ref to secret %s not permitted by any ReferenceGrant", nsname)
+			cond := staticConds.NewGatewayBackendTLSRefNotPermitted(msg)
+			return nil, &cond
+		}
+	}
+
+	if err := secretResolver.resolve(nsname); err != nil {
+		// field.NotFound could be better, but it doesn't allow us to set the error message.
+		valErr := field.Invalid(path, nsname, err.Error())
+		cond := staticConds.NewGatewayBackendTLSInvalidClientCertificateRef(valErr.Error())
+		return nil, &cond
+	}
+
+	cond := staticConds.NewGatewayBackendTLSClientCertificateConfigured()
+	return &nsname, &cond
+}
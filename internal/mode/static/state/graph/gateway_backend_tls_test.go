@@ -0,0 +1,174 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
+)
+
+func TestResolveBackendClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	createSecret := func(nsname types.NamespacedName) *apiv1.Secret {
+		return &apiv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: nsname.Namespace,
+				Name:      nsname.Name,
+			},
+			Data: map[string][]byte{
+				apiv1.TLSCertKey:       cert,
+				apiv1.TLSPrivateKeyKey: key,
+			},
+			Type: apiv1.SecretTypeTLS,
+		}
+	}
+
+	sameNsSecret := types.NamespacedName{Namespace: "test", Name: "client-cert"}
+	diffNsSecret := types.NamespacedName{Namespace: "diff-ns", Name: "client-cert"}
+	notPermittedSecret := types.NamespacedName{Namespace: "other-ns", Name: "client-cert"}
+
+	secrets := map[types.NamespacedName]*apiv1.Secret{
+		sameNsSecret:       createSecret(sameNsSecret),
+		diffNsSecret:       createSecret(diffNsSecret),
+		notPermittedSecret: createSecret(notPermittedSecret),
+	}
+
+	refGrants := map[types.NamespacedName]*v1beta1.ReferenceGrant{
+		{Namespace: "diff-ns", Name: "ref-grant"}: {
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "diff-ns",
+				Name:      "ref-grant",
+			},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{
+					{
+						Group:     v1.GroupName,
+						Kind:      kinds.Gateway,
+						Namespace: "test",
+					},
+				},
+				To: []v1beta1.ReferenceGrantTo{
+					{
+						Group: "core",
+						Kind:  "Secret",
+					},
+				},
+			},
+		},
+	}
+
+	createGateway := func(ref *v1.SecretObjectReference) *v1.Gateway {
+		gw := &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		}
+
+		if ref != nil {
+			gw.Spec.BackendTLS = &v1.GatewayBackendTLS{
+				ClientCertificateRef: ref,
+			}
+		}
+
+		return gw
+	}
+
+	tests := []struct {
+		gw      *v1.Gateway
+		expCert *types.NamespacedName
+		expCond *conditions.Condition
+		name    string
+	}{
+		{
+			gw:   createGateway(nil),
+			name: "no client certificate",
+		},
+		{
+			gw: createGateway(&v1.SecretObjectReference{
+				Name: "client-cert",
+			}),
+			expCert: &sameNsSecret,
+			expCond: helpers.GetPointer(staticConds.NewGatewayBackendTLSClientCertificateConfigured()),
+			name:    "secret in the same namespace",
+		},
+		{
+			gw: createGateway(&v1.SecretObjectReference{
+				Group:     helpers.GetPointer[v1.Group]("core"),
+				Kind:      helpers.GetPointer[v1.Kind]("Secret"),
+				Name:      "client-cert",
+				Namespace: helpers.GetPointer[v1.Namespace]("diff-ns"),
+			}),
+			expCert: &diffNsSecret,
+			expCond: helpers.GetPointer(staticConds.NewGatewayBackendTLSClientCertificateConfigured()),
+			name:    "secret in a different namespace permitted by a ReferenceGrant",
+		},
+		{
+			gw: createGateway(&v1.SecretObjectReference{
+				Name:      "client-cert",
+				Namespace: helpers.GetPointer[v1.Namespace]("other-ns"),
+			}),
+			expCond: helpers.GetPointer(staticConds.NewGatewayBackendTLSRefNotPermitted(
+				"Client certificate ref to secret other-ns/client-cert not permitted by any ReferenceGrant",
+			)),
+			name: "secret in a different namespace not permitted",
+		},
+		{
+			gw: createGateway(&v1.SecretObjectReference{
+				Name: "does-not-exist",
+			}),
+			expCond: helpers.GetPointer(staticConds.NewGatewayBackendTLSInvalidClientCertificateRef(
+				"spec.backendTLS.clientCertificateRef: Invalid value: test/does-not-exist: secret does not exist",
+			)),
+			name: "secret does not exist",
+		},
+		{
+			gw: createGateway(&v1.SecretObjectReference{
+				Kind: helpers.GetPointer[v1.Kind]("ConfigMap"),
+				Name: "client-cert",
+			}),
+			expCond: helpers.GetPointer(staticConds.NewGatewayBackendTLSInvalidClientCertificateRef(
+				"spec.backendTLS.clientCertificateRef.kind: Unsupported value: \"ConfigMap\": " +
+					"supported values: \"Secret\"",
+			)),
+			name: "unsupported kind",
+		},
+		{
+			gw: createGateway(&v1.SecretObjectReference{
+				Group: helpers.GetPointer[v1.Group]("example.com"),
+				Name:  "client-cert",
+			}),
+			expCond: helpers.GetPointer(staticConds.NewGatewayBackendTLSInvalidClientCertificateRef(
+				"spec.backendTLS.clientCertificateRef.group: Unsupported value: \"example.com\": " +
+					"supported values: \"\", \"core\"",
+			)),
+			name: "unsupported group",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			clientCert, cond := resolveBackendClientCertificate(
+				test.gw,
+				newSecretResolver(secrets),
+				newReferenceGrantResolver(refGrants),
+			)
+
+			g.Expect(clientCert).To(Equal(test.expCert))
+			g.Expect(cond).To(Equal(test.expCond))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
@@ -508,6 +509,78 @@ func createPortConflictResolver() listenerConflictResolver {
 	}
 }
 
+// resolveListenerConflictsAcrossGateways invalidates the Listeners that conflict with the Listeners of other
+// Gateways. The Gateways must be sorted by priority: the Listeners of a Gateway only lose to the Listeners of
+// the Gateways that come before it. Because all Gateways are served by the same NGINX, the Listeners of
+// different Gateways can only share a port if their servers are distinct. Two Listeners of different Gateways
+// conflict if they use the same port and:
+// - their protocols are incompatible (HTTP vs HTTPS or TLS), or
+// - their hostnames overlap, so the same server would serve the routes of both Gateways.
+// Conflicts between the Listeners of the same Gateway are resolved by the port conflict resolver.
+func resolveListenerConflictsAcrossGateways(gws []*Gateway) {
+	type claimedListener struct {
+		listener *Listener
+		gwNsName types.NamespacedName
+	}
+
+	protocolFormat := "Listener port %d is used by Gateway %s with an incompatible protocol; " +
+		"ensure only one protocol per port across Gateways"
+	hostnameFormat := "Listener port %d and hostname %q overlap with a Listener of Gateway %s; " +
+		"ensure Listeners of different Gateways use non-overlapping hostnames for the same port"
+
+	claimedPorts := make(map[v1.PortNumber][]claimedListener)
+
+	findConflict := func(l *Listener) []conditions.Condition {
+		for _, claimed := range claimedPorts[l.Source.Port] {
+			other := claimed.listener
+
+			if isSecureProtocol(other.Source.Protocol) != isSecureProtocol(l.Source.Protocol) {
+				msg := fmt.Sprintf(protocolFormat, l.Source.Port, claimed.gwNsName)
+				return staticConds.NewListenerProtocolConflict(msg)
+			}
+
+			if haveOverlap(other.Source.Hostname, l.Source.Hostname) {
+				msg := fmt.Sprintf(hostnameFormat, l.Source.Port, getHostname(l.Source.Hostname), claimed.gwNsName)
+				return staticConds.NewListenerHostnameConflict(msg)
+			}
+		}
+
+		return nil
+	}
+
+	for _, gw := range gws {
+		gwNsName := client.ObjectKeyFromObject(gw.Source)
+
+		var unclaimed []*Listener
+
+		for _, l := range gw.Listeners {
+			if !l.Valid {
+				continue
+			}
+
+			if conds := findConflict(l); len(conds) > 0 {
+				l.Valid = false
+				l.Conditions = append(l.Conditions, conds...)
+
+				continue
+			}
+
+			unclaimed = append(unclaimed, l)
+		}
+
+		for _, l := range unclaimed {
+			claimedPorts[l.Source.Port] = append(claimedPorts[l.Source.Port], claimedListener{
+				listener: l,
+				gwNsName: gwNsName,
+			})
+		}
+	}
+}
+
+func isSecureProtocol(protocol v1.ProtocolType) bool {
+	return protocol == v1.HTTPSProtocolType || protocol == v1.TLSProtocolType
+}
+
 func createExternalReferencesForTLSSecretsResolver(
 	gwNs string,
 	secretResolver *secretResolver,
@@ -638,3 +638,174 @@ func TestValidateTLSFieldOnTLSListener(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveListenerConflictsAcrossGateways(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(name string, protocol v1.ProtocolType, port v1.PortNumber, hostname string) *Listener {
+		l := &Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+			},
+			Valid: true,
+		}
+
+		if hostname != "" {
+			l.Source.Hostname = (*v1.Hostname)(helpers.GetPointer(hostname))
+		}
+
+		return l
+	}
+
+	createGateway := func(name string, listeners ...*Listener) *Gateway {
+		return &Gateway{
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			},
+			Listeners: listeners,
+			Valid:     true,
+		}
+	}
+
+	invalidListener := createListener("invalid", v1.HTTPProtocolType, 80, "")
+	invalidListener.Valid = false
+
+	gw1 := createGateway(
+		"gw-1",
+		createListener("http", v1.HTTPProtocolType, 80, ""),
+		createListener("https", v1.HTTPSProtocolType, 443, "foo.example.com"),
+		createListener("tls", v1.TLSProtocolType, 8443, "*.example.com"),
+	)
+
+	gw2 := createGateway(
+		"gw-2",
+		createListener("http-same-hostname", v1.HTTPProtocolType, 80, "bar.example.com"),
+		createListener("https-protocol-conflict", v1.HTTPSProtocolType, 80, "baz.example.com"),
+		createListener("http-other-port", v1.HTTPProtocolType, 8080, ""),
+		createListener("https-other-hostname", v1.HTTPSProtocolType, 443, "bar.example.com"),
+		createListener("tls-same-hostname", v1.TLSProtocolType, 443, "foo.example.com"),
+		createListener("tls-other-hostname", v1.TLSProtocolType, 8443, "foo.example.org"),
+		createListener("https-overlapping-hostname", v1.HTTPSProtocolType, 8443, "foo.example.com"),
+		createListener("tls-same-port-as-own-listener", v1.TLSProtocolType, 9443, "bar.example.com"),
+		createListener("https-same-port-as-own-listener", v1.HTTPSProtocolType, 9443, ""),
+		invalidListener,
+	)
+
+	gw3 := createGateway(
+		"gw-3",
+		createListener("http", v1.HTTPProtocolType, 8080, "bar.example.com"),
+		createListener("http-other-port", v1.HTTPProtocolType, 9090, ""),
+		createListener("https-other-hostname", v1.HTTPSProtocolType, 443, "baz.example.com"),
+	)
+
+	resolveListenerConflictsAcrossGateways([]*Gateway{gw1, gw2, gw3})
+
+	protocolConflict := func(port int, gw string) []conditions.Condition {
+		return staticConds.NewListenerProtocolConflict(fmt.Sprintf(
+			"Listener port %d is used by Gateway test/%s with an incompatible protocol; "+
+				"ensure only one protocol per port across Gateways",
+			port,
+			gw,
+		))
+	}
+
+	hostnameConflict := func(port int, hostname, gw string) []conditions.Condition {
+		return staticConds.NewListenerHostnameConflict(fmt.Sprintf(
+			"Listener port %d and hostname %q overlap with a Listener of Gateway test/%s; "+
+				"ensure Listeners of different Gateways use non-overlapping hostnames for the same port",
+			port,
+			hostname,
+			gw,
+		))
+	}
+
+	tests := []struct {
+		listener      *Listener
+		name          string
+		expConditions []conditions.Condition
+		expValid      bool
+	}{
+		{
+			name:     "listeners of the first gateway always win",
+			listener: gw1.Listeners[0],
+			expValid: true,
+		},
+		{
+			name:          "same port and protocol with a hostname that overlaps a catch-all hostname",
+			listener:      gw2.Listeners[0],
+			expConditions: hostnameConflict(80, "bar.example.com", "gw-1"),
+		},
+		{
+			name:          "incompatible protocol",
+			listener:      gw2.Listeners[1],
+			expConditions: protocolConflict(80, "gw-1"),
+		},
+		{
+			name:     "different port",
+			listener: gw2.Listeners[2],
+			expValid: true,
+		},
+		{
+			name:     "same port and protocol with a non-overlapping hostname",
+			listener: gw2.Listeners[3],
+			expValid: true,
+		},
+		{
+			name:          "HTTPS and TLS with the same hostname",
+			listener:      gw2.Listeners[4],
+			expConditions: hostnameConflict(443, "foo.example.com", "gw-1"),
+		},
+		{
+			name:     "HTTPS and TLS with non-overlapping hostnames",
+			listener: gw2.Listeners[5],
+			expValid: true,
+		},
+		{
+			name:          "hostname that overlaps a wildcard hostname",
+			listener:      gw2.Listeners[6],
+			expConditions: hostnameConflict(8443, "foo.example.com", "gw-1"),
+		},
+		{
+			name:     "listeners of the same gateway share a port",
+			listener: gw2.Listeners[7],
+			expValid: true,
+		},
+		{
+			name:     "listeners of the same gateway share a port with overlapping hostnames",
+			listener: gw2.Listeners[8],
+			expValid: true,
+		},
+		{
+			name:     "invalid listener is not changed",
+			listener: gw2.Listeners[9],
+		},
+		{
+			name:          "conflict with a listener of a newer gateway",
+			listener:      gw3.Listeners[0],
+			expConditions: hostnameConflict(8080, "bar.example.com", "gw-2"),
+		},
+		{
+			name:     "unused port",
+			listener: gw3.Listeners[1],
+			expValid: true,
+		},
+		{
+			name:     "port shared by listeners of two older gateways",
+			listener: gw3.Listeners[2],
+			expValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(test.listener.Valid).To(Equal(test.expValid))
+			g.Expect(test.listener.Conditions).To(Equal(test.expConditions))
+		})
+	}
+}
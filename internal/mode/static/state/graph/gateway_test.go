@@ -17,61 +17,11 @@ import (
 	staticConds "github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/conditions"
 )
 
-func TestProcessedGatewaysGetAllNsNames(t *testing.T) {
-	t.Parallel()
-	winner := &v1.Gateway{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "test",
-			Name:      "gateway-1",
-		},
-	}
-	loser := &v1.Gateway{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: "test",
-			Name:      "gateway-2",
-		},
-	}
-
-	tests := []struct {
-		gws      processedGateways
-		name     string
-		expected []types.NamespacedName
-	}{
-		{
-			gws:      processedGateways{},
-			expected: nil,
-			name:     "no gateways",
-		},
-		{
-			gws: processedGateways{
-				Winner: winner,
-				Ignored: map[types.NamespacedName]*v1.Gateway{
-					client.ObjectKeyFromObject(loser): loser,
-				},
-			},
-			expected: []types.NamespacedName{
-				client.ObjectKeyFromObject(winner),
-				client.ObjectKeyFromObject(loser),
-			},
-			name: "winner and ignored",
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-			g := NewWithT(t)
-			result := test.gws.GetAllNsNames()
-			g.Expect(result).To(Equal(test.expected))
-		})
-	}
-}
-
 func TestProcessGateways(t *testing.T) {
 	t.Parallel()
 	const gcName = "test-gc"
 
-	winner := &v1.Gateway{
+	gw1 := &v1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: "test",
 			Name:      "gateway-1",
@@ -80,7 +30,7 @@ func TestProcessGateways(t *testing.T) {
 			GatewayClassName: gcName,
 		},
 	}
-	loser := &v1.Gateway{
+	gw2 := &v1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: "test",
 			Name:      "gateway-2",
@@ -92,12 +42,12 @@ func TestProcessGateways(t *testing.T) {
 
 	tests := []struct {
 		gws      map[types.NamespacedName]*v1.Gateway
-		expected processedGateways
+		expected map[types.NamespacedName]*v1.Gateway
 		name     string
 	}{
 		{
 			gws:      nil,
-			expected: processedGateways{},
+			expected: nil,
 			name:     "no gateways",
 		},
 		{
@@ -106,29 +56,26 @@ func TestProcessGateways(t *testing.T) {
 					Spec: v1.GatewaySpec{GatewayClassName: "some-class"},
 				},
 			},
-			expected: processedGateways{},
+			expected: nil,
 			name:     "unrelated gateway",
 		},
 		{
 			gws: map[types.NamespacedName]*v1.Gateway{
-				{Namespace: "test", Name: "gateway-1"}: winner,
+				{Namespace: "test", Name: "gateway-1"}: gw1,
 			},
-			expected: processedGateways{
-				Winner:  winner,
-				Ignored: map[types.NamespacedName]*v1.Gateway{},
+			expected: map[types.NamespacedName]*v1.Gateway{
+				{Namespace: "test", Name: "gateway-1"}: gw1,
 			},
 			name: "one gateway",
 		},
 		{
 			gws: map[types.NamespacedName]*v1.Gateway{
-				{Namespace: "test", Name: "gateway-1"}: winner,
-				{Namespace: "test", Name: "gateway-2"}: loser,
+				{Namespace: "test", Name: "gateway-1"}: gw1,
+				{Namespace: "test", Name: "gateway-2"}: gw2,
 			},
-			expected: processedGateways{
-				Winner: winner,
-				Ignored: map[types.NamespacedName]*v1.Gateway{
-					{Namespace: "test", Name: "gateway-2"}: loser,
-				},
+			expected: map[types.NamespacedName]*v1.Gateway{
+				{Namespace: "test", Name: "gateway-1"}: gw1,
+				{Namespace: "test", Name: "gateway-2"}: gw2,
 			},
 			name: "multiple gateways",
 		},
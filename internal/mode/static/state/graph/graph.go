@@ -8,7 +8,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1alpha3"
@@ -34,27 +33,26 @@ type ClusterState struct {
 	Secrets            map[types.NamespacedName]*v1.Secret
 	CRDMetadata        map[types.NamespacedName]*metav1.PartialObjectMetadata
 	BackendTLSPolicies map[types.NamespacedName]*v1alpha3.BackendTLSPolicy
+	BackendLBPolicies  map[types.NamespacedName]*v1alpha2.BackendLBPolicy
 	ConfigMaps         map[types.NamespacedName]*v1.ConfigMap
 	NginxProxies       map[types.NamespacedName]*ngfAPI.NginxProxy
 	GRPCRoutes         map[types.NamespacedName]*gatewayv1.GRPCRoute
 	NGFPolicies        map[PolicyKey]policies.Policy
 	SnippetsFilters    map[types.NamespacedName]*ngfAPI.SnippetsFilter
+	Canaries           map[types.NamespacedName]*ngfAPI.Canary
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
 type Graph struct {
 	// GatewayClass holds the GatewayClass resource.
 	GatewayClass *GatewayClass
-	// Gateway holds the winning Gateway resource.
-	Gateway *Gateway
+	// Gateways holds the Gateway resources that belong to NGF (based on the GatewayClassName field of the resource).
+	// All Gateways are served by the same NGINX.
+	Gateways map[types.NamespacedName]*Gateway
 	// IgnoredGatewayClasses holds the ignored GatewayClass resources, which reference NGINX Gateway Fabric in the
 	// controllerName, but are not configured via the NGINX Gateway Fabric CLI argument. It doesn't hold the GatewayClass
 	// resources that do not belong to the NGINX Gateway Fabric.
 	IgnoredGatewayClasses map[types.NamespacedName]*gatewayv1.GatewayClass
-	// IgnoredGateways holds the ignored Gateway resources, which belong to the NGINX Gateway Fabric (based on the
-	// GatewayClassName field of the resource) but ignored. It doesn't hold the Gateway resources that do not belong to
-	// the NGINX Gateway Fabric.
-	IgnoredGateways map[types.NamespacedName]*gatewayv1.Gateway
 	// Routes hold Route resources.
 	Routes map[RouteKey]*L7Route
 	// L4Routes hold L4Route resources.
@@ -70,8 +68,15 @@ type Graph struct {
 	ReferencedServices map[types.NamespacedName]*ReferencedService
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*CaCertConfigMap
+	// ReferencedErrorPageConfigMaps includes ConfigMaps that have been referenced by any ErrorPagePolicies.
+	// If a ConfigMap doesn't exist, the value is nil.
+	ReferencedErrorPageConfigMaps map[types.NamespacedName]*v1.ConfigMap
+	// ErrorPageBackendRefs are the backends referenced by valid ErrorPagePolicies.
+	ErrorPageBackendRefs []BackendRef
 	// BackendTLSPolicies holds BackendTLSPolicy resources.
 	BackendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
+	// BackendLBPolicies holds BackendLBPolicy resources that target Services referenced by Routes.
+	BackendLBPolicies map[types.NamespacedName]*BackendLBPolicy
 	// NginxProxy holds the NginxProxy config for the GatewayClass.
 	NginxProxy *NginxProxy
 	// NGFPolicies holds all NGF Policies.
@@ -83,6 +88,8 @@ type Graph struct {
 	SnippetsFilters map[types.NamespacedName]*SnippetsFilter
 	// PlusSecrets holds the secrets related to NGINX Plus licensing.
 	PlusSecrets map[types.NamespacedName][]PlusSecretFile
+	// Canaries holds the Canaries that target HTTPRoutes in the graph.
+	Canaries map[types.NamespacedName]*Canary
 }
 
 // ProtectedPorts are the ports that may not be configured by a listener with a descriptive name of each port.
@@ -98,7 +105,8 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 		return exists || plusSecretExists
 	case *v1.ConfigMap:
 		_, exists := g.ReferencedCaCertConfigMaps[nsname]
-		return exists
+		_, errorPageExists := g.ReferencedErrorPageConfigMaps[nsname]
+		return exists || errorPageExists
 	case *v1.Namespace:
 		// `existed` is needed as it checks the graph's ReferencedNamespaces which stores all the namespaces that
 		// match the Gateway listener's label selector when the graph was created. This covers the case when
@@ -113,7 +121,7 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 		// `exists` does not cover the case highlighted above by `existed` and vice versa so both are needed.
 
 		_, existed := g.ReferencedNamespaces[nsname]
-		exists := isNamespaceReferenced(obj, g.Gateway)
+		exists := isNamespaceReferenced(obj, g.Gateways)
 		return existed || exists
 	// Service reference exists if at least one HTTPRoute references it.
 	case *v1.Service:
@@ -177,11 +185,8 @@ func (g *Graph) gatewayAPIResourceExist(ref v1alpha2.LocalPolicyTargetReference,
 
 	switch kind := ref.Kind; kind {
 	case kinds.Gateway:
-		if g.Gateway == nil {
-			return false
-		}
-
-		return gatewayExists(refNsName, g.Gateway.Source, g.IgnoredGateways)
+		_, exists := g.Gateways[refNsName]
+		return exists
 	case kinds.HTTPRoute, kinds.GRPCRoute:
 		_, exists := g.Routes[routeKeyForKind(kind, refNsName)]
 		return exists
@@ -192,6 +197,9 @@ func (g *Graph) gatewayAPIResourceExist(ref v1alpha2.LocalPolicyTargetReference,
 }
 
 // BuildGraph builds a Graph from a state.
+// If routeCache is not nil, the per-Route stages of building the HTTPRoutes and GRPCRoutes are only run for
+// the Routes whose dependencies have changed since the previous build (see RouteCache).
+// All other stages of the build are run in full.
 func BuildGraph(
 	state ClusterState,
 	controllerName string,
@@ -199,6 +207,8 @@ func BuildGraph(
 	plusSecrets map[types.NamespacedName][]PlusSecretFile,
 	validators validation.Validators,
 	protectedPorts ProtectedPorts,
+	plus bool,
+	routeCache *RouteCache,
 ) *Graph {
 	var globalSettings *policies.GlobalSettings
 
@@ -223,97 +233,139 @@ func BuildGraph(
 
 	processedGws := processGateways(state.Gateways, gcName)
 
+	gatewayNsNames := make([]types.NamespacedName, 0, len(processedGws))
+	for nsname := range processedGws {
+		gatewayNsNames = append(gatewayNsNames, nsname)
+	}
+
 	refGrantResolver := newReferenceGrantResolver(state.ReferenceGrants)
 
-	gw := buildGateway(processedGws.Winner, secretResolver, gc, refGrantResolver, protectedPorts)
+	gws := buildGateways(processedGws, secretResolver, gc, refGrantResolver, protectedPorts)
 
 	processedBackendTLSPolicies := processBackendTLSPolicies(
 		state.BackendTLSPolicies,
 		configMapResolver,
 		controllerName,
-		gw,
+		gws,
 	)
 
 	processedSnippetsFilters := processSnippetsFilters(state.SnippetsFilters)
 
-	routes := buildRoutesForGateways(
-		validators.HTTPFieldsValidator,
-		state.HTTPRoutes,
-		state.GRPCRoutes,
-		processedGws.GetAllNsNames(),
-		npCfg,
-		processedSnippetsFilters,
-	)
+	var routes map[RouteKey]*L7Route
+	if routeCache != nil {
+		routes = routeCache.buildRoutes(
+			validators.HTTPFieldsValidator,
+			state.HTTPRoutes,
+			state.GRPCRoutes,
+			gatewayNsNames,
+			npCfg,
+			processedSnippetsFilters,
+		)
+	} else {
+		routes = buildRoutesForGateways(
+			validators.HTTPFieldsValidator,
+			state.HTTPRoutes,
+			state.GRPCRoutes,
+			gatewayNsNames,
+			npCfg,
+			processedSnippetsFilters,
+		)
+	}
 
 	l4routes := buildL4RoutesForGateways(
 		state.TLSRoutes,
-		processedGws.GetAllNsNames(),
+		gatewayNsNames,
 		state.Services,
 		npCfg,
 		refGrantResolver,
+		processedBackendTLSPolicies,
 	)
 
-	bindRoutesToListeners(routes, l4routes, gw, state.Namespaces)
-	addBackendRefsToRouteRules(routes, refGrantResolver, state.Services, processedBackendTLSPolicies, npCfg)
+	bindRoutesToListeners(routes, l4routes, gws, state.Namespaces)
+	for _, gw := range gws {
+		gw.HTTPSRedirect = buildHTTPSRedirect(gw, npCfg)
+		gw.DefaultServers = buildDefaultServers(gw, npCfg, state.Services)
+	}
+	if routeCache != nil {
+		routeCache.addBackendRefsToRouteRules(
+			routes,
+			refGrantResolver,
+			state.ReferenceGrants,
+			state.Services,
+			processedBackendTLSPolicies,
+			npCfg,
+		)
+	} else {
+		addBackendRefsToRouteRules(routes, refGrantResolver, state.Services, processedBackendTLSPolicies, npCfg)
+	}
+
+	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gws)
 
-	referencedNamespaces := buildReferencedNamespaces(state.Namespaces, gw)
+	referencedServices := buildReferencedServices(routes, l4routes, gws)
+	for _, gw := range gws {
+		for _, ds := range gw.DefaultServers {
+			if ds.BackendRef == nil {
+				continue
+			}
 
-	referencedServices := buildReferencedServices(routes, l4routes, gw)
+			if _, exists := referencedServices[ds.BackendRef.SvcNsName]; !exists {
+				referencedServices[ds.BackendRef.SvcNsName] = &ReferencedService{}
+			}
+		}
+	}
 
 	// policies must be processed last because they rely on the state of the other resources in the graph
 	processedPolicies := processPolicies(
 		state.NGFPolicies,
 		validators.PolicyValidator,
-		processedGws,
+		gws,
 		routes,
 		referencedServices,
 		globalSettings,
 	)
 
+	processedBackendLBPolicies := processBackendLBPolicies(state.BackendLBPolicies, referencedServices)
+
+	processedCanaries := processCanaries(state.Canaries, routes, plus)
+
+	errorPageRefs := processErrorPagePolicyReferences(processedPolicies, state.ConfigMaps, state.Services)
+	for nsname := range errorPageRefs.services {
+		if _, exists := referencedServices[nsname]; !exists {
+			referencedServices[nsname] = &ReferencedService{}
+		}
+	}
+
 	setPlusSecretContent(state.Secrets, plusSecrets)
 
 	g := &Graph{
-		GatewayClass:               gc,
-		Gateway:                    gw,
-		Routes:                     routes,
-		L4Routes:                   l4routes,
-		IgnoredGatewayClasses:      processedGwClasses.Ignored,
-		IgnoredGateways:            processedGws.Ignored,
-		ReferencedSecrets:          secretResolver.getResolvedSecrets(),
-		ReferencedNamespaces:       referencedNamespaces,
-		ReferencedServices:         referencedServices,
-		ReferencedCaCertConfigMaps: configMapResolver.getResolvedConfigMaps(),
-		BackendTLSPolicies:         processedBackendTLSPolicies,
-		NginxProxy:                 npCfg,
-		NGFPolicies:                processedPolicies,
-		GlobalSettings:             globalSettings,
-		SnippetsFilters:            processedSnippetsFilters,
-		PlusSecrets:                plusSecrets,
+		GatewayClass:                  gc,
+		Gateways:                      gws,
+		Routes:                        routes,
+		L4Routes:                      l4routes,
+		IgnoredGatewayClasses:         processedGwClasses.Ignored,
+		ReferencedSecrets:             secretResolver.getResolvedSecrets(),
+		ReferencedNamespaces:          referencedNamespaces,
+		ReferencedServices:            referencedServices,
+		ReferencedCaCertConfigMaps:    configMapResolver.getResolvedConfigMaps(),
+		ReferencedErrorPageConfigMaps: errorPageRefs.configMaps,
+		ErrorPageBackendRefs:          errorPageRefs.backendRefs,
+		BackendTLSPolicies:            processedBackendTLSPolicies,
+		BackendLBPolicies:             processedBackendLBPolicies,
+		NginxProxy:                    npCfg,
+		NGFPolicies:                   processedPolicies,
+		GlobalSettings:                globalSettings,
+		SnippetsFilters:               processedSnippetsFilters,
+		PlusSecrets:                   plusSecrets,
+		Canaries:                      processedCanaries,
 	}
 
 	g.attachPolicies(controllerName)
+	g.attachBackendLBPolicies(controllerName)
+	g.attachCanaries()
 
 	return g
 }
 
-func gatewayExists(
-	gwNsName types.NamespacedName,
-	winner *gatewayv1.Gateway,
-	ignored map[types.NamespacedName]*gatewayv1.Gateway,
-) bool {
-	if winner == nil {
-		return false
-	}
-
-	if client.ObjectKeyFromObject(winner) == gwNsName {
-		return true
-	}
-
-	_, exists := ignored[gwNsName]
-
-	return exists
-}
-
 // SecretFileType describes the type of Secret file used for NGINX Plus.
 type SecretFileType int
 
@@ -1,6 +1,7 @@
 package graph
 
 import (
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -86,9 +87,12 @@ func TestBuildGraph(t *testing.T) {
 		},
 		Valid:        true,
 		IsReferenced: true,
-		Gateway:      types.NamespacedName{Namespace: testNs, Name: "gateway-1"},
-		Conditions:   btpAcceptedConds,
-		CaCertRef:    types.NamespacedName{Namespace: "service", Name: "configmap"},
+		Gateways: []types.NamespacedName{
+			{Namespace: testNs, Name: "gateway-1"},
+			{Namespace: testNs, Name: "gateway-2"},
+		},
+		Conditions: btpAcceptedConds,
+		CaCertRefs: []types.NamespacedName{{Namespace: "service", Name: "configmap"}},
 	}
 
 	commonGWBackendRef := gatewayv1.BackendRef{
@@ -168,8 +172,8 @@ func TestBuildGraph(t *testing.T) {
 			{
 				SvcNsName:        types.NamespacedName{Namespace: "service", Name: "foo"},
 				ServicePort:      v1.ServicePort{Port: 80},
-				Valid:            true,
 				Weight:           1,
+				Valid:            true,
 				BackendTLSPolicy: &btp,
 			},
 		}
@@ -718,15 +722,18 @@ func TestBuildGraph(t *testing.T) {
 		},
 		Spec: L4RouteSpec{
 			Hostnames: tr.Spec.Hostnames,
-			BackendRef: BackendRef{
-				SvcNsName: types.NamespacedName{
-					Namespace: "test",
-					Name:      "foo2",
-				},
-				ServicePort: v1.ServicePort{
-					Port: 80,
+			BackendRefs: []BackendRef{
+				{
+					SvcNsName: types.NamespacedName{
+						Namespace: "test",
+						Name:      "foo2",
+					},
+					ServicePort: v1.ServicePort{
+						Port: 80,
+					},
+					Weight: 1,
+					Valid:  true,
 				},
-				Valid: true,
 			},
 		},
 	}
@@ -748,15 +755,18 @@ func TestBuildGraph(t *testing.T) {
 		},
 		Spec: L4RouteSpec{
 			Hostnames: tr.Spec.Hostnames,
-			BackendRef: BackendRef{
-				SvcNsName: types.NamespacedName{
-					Namespace: "test",
-					Name:      "foo2",
-				},
-				ServicePort: v1.ServicePort{
-					Port: 80,
+			BackendRefs: []BackendRef{
+				{
+					SvcNsName: types.NamespacedName{
+						Namespace: "test",
+						Name:      "foo2",
+					},
+					ServicePort: v1.ServicePort{
+						Port: 80,
+					},
+					Weight: 1,
+					Valid:  true,
 				},
-				Valid: true,
 			},
 		},
 	}
@@ -809,6 +819,9 @@ func TestBuildGraph(t *testing.T) {
 		},
 	}
 
+	gwHostnameConflictFormat := "Listener port %d and hostname %q overlap with a Listener of Gateway %s; " +
+		"ensure Listeners of different Gateways use non-overlapping hostnames for the same port"
+
 	supportedKindsForListeners := []gatewayv1.RouteGroupKind{
 		{Kind: gatewayv1.Kind(kinds.HTTPRoute), Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
 		{Kind: gatewayv1.Kind(kinds.GRPCRoute), Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
@@ -821,60 +834,119 @@ func TestBuildGraph(t *testing.T) {
 				Valid:      true,
 				Conditions: []conditions.Condition{staticConds.NewGatewayClassResolvedRefs()},
 			},
-			Gateway: &Gateway{
-				Source: gw1,
-				Listeners: []*Listener{
-					{
-						Name:       "listener-80-1",
-						Source:     gw1.Spec.Listeners[0],
-						Valid:      true,
-						Attachable: true,
-						Routes: map[RouteKey]*L7Route{
-							CreateRouteKey(hr1): routeHR1,
-							CreateRouteKey(gr):  routeGR,
+			Gateways: map[types.NamespacedName]*Gateway{
+				client.ObjectKeyFromObject(gw1): {
+					Source: gw1,
+					Listeners: []*Listener{
+						{
+							Name:       "listener-80-1",
+							Source:     gw1.Spec.Listeners[0],
+							Valid:      true,
+							Attachable: true,
+							Routes: map[RouteKey]*L7Route{
+								CreateRouteKey(hr1): routeHR1,
+								CreateRouteKey(gr):  routeGR,
+							},
+							SupportedKinds:            supportedKindsForListeners,
+							L4Routes:                  map[L4RouteKey]*L4Route{},
+							AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"app": "allowed"}),
 						},
-						SupportedKinds:            supportedKindsForListeners,
-						L4Routes:                  map[L4RouteKey]*L4Route{},
-						AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"app": "allowed"}),
-					},
-					{
-						Name:           "listener-443-1",
-						Source:         gw1.Spec.Listeners[1],
-						Valid:          true,
-						Attachable:     true,
-						Routes:         map[RouteKey]*L7Route{CreateRouteKey(hr3): routeHR3},
-						L4Routes:       map[L4RouteKey]*L4Route{},
-						ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secret)),
-						SupportedKinds: supportedKindsForListeners,
-					},
-					{
-						Name:       "listener-443-2",
-						Source:     gw1.Spec.Listeners[2],
-						Valid:      true,
-						Attachable: true,
-						L4Routes:   map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
-						Routes:     map[RouteKey]*L7Route{},
-						SupportedKinds: []gatewayv1.RouteGroupKind{
-							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+						{
+							Name:           "listener-443-1",
+							Source:         gw1.Spec.Listeners[1],
+							Valid:          true,
+							Attachable:     true,
+							Routes:         map[RouteKey]*L7Route{CreateRouteKey(hr3): routeHR3},
+							L4Routes:       map[L4RouteKey]*L4Route{},
+							ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secret)),
+							SupportedKinds: supportedKindsForListeners,
+						},
+						{
+							Name:       "listener-443-2",
+							Source:     gw1.Spec.Listeners[2],
+							Valid:      true,
+							Attachable: true,
+							L4Routes:   map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
+							Routes:     map[RouteKey]*L7Route{},
+							SupportedKinds: []gatewayv1.RouteGroupKind{
+								{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+							},
+						},
+						{
+							Name:       "listener-8443",
+							Source:     gw1.Spec.Listeners[3],
+							Valid:      true,
+							Attachable: true,
+							L4Routes:   map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
+							Routes:     map[RouteKey]*L7Route{},
+							SupportedKinds: []gatewayv1.RouteGroupKind{
+								{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+							},
 						},
 					},
-					{
-						Name:       "listener-8443",
-						Source:     gw1.Spec.Listeners[3],
-						Valid:      true,
-						Attachable: true,
-						L4Routes:   map[L4RouteKey]*L4Route{CreateRouteKeyL4(tr): routeTR},
-						Routes:     map[RouteKey]*L7Route{},
-						SupportedKinds: []gatewayv1.RouteGroupKind{
-							{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+					Valid:    true,
+					Policies: []*Policy{processedGwPolicy},
+				},
+				client.ObjectKeyFromObject(gw2): {
+					Source: gw2,
+					Listeners: []*Listener{
+						{
+							Name:       "listener-80-1",
+							Source:     gw2.Spec.Listeners[0],
+							Valid:      false,
+							Attachable: true,
+							Conditions: staticConds.NewListenerHostnameConflict(fmt.Sprintf(
+								gwHostnameConflictFormat, 80, "", "test/gateway-1",
+							)),
+							Routes:                    map[RouteKey]*L7Route{},
+							SupportedKinds:            supportedKindsForListeners,
+							L4Routes:                  map[L4RouteKey]*L4Route{},
+							AllowedRouteLabelSelector: labels.SelectorFromSet(map[string]string{"app": "allowed"}),
+						},
+						{
+							Name:       "listener-443-1",
+							Source:     gw2.Spec.Listeners[1],
+							Valid:      false,
+							Attachable: true,
+							Conditions: staticConds.NewListenerHostnameConflict(fmt.Sprintf(
+								gwHostnameConflictFormat, 443, "*.example.com", "test/gateway-1",
+							)),
+							Routes:         map[RouteKey]*L7Route{},
+							L4Routes:       map[L4RouteKey]*L4Route{},
+							ResolvedSecret: helpers.GetPointer(client.ObjectKeyFromObject(secret)),
+							SupportedKinds: supportedKindsForListeners,
+						},
+						{
+							Name:       "listener-443-2",
+							Source:     gw2.Spec.Listeners[2],
+							Valid:      false,
+							Attachable: true,
+							Conditions: staticConds.NewListenerHostnameConflict(fmt.Sprintf(
+								gwHostnameConflictFormat, 443, "*.example.org", "test/gateway-1",
+							)),
+							L4Routes: map[L4RouteKey]*L4Route{},
+							Routes:   map[RouteKey]*L7Route{},
+							SupportedKinds: []gatewayv1.RouteGroupKind{
+								{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+							},
+						},
+						{
+							Name:       "listener-8443",
+							Source:     gw2.Spec.Listeners[3],
+							Valid:      false,
+							Attachable: true,
+							Conditions: staticConds.NewListenerHostnameConflict(fmt.Sprintf(
+								gwHostnameConflictFormat, 8443, "*.example.org", "test/gateway-1",
+							)),
+							L4Routes: map[L4RouteKey]*L4Route{},
+							Routes:   map[RouteKey]*L7Route{},
+							SupportedKinds: []gatewayv1.RouteGroupKind{
+								{Kind: kinds.TLSRoute, Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.GroupName)},
+							},
 						},
 					},
+					Valid: true,
 				},
-				Valid:    true,
-				Policies: []*Policy{processedGwPolicy},
-			},
-			IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
-				{Namespace: testNs, Name: "gateway-2"}: gw2,
 			},
 			Routes: map[RouteKey]*L7Route{
 				CreateRouteKey(hr1): routeHR1,
@@ -894,8 +966,12 @@ func TestBuildGraph(t *testing.T) {
 				client.ObjectKeyFromObject(ns): ns,
 			},
 			ReferencedServices: map[types.NamespacedName]*ReferencedService{
-				client.ObjectKeyFromObject(svc):  {},
-				client.ObjectKeyFromObject(svc1): {},
+				client.ObjectKeyFromObject(svc): {
+					GatewayNsNames: map[types.NamespacedName]struct{}{client.ObjectKeyFromObject(gw1): {}},
+				},
+				client.ObjectKeyFromObject(svc1): {
+					GatewayNsNames: map[types.NamespacedName]struct{}{client.ObjectKeyFromObject(gw1): {}},
+				},
 			},
 			ReferencedCaCertConfigMaps: map[types.NamespacedName]*CaCertConfigMap{
 				client.ObjectKeyFromObject(cm): {
@@ -1000,6 +1076,8 @@ func TestBuildGraph(t *testing.T) {
 					PolicyValidator:     fakePolicyValidator,
 				},
 				protectedPorts,
+				false,
+				nil,
 			)
 
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
@@ -1121,6 +1199,12 @@ func TestIsReferenced(t *testing.T) {
 			Name:      "configmap",
 		},
 	}
+	errorPageConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNs,
+			Name:      "error-pages",
+		},
+	}
 
 	gcWithNginxProxy := &GatewayClass{
 		Source: &gatewayv1.GatewayClass{
@@ -1147,7 +1231,9 @@ func TestIsReferenced(t *testing.T) {
 	}
 
 	graph := &Graph{
-		Gateway: gw,
+		Gateways: map[types.NamespacedName]*Gateway{
+			{Namespace: testNs, Name: "gw"}: gw,
+		},
 		ReferencedSecrets: map[types.NamespacedName]*Secret{
 			client.ObjectKeyFromObject(baseSecret): {
 				Source: baseSecret,
@@ -1165,6 +1251,9 @@ func TestIsReferenced(t *testing.T) {
 				CACert: []byte(caBlock),
 			},
 		},
+		ReferencedErrorPageConfigMaps: map[types.NamespacedName]*v1.ConfigMap{
+			client.ObjectKeyFromObject(errorPageConfigMap): errorPageConfigMap,
+		},
 	}
 
 	tests := []struct {
@@ -1280,6 +1369,12 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: true,
 		},
+		{
+			name:     "ConfigMap in graph's ReferencedErrorPageConfigMaps is referenced",
+			resource: errorPageConfigMap,
+			graph:    graph,
+			expected: true,
+		},
 		{
 			name:     "ConfigMap not in ReferencedConfigMaps with same Namespace and different Name is not referenced",
 			resource: sameNamespaceDifferentNameConfigMap,
@@ -1338,16 +1433,23 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 
 	getGraph := func() *Graph {
 		return &Graph{
-			Gateway: &Gateway{
-				Source: &gatewayv1.Gateway{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "gw",
-						Namespace: "test",
+			Gateways: map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "gw"}: {
+					Source: &gatewayv1.Gateway{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "gw",
+							Namespace: "test",
+						},
+					},
+				},
+				{Namespace: "test", Name: "other-gw"}: {
+					Source: &gatewayv1.Gateway{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "other-gw",
+							Namespace: "test",
+						},
 					},
 				},
-			},
-			IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
-				{Namespace: "test", Name: "ignored"}: {},
 			},
 			Routes: map[RouteKey]*L7Route{
 				hrKey: {},
@@ -1401,17 +1503,17 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 			expRelevant: false,
 		},
 		{
-			name:        "relevant; policy references the winning gateway",
+			name:        "relevant; policy references a gateway",
 			graph:       getGraph(),
 			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "gw")),
 			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-gw"},
 			expRelevant: true,
 		},
 		{
-			name:        "relevant; policy references an ignored gateway",
+			name:        "relevant; policy references another gateway",
 			graph:       getGraph(),
-			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "ignored")),
-			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-ignored"},
+			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "other-gw")),
+			nsname:      types.NamespacedName{Namespace: "test", Name: "ref-other-gw"},
 			expRelevant: true,
 		},
 		{
@@ -1450,25 +1552,15 @@ func TestIsNGFPolicyRelevant(t *testing.T) {
 			expRelevant: false,
 		},
 		{
-			name: "irrelevant; policy references a Gateway, but the graph's Gateway is nil",
+			name: "irrelevant; policy references a Gateway, but the graph has no Gateways",
 			graph: getModifiedGraph(func(g *Graph) *Graph {
-				g.Gateway = nil
+				g.Gateways = nil
 				return g
 			}),
-			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "diff")),
+			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "gw")),
 			nsname:      types.NamespacedName{Namespace: "test", Name: "nil-gw"},
 			expRelevant: false,
 		},
-		{
-			name: "irrelevant; policy references a Gateway, but the graph's Gateway.Source is nil",
-			graph: getModifiedGraph(func(g *Graph) *Graph {
-				g.Gateway.Source = nil
-				return g
-			}),
-			policy:      getPolicy(createTestRef(kinds.Gateway, gatewayv1.GroupName, "diff")),
-			nsname:      types.NamespacedName{Namespace: "test", Name: "nil-gw-source"},
-			expRelevant: false,
-		},
 		{
 			name: "relevant; policy references a Service that is referenced by a route, group core is inferred",
 			graph: getModifiedGraph(func(g *Graph) *Graph {
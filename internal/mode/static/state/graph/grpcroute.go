@@ -102,16 +102,11 @@ func processGRPCRouteRule(
 
 	// rule.BackendRefs are validated separately because of their special requirements
 	for _, b := range specRule.BackendRefs {
-		var interfaceFilters []interface{}
-		if len(b.Filters) > 0 {
-			interfaceFilters = make([]interface{}, 0, len(b.Filters))
-			for i, v := range b.Filters {
-				interfaceFilters[i] = v
-			}
-		}
 		rbr := RouteBackendRef{
 			BackendRef: b.BackendRef,
-			Filters:    interfaceFilters,
+		}
+		if len(b.Filters) > 0 {
+			rbr.Filters = convertGRPCRouteFilters(b.Filters)
 		}
 		backendRefs = append(backendRefs, rbr)
 	}
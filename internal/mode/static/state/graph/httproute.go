@@ -101,19 +101,22 @@ func processHTTPRouteRule(
 
 	backendRefs := make([]RouteBackendRef, 0, len(specRule.BackendRefs))
 
-	// rule.BackendRefs are validated separately because of their special requirements
-	for _, b := range specRule.BackendRefs {
-		var interfaceFilters []interface{}
-		if len(b.Filters) > 0 {
-			interfaceFilters = make([]interface{}, 0, len(b.Filters))
-			for i, v := range b.Filters {
-				interfaceFilters[i] = v
-			}
-		}
+	// rule.BackendRefs are validated separately because of their special requirements.
+	// Only the values of their filters are validated here, the same way as the filters of the rule.
+	for i, b := range specRule.BackendRefs {
 		rbr := RouteBackendRef{
 			BackendRef: b.BackendRef,
-			Filters:    interfaceFilters,
 		}
+		if len(b.Filters) > 0 {
+			rbr.Filters = convertHTTPRouteFilters(b.Filters)
+		}
+
+		filtersPath := rulePath.Child("backendRefs").Index(i).Child("filters")
+		if errs := validateBackendRefFilters(validator, rbr.Filters, filtersPath); len(errs) > 0 {
+			errors.invalid = append(errors.invalid, errs...)
+			routeFilters.Valid = false
+		}
+
 		backendRefs = append(backendRefs, rbr)
 	}
 
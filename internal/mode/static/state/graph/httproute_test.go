@@ -327,6 +327,29 @@ func TestBuildHTTPRoute(t *testing.T) {
 	addFilterToPath(hrInvalidAndUnresolvableSnippetsFilter, "/filter", invalidSnippetsFilterExtRef)
 	addFilterToPath(hrInvalidAndUnresolvableSnippetsFilter, "/filter", unresolvableSnippetsFilterExtRef)
 
+	// route with backendRef filters
+	hrBackendRefFilters := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/")
+	hrBackendRefFilters.Spec.Rules[0].BackendRefs = []gatewayv1.HTTPBackendRef{
+		{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{Name: "canary"},
+			},
+			Filters: []gatewayv1.HTTPRouteFilter{
+				{
+					Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+						Set: []gatewayv1.HTTPHeader{{Name: "Host", Value: "canary.example.com"}},
+					},
+				},
+			},
+		},
+	}
+
+	// route with invalid backendRef filters
+	hrInvalidBackendRefFilters := hrBackendRefFilters.DeepCopy()
+	hrInvalidBackendRefFilters.Spec.Rules[0].BackendRefs[0].Filters[0].RequestHeaderModifier.Set[0].Value =
+		invalidRedirectHostname
+
 	validatorInvalidFieldsInRule := &validationfakes.FakeHTTPFieldsValidator{
 		ValidatePathInMatchStub: func(path string) error {
 			if path == invalidPath {
@@ -893,6 +916,90 @@ func TestBuildHTTPRoute(t *testing.T) {
 			},
 			name: "rule with one invalid and one unresolvable snippets filter extension ref filter",
 		},
+		{
+			validator: validatorInvalidFieldsInRule,
+			hr:        hrBackendRefFilters,
+			expected: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrBackendRefFilters,
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrBackendRefFilters.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrBackendRefFilters.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Filters: RouteRuleFilters{
+								Valid:   true,
+								Filters: []Filter{},
+							},
+							Matches: hrBackendRefFilters.Spec.Rules[0].Matches,
+							RouteBackendRefs: []RouteBackendRef{
+								{
+									BackendRef: hrBackendRefFilters.Spec.Rules[0].BackendRefs[0].BackendRef,
+									Filters: convertHTTPRouteFilters(
+										hrBackendRefFilters.Spec.Rules[0].BackendRefs[0].Filters,
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+			name: "rule with backendRef filters",
+		},
+		{
+			validator: validatorInvalidFieldsInRule,
+			hr:        hrInvalidBackendRefFilters,
+			expected: &L7Route{
+				RouteType:  RouteTypeHTTP,
+				Source:     hrInvalidBackendRefFilters,
+				Valid:      false,
+				Attachable: true,
+				ParentRefs: []ParentRef{
+					{
+						Idx:         0,
+						Gateway:     gatewayNsName,
+						SectionName: hrInvalidBackendRefFilters.Spec.ParentRefs[0].SectionName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`All rules are invalid: spec.rules[0].backendRefs[0].filters[0].RequestHeaderModifier.set: ` +
+							`Invalid value: v1.HTTPHeader{Name:"Host", Value:"invalid.example.com"}: invalid hostname`,
+					),
+				},
+				Spec: L7RouteSpec{
+					Hostnames: hrInvalidBackendRefFilters.Spec.Hostnames,
+					Rules: []RouteRule{
+						{
+							ValidMatches: true,
+							Filters: RouteRuleFilters{
+								Valid:   false,
+								Filters: []Filter{},
+							},
+							Matches: hrInvalidBackendRefFilters.Spec.Rules[0].Matches,
+							RouteBackendRefs: []RouteBackendRef{
+								{
+									BackendRef: hrInvalidBackendRefFilters.Spec.Rules[0].BackendRefs[0].BackendRef,
+									Filters: convertHTTPRouteFilters(
+										hrInvalidBackendRefFilters.Spec.Rules[0].BackendRefs[0].Filters,
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+			name: "all rules invalid, with invalid backendRef filters",
+		},
 	}
 
 	gatewayNsNames := []types.NamespacedName{gatewayNsName}
@@ -0,0 +1,169 @@
+package graph
+
+import (
+	"sort"
+	"strings"
+
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// defaultHTTPSRedirectStatusCode is the status code used for HTTPS redirects when the NginxProxy doesn't set one.
+const defaultHTTPSRedirectStatusCode = 301
+
+// HTTPSRedirect holds the HTTP to HTTPS redirects for the Gateway.
+type HTTPSRedirect struct {
+	// Redirects holds the hostnames that are redirected from an HTTP port to an HTTPS port.
+	Redirects []HTTPSRedirectHost
+	// StatusCode is the status code of the redirect response.
+	StatusCode int
+}
+
+// HTTPSRedirectHost is a hostname that is redirected from an HTTP port to an HTTPS port.
+type HTTPSRedirectHost struct {
+	// Hostname is the hostname that is redirected.
+	Hostname string
+	// HTTPPort is the port of the HTTP Listener that the redirect is served on.
+	HTTPPort v1.PortNumber
+	// HTTPSPort is the port of the HTTPS Listener that the request is redirected to.
+	HTTPSPort v1.PortNumber
+}
+
+// buildHTTPSRedirect builds the HTTP to HTTPS redirects for the Gateway if they are enabled in the NginxProxy.
+// A redirect is generated on every valid HTTP Listener for every hostname served by Routes on a valid HTTPS Listener,
+// as long as the hostname matches the HTTP Listener's hostname. Hostnames that are covered by the hostnames of
+// Routes on the HTTP Listener's port are not redirected, so that explicitly configured Routes take precedence.
+// Must be called after Routes are bound to Listeners.
+func buildHTTPSRedirect(gw *Gateway, npCfg *NginxProxy) *HTTPSRedirect {
+	if gw == nil || !gw.Valid || npCfg == nil || !npCfg.Valid || npCfg.Source.Spec.HTTPSRedirect == nil {
+		return nil
+	}
+
+	statusCode := defaultHTTPSRedirectStatusCode
+	if npCfg.Source.Spec.HTTPSRedirect.StatusCode != nil {
+		statusCode = *npCfg.Source.Spec.HTTPSRedirect.StatusCode
+	}
+
+	// httpsPorts maps the hostnames served on HTTPS Listeners to the lowest HTTPS port they are served on.
+	httpsPorts := make(map[string]v1.PortNumber)
+	// httpHostnames holds the hostnames served by Routes on each HTTP port.
+	httpHostnames := make(map[v1.PortNumber]map[string]struct{})
+
+	for _, l := range gw.Listeners {
+		if !l.Valid {
+			continue
+		}
+
+		switch l.Source.Protocol {
+		case v1.HTTPSProtocolType:
+			for _, h := range listenerRouteHostnames(l) {
+				if port, exists := httpsPorts[h]; !exists || l.Source.Port < port {
+					httpsPorts[h] = l.Source.Port
+				}
+			}
+		case v1.HTTPProtocolType:
+			if httpHostnames[l.Source.Port] == nil {
+				httpHostnames[l.Source.Port] = make(map[string]struct{})
+			}
+
+			for _, h := range listenerRouteHostnames(l) {
+				httpHostnames[l.Source.Port][h] = struct{}{}
+			}
+		}
+	}
+
+	type redirectKey struct {
+		hostname string
+		port     v1.PortNumber
+	}
+
+	// Sort the HTTPS hostnames so that the result is deterministic when several of them produce the same
+	// redirect hostname. The wildcard hostname sorts last, so specific hostnames take precedence.
+	httpsHostnames := make([]string, 0, len(httpsPorts))
+	for h := range httpsPorts {
+		httpsHostnames = append(httpsHostnames, h)
+	}
+	sort.Strings(httpsHostnames)
+
+	seen := make(map[redirectKey]struct{})
+	var redirects []HTTPSRedirectHost
+
+	for _, l := range gw.Listeners {
+		if !l.Valid || l.Source.Protocol != v1.HTTPProtocolType {
+			continue
+		}
+
+		for _, httpsHost := range httpsHostnames {
+			httpsPort := httpsPorts[httpsHost]
+
+			var routeHostnames []v1.Hostname
+			if httpsHost != wildcardHostname {
+				routeHostnames = []v1.Hostname{v1.Hostname(httpsHost)}
+			}
+
+			for _, h := range findAcceptedHostnames(l.Source.Hostname, routeHostnames) {
+				if hostnameServed(httpHostnames[l.Source.Port], h) {
+					continue
+				}
+
+				key := redirectKey{hostname: h, port: l.Source.Port}
+				if _, exists := seen[key]; exists {
+					continue
+				}
+				seen[key] = struct{}{}
+
+				redirects = append(redirects, HTTPSRedirectHost{
+					Hostname:  h,
+					HTTPPort:  l.Source.Port,
+					HTTPSPort: httpsPort,
+				})
+			}
+		}
+	}
+
+	sort.Slice(redirects, func(i, j int) bool {
+		if redirects[i].HTTPPort != redirects[j].HTTPPort {
+			return redirects[i].HTTPPort < redirects[j].HTTPPort
+		}
+
+		return redirects[i].Hostname < redirects[j].Hostname
+	})
+
+	return &HTTPSRedirect{
+		Redirects:  redirects,
+		StatusCode: statusCode,
+	}
+}
+
+// hostnameServed returns whether any of the served hostnames covers the hostname. A wildcard or catch-all hostname
+// covers the more specific hostnames as well: NGINX prefers the more specific server_name of a redirect server,
+// so the redirect would take precedence over the Route otherwise.
+func hostnameServed(servedHostnames map[string]struct{}, hostname string) bool {
+	for served := range servedHostnames {
+		if served == wildcardHostname || served == hostname {
+			return true
+		}
+
+		if strings.HasPrefix(served, "*.") && strings.HasSuffix(hostname, strings.TrimPrefix(served, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listenerRouteHostnames returns the hostnames of all Routes attached to the Listener.
+func listenerRouteHostnames(l *Listener) []string {
+	var hostnames []string
+
+	for _, r := range l.Routes {
+		for _, ref := range r.ParentRefs {
+			if ref.Attachment == nil {
+				continue
+			}
+
+			hostnames = append(hostnames, ref.Attachment.AcceptedHostnames[l.Name]...)
+		}
+	}
+
+	return hostnames
+}
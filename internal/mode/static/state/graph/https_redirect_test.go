@@ -0,0 +1,306 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+)
+
+func TestBuildHTTPSRedirect(t *testing.T) {
+	t.Parallel()
+
+	createListener := func(
+		name string,
+		protocol v1.ProtocolType,
+		port v1.PortNumber,
+		hostname *v1.Hostname,
+		routeHostnames ...string,
+	) *Listener {
+		l := &Listener{
+			Name: name,
+			Source: v1.Listener{
+				Name:     v1.SectionName(name),
+				Protocol: protocol,
+				Port:     port,
+				Hostname: hostname,
+			},
+			Routes: map[RouteKey]*L7Route{},
+			Valid:  true,
+		}
+
+		if len(routeHostnames) > 0 {
+			key := RouteKey{NamespacedName: types.NamespacedName{Namespace: "test", Name: name + "-route"}}
+			l.Routes[key] = &L7Route{
+				ParentRefs: []ParentRef{
+					{
+						Attachment: &ParentRefAttachmentStatus{
+							AcceptedHostnames: map[string][]string{name: routeHostnames},
+							Attached:          true,
+						},
+					},
+				},
+			}
+		}
+
+		return l
+	}
+
+	enabledNp := &NginxProxy{
+		Source: &ngfAPI.NginxProxy{
+			Spec: ngfAPI.NginxProxySpec{
+				HTTPSRedirect: &ngfAPI.HTTPSRedirect{},
+			},
+		},
+		Valid: true,
+	}
+
+	tests := []struct {
+		gw    *Gateway
+		npCfg *NginxProxy
+		exp   *HTTPSRedirect
+		name  string
+	}{
+		{
+			name:  "nil gateway",
+			gw:    nil,
+			npCfg: enabledNp,
+			exp:   nil,
+		},
+		{
+			name: "invalid gateway",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com"),
+				},
+				Valid: false,
+			},
+			npCfg: enabledNp,
+			exp:   nil,
+		},
+		{
+			name: "nil nginx proxy",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: nil,
+			exp:   nil,
+		},
+		{
+			name: "invalid nginx proxy",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: &NginxProxy{
+				Source: enabledNp.Source,
+				Valid:  false,
+			},
+			exp: nil,
+		},
+		{
+			name: "redirect not enabled",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{},
+				Valid:  true,
+			},
+			exp: nil,
+		},
+		{
+			name: "no http listeners",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				StatusCode: 301,
+			},
+		},
+		{
+			name: "redirects for hostnames on https listeners",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com", "bar.example.com"),
+					createListener("https-8443", v1.HTTPSProtocolType, 8443, nil, "foo.example.com", "baz.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: &NginxProxy{
+				Source: &ngfAPI.NginxProxy{
+					Spec: ngfAPI.NginxProxySpec{
+						HTTPSRedirect: &ngfAPI.HTTPSRedirect{
+							StatusCode: helpers.GetPointer(308),
+						},
+					},
+				},
+				Valid: true,
+			},
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: "bar.example.com", HTTPPort: 80, HTTPSPort: 443},
+					{Hostname: "baz.example.com", HTTPPort: 80, HTTPSPort: 8443},
+					{Hostname: "foo.example.com", HTTPPort: 80, HTTPSPort: 443},
+				},
+				StatusCode: 308,
+			},
+		},
+		{
+			name: "http routes take precedence; invalid listeners are ignored",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil, "foo.example.com"),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com", "bar.example.com"),
+					func() *Listener {
+						l := createListener("invalid-https", v1.HTTPSProtocolType, 444, nil, "baz.example.com")
+						l.Valid = false
+						return l
+					}(),
+					func() *Listener {
+						l := createListener("invalid-http", v1.HTTPProtocolType, 81, nil)
+						l.Valid = false
+						return l
+					}(),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: "bar.example.com", HTTPPort: 80, HTTPSPort: 443},
+				},
+				StatusCode: 301,
+			},
+		},
+		{
+			name: "wildcard http route hostname takes precedence",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil, "*.example.com"),
+					createListener(
+						"https",
+						v1.HTTPSProtocolType,
+						443,
+						nil,
+						"foo.example.com",
+						"*.a.example.com",
+						"*.example.com",
+						"foo.other.com",
+					),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: "foo.other.com", HTTPPort: 80, HTTPSPort: 443},
+				},
+				StatusCode: 301,
+			},
+		},
+		{
+			name: "catch-all http route takes precedence",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil, wildcardHostname),
+					createListener("http-8080", v1.HTTPProtocolType, 8080, nil),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: "foo.example.com", HTTPPort: 8080, HTTPSPort: 443},
+				},
+				StatusCode: 301,
+			},
+		},
+		{
+			name: "exact http route hostname does not stop a wildcard redirect",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil, "foo.example.com"),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "*.example.com"),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: "*.example.com", HTTPPort: 80, HTTPSPort: 443},
+				},
+				StatusCode: 301,
+			},
+		},
+		{
+			name: "http listener hostname restricts redirects",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, helpers.GetPointer[v1.Hostname]("*.example.com")),
+					createListener("http-8080", v1.HTTPProtocolType, 8080, helpers.GetPointer[v1.Hostname]("foo.example.com")),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, "foo.example.com", "foo.other.com"),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: "foo.example.com", HTTPPort: 80, HTTPSPort: 443},
+					{Hostname: "foo.example.com", HTTPPort: 8080, HTTPSPort: 443},
+				},
+				StatusCode: 301,
+			},
+		},
+		{
+			name: "wildcard https hostname",
+			gw: &Gateway{
+				Listeners: []*Listener{
+					createListener("http", v1.HTTPProtocolType, 80, nil),
+					createListener("http-8080", v1.HTTPProtocolType, 8080, helpers.GetPointer[v1.Hostname]("foo.example.com")),
+					createListener("https", v1.HTTPSProtocolType, 443, nil, wildcardHostname),
+				},
+				Valid: true,
+			},
+			npCfg: enabledNp,
+			exp: &HTTPSRedirect{
+				Redirects: []HTTPSRedirectHost{
+					{Hostname: wildcardHostname, HTTPPort: 80, HTTPSPort: 443},
+					{Hostname: "foo.example.com", HTTPPort: 8080, HTTPSPort: 443},
+				},
+				StatusCode: 301,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildHTTPSRedirect(test.gw, test.npCfg)).To(Equal(test.exp))
+		})
+	}
+}
@@ -7,15 +7,15 @@ import (
 )
 
 // buildReferencedNamespaces returns a map of all the Namespace resources from the current clusterNamespaces with
-// a label that matches any of the Gateway Listener's label selector.
+// a label that matches any of the Gateway Listener's label selector of any of the Gateways.
 func buildReferencedNamespaces(
 	clusterNamespaces map[types.NamespacedName]*v1.Namespace,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 ) map[types.NamespacedName]*v1.Namespace {
 	referencedNamespaces := make(map[types.NamespacedName]*v1.Namespace)
 
 	for name, ns := range clusterNamespaces {
-		if isNamespaceReferenced(ns, gw) {
+		if isNamespaceReferenced(ns, gws) {
 			referencedNamespaces[name] = ns
 		}
 	}
@@ -27,20 +27,22 @@ func buildReferencedNamespaces(
 }
 
 // isNamespaceReferenced returns true if a given Namespace resource has a label
-// that matches any of the Gateway Listener's label selector.
-func isNamespaceReferenced(ns *v1.Namespace, gw *Gateway) bool {
-	if gw == nil || ns == nil {
+// that matches any of the Gateway Listener's label selector of any of the Gateways.
+func isNamespaceReferenced(ns *v1.Namespace, gws map[types.NamespacedName]*Gateway) bool {
+	if len(gws) == 0 || ns == nil {
 		return false
 	}
 
 	nsLabels := labels.Set(ns.GetLabels())
-	for _, listener := range gw.Listeners {
-		if listener.AllowedRouteLabelSelector == nil {
-			// Can have listeners with AllowedRouteLabelSelector not set.
-			continue
-		}
-		if listener.AllowedRouteLabelSelector.Matches(nsLabels) {
-			return true
+	for _, gw := range gws {
+		for _, listener := range gw.Listeners {
+			if listener.AllowedRouteLabelSelector == nil {
+				// Can have listeners with AllowedRouteLabelSelector not set.
+				continue
+			}
+			if listener.AllowedRouteLabelSelector.Matches(nsLabels) {
+				return true
+			}
 		}
 	}
 
@@ -44,12 +44,12 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 	}
 
 	tests := []struct {
-		gw            *Gateway
+		gws           map[types.NamespacedName]*Gateway
 		expectedRefNS map[types.NamespacedName]*v1.Namespace
 		name          string
 	}{
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{
 					{
 						Name:                      "listener-2",
@@ -58,14 +58,14 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 					},
 				},
 				Valid: true,
-			},
+			}},
 			expectedRefNS: map[types.NamespacedName]*v1.Namespace{
 				{Name: "ns2"}: ns2,
 			},
 			name: "gateway matches labels with one namespace",
 		},
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{
 					{
 						Name:                      "listener-1",
@@ -79,7 +79,7 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 					},
 				},
 				Valid: true,
-			},
+			}},
 			expectedRefNS: map[types.NamespacedName]*v1.Namespace{
 				{Name: "ns2"}: ns2,
 				{Name: "ns3"}: ns3,
@@ -87,15 +87,15 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 			name: "gateway matches labels with two namespaces",
 		},
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{},
 				Valid:     true,
-			},
+			}},
 			expectedRefNS: nil,
 			name:          "gateway has no Listeners",
 		},
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{
 					{
 						Name:  "listener-1",
@@ -107,12 +107,12 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 					},
 				},
 				Valid: true,
-			},
+			}},
 			expectedRefNS: nil,
 			name:          "gateway has multiple listeners with no AllowedRouteLabelSelector set",
 		},
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{
 					{
 						Name:                      "listener-1",
@@ -121,13 +121,13 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 					},
 				},
 				Valid: true,
-			},
+			}},
 
 			expectedRefNS: nil,
 			name:          "gateway doesn't match labels with any namespace",
 		},
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{
 					{
 						Name:                      "listener-1",
@@ -141,14 +141,14 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 					},
 				},
 				Valid: true,
-			},
+			}},
 			expectedRefNS: map[types.NamespacedName]*v1.Namespace{
 				{Name: "ns2"}: ns2,
 			},
 			name: "gateway has two listeners and only matches labels with one namespace",
 		},
 		{
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{
 					{
 						Name:                      "listener-1",
@@ -161,7 +161,7 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 					},
 				},
 				Valid: true,
-			},
+			}},
 			expectedRefNS: map[types.NamespacedName]*v1.Namespace{
 				{Name: "ns2"}: ns2,
 			},
@@ -173,7 +173,7 @@ func TestBuildReferencedNamespaces(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			g.Expect(buildReferencedNamespaces(clusterNamespaces, test.gw)).To(Equal(test.expectedRefNS))
+			g.Expect(buildReferencedNamespaces(clusterNamespaces, test.gws)).To(Equal(test.expectedRefNS))
 		})
 	}
 }
@@ -182,15 +182,15 @@ func TestIsNamespaceReferenced(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		ns   *v1.Namespace
-		gw   *Gateway
+		gws  map[types.NamespacedName]*Gateway
 		name string
 		exp  bool
 	}{
 		{
 			ns:   nil,
-			gw:   nil,
+			gws:  nil,
 			exp:  false,
-			name: "namespace and gateway are nil",
+			name: "namespace is nil and there are no gateways",
 		},
 		{
 			ns: &v1.Namespace{
@@ -198,16 +198,16 @@ func TestIsNamespaceReferenced(t *testing.T) {
 					Name: "ns1",
 				},
 			},
-			gw:   nil,
+			gws:  nil,
 			exp:  false,
-			name: "namespace is valid but gateway is nil",
+			name: "namespace is valid but there are no gateways",
 		},
 		{
 			ns: nil,
-			gw: &Gateway{
+			gws: map[types.NamespacedName]*Gateway{{Name: "gw"}: {
 				Listeners: []*Listener{},
 				Valid:     true,
-			},
+			}},
 			exp:  false,
 			name: "gateway is valid but namespace is nil",
 		},
@@ -218,7 +218,7 @@ func TestIsNamespaceReferenced(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			g.Expect(isNamespaceReferenced(test.ns, test.gw)).To(Equal(test.exp))
+			g.Expect(isNamespaceReferenced(test.ns, test.gws)).To(Equal(test.exp))
 		})
 	}
 }
@@ -133,6 +133,77 @@ func validateNginxProxy(
 
 	allErrs = append(allErrs, validateRewriteClientIP(npCfg)...)
 
+	allErrs = append(allErrs, validateHTTPSRedirect(npCfg)...)
+
+	allErrs = append(allErrs, validateDefaultServer(validator, npCfg)...)
+
+	allErrs = append(allErrs, validateDNSResolver(validator, npCfg)...)
+
+	return allErrs
+}
+
+func validateDNSResolver(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	dnsResolver := npCfg.Spec.DNSResolver
+	if dnsResolver == nil {
+		return allErrs
+	}
+
+	dnsResolverPath := field.NewPath("spec").Child("dnsResolver")
+	addressesPath := dnsResolverPath.Child("addresses")
+
+	if dnsResolver.Timeout != nil {
+		if err := validator.ValidateNginxDuration(string(*dnsResolver.Timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(dnsResolverPath.Child("timeout"), *dnsResolver.Timeout, err.Error()))
+		}
+	}
+
+	if dnsResolver.CacheTTL != nil {
+		if err := validator.ValidateNginxDuration(string(*dnsResolver.CacheTTL)); err != nil {
+			allErrs = append(
+				allErrs,
+				field.Invalid(dnsResolverPath.Child("cacheTTL"), *dnsResolver.CacheTTL, err.Error()),
+			)
+		}
+	}
+
+	if len(dnsResolver.Addresses) == 0 {
+		allErrs = append(allErrs, field.Required(addressesPath, "at least one address is required"))
+	}
+
+	if len(dnsResolver.Addresses) > 16 {
+		allErrs = append(allErrs, field.TooMany(addressesPath, len(dnsResolver.Addresses), 16))
+	}
+
+	for i, addr := range dnsResolver.Addresses {
+		valuePath := addressesPath.Index(i).Child("value")
+
+		switch addr.Type {
+		case ngfAPI.DNSResolverIPAddressType:
+			if err := k8svalidation.IsValidIP(valuePath, addr.Value); err != nil {
+				allErrs = append(allErrs, err...)
+			}
+		case ngfAPI.DNSResolverHostnameAddressType:
+			if errs := k8svalidation.IsDNS1123Subdomain(addr.Value); len(errs) > 0 {
+				for _, e := range errs {
+					allErrs = append(allErrs, field.Invalid(valuePath, addr.Value, e))
+				}
+			}
+		default:
+			allErrs = append(
+				allErrs,
+				field.NotSupported(addressesPath.Index(i).Child("type"),
+					addr.Type,
+					[]string{
+						string(ngfAPI.DNSResolverIPAddressType),
+						string(ngfAPI.DNSResolverHostnameAddressType),
+					},
+				),
+			)
+		}
+	}
+
 	return allErrs
 }
 
@@ -248,3 +319,101 @@ func validateRewriteClientIP(npCfg *ngfAPI.NginxProxy) field.ErrorList {
 
 	return allErrs
 }
+
+func validateHTTPSRedirect(npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	redirect := npCfg.Spec.HTTPSRedirect
+	if redirect == nil || redirect.StatusCode == nil {
+		return allErrs
+	}
+
+	switch *redirect.StatusCode {
+	case 301, 308:
+	default:
+		allErrs = append(
+			allErrs,
+			field.NotSupported(
+				field.NewPath("spec").Child("httpsRedirect").Child("statusCode"),
+				*redirect.StatusCode,
+				[]string{"301", "308"},
+			),
+		)
+	}
+
+	return allErrs
+}
+
+func validateDefaultServer(validator validation.GenericValidator, npCfg *ngfAPI.NginxProxy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	defaultServer := npCfg.Spec.DefaultServer
+	if defaultServer == nil {
+		return allErrs
+	}
+
+	defaultServerPath := field.NewPath("spec").Child("defaultServer")
+
+	if defaultServer.Action != nil {
+		allErrs = append(
+			allErrs,
+			validateDefaultServerAction(validator, *defaultServer.Action, defaultServerPath.Child("action"))...,
+		)
+	}
+
+	for i, l := range defaultServer.Listeners {
+		actionPath := defaultServerPath.Child("listeners").Index(i).Child("action")
+		allErrs = append(allErrs, validateDefaultServerAction(validator, l.Action, actionPath)...)
+	}
+
+	return allErrs
+}
+
+func validateDefaultServerAction(
+	validator validation.GenericValidator,
+	action ngfAPI.DefaultServerAction,
+	actionPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if ret := action.Return; ret != nil {
+		returnPath := actionPath.Child("return")
+
+		if ret.Body != nil {
+			if err := validator.ValidateEscapedString(*ret.Body); err != nil {
+				allErrs = append(allErrs, field.Invalid(returnPath.Child("body"), *ret.Body, err.Error()))
+			}
+		}
+
+		if ret.ContentType != nil {
+			if err := validator.ValidateMIMEType(string(*ret.ContentType)); err != nil {
+				allErrs = append(allErrs, field.Invalid(returnPath.Child("contentType"), *ret.ContentType, err.Error()))
+			}
+		}
+	}
+
+	if redirect := action.Redirect; redirect != nil {
+		redirectPath := actionPath.Child("redirect")
+
+		if err := validator.ValidateEscapedString(redirect.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(redirectPath.Child("url"), redirect.URL, err.Error()))
+		}
+
+		if redirect.StatusCode != nil {
+			switch *redirect.StatusCode {
+			case 301, 302, 303, 307, 308:
+			default:
+				allErrs = append(
+					allErrs,
+					field.NotSupported(
+						redirectPath.Child("statusCode"),
+						*redirect.StatusCode,
+						[]string{"301", "302", "303", "307", "308"},
+					),
+				)
+			}
+		}
+	}
+
+	return allErrs
+}
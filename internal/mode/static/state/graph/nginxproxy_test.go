@@ -773,3 +773,274 @@ func TestValidateLogging(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHTTPSRedirect(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np             *ngfAPI.NginxProxy
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					HTTPSRedirect: &ngfAPI.HTTPSRedirect{
+						StatusCode: helpers.GetPointer(301),
+					},
+				},
+			},
+			name:           "valid 301 status code",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					HTTPSRedirect: &ngfAPI.HTTPSRedirect{
+						StatusCode: helpers.GetPointer(308),
+					},
+				},
+			},
+			name:           "valid 308 status code",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					HTTPSRedirect: &ngfAPI.HTTPSRedirect{},
+				},
+			},
+			name:           "empty status code",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					HTTPSRedirect: &ngfAPI.HTTPSRedirect{
+						StatusCode: helpers.GetPointer(302),
+					},
+				},
+			},
+			name: "invalid status code",
+			errorString: "spec.httpsRedirect.statusCode: Unsupported value: 302: supported values: " +
+				"\"301\", \"308\"",
+			expectErrCount: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateHTTPSRedirect(test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateDefaultServer(t *testing.T) {
+	t.Parallel()
+
+	invalidValidator := &validationfakes.FakeGenericValidator{}
+	invalidValidator.ValidateEscapedStringReturns(errors.New("error"))
+	invalidValidator.ValidateMIMETypeReturns(errors.New("error"))
+
+	tests := []struct {
+		np             *ngfAPI.NginxProxy
+		validator      *validationfakes.FakeGenericValidator
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{},
+			},
+			validator:      invalidValidator,
+			name:           "default server not set",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DefaultServer: &ngfAPI.DefaultServer{
+						Action: &ngfAPI.DefaultServerAction{
+							Return: &ngfAPI.DefaultServerReturn{
+								StatusCode:  418,
+								Body:        helpers.GetPointer("teapot"),
+								ContentType: helpers.GetPointer[ngfAPI.MIMEType]("text/plain"),
+							},
+						},
+						Listeners: []ngfAPI.ListenerDefaultServer{
+							{
+								Name: "http",
+								Action: ngfAPI.DefaultServerAction{
+									Redirect: &ngfAPI.DefaultServerRedirect{
+										StatusCode: helpers.GetPointer[int32](307),
+										URL:        "https://example.com$request_uri",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			validator:      &validationfakes.FakeGenericValidator{},
+			name:           "valid default server",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DefaultServer: &ngfAPI.DefaultServer{
+						Action: &ngfAPI.DefaultServerAction{
+							Return: &ngfAPI.DefaultServerReturn{
+								StatusCode:  418,
+								Body:        helpers.GetPointer("teapot"),
+								ContentType: helpers.GetPointer[ngfAPI.MIMEType]("text/plain"),
+							},
+						},
+						Listeners: []ngfAPI.ListenerDefaultServer{
+							{
+								Name: "http",
+								Action: ngfAPI.DefaultServerAction{
+									Redirect: &ngfAPI.DefaultServerRedirect{
+										StatusCode: helpers.GetPointer[int32](300),
+										URL:        "https://example.com$request_uri",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			validator: invalidValidator,
+			name:      "invalid default server",
+			errorString: "[spec.defaultServer.action.return.body: Invalid value: \"teapot\": error, " +
+				"spec.defaultServer.action.return.contentType: Invalid value: \"text/plain\": error, " +
+				"spec.defaultServer.listeners[0].action.redirect.url: " +
+				"Invalid value: \"https://example.com$request_uri\": error, " +
+				"spec.defaultServer.listeners[0].action.redirect.statusCode: Unsupported value: 300: " +
+				"supported values: \"301\", \"302\", \"303\", \"307\", \"308\"]",
+			expectErrCount: 4,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateDefaultServer(test.validator, test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
+
+func TestValidateDNSResolver(t *testing.T) {
+	t.Parallel()
+
+	invalidValidator := &validationfakes.FakeGenericValidator{}
+	invalidValidator.ValidateNginxDurationReturns(errors.New("error"))
+
+	tests := []struct {
+		np             *ngfAPI.NginxProxy
+		validator      *validationfakes.FakeGenericValidator
+		name           string
+		errorString    string
+		expectErrCount int
+	}{
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{},
+			},
+			validator:      invalidValidator,
+			name:           "DNS resolver not set",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DNSResolver: &ngfAPI.DNSResolver{
+						Timeout:  helpers.GetPointer[ngfAPI.Duration]("10s"),
+						CacheTTL: helpers.GetPointer[ngfAPI.Duration]("30s"),
+						Addresses: []ngfAPI.DNSResolverAddress{
+							{Type: ngfAPI.DNSResolverIPAddressType, Value: "10.96.0.10"},
+							{Type: ngfAPI.DNSResolverIPAddressType, Value: "fd00::10"},
+							{Type: ngfAPI.DNSResolverHostnameAddressType, Value: "kube-dns.kube-system.svc"},
+						},
+					},
+				},
+			},
+			validator:      &validationfakes.FakeGenericValidator{},
+			name:           "valid DNS resolver",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DNSResolver: &ngfAPI.DNSResolver{
+						Timeout:  helpers.GetPointer[ngfAPI.Duration]("10s"),
+						CacheTTL: helpers.GetPointer[ngfAPI.Duration]("30s"),
+						Addresses: []ngfAPI.DNSResolverAddress{
+							{Type: ngfAPI.DNSResolverIPAddressType, Value: "10.96.0.10"},
+						},
+					},
+				},
+			},
+			validator: invalidValidator,
+			name:      "invalid durations",
+			errorString: "[spec.dnsResolver.timeout: Invalid value: \"10s\": error, " +
+				"spec.dnsResolver.cacheTTL: Invalid value: \"30s\": error]",
+			expectErrCount: 2,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DNSResolver: &ngfAPI.DNSResolver{},
+				},
+			},
+			validator:      &validationfakes.FakeGenericValidator{},
+			name:           "no addresses",
+			errorString:    "spec.dnsResolver.addresses: Required value: at least one address is required",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPI.NginxProxy{
+				Spec: ngfAPI.NginxProxySpec{
+					DNSResolver: &ngfAPI.DNSResolver{
+						Addresses: []ngfAPI.DNSResolverAddress{
+							{Type: ngfAPI.DNSResolverIPAddressType, Value: "10.96.0"},
+							{Type: ngfAPI.DNSResolverHostnameAddressType, Value: "$invalid"},
+							{Type: "CIDR", Value: "10.0.0.0/8"},
+						},
+					},
+				},
+			},
+			validator:      &validationfakes.FakeGenericValidator{},
+			name:           "invalid addresses",
+			expectErrCount: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateDNSResolver(test.validator, test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if test.errorString != "" {
+				g.Expect(allErrs.ToAggregate().Error()).To(Equal(test.errorString))
+			}
+		})
+	}
+}
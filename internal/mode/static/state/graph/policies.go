@@ -68,7 +68,7 @@ const (
 
 // attachPolicies attaches the graph's processed policies to the resources they target. It modifies the graph in place.
 func (g *Graph) attachPolicies(ctlrName string) {
-	if g.Gateway == nil {
+	if len(g.Gateways) == 0 {
 		return
 	}
 
@@ -76,7 +76,12 @@ func (g *Graph) attachPolicies(ctlrName string) {
 		for _, ref := range policy.TargetRefs {
 			switch ref.Kind {
 			case kinds.Gateway:
-				attachPolicyToGateway(policy, ref, g.Gateway, g.IgnoredGateways, ctlrName)
+				gw, exists := g.Gateways[ref.Nsname]
+				if !exists {
+					continue
+				}
+
+				attachPolicyToGateway(policy, gw, ctlrName)
 			case kinds.HTTPRoute, kinds.GRPCRoute:
 				route, exists := g.Routes[routeKeyForKind(ref.Kind, ref.Nsname)]
 				if !exists {
@@ -90,7 +95,7 @@ func (g *Graph) attachPolicies(ctlrName string) {
 					continue
 				}
 
-				attachPolicyToService(policy, svc, g.Gateway, ctlrName)
+				attachPolicyToService(policy, svc, g.Gateways, ctlrName)
 			}
 		}
 	}
@@ -99,32 +104,43 @@ func (g *Graph) attachPolicies(ctlrName string) {
 func attachPolicyToService(
 	policy *Policy,
 	svc *ReferencedService,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	ctlrName string,
 ) {
-	if ngfPolicyAncestorsFull(policy, ctlrName) {
-		return
-	}
+	attached := false
 
-	ancestor := PolicyAncestor{
-		Ancestor: createParentReference(v1.GroupName, kinds.Gateway, client.ObjectKeyFromObject(gw.Source)),
-	}
+	for _, gw := range getSortedGateways(gws) {
+		if _, exists := svc.GatewayNsNames[client.ObjectKeyFromObject(gw.Source)]; !exists {
+			continue
+		}
 
-	if !gw.Valid {
-		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")}
-		if ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
-			return
+		if ngfPolicyAncestorsFull(policy, ctlrName) {
+			break
 		}
 
-		policy.Ancestors = append(policy.Ancestors, ancestor)
-		return
-	}
+		ancestor := PolicyAncestor{
+			Ancestor: createParentReference(v1.GroupName, kinds.Gateway, client.ObjectKeyFromObject(gw.Source)),
+		}
 
-	if !ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
-		policy.Ancestors = append(policy.Ancestors, ancestor)
+		if !gw.Valid {
+			ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")}
+			if !ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
+				policy.Ancestors = append(policy.Ancestors, ancestor)
+			}
+
+			continue
+		}
+
+		if !ancestorsContainsAncestorRef(policy.Ancestors, ancestor.Ancestor) {
+			policy.Ancestors = append(policy.Ancestors, ancestor)
+		}
+
+		attached = true
 	}
 
-	svc.Policies = append(svc.Policies, policy)
+	if attached {
+		svc.Policies = append(svc.Policies, policy)
+	}
 }
 
 func attachPolicyToRoute(policy *Policy, route *L7Route, ctlrName string) {
@@ -156,19 +172,11 @@ func attachPolicyToRoute(policy *Policy, route *L7Route, ctlrName string) {
 
 func attachPolicyToGateway(
 	policy *Policy,
-	ref PolicyTargetRef,
 	gw *Gateway,
-	ignoredGateways map[types.NamespacedName]*v1.Gateway,
 	ctlrName string,
 ) {
-	_, ignored := ignoredGateways[ref.Nsname]
-
-	if !ignored && ref.Nsname != client.ObjectKeyFromObject(gw.Source) {
-		return
-	}
-
 	ancestor := PolicyAncestor{
-		Ancestor: createParentReference(v1.GroupName, kinds.Gateway, ref.Nsname),
+		Ancestor: createParentReference(v1.GroupName, kinds.Gateway, client.ObjectKeyFromObject(gw.Source)),
 	}
 
 	if ngfPolicyAncestorsFull(policy, ctlrName) {
@@ -176,12 +184,6 @@ func attachPolicyToGateway(
 		return
 	}
 
-	if ignored {
-		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is ignored")}
-		policy.Ancestors = append(policy.Ancestors, ancestor)
-		return
-	}
-
 	if !gw.Valid {
 		ancestor.Conditions = []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is invalid")}
 		policy.Ancestors = append(policy.Ancestors, ancestor)
@@ -195,12 +197,12 @@ func attachPolicyToGateway(
 func processPolicies(
 	pols map[PolicyKey]policies.Policy,
 	validator validation.PolicyValidator,
-	gateways processedGateways,
+	gateways map[types.NamespacedName]*Gateway,
 	routes map[RouteKey]*L7Route,
 	services map[types.NamespacedName]*ReferencedService,
 	globalSettings *policies.GlobalSettings,
 ) map[PolicyKey]*Policy {
-	if len(pols) == 0 || gateways.Winner == nil {
+	if len(pols) == 0 || len(gateways) == 0 {
 		return nil
 	}
 
@@ -217,7 +219,7 @@ func processPolicies(
 
 			switch refGroupKind(ref.Group, ref.Kind) {
 			case gatewayGroupKind:
-				if !gatewayExists(refNsName, gateways.Winner, gateways.Ignored) {
+				if _, exists := gateways[refNsName]; !exists {
 					continue
 				}
 			case hrGroupKind, grpcGroupKind:
@@ -75,8 +75,8 @@ func TestAttachPolicies(t *testing.T) {
 	}
 
 	expectNoGatewayPolicyAttachment := func(g *WithT, graph *Graph) {
-		if graph.Gateway != nil {
-			g.Expect(graph.Gateway.Policies).To(BeNil())
+		for _, gw := range graph.Gateways {
+			g.Expect(gw.Policies).To(BeNil())
 		}
 	}
 
@@ -93,8 +93,8 @@ func TestAttachPolicies(t *testing.T) {
 	}
 
 	expectGatewayPolicyAttachment := func(g *WithT, graph *Graph) {
-		if graph.Gateway != nil {
-			g.Expect(graph.Gateway.Policies).To(HaveLen(1))
+		for _, gw := range graph.Gateways {
+			g.Expect(gw.Policies).To(HaveLen(1))
 		}
 	}
 
@@ -144,11 +144,11 @@ func TestAttachPolicies(t *testing.T) {
 		)
 	}
 
-	getGateway := func() *Gateway {
+	createGateway := func(name string) *Gateway {
 		return &Gateway{
 			Source: &v1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gateway",
+					Name:      name,
 					Namespace: testNs,
 				},
 			},
@@ -156,14 +156,25 @@ func TestAttachPolicies(t *testing.T) {
 		}
 	}
 
+	getGateways := func() map[types.NamespacedName]*Gateway {
+		return map[types.NamespacedName]*Gateway{
+			{Namespace: testNs, Name: "gateway"}:  createGateway("gateway"),
+			{Namespace: testNs, Name: "gateway1"}: createGateway("gateway1"),
+		}
+	}
+
 	getServices := func() map[types.NamespacedName]*ReferencedService {
 		return map[types.NamespacedName]*ReferencedService{
-			{Namespace: testNs, Name: "svc-1"}: {},
+			{Namespace: testNs, Name: "svc-1"}: {
+				GatewayNsNames: map[types.NamespacedName]struct{}{
+					{Namespace: testNs, Name: "gateway"}: {},
+				},
+			},
 		}
 	}
 
 	tests := []struct {
-		gateway     *Gateway
+		gateways    map[types.NamespacedName]*Gateway
 		routes      map[RouteKey]*L7Route
 		svcs        map[types.NamespacedName]*ReferencedService
 		ngfPolicies map[PolicyKey]*Policy
@@ -171,14 +182,14 @@ func TestAttachPolicies(t *testing.T) {
 		expects     []func(g *WithT, graph *Graph)
 	}{
 		{
-			name:        "nil Gateway; no policies attach",
+			name:        "no Gateways; no policies attach",
 			routes:      getRoutes(),
 			ngfPolicies: getPolicies(),
 			expects:     expectNoAttachmentList,
 		},
 		{
 			name:        "nil Routes; gateway and service policies attach",
-			gateway:     getGateway(),
+			gateways:    getGateways(),
 			svcs:        getServices(),
 			ngfPolicies: getPolicies(),
 			expects: []func(g *WithT, graph *Graph){
@@ -191,7 +202,7 @@ func TestAttachPolicies(t *testing.T) {
 			name:        "nil ReferencedServices; gateway and route policies attach",
 			routes:      getRoutes(),
 			ngfPolicies: getPolicies(),
-			gateway:     getGateway(),
+			gateways:    getGateways(),
 			expects: []func(g *WithT, graph *Graph){
 				expectGatewayPolicyAttachment,
 				expectRoutePolicyAttachment,
@@ -203,7 +214,7 @@ func TestAttachPolicies(t *testing.T) {
 			routes:      getRoutes(),
 			svcs:        getServices(),
 			ngfPolicies: getPolicies(),
-			gateway:     getGateway(),
+			gateways:    getGateways(),
 			expects:     expectAllAttachmentList,
 		},
 	}
@@ -214,7 +225,7 @@ func TestAttachPolicies(t *testing.T) {
 			g := NewWithT(t)
 
 			graph := &Graph{
-				Gateway:            test.gateway,
+				Gateways:           test.gateways,
 				Routes:             test.routes,
 				ReferencedServices: test.svcs,
 				NGFPolicies:        test.ngfPolicies,
@@ -381,8 +392,6 @@ func TestAttachPolicyToRoute(t *testing.T) {
 func TestAttachPolicyToGateway(t *testing.T) {
 	t.Parallel()
 	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
-	gateway2NsName := types.NamespacedName{Namespace: testNs, Name: "gateway2"}
-	ignoredGatewayNsName := types.NamespacedName{Namespace: testNs, Name: "ignored"}
 
 	newGateway := func(valid bool, nsname types.NamespacedName) *Gateway {
 		return &Gateway{
@@ -441,26 +450,6 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			},
 			expAttached: true,
 		},
-		{
-			name: "not attached; gateway ignored",
-			policy: &Policy{
-				Source: &policiesfakes.FakePolicy{},
-				TargetRefs: []PolicyTargetRef{
-					{
-						Nsname: ignoredGatewayNsName,
-						Kind:   "Gateway",
-					},
-				},
-			},
-			gw: newGateway(true, gatewayNsName),
-			expAncestors: []PolicyAncestor{
-				{
-					Ancestor:   getGatewayParentRef(ignoredGatewayNsName),
-					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("TargetRef is ignored")},
-				},
-			},
-			expAttached: false,
-		},
 		{
 			name: "not attached; invalid gateway",
 			policy: &Policy{
@@ -481,21 +470,6 @@ func TestAttachPolicyToGateway(t *testing.T) {
 			},
 			expAttached: false,
 		},
-		{
-			name: "not attached; non-NGF gateway",
-			policy: &Policy{
-				Source: &policiesfakes.FakePolicy{},
-				TargetRefs: []PolicyTargetRef{
-					{
-						Nsname: gateway2NsName,
-						Kind:   "Gateway",
-					},
-				},
-			},
-			gw:           newGateway(true, gatewayNsName),
-			expAncestors: nil,
-			expAttached:  false,
-		},
 		{
 			name: "not attached; max ancestors",
 			policy: &Policy{
@@ -514,15 +488,11 @@ func TestAttachPolicyToGateway(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		ignoredGateways := map[types.NamespacedName]*v1.Gateway{
-			ignoredGatewayNsName: nil,
-		}
-
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			attachPolicyToGateway(test.policy, test.policy.TargetRefs[0], test.gw, ignoredGateways, "nginx-gateway")
+			attachPolicyToGateway(test.policy, test.gw, "nginx-gateway")
 
 			if test.expAttached {
 				g.Expect(test.gw.Policies).To(HaveLen(1))
@@ -541,22 +511,37 @@ func TestAttachPolicyToService(t *testing.T) {
 	gwNsname := types.NamespacedName{Namespace: testNs, Name: "gateway"}
 	gw2Nsname := types.NamespacedName{Namespace: testNs, Name: "gateway2"}
 
-	getGateway := func(valid bool) *Gateway {
+	getGateway := func(valid bool, nsname types.NamespacedName) *Gateway {
 		return &Gateway{
 			Source: &v1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      gwNsname.Name,
-					Namespace: gwNsname.Namespace,
+					Name:      nsname.Name,
+					Namespace: nsname.Namespace,
 				},
 			},
 			Valid: valid,
 		}
 	}
 
+	getGateways := func(valid bool) map[types.NamespacedName]*Gateway {
+		return map[types.NamespacedName]*Gateway{
+			gwNsname: getGateway(valid, gwNsname),
+		}
+	}
+
+	getService := func(gwNsNames ...types.NamespacedName) *ReferencedService {
+		svc := &ReferencedService{GatewayNsNames: make(map[types.NamespacedName]struct{})}
+		for _, nsname := range gwNsNames {
+			svc.GatewayNsNames[nsname] = struct{}{}
+		}
+
+		return svc
+	}
+
 	tests := []struct {
 		policy       *Policy
 		svc          *ReferencedService
-		gw           *Gateway
+		gws          map[types.NamespacedName]*Gateway
 		name         string
 		expAncestors []PolicyAncestor
 		expAttached  bool
@@ -564,8 +549,8 @@ func TestAttachPolicyToService(t *testing.T) {
 		{
 			name:        "attachment",
 			policy:      &Policy{Source: &policiesfakes.FakePolicy{}},
-			svc:         &ReferencedService{},
-			gw:          getGateway(true /*valid*/),
+			svc:         getService(gwNsname),
+			gws:         getGateways(true /*valid*/),
 			expAttached: true,
 			expAncestors: []PolicyAncestor{
 				{
@@ -583,8 +568,8 @@ func TestAttachPolicyToService(t *testing.T) {
 					},
 				},
 			},
-			svc:         &ReferencedService{},
-			gw:          getGateway(true /*valid*/),
+			svc:         getService(gwNsname),
+			gws:         getGateways(true /*valid*/),
 			expAttached: true,
 			expAncestors: []PolicyAncestor{
 				{
@@ -602,8 +587,8 @@ func TestAttachPolicyToService(t *testing.T) {
 					},
 				},
 			},
-			svc:         &ReferencedService{},
-			gw:          getGateway(true /*valid*/),
+			svc:         getService(gwNsname),
+			gws:         getGateways(true /*valid*/),
 			expAttached: true,
 			expAncestors: []PolicyAncestor{
 				{
@@ -617,8 +602,8 @@ func TestAttachPolicyToService(t *testing.T) {
 		{
 			name:        "no attachment; gateway is invalid",
 			policy:      &Policy{Source: &policiesfakes.FakePolicy{}},
-			svc:         &ReferencedService{},
-			gw:          getGateway(false /*invalid*/),
+			svc:         getService(gwNsname),
+			gws:         getGateways(false /*invalid*/),
 			expAttached: false,
 			expAncestors: []PolicyAncestor{
 				{
@@ -627,11 +612,56 @@ func TestAttachPolicyToService(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "attachment; service is referenced by routes of multiple gateways",
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			svc:    getService(gwNsname, gw2Nsname),
+			gws: map[types.NamespacedName]*Gateway{
+				gwNsname:  getGateway(true /*valid*/, gwNsname),
+				gw2Nsname: getGateway(true /*valid*/, gw2Nsname),
+			},
+			expAttached: true,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: getGatewayParentRef(gwNsname),
+				},
+				{
+					Ancestor: getGatewayParentRef(gw2Nsname),
+				},
+			},
+		},
+		{
+			name:   "attachment; one of the gateways is invalid",
+			policy: &Policy{Source: &policiesfakes.FakePolicy{}},
+			svc:    getService(gwNsname, gw2Nsname),
+			gws: map[types.NamespacedName]*Gateway{
+				gwNsname:  getGateway(false /*invalid*/, gwNsname),
+				gw2Nsname: getGateway(true /*valid*/, gw2Nsname),
+			},
+			expAttached: true,
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   getGatewayParentRef(gwNsname),
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+				},
+				{
+					Ancestor: getGatewayParentRef(gw2Nsname),
+				},
+			},
+		},
+		{
+			name:         "no attachment; service is not referenced by the routes of any gateway",
+			policy:       &Policy{Source: &policiesfakes.FakePolicy{}},
+			svc:          getService(),
+			gws:          getGateways(true /*valid*/),
+			expAttached:  false,
+			expAncestors: nil,
+		},
 		{
 			name:         "no attachment; max ancestor",
 			policy:       &Policy{Source: createTestPolicyWithAncestors(16)},
-			svc:          &ReferencedService{},
-			gw:           getGateway(true /*valid*/),
+			svc:          getService(gwNsname),
+			gws:          getGateways(true /*valid*/),
 			expAttached:  false,
 			expAncestors: nil,
 		},
@@ -642,7 +672,7 @@ func TestAttachPolicyToService(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			attachPolicyToService(test.policy, test.svc, test.gw, "ctlr")
+			attachPolicyToService(test.policy, test.svc, test.gws, "ctlr")
 			if test.expAttached {
 				g.Expect(test.svc.Policies).To(HaveLen(1))
 			} else {
@@ -663,7 +693,7 @@ func TestProcessPolicies(t *testing.T) {
 	hrRef := createTestRef(kinds.HTTPRoute, v1.GroupName, "hr")
 	grpcRef := createTestRef(kinds.GRPCRoute, v1.GroupName, "grpc")
 	gatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "gw")
-	ignoredGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "ignored")
+	otherGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "other-gw")
 	svcRef := createTestRef(kinds.Service, "core", "svc")
 
 	// These refs reference objects that do not belong to NGF.
@@ -677,7 +707,7 @@ func TestProcessPolicies(t *testing.T) {
 	pol1, pol1Key := createTestPolicyAndKey(policyGVK, "pol1", hrRef)
 	pol2, pol2Key := createTestPolicyAndKey(policyGVK, "pol2", grpcRef)
 	pol3, pol3Key := createTestPolicyAndKey(policyGVK, "pol3", gatewayRef)
-	pol4, pol4Key := createTestPolicyAndKey(policyGVK, "pol4", ignoredGatewayRef)
+	pol4, pol4Key := createTestPolicyAndKey(policyGVK, "pol4", otherGatewayRef)
 	pol5, pol5Key := createTestPolicyAndKey(policyGVK, "pol5", hrDoesNotExistRef)
 	pol6, pol6Key := createTestPolicyAndKey(policyGVK, "pol6", hrWrongGroup)
 	pol7, pol7Key := createTestPolicyAndKey(policyGVK, "pol7", gatewayWrongGroupRef)
@@ -755,7 +785,7 @@ func TestProcessPolicies(t *testing.T) {
 					Source: pol4,
 					TargetRefs: []PolicyTargetRef{
 						{
-							Nsname: types.NamespacedName{Namespace: testNs, Name: "ignored"},
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "other-gw"},
 							Kind:   kinds.Gateway,
 							Group:  v1.GroupName,
 						},
@@ -868,17 +898,19 @@ func TestProcessPolicies(t *testing.T) {
 		},
 	}
 
-	gateways := processedGateways{
-		Winner: &v1.Gateway{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "gw",
-				Namespace: testNs,
+	gateways := map[types.NamespacedName]*Gateway{
+		{Namespace: testNs, Name: "gw"}: {
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: testNs,
+				},
 			},
 		},
-		Ignored: map[types.NamespacedName]*v1.Gateway{
-			{Namespace: testNs, Name: "ignored"}: {
+		{Namespace: testNs, Name: "other-gw"}: {
+			Source: &v1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:      "gw",
+					Name:      "other-gw",
 					Namespace: testNs,
 				},
 			},
@@ -1039,11 +1071,13 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 		},
 	}
 
-	gateways := processedGateways{
-		Winner: &v1.Gateway{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      "gw",
-				Namespace: testNs,
+	gateways := map[types.NamespacedName]*Gateway{
+		{Namespace: testNs, Name: "gw"}: {
+			Source: &v1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: testNs,
+				},
 			},
 		},
 	}
@@ -39,8 +39,16 @@ func backendTLSPolicyAncestorsFull(
 // We aren't considering the number of NGF managed ancestors in the current list because the updated list
 // is the new source of truth.
 func ngfPolicyAncestorsFull(policy *Policy, ctlrName string) bool {
-	currAncestors := policy.Source.GetPolicyStatus().Ancestors
+	return policyAncestorsFull(policy.Source.GetPolicyStatus().Ancestors, len(policy.Ancestors), ctlrName)
+}
 
+// policyAncestorsFull returns whether or not an ancestor list is full, given the current ancestor statuses of
+// a policy and the number of NGF managed ancestors already added to the updated list.
+func policyAncestorsFull(
+	currAncestors []v1alpha2.PolicyAncestorStatus,
+	updatedAncestorsCount int,
+	ctlrName string,
+) bool {
 	var nonNGFControllerCount int
 	for _, ancestor := range currAncestors {
 		if ancestor.ControllerName != v1.GatewayController(ctlrName) {
@@ -48,7 +56,7 @@ func ngfPolicyAncestorsFull(policy *Policy, ctlrName string) bool {
 		}
 	}
 
-	return nonNGFControllerCount+len(policy.Ancestors) >= maxAncestors
+	return nonNGFControllerCount+updatedAncestorsCount >= maxAncestors
 }
 
 func createParentReference(
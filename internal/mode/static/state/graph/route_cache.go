@@ -0,0 +1,551 @@
+package graph
+
+import (
+	"maps"
+	"slices"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+)
+
+// RouteCache memoizes the per-Route stages of building HTTPRoutes and GRPCRoutes across graph builds:
+// parsing and validating the Route, and resolving the backendRefs of its rules. Each stage records the resources
+// it depends on, so a change to a resource only recomputes the Routes that depend on it.
+//
+// A parsed Route is reused as long as its source object, the Gateways, the HTTP2 setting of the NginxProxy
+// and the SnippetsFilters the Route references are unchanged.
+//
+// The backendRefs of a Route are reused as long as its parsed Route is reused, and the Services it references,
+// the BackendTLSPolicies of those Services and the NginxProxy are unchanged. If the Route references Services
+// in other namespaces, the ReferenceGrants must be unchanged as well.
+//
+// The stages that combine the Routes with each other and with the Gateways — binding to Listeners, attaching
+// policies — and the dataplane configuration are still computed in full on every build.
+//
+// RouteCache is not safe for concurrent use.
+type RouteCache struct {
+	routes         map[RouteKey]cachedRoute
+	gatewayNsNames map[types.NamespacedName]struct{}
+	// referenceGrants are the ReferenceGrants of the previous build.
+	referenceGrants map[types.NamespacedName]*v1beta1.ReferenceGrant
+	// npCfgSource is the source of the NginxProxy of the previous build.
+	npCfgSource   *ngfAPI.NginxProxy
+	npCfgValid    bool
+	http2disabled bool
+}
+
+type cachedRoute struct {
+	// source is the source object the Route was built from.
+	source client.Object
+	// snippetsFilters are the SnippetsFilters the Route looked up when it was built, keyed by their
+	// NamespacedName. The value is nil if the SnippetsFilter didn't exist.
+	snippetsFilters map[types.NamespacedName]*ngfAPI.SnippetsFilter
+	// route is the Route before it was bound to any Listener. It is nil if the Route doesn't belong to any Gateway.
+	route *L7Route
+	// backendRefs are the resolved backendRefs of the Route. They are nil until they are resolved.
+	backendRefs *cachedBackendRefs
+	// generation is the generation of the source object when the Route was built.
+	generation int64
+}
+
+// cachedBackendRefs are the resolved backendRefs of a Route and the resources they were resolved from.
+type cachedBackendRefs struct {
+	// services are the Services that the backendRefs reference, keyed by their NamespacedName.
+	// The value is nil if the Service didn't exist.
+	services map[types.NamespacedName]*apiv1.Service
+	// backendTLSPolicies are the BackendTLSPolicies that target the referenced Services.
+	backendTLSPolicies map[types.NamespacedName]cachedBackendTLSPolicy
+	// rules are the backendRefs of each rule of the Route.
+	rules [][]BackendRef
+	// conditions are the conditions that resolving the backendRefs added to the Route.
+	conditions []conditions.Condition
+	// crossNamespace is true if a backendRef references a Service in another namespace, which makes the backendRefs
+	// depend on the ReferenceGrants.
+	crossNamespace bool
+}
+
+// cachedBackendTLSPolicy is a BackendTLSPolicy that was looked up when resolving the backendRefs of a Route.
+type cachedBackendTLSPolicy struct {
+	source *v1alpha3.BackendTLSPolicy
+	// invalidMessage is the message that a backendRef gets if the policy is invalid.
+	invalidMessage string
+	// conditions are the conditions that resolving the backendRefs added to the policy.
+	conditions []conditions.Condition
+	valid      bool
+	// referenced is true if resolving the backendRefs marked the policy as referenced.
+	referenced bool
+}
+
+// NewRouteCache creates a new RouteCache.
+func NewRouteCache() *RouteCache {
+	return &RouteCache{
+		routes: make(map[RouteKey]cachedRoute),
+	}
+}
+
+// buildRoutes builds the L7 Routes like buildRoutesForGateways, reusing the parsed Routes that haven't
+// changed since the previous build.
+func (c *RouteCache) buildRoutes(
+	validator validation.HTTPFieldsValidator,
+	httpRoutes map[types.NamespacedName]*v1.HTTPRoute,
+	grpcRoutes map[types.NamespacedName]*v1.GRPCRoute,
+	gatewayNsNames []types.NamespacedName,
+	npCfg *NginxProxy,
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+) map[RouteKey]*L7Route {
+	http2disabled := isHTTP2Disabled(npCfg)
+
+	// Every Route depends on the set of Gateways, and GRPCRoutes depend on the HTTP2 setting,
+	// so a change to any of them invalidates the whole cache.
+	if !c.hasGateways(gatewayNsNames) || c.http2disabled != http2disabled {
+		c.routes = make(map[RouteKey]cachedRoute)
+		c.gatewayNsNames = make(map[types.NamespacedName]struct{}, len(gatewayNsNames))
+		for _, nsname := range gatewayNsNames {
+			c.gatewayNsNames[nsname] = struct{}{}
+		}
+		c.http2disabled = http2disabled
+	}
+
+	if len(gatewayNsNames) == 0 {
+		return nil
+	}
+
+	routes := make(map[RouteKey]*L7Route)
+	existing := make(map[RouteKey]struct{}, len(httpRoutes)+len(grpcRoutes))
+
+	for _, route := range httpRoutes {
+		key := CreateRouteKey(route)
+		existing[key] = struct{}{}
+
+		r := c.getOrBuild(key, route, validator, snippetsFilters, func() *L7Route {
+			return buildHTTPRoute(validator, route, gatewayNsNames, snippetsFilters)
+		})
+		if r != nil {
+			routes[key] = r
+		}
+	}
+
+	for _, route := range grpcRoutes {
+		key := CreateRouteKey(route)
+		existing[key] = struct{}{}
+
+		r := c.getOrBuild(key, route, validator, snippetsFilters, func() *L7Route {
+			return buildGRPCRoute(validator, route, gatewayNsNames, http2disabled, snippetsFilters)
+		})
+		if r != nil {
+			routes[key] = r
+		}
+	}
+
+	for key := range c.routes {
+		if _, exists := existing[key]; !exists {
+			delete(c.routes, key)
+		}
+	}
+
+	return routes
+}
+
+func (c *RouteCache) hasGateways(gatewayNsNames []types.NamespacedName) bool {
+	if c.gatewayNsNames == nil || len(c.gatewayNsNames) != len(gatewayNsNames) {
+		return false
+	}
+
+	for _, nsname := range gatewayNsNames {
+		if _, exists := c.gatewayNsNames[nsname]; !exists {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *RouteCache) getOrBuild(
+	key RouteKey,
+	source client.Object,
+	validator validation.HTTPFieldsValidator,
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+	build func() *L7Route,
+) *L7Route {
+	if cached, exists := c.routes[key]; exists && cached.isUpToDate(source, snippetsFilters) {
+		if cached.route == nil {
+			return nil
+		}
+
+		// Building a Route marks the SnippetsFilters it references as referenced, so we need to do the same.
+		for nsname := range cached.snippetsFilters {
+			if sf, exists := snippetsFilters[nsname]; exists {
+				sf.Referenced = true
+			}
+		}
+
+		return copyBuiltL7Route(cached.route, snippetsFilters)
+	}
+
+	route := build()
+
+	cached := cachedRoute{
+		source:          source,
+		generation:      source.GetGeneration(),
+		snippetsFilters: getLookedUpSnippetsFilters(route, validator, snippetsFilters),
+	}
+	if route != nil {
+		cached.route = copyBuiltL7Route(route, nil)
+	}
+
+	c.routes[key] = cached
+
+	return route
+}
+
+func (r cachedRoute) isUpToDate(
+	source client.Object,
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+) bool {
+	if r.source != source || r.generation != source.GetGeneration() {
+		return false
+	}
+
+	for nsname, sfSource := range r.snippetsFilters {
+		var currentSource *ngfAPI.SnippetsFilter
+		if sf, exists := snippetsFilters[nsname]; exists {
+			currentSource = sf.Source
+		}
+
+		if currentSource != sfSource {
+			return false
+		}
+	}
+
+	return true
+}
+
+// addBackendRefsToRouteRules adds the backendRefs to the rules of the Routes like addBackendRefsToRouteRules,
+// reusing the resolved backendRefs of the Routes whose dependencies haven't changed since the previous build.
+// The Routes must be the ones returned by buildRoutes in the same build.
+func (c *RouteCache) addBackendRefsToRouteRules(
+	routes map[RouteKey]*L7Route,
+	refGrantResolver *referenceGrantResolver,
+	refGrants map[types.NamespacedName]*v1beta1.ReferenceGrant,
+	services map[types.NamespacedName]*apiv1.Service,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+	npCfg *NginxProxy,
+) {
+	refGrantsChanged := !maps.Equal(c.referenceGrants, refGrants)
+	c.referenceGrants = maps.Clone(refGrants)
+
+	var npCfgSource *ngfAPI.NginxProxy
+	var npCfgValid bool
+	if npCfg != nil {
+		npCfgSource, npCfgValid = npCfg.Source, npCfg.Valid
+	}
+
+	// The NginxProxy affects the IP family and the ExternalName Services of every backendRef.
+	npCfgChanged := c.npCfgSource != npCfgSource || c.npCfgValid != npCfgValid
+	c.npCfgSource, c.npCfgValid = npCfgSource, npCfgValid
+
+	policiesByService := getBackendTLSPoliciesByService(backendTLSPolicies)
+
+	for key, route := range routes {
+		cached, exists := c.routes[key]
+		if !exists {
+			addBackendRefsToRules(route, refGrantResolver, services, backendTLSPolicies, npCfg)
+			continue
+		}
+
+		if refs := cached.backendRefs; refs != nil && !npCfgChanged && (!refGrantsChanged || !refs.crossNamespace) &&
+			refs.isUpToDate(services, policiesByService) {
+			refs.apply(route, backendTLSPolicies)
+			continue
+		}
+
+		cached.backendRefs = resolveBackendRefs(
+			route,
+			refGrantResolver,
+			services,
+			backendTLSPolicies,
+			policiesByService,
+			npCfg,
+		)
+		c.routes[key] = cached
+	}
+}
+
+// resolveBackendRefs adds the backendRefs to the rules of the Route and records what they were resolved from.
+func resolveBackendRefs(
+	route *L7Route,
+	refGrantResolver *referenceGrantResolver,
+	services map[types.NamespacedName]*apiv1.Service,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+	policiesByService map[types.NamespacedName]map[types.NamespacedName]*BackendTLSPolicy,
+	npCfg *NginxProxy,
+) *cachedBackendRefs {
+	refs := &cachedBackendRefs{}
+	refs.services, refs.crossNamespace = getBackendRefServices(route, services)
+
+	// Resolving the backendRefs marks the BackendTLSPolicies of the Services as referenced and adds conditions
+	// to them. To record the changes that this Route makes, the policies are marked as not referenced
+	// beforehand and restored afterward.
+	type policyState struct {
+		conditionsLen int
+		referenced    bool
+	}
+
+	policies := getBackendTLSPoliciesForServices(policiesByService, refs.services)
+	policyStates := make(map[types.NamespacedName]policyState, len(policies))
+
+	for nsname, policy := range policies {
+		policyStates[nsname] = policyState{conditionsLen: len(policy.Conditions), referenced: policy.IsReferenced}
+		policy.IsReferenced = false
+	}
+
+	conditionsLen := len(route.Conditions)
+
+	addBackendRefsToRules(route, refGrantResolver, services, backendTLSPolicies, npCfg)
+
+	refs.conditions = slices.Clone(route.Conditions[conditionsLen:])
+
+	refs.rules = make([][]BackendRef, 0, len(route.Spec.Rules))
+	for _, rule := range route.Spec.Rules {
+		refs.rules = append(refs.rules, slices.Clone(rule.BackendRefs))
+	}
+
+	refs.backendTLSPolicies = make(map[types.NamespacedName]cachedBackendTLSPolicy, len(policies))
+	for nsname, policy := range policies {
+		state := policyStates[nsname]
+
+		refs.backendTLSPolicies[nsname] = cachedBackendTLSPolicy{
+			source:         policy.Source,
+			invalidMessage: getBackendTLSPolicyInvalidMessage(policy),
+			conditions:     slices.Clone(policy.Conditions[state.conditionsLen:]),
+			valid:          policy.Valid,
+			referenced:     policy.IsReferenced,
+		}
+
+		policy.IsReferenced = policy.IsReferenced || state.referenced
+	}
+
+	return refs
+}
+
+// isUpToDate returns true if the Services and the BackendTLSPolicies that the backendRefs were resolved from
+// are unchanged.
+func (r *cachedBackendRefs) isUpToDate(
+	services map[types.NamespacedName]*apiv1.Service,
+	policiesByService map[types.NamespacedName]map[types.NamespacedName]*BackendTLSPolicy,
+) bool {
+	for nsname, svc := range r.services {
+		if services[nsname] != svc {
+			return false
+		}
+	}
+
+	policies := getBackendTLSPoliciesForServices(policiesByService, r.services)
+	if len(policies) != len(r.backendTLSPolicies) {
+		return false
+	}
+
+	for nsname, policy := range policies {
+		cached, exists := r.backendTLSPolicies[nsname]
+		if !exists || cached.source != policy.Source || cached.valid != policy.Valid ||
+			cached.invalidMessage != getBackendTLSPolicyInvalidMessage(policy) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// apply adds the backendRefs to the rules of the Route, and makes the same changes to the Route and
+// the BackendTLSPolicies that resolving them made.
+func (r *cachedBackendRefs) apply(route *L7Route, backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy) {
+	for idx, backendRefs := range r.rules {
+		if backendRefs == nil {
+			continue
+		}
+
+		backendRefs = slices.Clone(backendRefs)
+
+		// The cached backendRefs point to the BackendTLSPolicies of the build they were resolved in.
+		for i, ref := range backendRefs {
+			if ref.BackendTLSPolicy != nil {
+				nsname := client.ObjectKeyFromObject(ref.BackendTLSPolicy.Source)
+				backendRefs[i].BackendTLSPolicy = backendTLSPolicies[nsname]
+			}
+		}
+
+		route.Spec.Rules[idx].BackendRefs = backendRefs
+	}
+
+	route.Conditions = append(route.Conditions, r.conditions...)
+
+	for nsname, cached := range r.backendTLSPolicies {
+		policy := backendTLSPolicies[nsname]
+		policy.IsReferenced = policy.IsReferenced || cached.referenced
+		policy.Conditions = append(policy.Conditions, cached.conditions...)
+	}
+}
+
+// getBackendRefServices returns the Services that the backendRefs of the Route reference, keyed by their
+// NamespacedName, and whether any of them is in another namespace than the Route.
+// The value is nil if the Service doesn't exist.
+func getBackendRefServices(
+	route *L7Route,
+	services map[types.NamespacedName]*apiv1.Service,
+) (refServices map[types.NamespacedName]*apiv1.Service, crossNamespace bool) {
+	routeNs := route.Source.GetNamespace()
+	refServices = make(map[types.NamespacedName]*apiv1.Service)
+
+	for _, rule := range route.Spec.Rules {
+		for _, ref := range rule.RouteBackendRefs {
+			ns := routeNs
+			if ref.Namespace != nil {
+				ns = string(*ref.Namespace)
+			}
+
+			if ns != routeNs {
+				crossNamespace = true
+			}
+
+			nsname := types.NamespacedName{Namespace: ns, Name: string(ref.Name)}
+			refServices[nsname] = services[nsname]
+		}
+	}
+
+	return refServices, crossNamespace
+}
+
+// getBackendTLSPoliciesByService returns the BackendTLSPolicies keyed by the NamespacedName of the Services they
+// target, and then by their own NamespacedName. The targets are matched like in findBackendTLSPolicyForService.
+func getBackendTLSPoliciesByService(
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
+) map[types.NamespacedName]map[types.NamespacedName]*BackendTLSPolicy {
+	policiesByService := make(map[types.NamespacedName]map[types.NamespacedName]*BackendTLSPolicy)
+
+	for nsname, policy := range backendTLSPolicies {
+		for _, targetRef := range policy.Source.Spec.TargetRefs {
+			svcNsName := types.NamespacedName{Namespace: policy.Source.Namespace, Name: string(targetRef.Name)}
+
+			if policiesByService[svcNsName] == nil {
+				policiesByService[svcNsName] = make(map[types.NamespacedName]*BackendTLSPolicy)
+			}
+
+			policiesByService[svcNsName][nsname] = policy
+		}
+	}
+
+	return policiesByService
+}
+
+// getBackendTLSPoliciesForServices returns the BackendTLSPolicies that target any of the Services.
+func getBackendTLSPoliciesForServices(
+	policiesByService map[types.NamespacedName]map[types.NamespacedName]*BackendTLSPolicy,
+	services map[types.NamespacedName]*apiv1.Service,
+) map[types.NamespacedName]*BackendTLSPolicy {
+	policies := make(map[types.NamespacedName]*BackendTLSPolicy)
+
+	for svcNsName := range services {
+		maps.Copy(policies, policiesByService[svcNsName])
+	}
+
+	return policies
+}
+
+// getBackendTLSPolicyInvalidMessage returns the message that a backendRef gets if the BackendTLSPolicy is invalid.
+func getBackendTLSPolicyInvalidMessage(policy *BackendTLSPolicy) string {
+	if policy.Valid || len(policy.Conditions) == 0 {
+		return ""
+	}
+
+	return policy.Conditions[0].Message
+}
+
+// getLookedUpSnippetsFilters returns the SnippetsFilters that were looked up when building the Route.
+// A SnippetsFilter is looked up for every valid ExtensionRef filter that references the SnippetsFilter kind.
+func getLookedUpSnippetsFilters(
+	route *L7Route,
+	validator validation.HTTPFieldsValidator,
+	snippetsFilters map[types.NamespacedName]*SnippetsFilter,
+) map[types.NamespacedName]*ngfAPI.SnippetsFilter {
+	if route == nil {
+		return nil
+	}
+
+	var lookedUp map[types.NamespacedName]*ngfAPI.SnippetsFilter
+
+	for _, rule := range route.Spec.Rules {
+		for _, f := range rule.Filters.Filters {
+			if f.FilterType != FilterExtensionRef || f.ExtensionRef == nil {
+				continue
+			}
+
+			if f.ExtensionRef.Group != ngfAPI.GroupName || f.ExtensionRef.Kind != kinds.SnippetsFilter {
+				continue
+			}
+
+			if len(validateFilter(validator, f, field.NewPath("filter"))) > 0 {
+				continue
+			}
+
+			if lookedUp == nil {
+				lookedUp = make(map[types.NamespacedName]*ngfAPI.SnippetsFilter)
+			}
+
+			nsname := types.NamespacedName{Namespace: route.Source.GetNamespace(), Name: string(f.ExtensionRef.Name)}
+
+			var sfSource *ngfAPI.SnippetsFilter
+			if sf, exists := snippetsFilters[nsname]; exists {
+				sfSource = sf.Source
+			}
+
+			lookedUp[nsname] = sfSource
+		}
+	}
+
+	return lookedUp
+}
+
+// copyBuiltL7Route copies a Route that hasn't been bound to any Listener yet. The copy doesn't share any
+// fields that are modified after the Route is built.
+// If snippetsFilters is not nil, the resolved SnippetsFilters in the copy are replaced with the ones from
+// snippetsFilters.
+func copyBuiltL7Route(route *L7Route, snippetsFilters map[types.NamespacedName]*SnippetsFilter) *L7Route {
+	routeCopy := *route
+
+	routeCopy.ParentRefs = slices.Clone(route.ParentRefs)
+	routeCopy.Conditions = slices.Clone(route.Conditions)
+	routeCopy.Policies = nil
+
+	if route.Spec.Rules != nil {
+		routeCopy.Spec.Rules = make([]RouteRule, 0, len(route.Spec.Rules))
+	}
+
+	for _, rule := range route.Spec.Rules {
+		rule.BackendRefs = nil
+		rule.Canary = nil
+		rule.Filters.Filters = slices.Clone(rule.Filters.Filters)
+
+		for i, f := range rule.Filters.Filters {
+			if snippetsFilters == nil || f.ResolvedExtensionRef == nil || f.ResolvedExtensionRef.SnippetsFilter == nil {
+				continue
+			}
+
+			sf := snippetsFilters[client.ObjectKeyFromObject(f.ResolvedExtensionRef.SnippetsFilter.Source)]
+			rule.Filters.Filters[i].ResolvedExtensionRef = &ExtensionRefFilter{SnippetsFilter: sf, Valid: sf.Valid}
+		}
+
+		routeCopy.Spec.Rules = append(routeCopy.Spec.Rules, rule)
+	}
+
+	return &routeCopy
+}
@@ -0,0 +1,722 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/internal/framework/kinds"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/validation/validationfakes"
+)
+
+func TestRouteCacheEquivalence(t *testing.T) {
+	t.Parallel()
+
+	const (
+		gcName         = "my-class"
+		controllerName = "my.controller"
+		otherNs        = "other-ns"
+	)
+
+	createGateway := func(name string) *gatewayv1.Gateway {
+		return &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNs,
+				Name:      name,
+			},
+			Spec: gatewayv1.GatewaySpec{
+				GatewayClassName: gcName,
+				Listeners: []gatewayv1.Listener{
+					{
+						Name:     "listener-80",
+						Port:     80,
+						Protocol: gatewayv1.HTTPProtocolType,
+					},
+				},
+			},
+		}
+	}
+
+	parentRefs := []gatewayv1.ParentReference{
+		{
+			Name: "gateway-1",
+		},
+		{
+			Name: "gateway-2",
+		},
+	}
+
+	backendRefs := []gatewayv1.HTTPBackendRef{
+		{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Kind: helpers.GetPointer[gatewayv1.Kind](kinds.Service),
+					Name: "svc",
+					Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+				},
+			},
+		},
+	}
+
+	createHTTPRoute := func(name, hostname, snippetsFilter string) *gatewayv1.HTTPRoute {
+		hr := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  testNs,
+				Name:       name,
+				Generation: 1,
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: parentRefs,
+				},
+				Hostnames: []gatewayv1.Hostname{gatewayv1.Hostname(hostname)},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						Matches: []gatewayv1.HTTPRouteMatch{
+							{
+								Path: &gatewayv1.HTTPPathMatch{
+									Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+									Value: helpers.GetPointer("/"),
+								},
+							},
+						},
+						BackendRefs: backendRefs,
+					},
+				},
+			},
+		}
+
+		if snippetsFilter != "" {
+			hr.Spec.Rules[0].Filters = []gatewayv1.HTTPRouteFilter{
+				{
+					Type: gatewayv1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &gatewayv1.LocalObjectReference{
+						Group: ngfAPI.GroupName,
+						Kind:  kinds.SnippetsFilter,
+						Name:  gatewayv1.ObjectName(snippetsFilter),
+					},
+				},
+			}
+		}
+
+		return hr
+	}
+
+	createGRPCRoute := func(name, hostname string) *gatewayv1.GRPCRoute {
+		return &gatewayv1.GRPCRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  testNs,
+				Name:       name,
+				Generation: 1,
+			},
+			Spec: gatewayv1.GRPCRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: parentRefs,
+				},
+				Hostnames: []gatewayv1.Hostname{gatewayv1.Hostname(hostname)},
+				Rules: []gatewayv1.GRPCRouteRule{
+					{
+						BackendRefs: []gatewayv1.GRPCBackendRef{
+							{
+								BackendRef: backendRefs[0].BackendRef,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	createSnippetsFilter := func(name, value string) *ngfAPI.SnippetsFilter {
+		return &ngfAPI.SnippetsFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNs,
+				Name:      name,
+			},
+			Spec: ngfAPI.SnippetsFilterSpec{
+				Snippets: []ngfAPI.Snippet{
+					{
+						Context: ngfAPI.NginxContextHTTPServerLocation,
+						Value:   value,
+					},
+				},
+			},
+		}
+	}
+
+	createNginxProxy := func(disableHTTP2 bool, ipFamily ngfAPI.IPFamilyType) *ngfAPI.NginxProxy {
+		return &ngfAPI.NginxProxy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nginx-proxy",
+			},
+			Spec: ngfAPI.NginxProxySpec{
+				DisableHTTP2: disableHTTP2,
+				IPFamily:     helpers.GetPointer(ipFamily),
+			},
+		}
+	}
+
+	createService := func(namespace string) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "svc",
+			},
+			Spec: v1.ServiceSpec{
+				IPFamilies: []v1.IPFamily{v1.IPv4Protocol},
+				Ports: []v1.ServicePort{
+					{
+						Port: 80,
+					},
+				},
+			},
+		}
+	}
+
+	createBackendTLSPolicy := func(wellKnownCACerts v1alpha3.WellKnownCACertificatesType) *v1alpha3.BackendTLSPolicy {
+		return &v1alpha3.BackendTLSPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNs,
+				Name:      "btp",
+			},
+			Spec: v1alpha3.BackendTLSPolicySpec{
+				TargetRefs: []v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+					{
+						LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+							Kind: kinds.Service,
+							Name: "svc",
+						},
+					},
+				},
+				Validation: v1alpha3.BackendTLSPolicyValidation{
+					WellKnownCACertificates: helpers.GetPointer(wellKnownCACerts),
+					Hostname:                "svc.example.com",
+				},
+			},
+		}
+	}
+
+	crossNsHR := createHTTPRoute("hr-cross", "cross.example.com", "")
+	crossNsHR.Spec.Rules[0].BackendRefs = []gatewayv1.HTTPBackendRef{
+		{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Kind:      helpers.GetPointer[gatewayv1.Kind](kinds.Service),
+					Name:      "svc",
+					Namespace: helpers.GetPointer[gatewayv1.Namespace](otherNs),
+					Port:      helpers.GetPointer[gatewayv1.PortNumber](80),
+				},
+			},
+		},
+	}
+
+	refGrant := &v1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: otherNs,
+			Name:      "ref-grant",
+		},
+		Spec: v1beta1.ReferenceGrantSpec{
+			From: []v1beta1.ReferenceGrantFrom{
+				{
+					Group:     gatewayv1.GroupName,
+					Kind:      kinds.HTTPRoute,
+					Namespace: v1beta1.Namespace(testNs),
+				},
+			},
+			To: []v1beta1.ReferenceGrantTo{
+				{
+					Kind: kinds.Service,
+				},
+			},
+		},
+	}
+
+	gc := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: gcName,
+		},
+		Spec: gatewayv1.GatewayClassSpec{
+			ControllerName: controllerName,
+			ParametersRef: &gatewayv1.ParametersReference{
+				Group: ngfAPI.GroupName,
+				Kind:  kinds.NginxProxy,
+				Name:  "nginx-proxy",
+			},
+		},
+	}
+
+	state := ClusterState{
+		GatewayClasses: map[types.NamespacedName]*gatewayv1.GatewayClass{
+			client.ObjectKeyFromObject(gc): gc,
+		},
+		Gateways:           map[types.NamespacedName]*gatewayv1.Gateway{},
+		HTTPRoutes:         map[types.NamespacedName]*gatewayv1.HTTPRoute{},
+		GRPCRoutes:         map[types.NamespacedName]*gatewayv1.GRPCRoute{},
+		Services:           map[types.NamespacedName]*v1.Service{},
+		ReferenceGrants:    map[types.NamespacedName]*v1beta1.ReferenceGrant{},
+		BackendTLSPolicies: map[types.NamespacedName]*v1alpha3.BackendTLSPolicy{},
+		NginxProxies:       map[types.NamespacedName]*ngfAPI.NginxProxy{},
+		SnippetsFilters:    map[types.NamespacedName]*ngfAPI.SnippetsFilter{},
+	}
+
+	upsert := func(m any, obj client.Object) {
+		nsname := client.ObjectKeyFromObject(obj)
+
+		switch o := obj.(type) {
+		case *gatewayv1.Gateway:
+			m.(map[types.NamespacedName]*gatewayv1.Gateway)[nsname] = o
+		case *gatewayv1.HTTPRoute:
+			m.(map[types.NamespacedName]*gatewayv1.HTTPRoute)[nsname] = o
+		case *gatewayv1.GRPCRoute:
+			m.(map[types.NamespacedName]*gatewayv1.GRPCRoute)[nsname] = o
+		case *v1.Service:
+			m.(map[types.NamespacedName]*v1.Service)[nsname] = o
+		case *v1beta1.ReferenceGrant:
+			m.(map[types.NamespacedName]*v1beta1.ReferenceGrant)[nsname] = o
+		case *v1alpha3.BackendTLSPolicy:
+			m.(map[types.NamespacedName]*v1alpha3.BackendTLSPolicy)[nsname] = o
+		case *ngfAPI.NginxProxy:
+			m.(map[types.NamespacedName]*ngfAPI.NginxProxy)[nsname] = o
+		case *ngfAPI.SnippetsFilter:
+			m.(map[types.NamespacedName]*ngfAPI.SnippetsFilter)[nsname] = o
+		default:
+			panic("unexpected object type")
+		}
+	}
+
+	updatedHR1 := createHTTPRoute("hr-1", "foo.example.com", "sf")
+	updatedHR1.Generation = 2
+
+	// Each step changes the cluster state the same way the ChangeProcessor does: every upsert stores a new object.
+	steps := []struct {
+		change func()
+		name   string
+	}{
+		{
+			name: "initial state",
+			change: func() {
+				upsert(state.Gateways, createGateway("gateway-1"))
+				upsert(state.HTTPRoutes, createHTTPRoute("hr-1", "foo.example.com", ""))
+				upsert(state.HTTPRoutes, createHTTPRoute("hr-2", "bar.example.com", "sf"))
+				upsert(state.GRPCRoutes, createGRPCRoute("gr", "grpc.example.com"))
+			},
+		},
+		{
+			name:   "no changes",
+			change: func() {},
+		},
+		{
+			name: "route updated",
+			change: func() {
+				upsert(state.HTTPRoutes, createHTTPRoute("hr-1", "baz.example.com", ""))
+			},
+		},
+		{
+			name: "route updated in place with new generation",
+			change: func() {
+				hr := state.HTTPRoutes[types.NamespacedName{Namespace: testNs, Name: "hr-1"}]
+				hr.Spec.Hostnames = []gatewayv1.Hostname{"in-place.example.com"}
+				hr.Generation++
+			},
+		},
+		{
+			name: "referenced SnippetsFilter created",
+			change: func() {
+				upsert(state.SnippetsFilters, createSnippetsFilter("sf", "return 200;"))
+			},
+		},
+		{
+			name: "unreferenced SnippetsFilter created",
+			change: func() {
+				upsert(state.SnippetsFilters, createSnippetsFilter("unref-sf", "return 200;"))
+			},
+		},
+		{
+			name: "route now references SnippetsFilter",
+			change: func() {
+				upsert(state.HTTPRoutes, updatedHR1)
+			},
+		},
+		{
+			name: "referenced SnippetsFilter updated to be invalid",
+			change: func() {
+				upsert(state.SnippetsFilters, createSnippetsFilter("sf", ""))
+			},
+		},
+		{
+			name: "referenced SnippetsFilter updated to be valid",
+			change: func() {
+				upsert(state.SnippetsFilters, createSnippetsFilter("sf", "return 201;"))
+			},
+		},
+		{
+			name: "backend Service created",
+			change: func() {
+				upsert(state.Services, createService(testNs))
+			},
+		},
+		{
+			name: "backend Service updated",
+			change: func() {
+				svc := createService(testNs)
+				svc.Spec.Ports[0].Port = 8080
+				upsert(state.Services, svc)
+			},
+		},
+		{
+			name: "backend Service reverted",
+			change: func() {
+				upsert(state.Services, createService(testNs))
+			},
+		},
+		{
+			name: "unreferenced Service created",
+			change: func() {
+				unrefSvc := createService(testNs)
+				unrefSvc.Name = "unref-svc"
+				upsert(state.Services, unrefSvc)
+			},
+		},
+		{
+			name: "route with cross-namespace backendRef created",
+			change: func() {
+				upsert(state.HTTPRoutes, crossNsHR)
+				upsert(state.Services, createService(otherNs))
+			},
+		},
+		{
+			name: "ReferenceGrant created",
+			change: func() {
+				upsert(state.ReferenceGrants, refGrant)
+			},
+		},
+		{
+			name: "ReferenceGrant deleted",
+			change: func() {
+				delete(state.ReferenceGrants, client.ObjectKeyFromObject(refGrant))
+			},
+		},
+		{
+			name: "BackendTLSPolicy created",
+			change: func() {
+				upsert(state.BackendTLSPolicies, createBackendTLSPolicy(v1alpha3.WellKnownCACertificatesSystem))
+			},
+		},
+		{
+			name: "BackendTLSPolicy updated to be invalid",
+			change: func() {
+				upsert(state.BackendTLSPolicies, createBackendTLSPolicy("Unsupported"))
+			},
+		},
+		{
+			name: "BackendTLSPolicy updated to be valid",
+			change: func() {
+				upsert(state.BackendTLSPolicies, createBackendTLSPolicy(v1alpha3.WellKnownCACertificatesSystem))
+			},
+		},
+		{
+			name: "NginxProxy created",
+			change: func() {
+				upsert(state.NginxProxies, createNginxProxy(false, ngfAPI.Dual))
+			},
+		},
+		{
+			name: "IP family updated to not match the Services",
+			change: func() {
+				upsert(state.NginxProxies, createNginxProxy(false, ngfAPI.IPv6))
+			},
+		},
+		{
+			name: "BackendTLSPolicy deleted",
+			change: func() {
+				delete(state.BackendTLSPolicies, types.NamespacedName{Namespace: testNs, Name: "btp"})
+			},
+		},
+		{
+			name: "second Gateway created",
+			change: func() {
+				upsert(state.Gateways, createGateway("gateway-2"))
+			},
+		},
+		{
+			name: "HTTP2 disabled",
+			change: func() {
+				upsert(state.NginxProxies, createNginxProxy(true, ngfAPI.Dual))
+			},
+		},
+		{
+			name: "HTTP2 enabled",
+			change: func() {
+				upsert(state.NginxProxies, createNginxProxy(false, ngfAPI.Dual))
+			},
+		},
+		{
+			name: "referenced SnippetsFilter deleted",
+			change: func() {
+				delete(state.SnippetsFilters, types.NamespacedName{Namespace: testNs, Name: "sf"})
+			},
+		},
+		{
+			name: "route deleted",
+			change: func() {
+				delete(state.HTTPRoutes, types.NamespacedName{Namespace: testNs, Name: "hr-2"})
+			},
+		},
+		{
+			name: "all Gateways deleted",
+			change: func() {
+				delete(state.Gateways, types.NamespacedName{Namespace: testNs, Name: "gateway-1"})
+				delete(state.Gateways, types.NamespacedName{Namespace: testNs, Name: "gateway-2"})
+			},
+		},
+		{
+			name: "Gateway and routes recreated",
+			change: func() {
+				upsert(state.Gateways, createGateway("gateway-1"))
+				upsert(state.HTTPRoutes, createHTTPRoute("hr-2", "bar.example.com", "sf"))
+			},
+		},
+	}
+
+	validators := validation.Validators{
+		HTTPFieldsValidator: &validationfakes.FakeHTTPFieldsValidator{},
+		GenericValidator:    &validationfakes.FakeGenericValidator{},
+		PolicyValidator:     &validationfakes.FakePolicyValidator{},
+	}
+
+	cache := NewRouteCache()
+
+	// The steps are applied in order, so they can't run as parallel subtests.
+	for _, step := range steps {
+		step.change()
+
+		incremental := BuildGraph(state, controllerName, gcName, nil, validators, nil, false, cache)
+		full := BuildGraph(state, controllerName, gcName, nil, validators, nil, false, nil)
+
+		g := NewWithT(t)
+		g.Expect(helpers.Diff(full, incremental)).To(BeEmpty(), step.name)
+	}
+}
+
+func TestRouteCacheReusesUnchangedRoutes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+
+	createHTTPRoute := func(name string) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNs,
+				Name:      name,
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Name: gatewayv1.ObjectName(gwNsName.Name),
+						},
+					},
+				},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						Matches: []gatewayv1.HTTPRouteMatch{
+							{
+								Path: &gatewayv1.HTTPPathMatch{
+									Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+									Value: helpers.GetPointer("/"),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	hr1 := createHTTPRoute("hr-1")
+	hr2 := createHTTPRoute("hr-2")
+
+	httpRoutes := map[types.NamespacedName]*gatewayv1.HTTPRoute{
+		client.ObjectKeyFromObject(hr1): hr1,
+		client.ObjectKeyFromObject(hr2): hr2,
+	}
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{}
+	gatewayNsNames := []types.NamespacedName{gwNsName}
+
+	cache := NewRouteCache()
+
+	routes := cache.buildRoutes(validator, httpRoutes, nil, gatewayNsNames, nil, nil)
+	g.Expect(routes).To(HaveLen(2))
+	g.Expect(validator.ValidatePathInMatchCallCount()).To(Equal(2))
+
+	// modify the returned Route like binding to Listeners does, to ensure the cached copy is not affected
+	routes[CreateRouteKey(hr1)].ParentRefs[0].Attachment = &ParentRefAttachmentStatus{Attached: true}
+	routes[CreateRouteKey(hr1)].Spec.Rules[0].BackendRefs = []BackendRef{{Valid: true}}
+
+	routes = cache.buildRoutes(validator, httpRoutes, nil, gatewayNsNames, nil, nil)
+	g.Expect(routes).To(HaveLen(2))
+	g.Expect(validator.ValidatePathInMatchCallCount()).To(Equal(2))
+	g.Expect(routes[CreateRouteKey(hr1)].ParentRefs[0].Attachment).To(BeNil())
+	g.Expect(routes[CreateRouteKey(hr1)].Spec.Rules[0].BackendRefs).To(BeNil())
+
+	updatedHR1 := createHTTPRoute("hr-1")
+	httpRoutes[client.ObjectKeyFromObject(updatedHR1)] = updatedHR1
+
+	routes = cache.buildRoutes(validator, httpRoutes, nil, gatewayNsNames, nil, nil)
+	g.Expect(routes).To(HaveLen(2))
+	g.Expect(validator.ValidatePathInMatchCallCount()).To(Equal(3))
+	g.Expect(routes[CreateRouteKey(updatedHR1)].Source).To(BeIdenticalTo(updatedHR1))
+
+	delete(httpRoutes, client.ObjectKeyFromObject(hr2))
+
+	routes = cache.buildRoutes(validator, httpRoutes, nil, gatewayNsNames, nil, nil)
+	g.Expect(routes).To(HaveLen(1))
+	g.Expect(cache.routes).To(HaveLen(1))
+
+	routes = cache.buildRoutes(validator, httpRoutes, nil, nil, nil, nil)
+	g.Expect(routes).To(BeNil())
+	g.Expect(cache.routes).To(BeEmpty())
+}
+
+func TestRouteCacheReusesUnchangedBackendRefs(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+
+	createHTTPRoute := func(name, svcName string) *gatewayv1.HTTPRoute {
+		return &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNs,
+				Name:      name,
+			},
+			Spec: gatewayv1.HTTPRouteSpec{
+				CommonRouteSpec: gatewayv1.CommonRouteSpec{
+					ParentRefs: []gatewayv1.ParentReference{
+						{
+							Name: gatewayv1.ObjectName(gwNsName.Name),
+						},
+					},
+				},
+				Rules: []gatewayv1.HTTPRouteRule{
+					{
+						Matches: []gatewayv1.HTTPRouteMatch{
+							{
+								Path: &gatewayv1.HTTPPathMatch{
+									Type:  helpers.GetPointer(gatewayv1.PathMatchPathPrefix),
+									Value: helpers.GetPointer("/"),
+								},
+							},
+						},
+						BackendRefs: []gatewayv1.HTTPBackendRef{
+							{
+								BackendRef: gatewayv1.BackendRef{
+									BackendObjectReference: gatewayv1.BackendObjectReference{
+										Kind: helpers.GetPointer[gatewayv1.Kind](kinds.Service),
+										Name: gatewayv1.ObjectName(svcName),
+										Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	createService := func(name string) *v1.Service {
+		return &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNs,
+				Name:      name,
+			},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{
+						Port: 80,
+					},
+				},
+			},
+		}
+	}
+
+	hr1 := createHTTPRoute("hr-1", "svc-1")
+	hr2 := createHTTPRoute("hr-2", "svc-2")
+
+	httpRoutes := map[types.NamespacedName]*gatewayv1.HTTPRoute{
+		client.ObjectKeyFromObject(hr1): hr1,
+		client.ObjectKeyFromObject(hr2): hr2,
+	}
+
+	svc1 := createService("svc-1")
+	svc2 := createService("svc-2")
+
+	services := map[types.NamespacedName]*v1.Service{
+		client.ObjectKeyFromObject(svc1): svc1,
+		client.ObjectKeyFromObject(svc2): svc2,
+	}
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{}
+	gatewayNsNames := []types.NamespacedName{gwNsName}
+	refGrantResolver := newReferenceGrantResolver(nil)
+
+	cache := NewRouteCache()
+
+	build := func() map[RouteKey]*L7Route {
+		routes := cache.buildRoutes(validator, httpRoutes, nil, gatewayNsNames, nil, nil)
+		cache.addBackendRefsToRouteRules(routes, refGrantResolver, nil, services, nil, nil)
+		return routes
+	}
+
+	hr1Key := CreateRouteKey(hr1)
+	hr2Key := CreateRouteKey(hr2)
+
+	routes := build()
+	g.Expect(routes[hr1Key].Spec.Rules[0].BackendRefs).To(HaveLen(1))
+	g.Expect(routes[hr1Key].Spec.Rules[0].BackendRefs[0].Valid).To(BeTrue())
+
+	hr1BackendRefs := cache.routes[hr1Key].backendRefs
+	hr2BackendRefs := cache.routes[hr2Key].backendRefs
+	g.Expect(hr1BackendRefs).ToNot(BeNil())
+	g.Expect(hr2BackendRefs).ToNot(BeNil())
+
+	// modify the returned backendRefs, to ensure the cached copy is not affected
+	routes[hr1Key].Spec.Rules[0].BackendRefs[0].Valid = false
+
+	routes = build()
+	g.Expect(cache.routes[hr1Key].backendRefs).To(BeIdenticalTo(hr1BackendRefs))
+	g.Expect(cache.routes[hr2Key].backendRefs).To(BeIdenticalTo(hr2BackendRefs))
+	g.Expect(routes[hr1Key].Spec.Rules[0].BackendRefs[0].Valid).To(BeTrue())
+
+	// only the Route that references the updated Service is resolved again
+	updatedSvc2 := createService("svc-2")
+	updatedSvc2.Spec.Ports[0].Port = 8080
+	services[client.ObjectKeyFromObject(updatedSvc2)] = updatedSvc2
+
+	routes = build()
+	g.Expect(cache.routes[hr1Key].backendRefs).To(BeIdenticalTo(hr1BackendRefs))
+	g.Expect(cache.routes[hr2Key].backendRefs).ToNot(BeIdenticalTo(hr2BackendRefs))
+	g.Expect(routes[hr2Key].Spec.Rules[0].BackendRefs[0].Valid).To(BeFalse())
+	g.Expect(routes[hr2Key].Conditions).To(HaveLen(1))
+
+	hr2BackendRefs = cache.routes[hr2Key].backendRefs
+
+	// the conditions of the reused backendRefs are added to the Route again
+	routes = build()
+	g.Expect(cache.routes[hr2Key].backendRefs).To(BeIdenticalTo(hr2BackendRefs))
+	g.Expect(routes[hr2Key].Conditions).To(HaveLen(1))
+}
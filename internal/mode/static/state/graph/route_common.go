@@ -92,9 +92,8 @@ type L4Route struct {
 type L4RouteSpec struct {
 	// Hostnames defines a set of hostnames used to select a Route used to process the request.
 	Hostnames []v1.Hostname
-	// FIXME (sarthyparty): change to slice of BackendRef, as for now we are only supporting one BackendRef.
-	// We will eventually support multiple BackendRef https://github.com/nginx/nginx-gateway-fabric/issues/2184
-	BackendRef BackendRef
+	// BackendRefs are the weighted backends of the Route. The connections are split between them by weight.
+	BackendRefs []BackendRef
 }
 
 // L7Route is the generic type for the layer 7 routes, HTTPRoute and GRPCRoute.
@@ -133,6 +132,8 @@ type RouteRule struct {
 	BackendRefs []BackendRef
 	// Filters define processing steps that must be completed during the request or response lifecycle.
 	Filters RouteRuleFilters
+	// Canary is the valid Canary that targets the rule, if any.
+	Canary *Canary
 	// ValidMatches indicates if the matches are valid and accepted by the Route.
 	ValidMatches bool
 }
@@ -140,7 +141,7 @@ type RouteRule struct {
 // RouteBackendRef is a wrapper for v1.BackendRef and any BackendRef filters from the HTTPRoute or GRPCRoute.
 type RouteBackendRef struct {
 	v1.BackendRef
-	Filters []any
+	Filters []Filter
 }
 
 // CreateRouteKey takes a client.Object and creates a RouteKey.
@@ -189,6 +190,7 @@ func buildL4RoutesForGateways(
 	services map[types.NamespacedName]*apiv1.Service,
 	npCfg *NginxProxy,
 	resolver *referenceGrantResolver,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 ) map[L4RouteKey]*L4Route {
 	if len(gatewayNsNames) == 0 {
 		return nil
@@ -202,6 +204,7 @@ func buildL4RoutesForGateways(
 			services,
 			npCfg,
 			resolver.refAllowedFrom(fromTLSRoute(route.Namespace)),
+			backendTLSPolicies,
 		)
 		if r != nil {
 			routes[CreateRouteKeyL4(route)] = r
@@ -326,15 +329,15 @@ func findGatewayForParentRef(
 func bindRoutesToListeners(
 	l7Routes map[RouteKey]*L7Route,
 	l4Routes map[L4RouteKey]*L4Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 ) {
-	if gw == nil {
+	if len(gws) == 0 {
 		return
 	}
 
 	for _, r := range l7Routes {
-		bindL7RouteToListeners(r, gw, namespaces)
+		bindL7RouteToListeners(r, gws, namespaces)
 	}
 
 	var routes []*L4Route
@@ -347,11 +350,12 @@ func bindRoutesToListeners(
 		return ngfSort.LessClientObject(routes[i].Source, routes[j].Source)
 	})
 
-	// portHostnamesMap exists to detect duplicate hostnames on the same port
+	// portHostnamesMap exists to detect duplicate hostnames on the same port.
+	// It is shared by all Gateways, because all Gateways are served by the same NGINX.
 	portHostnamesMap := make(map[string]struct{})
 
 	for _, r := range routes {
-		bindL4RouteToListeners(r, gw, namespaces, portHostnamesMap)
+		bindL4RouteToListeners(r, gws, namespaces, portHostnamesMap)
 	}
 }
 
@@ -387,16 +391,7 @@ func validateParentRef(
 		return attachment, attachableListeners
 	}
 
-	// Case 3: the parentRef references an ignored Gateway resource.
-
-	referencesWinningGw := ref.Gateway.Namespace == gw.Source.Namespace && ref.Gateway.Name == gw.Source.Name
-
-	if !referencesWinningGw {
-		attachment.FailedCondition = staticConds.NewRouteNotAcceptedGatewayIgnored()
-		return attachment, attachableListeners
-	}
-
-	// Case 4: Attachment is not possible because Gateway is invalid
+	// Case 3: Attachment is not possible because Gateway is invalid
 
 	if !gw.Valid {
 		attachment.FailedCondition = staticConds.NewRouteInvalidGateway()
@@ -407,7 +402,7 @@ func validateParentRef(
 
 func bindL4RouteToListeners(
 	route *L4Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 	portHostnamesMap map[string]struct{},
 ) {
@@ -418,13 +413,15 @@ func bindL4RouteToListeners(
 	for i := range route.ParentRefs {
 		ref := &(route.ParentRefs)[i]
 
+		// ParentRefs only include references to the Gateways that belong to NGF.
+		gw := gws[ref.Gateway]
+
 		attachment, attachableListeners := validateParentRef(ref, gw)
 
 		if attachment.FailedCondition != (conditions.Condition{}) {
 			continue
 		}
 
-		// Winning Gateway
 		// Try to attach Route to all matching listeners
 
 		cond, attached := tryToAttachL4RouteToListeners(
@@ -561,7 +558,7 @@ func bindToListenerL4(
 
 func bindL7RouteToListeners(
 	route *L7Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 	namespaces map[types.NamespacedName]*apiv1.Namespace,
 ) {
 	if !route.Attachable {
@@ -571,13 +568,15 @@ func bindL7RouteToListeners(
 	for i := range route.ParentRefs {
 		ref := &(route.ParentRefs)[i]
 
+		// ParentRefs only include references to the Gateways that belong to NGF.
+		gw := gws[ref.Gateway]
+
 		attachment, attachableListeners := validateParentRef(ref, gw)
 
 		if attachment.FailedCondition != (conditions.Condition{}) {
 			continue
 		}
 
-		// Winning Gateway
 		// Try to attach Route to all matching listeners
 
 		cond, attached := tryToAttachL7RouteToListeners(
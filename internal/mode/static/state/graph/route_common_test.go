@@ -408,8 +408,8 @@ func TestBindRouteToListeners(t *testing.T) {
 			},
 		},
 	}
-	ignoredGwNsName := types.NamespacedName{Namespace: "test", Name: "ignored-gateway"}
-	routeWithIgnoredGateway := &L7Route{
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other-gateway"}
+	routeWithOtherGateway := &L7Route{
 		RouteType:  RouteTypeHTTP,
 		Source:     hr,
 		Valid:      true,
@@ -417,7 +417,7 @@ func TestBindRouteToListeners(t *testing.T) {
 		ParentRefs: []ParentRef{
 			{
 				Idx:         0,
-				Gateway:     ignoredGwNsName,
+				Gateway:     otherGwNsName,
 				SectionName: hr.Spec.ParentRefs[0].SectionName,
 			},
 		},
@@ -741,7 +741,7 @@ func TestBindRouteToListeners(t *testing.T) {
 			name: "no matching listener hostname",
 		},
 		{
-			route: routeWithIgnoredGateway,
+			route: routeWithOtherGateway,
 			gateway: &Gateway{
 				Source: gw,
 				Valid:  true,
@@ -752,19 +752,20 @@ func TestBindRouteToListeners(t *testing.T) {
 			expectedSectionNameRefs: []ParentRef{
 				{
 					Idx:         0,
-					Gateway:     ignoredGwNsName,
+					Gateway:     otherGwNsName,
 					SectionName: hr.Spec.ParentRefs[0].SectionName,
 					Attachment: &ParentRefAttachmentStatus{
-						Attached:          false,
-						FailedCondition:   staticConds.NewRouteNotAcceptedGatewayIgnored(),
-						AcceptedHostnames: map[string][]string{},
+						Attached: true,
+						AcceptedHostnames: map[string][]string{
+							"listener-80-1": {"foo.example.com"},
+						},
 					},
 				},
 			},
 			expectedGatewayListeners: []*Listener{
 				createListener("listener-80-1"),
 			},
-			name: "gateway is ignored",
+			name: "route attaches to another gateway",
 		},
 		{
 			route: invalidRoute,
@@ -1224,9 +1225,25 @@ func TestBindRouteToListeners(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
 
+			otherGw := &Gateway{
+				Source: &gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: otherGwNsName.Namespace,
+						Name:      otherGwNsName.Name,
+					},
+				},
+				Valid:     true,
+				Listeners: []*Listener{createListener("listener-80-1")},
+			}
+
+			gws := map[types.NamespacedName]*Gateway{
+				client.ObjectKeyFromObject(test.gateway.Source): test.gateway,
+				otherGwNsName: otherGw,
+			}
+
 			bindL7RouteToListeners(
 				test.route,
-				test.gateway,
+				gws,
 				namespaces,
 			)
 
@@ -1620,22 +1637,6 @@ func TestBindL4RouteToListeners(t *testing.T) {
 		},
 		Attachable: true,
 	}
-	routeReferencesWrongNamespace := &L4Route{
-		Source: tr,
-		Spec: L4RouteSpec{
-			Hostnames: tr.Spec.Hostnames,
-		},
-		Valid: true,
-		ParentRefs: []ParentRef{
-			{
-				Idx:         0,
-				Gateway:     client.ObjectKeyFromObject(gwWrongNamespace),
-				SectionName: tr.Spec.ParentRefs[0].SectionName,
-			},
-		},
-		Attachable: true,
-	}
-
 	tests := []struct {
 		route                    *L4Route
 		gateway                  *Gateway
@@ -1750,37 +1751,6 @@ func TestBindL4RouteToListeners(t *testing.T) {
 			},
 			name: "port is not nil",
 		},
-		{
-			route: routeReferencesWrongNamespace,
-			gateway: &Gateway{
-				Source: gw,
-				Valid:  true,
-				Listeners: []*Listener{
-					createListener("listener-443"),
-				},
-			},
-			expectedSectionNameRefs: []ParentRef{
-				{
-					Attachment: &ParentRefAttachmentStatus{
-						AcceptedHostnames: map[string][]string{},
-						FailedCondition: conditions.Condition{
-							Type:    "Accepted",
-							Status:  "False",
-							Reason:  "GatewayIgnored",
-							Message: "The Gateway is ignored by the controller",
-						},
-						Attached: false,
-					},
-					SectionName: tr.Spec.ParentRefs[0].SectionName,
-					Gateway:     client.ObjectKeyFromObject(gwWrongNamespace),
-					Idx:         0,
-				},
-			},
-			expectedGatewayListeners: []*Listener{
-				createListener("listener-443"),
-			},
-			name: "ignored gateway",
-		},
 		{
 			route: createNormalRoute(gw),
 			gateway: &Gateway{
@@ -2093,9 +2063,13 @@ func TestBindL4RouteToListeners(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
+			gws := map[types.NamespacedName]*Gateway{
+				client.ObjectKeyFromObject(test.gateway.Source): test.gateway,
+			}
+
 			bindL4RouteToListeners(
 				test.route,
-				test.gateway,
+				gws,
 				namespaces,
 				map[string]struct{}{},
 			)
@@ -2137,6 +2111,7 @@ func TestBuildL4RoutesForGateways_NoGateways(t *testing.T) {
 		services,
 		nil,
 		refGrantResolver,
+		nil,
 	)).To(BeNil())
 }
 
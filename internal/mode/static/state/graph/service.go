@@ -2,57 +2,67 @@ package graph
 
 import (
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// A ReferencedService represents a Kubernetes Service that is referenced by a Route and that belongs to the
-// winning Gateway. It does not contain the v1.Service object, because Services are resolved when building
+// A ReferencedService represents a Kubernetes Service that is referenced by a Route and that belongs to at least
+// one Gateway. It does not contain the v1.Service object, because Services are resolved when building
 // the dataplane.Configuration.
 type ReferencedService struct {
+	// GatewayNsNames are the NamespacedNames of the Gateways that the Routes referencing the Service belong to.
+	GatewayNsNames map[types.NamespacedName]struct{}
 	// Policies is a list of NGF Policies that target this Service.
 	Policies []*Policy
+	// BackendLBPolicy is the valid BackendLBPolicy that targets this Service, if any.
+	BackendLBPolicy *BackendLBPolicy
 }
 
 func buildReferencedServices(
 	l7routes map[RouteKey]*L7Route,
 	l4Routes map[L4RouteKey]*L4Route,
-	gw *Gateway,
+	gws map[types.NamespacedName]*Gateway,
 ) map[types.NamespacedName]*ReferencedService {
-	if gw == nil {
+	if len(gws) == 0 {
 		return nil
 	}
 
 	referencedServices := make(map[types.NamespacedName]*ReferencedService)
 
-	belongsToWinningGw := func(refs []ParentRef) bool {
+	getGatewayNsNames := func(refs []ParentRef) []types.NamespacedName {
+		var gwNsNames []types.NamespacedName
+
 		for _, ref := range refs {
-			if ref.Gateway == client.ObjectKeyFromObject(gw.Source) {
-				return true
+			if _, exists := gws[ref.Gateway]; exists {
+				gwNsNames = append(gwNsNames, ref.Gateway)
 			}
 		}
 
-		return false
+		return gwNsNames
 	}
 
-	// Processes both valid and invalid BackendRefs as invalid ones still have referenced services
-	// we may want to track.
-	addServicesForL7Routes := func(routeRules []RouteRule) {
-		for _, rule := range routeRules {
-			for _, ref := range rule.BackendRefs {
-				if ref.SvcNsName != (types.NamespacedName{}) {
-					referencedServices[ref.SvcNsName] = &ReferencedService{
-						Policies: nil,
-					}
-				}
+	addService := func(nsname types.NamespacedName, gwNsNames []types.NamespacedName) {
+		if nsname == (types.NamespacedName{}) {
+			return
+		}
+
+		svc, exists := referencedServices[nsname]
+		if !exists {
+			svc = &ReferencedService{
+				GatewayNsNames: make(map[types.NamespacedName]struct{}),
 			}
+			referencedServices[nsname] = svc
+		}
+
+		for _, gwNsName := range gwNsNames {
+			svc.GatewayNsNames[gwNsName] = struct{}{}
 		}
 	}
 
-	addServicesForL4Routes := func(route *L4Route) {
-		nsname := route.Spec.BackendRef.SvcNsName
-		if nsname != (types.NamespacedName{}) {
-			referencedServices[nsname] = &ReferencedService{
-				Policies: nil,
+	// Processes both valid and invalid BackendRefs as invalid ones still have referenced services
+	// we may want to track.
+	addServicesForL7Routes := func(routeRules []RouteRule, gwNsNames []types.NamespacedName) {
+		for _, rule := range routeRules {
+			for _, ref := range rule.BackendRefs {
+				addService(ref.SvcNsName, gwNsNames)
 			}
 		}
 	}
@@ -65,11 +75,12 @@ func buildReferencedServices(
 			continue
 		}
 
-		if !belongsToWinningGw(route.ParentRefs) {
+		gwNsNames := getGatewayNsNames(route.ParentRefs)
+		if len(gwNsNames) == 0 {
 			continue
 		}
 
-		addServicesForL7Routes(route.Spec.Rules)
+		addServicesForL7Routes(route.Spec.Rules, gwNsNames)
 	}
 
 	for _, route := range l4Routes {
@@ -77,11 +88,14 @@ func buildReferencedServices(
 			continue
 		}
 
-		if !belongsToWinningGw(route.ParentRefs) {
+		gwNsNames := getGatewayNsNames(route.ParentRefs)
+		if len(gwNsNames) == 0 {
 			continue
 		}
 
-		addServicesForL4Routes(route)
+		for _, br := range route.Spec.BackendRefs {
+			addService(br.SvcNsName, gwNsNames)
+		}
 	}
 
 	if len(referencedServices) == 0 {
@@ -21,7 +21,30 @@ func TestBuildReferencedServices(t *testing.T) {
 			},
 		},
 	}
-	ignoredGw := types.NamespacedName{Namespace: "test", Name: "ignoredGw"}
+	gw2Nsname := types.NamespacedName{Namespace: "test", Name: "gw2Nsname"}
+	gw2 := &Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: gw2Nsname.Namespace,
+				Name:      gw2Nsname.Name,
+			},
+		},
+	}
+	nonNGFGw := types.NamespacedName{Namespace: "test", Name: "nonNGFGw"}
+
+	gws := map[types.NamespacedName]*Gateway{
+		gwNsname:  gw,
+		gw2Nsname: gw2,
+	}
+
+	referencedBy := func(gwNsNames ...types.NamespacedName) *ReferencedService {
+		svc := &ReferencedService{GatewayNsNames: make(map[types.NamespacedName]struct{})}
+		for _, nsname := range gwNsNames {
+			svc.GatewayNsNames[nsname] = struct{}{}
+		}
+
+		return svc
+	}
 
 	getNormalL7Route := func() *L7Route {
 		return &L7Route{
@@ -37,6 +60,7 @@ func TestBuildReferencedServices(t *testing.T) {
 						BackendRefs: []BackendRef{
 							{
 								SvcNsName: types.NamespacedName{Namespace: "banana-ns", Name: "service"},
+								Weight:    1,
 							},
 						},
 					},
@@ -53,8 +77,11 @@ func TestBuildReferencedServices(t *testing.T) {
 	getNormalL4Route := func() *L4Route {
 		return &L4Route{
 			Spec: L4RouteSpec{
-				BackendRef: BackendRef{
-					SvcNsName: types.NamespacedName{Namespace: "tlsroute-ns", Name: "service"},
+				BackendRefs: []BackendRef{
+					{
+						SvcNsName: types.NamespacedName{Namespace: "tlsroute-ns", Name: "service"},
+						Weight:    1,
+					},
 				},
 			},
 			Valid: true,
@@ -92,6 +119,7 @@ func TestBuildReferencedServices(t *testing.T) {
 				BackendRefs: []BackendRef{
 					{
 						SvcNsName: types.NamespacedName{Namespace: "service-ns", Name: "service"},
+						Weight:    1,
 					},
 				},
 			},
@@ -99,6 +127,7 @@ func TestBuildReferencedServices(t *testing.T) {
 				BackendRefs: []BackendRef{
 					{
 						SvcNsName: types.NamespacedName{Namespace: "service-ns2", Name: "service2"},
+						Weight:    1,
 					},
 				},
 			},
@@ -108,12 +137,12 @@ func TestBuildReferencedServices(t *testing.T) {
 	})
 
 	normalL4Route2 := getModifiedL4Route(func(route *L4Route) *L4Route {
-		route.Spec.BackendRef.SvcNsName = types.NamespacedName{Namespace: "tlsroute-ns", Name: "service2"}
+		route.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{Namespace: "tlsroute-ns", Name: "service2"}
 		return route
 	})
 
 	normalL4RouteWithSameSvcAsL7Route := getModifiedL4Route(func(route *L4Route) *L4Route {
-		route.Spec.BackendRef.SvcNsName = types.NamespacedName{Namespace: "service-ns", Name: "service"}
+		route.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{Namespace: "service-ns", Name: "service"}
 		return route
 	})
 
@@ -133,17 +162,17 @@ func TestBuildReferencedServices(t *testing.T) {
 	})
 
 	validL4RouteNoServiceNsName := getModifiedL4Route(func(route *L4Route) *L4Route {
-		route.Spec.BackendRef.SvcNsName = types.NamespacedName{}
+		route.Spec.BackendRefs[0].SvcNsName = types.NamespacedName{}
 		return route
 	})
 
-	normalL4RouteWinningAndIgnoredGws := getModifiedL4Route(func(route *L4Route) *L4Route {
+	normalL4RouteNGFAndNonNGFGws := getModifiedL4Route(func(route *L4Route) *L4Route {
 		route.ParentRefs = []ParentRef{
 			{
-				Gateway: ignoredGw,
+				Gateway: nonNGFGw,
 			},
 			{
-				Gateway: ignoredGw,
+				Gateway: nonNGFGw,
 			},
 			{
 				Gateway: gwNsname,
@@ -152,28 +181,44 @@ func TestBuildReferencedServices(t *testing.T) {
 		return route
 	})
 
-	normalRouteWinningAndIgnoredGws := getModifiedL7Route(func(route *L7Route) *L7Route {
+	normalRouteNGFAndNonNGFGws := getModifiedL7Route(func(route *L7Route) *L7Route {
 		route.ParentRefs = []ParentRef{
 			{
-				Gateway: ignoredGw,
+				Gateway: nonNGFGw,
 			},
 			{
 				Gateway: gwNsname,
 			},
 			{
-				Gateway: ignoredGw,
+				Gateway: nonNGFGw,
 			},
 		}
 		return route
 	})
 
-	normalL4RouteIgnoredGw := getModifiedL4Route(func(route *L4Route) *L4Route {
-		route.ParentRefs[0].Gateway = ignoredGw
+	normalL4RouteNonNGFGw := getModifiedL4Route(func(route *L4Route) *L4Route {
+		route.ParentRefs[0].Gateway = nonNGFGw
 		return route
 	})
 
-	normalL7RouteIgnoredGw := getModifiedL7Route(func(route *L7Route) *L7Route {
-		route.ParentRefs[0].Gateway = ignoredGw
+	normalL7RouteNonNGFGw := getModifiedL7Route(func(route *L7Route) *L7Route {
+		route.ParentRefs[0].Gateway = nonNGFGw
+		return route
+	})
+
+	normalRouteGw2 := getModifiedL7Route(func(route *L7Route) *L7Route {
+		route.ParentRefs[0].Gateway = gw2Nsname
+		return route
+	})
+
+	validRouteTwoServicesTwoRulesGw2 := getModifiedL7Route(func(route *L7Route) *L7Route {
+		route.ParentRefs[0].Gateway = gw2Nsname
+		route.Spec.Rules = validRouteTwoServicesTwoRules.Spec.Rules
+		return route
+	})
+
+	normalL4RouteBothGws := getModifiedL4Route(func(route *L4Route) *L4Route {
+		route.ParentRefs = append(route.ParentRefs, ParentRef{Gateway: gw2Nsname})
 		return route
 	})
 
@@ -181,12 +226,12 @@ func TestBuildReferencedServices(t *testing.T) {
 		l7Routes map[RouteKey]*L7Route
 		l4Routes map[L4RouteKey]*L4Route
 		exp      map[types.NamespacedName]*ReferencedService
-		gw       *Gateway
+		gws      map[types.NamespacedName]*Gateway
 		name     string
 	}{
 		{
 			name: "normal routes",
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "normal-route"}}: normalRoute,
 			},
@@ -194,35 +239,35 @@ func TestBuildReferencedServices(t *testing.T) {
 				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}: normalL4Route,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "banana-ns", Name: "service"}:   {},
-				{Namespace: "tlsroute-ns", Name: "service"}: {},
+				{Namespace: "banana-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "tlsroute-ns", Name: "service"}: referencedBy(gwNsname),
 			},
 		},
 		{
 			name: "l7 route with two services in one Rule", // l4 routes don't support multiple services right now
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "two-svc-one-rule"}}: validRouteTwoServicesOneRule,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "service-ns", Name: "service"}:   {},
-				{Namespace: "service-ns2", Name: "service2"}: {},
+				{Namespace: "service-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "service-ns2", Name: "service2"}: referencedBy(gwNsname),
 			},
 		},
 		{
 			name: "route with one service per rule", // l4 routes don't support multiple rules right now
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "service-ns", Name: "service"}:   {},
-				{Namespace: "service-ns2", Name: "service2"}: {},
+				{Namespace: "service-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "service-ns2", Name: "service2"}: referencedBy(gwNsname),
 			},
 		},
 		{
 			name: "multiple valid routes with same services",
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
 				{NamespacedName: types.NamespacedName{Name: "two-svc-one-rule"}}: validRouteTwoServicesOneRule,
@@ -233,40 +278,40 @@ func TestBuildReferencedServices(t *testing.T) {
 				{NamespacedName: types.NamespacedName{Name: "l4-route-same-svc-as-l7-route"}}: normalL4RouteWithSameSvcAsL7Route,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "service-ns", Name: "service"}:   {},
-				{Namespace: "service-ns2", Name: "service2"}: {},
-				{Namespace: "tlsroute-ns", Name: "service"}:  {},
-				{Namespace: "tlsroute-ns", Name: "service2"}: {},
+				{Namespace: "service-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "service-ns2", Name: "service2"}: referencedBy(gwNsname),
+				{Namespace: "tlsroute-ns", Name: "service"}:  referencedBy(gwNsname),
+				{Namespace: "tlsroute-ns", Name: "service2"}: referencedBy(gwNsname),
 			},
 		},
 		{
-			name: "valid routes that do not belong to winning gateway",
-			gw:   gw,
+			name: "valid routes that do not belong to NGF gateways",
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
-				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gws"}}: normalL7RouteIgnoredGw,
+				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gws"}}: normalL7RouteNonNGFGw,
 			},
 			l4Routes: map[L4RouteKey]*L4Route{
-				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gw"}}: normalL4RouteIgnoredGw,
+				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gw"}}: normalL4RouteNonNGFGw,
 			},
 			exp: nil,
 		},
 		{
-			name: "valid routes that belong to both winning and ignored gateways",
-			gw:   gw,
+			name: "valid routes that belong to both NGF and non-NGF gateways",
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
-				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gws"}}: normalRouteWinningAndIgnoredGws,
+				{NamespacedName: types.NamespacedName{Name: "belongs-to-ignored-gws"}}: normalRouteNGFAndNonNGFGws,
 			},
 			l4Routes: map[L4RouteKey]*L4Route{
-				{NamespacedName: types.NamespacedName{Name: "ignored-gw"}}: normalL4RouteWinningAndIgnoredGws,
+				{NamespacedName: types.NamespacedName{Name: "ignored-gw"}}: normalL4RouteNGFAndNonNGFGws,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "banana-ns", Name: "service"}:   {},
-				{Namespace: "tlsroute-ns", Name: "service"}: {},
+				{Namespace: "banana-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "tlsroute-ns", Name: "service"}: referencedBy(gwNsname),
 			},
 		},
 		{
 			name: "valid routes with different services",
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule"}}: validRouteTwoServicesTwoRules,
 				{NamespacedName: types.NamespacedName{Name: "normal-route"}}:     normalRoute,
@@ -275,15 +320,33 @@ func TestBuildReferencedServices(t *testing.T) {
 				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}: normalL4Route,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "service-ns", Name: "service"}:   {},
-				{Namespace: "service-ns2", Name: "service2"}: {},
-				{Namespace: "banana-ns", Name: "service"}:    {},
-				{Namespace: "tlsroute-ns", Name: "service"}:  {},
+				{Namespace: "service-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "service-ns2", Name: "service2"}: referencedBy(gwNsname),
+				{Namespace: "banana-ns", Name: "service"}:    referencedBy(gwNsname),
+				{Namespace: "tlsroute-ns", Name: "service"}:  referencedBy(gwNsname),
+			},
+		},
+		{
+			name: "routes of multiple gateways with same services",
+			gws:  gws,
+			l7Routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Name: "normal-route"}}:         normalRoute,
+				{NamespacedName: types.NamespacedName{Name: "normal-route-gw2"}}:     normalRouteGw2,
+				{NamespacedName: types.NamespacedName{Name: "one-svc-per-rule-gw2"}}: validRouteTwoServicesTwoRulesGw2,
+			},
+			l4Routes: map[L4RouteKey]*L4Route{
+				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}: normalL4RouteBothGws,
+			},
+			exp: map[types.NamespacedName]*ReferencedService{
+				{Namespace: "banana-ns", Name: "service"}:    referencedBy(gwNsname, gw2Nsname),
+				{Namespace: "service-ns", Name: "service"}:   referencedBy(gw2Nsname),
+				{Namespace: "service-ns2", Name: "service2"}: referencedBy(gw2Nsname),
+				{Namespace: "tlsroute-ns", Name: "service"}:  referencedBy(gwNsname, gw2Nsname),
 			},
 		},
 		{
 			name: "invalid routes",
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "invalid-route"}}: invalidRoute,
 			},
@@ -294,7 +357,7 @@ func TestBuildReferencedServices(t *testing.T) {
 		},
 		{
 			name: "combination of valid and invalid routes",
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "normal-route"}}:  normalRoute,
 				{NamespacedName: types.NamespacedName{Name: "invalid-route"}}: invalidRoute,
@@ -304,13 +367,13 @@ func TestBuildReferencedServices(t *testing.T) {
 				{NamespacedName: types.NamespacedName{Name: "normal-l4-route"}}:  normalL4Route,
 			},
 			exp: map[types.NamespacedName]*ReferencedService{
-				{Namespace: "banana-ns", Name: "service"}:   {},
-				{Namespace: "tlsroute-ns", Name: "service"}: {},
+				{Namespace: "banana-ns", Name: "service"}:   referencedBy(gwNsname),
+				{Namespace: "tlsroute-ns", Name: "service"}: referencedBy(gwNsname),
 			},
 		},
 		{
 			name: "valid route no service nsname",
-			gw:   gw,
+			gws:  gws,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "no-service-nsname"}}: validRouteNoServiceNsName,
 			},
@@ -320,8 +383,8 @@ func TestBuildReferencedServices(t *testing.T) {
 			exp: nil,
 		},
 		{
-			name: "nil gateway",
-			gw:   nil,
+			name: "no gateways",
+			gws:  nil,
 			l7Routes: map[RouteKey]*L7Route{
 				{NamespacedName: types.NamespacedName{Name: "no-service-nsname"}}: validRouteNoServiceNsName,
 			},
@@ -337,7 +400,7 @@ func TestBuildReferencedServices(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			g.Expect(buildReferencedServices(test.l7Routes, test.l4Routes, test.gw)).To(Equal(test.exp))
+			g.Expect(buildReferencedServices(test.l7Routes, test.l4Routes, test.gws)).To(Equal(test.exp))
 		})
 	}
 }
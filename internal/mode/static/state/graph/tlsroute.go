@@ -17,6 +17,7 @@ func buildTLSRoute(
 	services map[types.NamespacedName]*apiv1.Service,
 	npCfg *NginxProxy,
 	refGrantResolver func(resource toResource) bool,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 ) *L4Route {
 	r := &L4Route{
 		Source: gtr,
@@ -45,59 +46,97 @@ func buildTLSRoute(
 
 	r.Spec.Hostnames = gtr.Spec.Hostnames
 
-	if len(gtr.Spec.Rules) != 1 || len(gtr.Spec.Rules[0].BackendRefs) != 1 {
+	if len(gtr.Spec.Rules) != 1 || len(gtr.Spec.Rules[0].BackendRefs) == 0 {
 		r.Valid = false
 		cond := staticConds.NewRouteBackendRefUnsupportedValue(
-			"Must have exactly one Rule and BackendRef",
+			"Must have exactly one Rule with at least one BackendRef",
 		)
 		r.Conditions = append(r.Conditions, cond)
 		return r
 	}
 
-	br, cond := validateBackendRefTLSRoute(gtr, services, npCfg, refGrantResolver)
+	refs := gtr.Spec.Rules[0].BackendRefs
+	r.Spec.BackendRefs = make([]BackendRef, 0, len(refs))
 
-	r.Spec.BackendRef = br
-	r.Valid = true
-	r.Attachable = true
+	for i, ref := range refs {
+		refPath := field.NewPath("spec").Child("rules").Index(0).Child("backendRefs").Index(i)
+
+		br, cond := validateBackendRefTLSRoute(
+			ref,
+			gtr.Namespace,
+			services,
+			npCfg,
+			refGrantResolver,
+			refPath,
+			backendTLSPolicies,
+		)
+
+		r.Spec.BackendRefs = append(r.Spec.BackendRefs, br)
+
+		if cond != nil {
+			r.Conditions = append(r.Conditions, *cond)
+		}
+	}
 
-	if cond != nil {
-		r.Conditions = append(r.Conditions, *cond)
+	// The upstream TLS settings are configured in the stream server, so all backends need the same BackendTLSPolicy.
+	if len(r.Spec.BackendRefs) > 1 {
+		if cond := validateBackendTLSPolicyMatchingAllBackends(r.Spec.BackendRefs); cond != nil {
+			r.Conditions = append(r.Conditions, *cond)
+			for i := range r.Spec.BackendRefs {
+				r.Spec.BackendRefs[i].Valid = false
+			}
+		}
 	}
 
+	r.Valid = true
+	r.Attachable = true
+
 	return r
 }
 
-func validateBackendRefTLSRoute(gtr *v1alpha2.TLSRoute,
+func validateBackendRefTLSRoute(
+	ref v1alpha2.BackendRef,
+	routeNs string,
 	services map[types.NamespacedName]*apiv1.Service,
 	npCfg *NginxProxy,
 	refGrantResolver func(resource toResource) bool,
+	refPath *field.Path,
+	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 ) (BackendRef, *conditions.Condition) {
-	// Length of BackendRefs and Rules is guaranteed to be one due to earlier check in buildTLSRoute
-	refPath := field.NewPath("spec").Child("rules").Index(0).Child("backendRefs").Index(0)
-
-	ref := gtr.Spec.Rules[0].BackendRefs[0]
+	// NGINX handles an invalid ref by closing the connections that are split to it.
+	// Because of that, we always calculate the weight, even if the ref is invalid.
+	weight := int32(1)
+	if ref.Weight != nil {
+		if validateWeight(*ref.Weight) != nil {
+			// We don't need to add a condition because validateBackendRef will do that.
+			weight = 0 // 0 will get no traffic
+		} else {
+			weight = *ref.Weight
+		}
+	}
 
 	if valid, cond := validateBackendRef(
 		ref,
-		gtr.Namespace,
+		routeNs,
 		refGrantResolver,
 		refPath,
 	); !valid {
 		backendRef := BackendRef{
-			Valid: false,
+			Weight: weight,
+			Valid:  false,
 		}
 
 		return backendRef, &cond
 	}
 
-	ns := gtr.Namespace
+	ns := routeNs
 	if ref.Namespace != nil {
 		ns = string(*ref.Namespace)
 	}
 
 	svcNsName := types.NamespacedName{
 		Namespace: ns,
-		Name:      string(gtr.Spec.Rules[0].BackendRefs[0].Name),
+		Name:      string(ref.Name),
 	}
 
 	svcIPFamily, svcPort, err := getIPFamilyAndPortFromRef(
@@ -110,6 +149,7 @@ func validateBackendRefTLSRoute(gtr *v1alpha2.TLSRoute,
 	backendRef := BackendRef{
 		SvcNsName:   svcNsName,
 		ServicePort: svcPort,
+		Weight:      weight,
 		Valid:       true,
 	}
 
@@ -125,5 +165,34 @@ func validateBackendRefTLSRoute(gtr *v1alpha2.TLSRoute,
 		return backendRef, helpers.GetPointer(staticConds.NewRouteInvalidIPFamily(err.Error()))
 	}
 
+	if err := validateTLSRouteBackendRefAppProtocol(svcPort.AppProtocol); err != nil {
+		backendRef.Valid = false
+
+		return backendRef, helpers.GetPointer(staticConds.NewRouteBackendRefUnsupportedProtocol(err.Error()))
+	}
+
+	externalName, err := getExternalName(services[svcNsName], npCfg)
+	if err != nil {
+		backendRef.Valid = false
+
+		return backendRef, helpers.GetPointer(staticConds.NewRouteBackendRefUnsupportedValue(err.Error()))
+	}
+
+	backendRef.ExternalName = externalName
+
+	backendTLSPolicy, err := findBackendTLSPolicyForService(
+		backendTLSPolicies,
+		ref.Namespace,
+		string(ref.Name),
+		routeNs,
+	)
+	if err != nil {
+		backendRef.Valid = false
+
+		return backendRef, helpers.GetPointer(staticConds.NewRouteBackendRefUnsupportedValue(err.Error()))
+	}
+
+	backendRef.BackendTLSPolicy = backendTLSPolicy
+
 	return backendRef, nil
 }
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1alpha3"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/internal/framework/conditions"
@@ -212,6 +213,39 @@ func TestBuildTLSRoute(t *testing.T) {
 		},
 	)
 
+	weightedRefsGtr := createTLSRoute("app.example.com",
+		[]v1alpha2.TLSRouteRule{
+			{
+				BackendRefs: []gatewayv1.BackendRef{
+					{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: "hi",
+							Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+						},
+						Weight: helpers.GetPointer[int32](80),
+					},
+					{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: "blue",
+							Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+						},
+						Weight: helpers.GetPointer[int32](20),
+					},
+					{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Name: "green",
+							Port: helpers.GetPointer[gatewayv1.PortNumber](80),
+						},
+						Weight: helpers.GetPointer[int32](-1),
+					},
+				},
+			},
+		},
+		[]gatewayv1.ParentReference{
+			parentRef,
+		},
+	)
+
 	svcNsName := types.NamespacedName{
 		Namespace: "test",
 		Name:      "hi",
@@ -266,14 +300,47 @@ func TestBuildTLSRoute(t *testing.T) {
 	alwaysTrueRefGrantResolver := func(_ toResource) bool { return true }
 	alwaysFalseRefGrantResolver := func(_ toResource) bool { return false }
 
+	createBackendTLSPolicy := func(name, svcName string, valid bool) *BackendTLSPolicy {
+		btp := &BackendTLSPolicy{
+			Source: &v1alpha3.BackendTLSPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+				Spec: v1alpha3.BackendTLSPolicySpec{
+					TargetRefs: []v1alpha2.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: v1alpha2.LocalPolicyTargetReference{
+								Kind: "Service",
+								Name: gatewayv1.ObjectName(svcName),
+							},
+						},
+					},
+					Validation: v1alpha3.BackendTLSPolicyValidation{
+						Hostname: "app.example.com",
+					},
+				},
+			},
+			Valid: valid,
+		}
+
+		if !valid {
+			btp.Conditions = []conditions.Condition{staticConds.NewPolicyInvalid("invalid policy")}
+		}
+
+		return btp
+	}
+
+	validBtp := createBackendTLSPolicy("btp", "hi", true)
+	invalidBtp := createBackendTLSPolicy("btp", "hi", false)
+	mismatchedBtp := createBackendTLSPolicy("btp", "hi", true)
+
 	tests := []struct {
-		expected       *L4Route
-		gtr            *v1alpha2.TLSRoute
-		services       map[types.NamespacedName]*apiv1.Service
-		resolver       func(resource toResource) bool
-		name           string
-		gatewayNsNames []types.NamespacedName
-		npCfg          NginxProxy
+		expected           *L4Route
+		gtr                *v1alpha2.TLSRoute
+		services           map[types.NamespacedName]*apiv1.Service
+		resolver           func(resource toResource) bool
+		backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy
+		name               string
+		gatewayNsNames     []types.NamespacedName
+		npCfg              NginxProxy
 	}{
 		{
 			gtr: duplicateParentRefsGtr,
@@ -324,7 +391,7 @@ func TestBuildTLSRoute(t *testing.T) {
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
-					"Must have exactly one Rule and BackendRef",
+					"Must have exactly one Rule with at least one BackendRef",
 				)},
 				Valid: false,
 			},
@@ -342,12 +409,15 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						SvcNsName: types.NamespacedName{
-							Namespace: "test",
-							Name:      "hi",
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{
+								Namespace: "test",
+								Name:      "hi",
+							},
+							Weight: 1,
+							Valid:  false,
 						},
-						Valid: false,
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefRefBackendNotFound(
@@ -370,8 +440,11 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						Valid: false,
+					BackendRefs: []BackendRef{
+						{
+							Weight: 1,
+							Valid:  false,
+						},
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefInvalidKind(
@@ -397,8 +470,11 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						Valid: false,
+					BackendRefs: []BackendRef{
+						{
+							Weight: 1,
+							Valid:  false,
+						},
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefInvalidKind(
@@ -424,8 +500,11 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						Valid: false,
+					BackendRefs: []BackendRef{
+						{
+							Weight: 1,
+							Valid:  false,
+						},
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefRefNotPermitted(
@@ -450,8 +529,11 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						Valid: false,
+					BackendRefs: []BackendRef{
+						{
+							Weight: 1,
+							Valid:  false,
+						},
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
@@ -476,9 +558,12 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						SvcNsName:   svcNsName,
-						ServicePort: apiv1.ServicePort{Port: 80},
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:   svcNsName,
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      1,
+						},
 					},
 				},
 				Conditions: []conditions.Condition{staticConds.NewRouteInvalidIPFamily(
@@ -507,10 +592,13 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						SvcNsName:   diffSvcNsName,
-						ServicePort: apiv1.ServicePort{Port: 80},
-						Valid:       true,
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:   diffSvcNsName,
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      1,
+							Valid:       true,
+						},
 					},
 				},
 				Attachable: true,
@@ -532,10 +620,13 @@ func TestBuildTLSRoute(t *testing.T) {
 					Hostnames: []gatewayv1.Hostname{
 						"app.example.com",
 					},
-					BackendRef: BackendRef{
-						SvcNsName:   svcNsName,
-						ServicePort: apiv1.ServicePort{Port: 80},
-						Valid:       true,
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:   svcNsName,
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      1,
+							Valid:       true,
+						},
 					},
 				},
 				Attachable: true,
@@ -548,6 +639,165 @@ func TestBuildTLSRoute(t *testing.T) {
 			resolver: alwaysTrueRefGrantResolver,
 			name:     "valid; same namespace",
 		},
+		{
+			gtr: weightedRefsGtr,
+			expected: &L4Route{
+				Source:     weightedRefsGtr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					Hostnames: []gatewayv1.Hostname{
+						"app.example.com",
+					},
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:   svcNsName,
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      80,
+							Valid:       true,
+						},
+						{
+							SvcNsName:   types.NamespacedName{Namespace: "test", Name: "blue"},
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      20,
+							Valid:       true,
+						},
+						{
+							Weight: 0,
+							Valid:  false,
+						},
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
+					"spec.rules[0].backendRefs[2].weight: Invalid value: -1: must be in the range [0, 1000000]",
+				)},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				svcNsName:                         ipv4Svc,
+				{Namespace: "test", Name: "blue"}: createSvc("blue", 80),
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "valid; multiple weighted backendRefs",
+		},
+		{
+			gtr: validRefSameNs,
+			expected: &L4Route{
+				Source:     validRefSameNs,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					Hostnames: []gatewayv1.Hostname{
+						"app.example.com",
+					},
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:        svcNsName,
+							ServicePort:      apiv1.ServicePort{Port: 80},
+							BackendTLSPolicy: validBtp,
+							Weight:           1,
+							Valid:            true,
+						},
+					},
+				},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				svcNsName: ipv4Svc,
+			},
+			backendTLSPolicies: map[types.NamespacedName]*BackendTLSPolicy{
+				{Namespace: "test", Name: "btp"}: validBtp,
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "valid; backendRef with a BackendTLSPolicy",
+		},
+		{
+			gtr: validRefSameNs,
+			expected: &L4Route{
+				Source:     validRefSameNs,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					Hostnames: []gatewayv1.Hostname{
+						"app.example.com",
+					},
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:   svcNsName,
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      1,
+							Valid:       false,
+						},
+					},
+				},
+				Conditions: []conditions.Condition{staticConds.NewRouteBackendRefUnsupportedValue(
+					"the backend TLS policy is invalid: invalid policy",
+				)},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				svcNsName: ipv4Svc,
+			},
+			backendTLSPolicies: map[types.NamespacedName]*BackendTLSPolicy{
+				{Namespace: "test", Name: "btp"}: invalidBtp,
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "invalid; backendRef with an invalid BackendTLSPolicy",
+		},
+		{
+			gtr: weightedRefsGtr,
+			expected: &L4Route{
+				Source:     weightedRefsGtr,
+				ParentRefs: []ParentRef{parentRefGraph},
+				Spec: L4RouteSpec{
+					Hostnames: []gatewayv1.Hostname{
+						"app.example.com",
+					},
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:        svcNsName,
+							ServicePort:      apiv1.ServicePort{Port: 80},
+							BackendTLSPolicy: mismatchedBtp,
+							Weight:           80,
+							Valid:            false,
+						},
+						{
+							SvcNsName:   types.NamespacedName{Namespace: "test", Name: "blue"},
+							ServicePort: apiv1.ServicePort{Port: 80},
+							Weight:      20,
+							Valid:       false,
+						},
+						{
+							Weight: 0,
+							Valid:  false,
+						},
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteBackendRefUnsupportedValue(
+						"spec.rules[0].backendRefs[2].weight: Invalid value: -1: must be in the range [0, 1000000]",
+					),
+					staticConds.NewRouteBackendRefUnsupportedValue(
+						"Backend TLS policies do not match for all backends",
+					),
+				},
+				Attachable: true,
+				Valid:      true,
+			},
+			gatewayNsNames: []types.NamespacedName{gatewayNsName},
+			services: map[types.NamespacedName]*apiv1.Service{
+				svcNsName:                         ipv4Svc,
+				{Namespace: "test", Name: "blue"}: createSvc("blue", 80),
+			},
+			backendTLSPolicies: map[types.NamespacedName]*BackendTLSPolicy{
+				{Namespace: "test", Name: "btp"}: mismatchedBtp,
+			},
+			resolver: alwaysTrueRefGrantResolver,
+			name:     "invalid; backendRefs with different BackendTLSPolicies",
+		},
 	}
 
 	for _, test := range tests {
@@ -561,6 +811,7 @@ func TestBuildTLSRoute(t *testing.T) {
 				test.services,
 				&test.npCfg,
 				test.resolver,
+				test.backendTLSPolicies,
 			)
 			g.Expect(helpers.Diff(test.expected, r)).To(BeEmpty())
 		})
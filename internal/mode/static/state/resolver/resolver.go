@@ -36,16 +36,28 @@ type Endpoint struct {
 	Port int32
 	// IPv6 is true if the endpoint is an IPv6 address.
 	IPv6 bool
+	// Terminating is true if the endpoint is not ready because it is terminating.
+	// Terminating endpoints must not receive new connections; they are only used for draining existing connections.
+	Terminating bool
+	// Backup is true if the endpoint should only receive traffic when the preferred endpoints are unavailable.
+	// Endpoints are backups when their EndpointSlice hints assign them to zones other than the zone of NGINX.
+	Backup bool
+	// Resolve is true if the Address is a hostname that NGINX resolves at runtime, like the external hostname
+	// of an ExternalName Service.
+	Resolve bool
 }
 
 // ServiceResolverImpl implements ServiceResolver.
 type ServiceResolverImpl struct {
 	client client.Client
+	zone   string
 }
 
 // NewServiceResolverImpl creates a new instance of a ServiceResolverImpl.
-func NewServiceResolverImpl(c client.Client) *ServiceResolverImpl {
-	return &ServiceResolverImpl{client: c}
+// zone is the topology zone of the node where NGINX runs. It is used to prefer the endpoints that the
+// EndpointSlice hints assign to the same zone. If zone is empty, the hints are ignored.
+func NewServiceResolverImpl(c client.Client, zone string) *ServiceResolverImpl {
+	return &ServiceResolverImpl{client: c, zone: zone}
 }
 
 // Resolve resolves a Service's NamespacedName and ServicePort to a list of Endpoints.
@@ -81,6 +93,7 @@ func (e *ServiceResolverImpl) Resolve(
 		endpointSliceList,
 		initEndpointSetWithCalculatedSize,
 		allowedAddressType,
+		e.zone,
 	)
 }
 
@@ -113,6 +126,7 @@ func resolveEndpoints(
 	endpointSliceList discoveryV1.EndpointSliceList,
 	initEndpointsSet initEndpointSetFunc,
 	allowedAddressType []discoveryV1.AddressType,
+	zone string,
 ) ([]Endpoint, error) {
 	filteredSlices := filterEndpointSliceList(endpointSliceList, svcPort, allowedAddressType)
 
@@ -124,10 +138,15 @@ func resolveEndpoints(
 	// Using a set to prevent returning duplicate endpoints.
 	endpointSet := initEndpointsSet(filteredSlices)
 
+	// Like kube-proxy, the zone hints are only used if all ready endpoints have them.
+	allHinted := true
+	zoneEndpoints := make(map[Endpoint]struct{})
+
 	for _, eps := range filteredSlices {
 		ipv6 := eps.AddressType == discoveryV1.AddressTypeIPv6
 		for _, endpoint := range eps.Endpoints {
-			if !endpointReady(endpoint) {
+			terminating := !endpointReady(endpoint)
+			if terminating && !endpointTerminating(endpoint) {
 				continue
 			}
 
@@ -135,15 +154,42 @@ func resolveEndpoints(
 			// that have a matching port.
 			endpointPort := findPort(eps.Ports, svcPort)
 
+			hinted, forZone := endpointZoneHints(endpoint, zone)
+			if !terminating && !hinted {
+				allHinted = false
+			}
+
 			for _, address := range endpoint.Addresses {
-				ep := Endpoint{Address: address, Port: endpointPort, IPv6: ipv6}
+				ep := Endpoint{Address: address, Port: endpointPort, IPv6: ipv6, Terminating: terminating}
 				endpointSet[ep] = struct{}{}
+
+				if forZone && !terminating {
+					zoneEndpoints[ep] = struct{}{}
+				}
 			}
 		}
 	}
 
+	// If no ready endpoint is assigned to the zone, all endpoints are used equally.
+	preferZone := zone != "" && allHinted && len(zoneEndpoints) > 0
+
 	endpoints := make([]Endpoint, 0, len(endpointSet))
 	for ep := range endpointSet {
+		if ep.Terminating {
+			readyEp := ep
+			readyEp.Terminating = false
+
+			// The endpoint is ready in another EndpointSlice.
+			if _, exists := endpointSet[readyEp]; exists {
+				continue
+			}
+		}
+
+		if preferZone && !ep.Terminating {
+			_, forZone := zoneEndpoints[ep]
+			ep.Backup = !forZone
+		}
+
 		endpoints = append(endpoints, ep)
 	}
 
@@ -184,6 +230,26 @@ func endpointReady(endpoint discoveryV1.Endpoint) bool {
 	return ready != nil && *ready
 }
 
+// endpointZoneHints returns whether the endpoint has zone hints, and if the hints include the zone.
+func endpointZoneHints(endpoint discoveryV1.Endpoint, zone string) (hinted, forZone bool) {
+	if endpoint.Hints == nil || len(endpoint.Hints.ForZones) == 0 {
+		return false, false
+	}
+
+	for _, z := range endpoint.Hints.ForZones {
+		if z.Name == zone {
+			return true, true
+		}
+	}
+
+	return true, false
+}
+
+func endpointTerminating(endpoint discoveryV1.Endpoint) bool {
+	terminating := endpoint.Conditions.Terminating
+	return terminating != nil && *terminating
+}
+
 func filterEndpointSliceList(
 	endpointSliceList discoveryV1.EndpointSliceList,
 	port v1.ServicePort,
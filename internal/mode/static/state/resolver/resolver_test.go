@@ -370,6 +370,170 @@ func TestEndpointReady(t *testing.T) {
 	}
 }
 
+func TestResolveEndpointsZoneHints(t *testing.T) {
+	t.Parallel()
+
+	svcNsName := types.NamespacedName{Namespace: "test", Name: "svc"}
+	svcPort := v1.ServicePort{Name: svcPortName, Port: 80}
+
+	createEndpoint := func(address string, ready bool, zones ...string) discoveryV1.Endpoint {
+		ep := discoveryV1.Endpoint{
+			Addresses: []string{address},
+			Conditions: discoveryV1.EndpointConditions{
+				Ready:       helpers.GetPointer(ready),
+				Terminating: helpers.GetPointer(!ready),
+			},
+		}
+
+		if len(zones) > 0 {
+			ep.Hints = &discoveryV1.EndpointHints{}
+			for _, zone := range zones {
+				ep.Hints.ForZones = append(ep.Hints.ForZones, discoveryV1.ForZone{Name: zone})
+			}
+		}
+
+		return ep
+	}
+
+	createSliceList := func(endpoints ...discoveryV1.Endpoint) discoveryV1.EndpointSliceList {
+		return discoveryV1.EndpointSliceList{
+			Items: []discoveryV1.EndpointSlice{
+				{
+					AddressType: discoveryV1.AddressTypeIPv4,
+					Endpoints:   endpoints,
+					Ports: []discoveryV1.EndpointPort{
+						{
+							Name: &svcPortName,
+							Port: helpers.GetPointer[int32](8080),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		zone         string
+		sliceList    discoveryV1.EndpointSliceList
+		expEndpoints []Endpoint
+	}{
+		{
+			name: "endpoints of other zones are backups",
+			zone: "zone-a",
+			sliceList: createSliceList(
+				createEndpoint("10.0.0.1", true, "zone-a"),
+				createEndpoint("10.0.0.2", true, "zone-b"),
+				createEndpoint("10.0.0.3", true, "zone-b", "zone-a"),
+				createEndpoint("10.0.0.4", false, "zone-b"),
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 8080},
+				{Address: "10.0.0.2", Port: 8080, Backup: true},
+				{Address: "10.0.0.3", Port: 8080},
+				{Address: "10.0.0.4", Port: 8080, Terminating: true},
+			},
+		},
+		{
+			name: "zone is unknown",
+			sliceList: createSliceList(
+				createEndpoint("10.0.0.1", true, "zone-a"),
+				createEndpoint("10.0.0.2", true, "zone-b"),
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 8080},
+				{Address: "10.0.0.2", Port: 8080},
+			},
+		},
+		{
+			name: "not all ready endpoints have hints",
+			zone: "zone-a",
+			sliceList: createSliceList(
+				createEndpoint("10.0.0.1", true, "zone-a"),
+				createEndpoint("10.0.0.2", true),
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 8080},
+				{Address: "10.0.0.2", Port: 8080},
+			},
+		},
+		{
+			name: "no ready endpoints for the zone",
+			zone: "zone-a",
+			sliceList: createSliceList(
+				createEndpoint("10.0.0.1", false, "zone-a"),
+				createEndpoint("10.0.0.2", true, "zone-b"),
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 8080, Terminating: true},
+				{Address: "10.0.0.2", Port: 8080},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			endpoints, err := resolveEndpoints(
+				svcNsName,
+				svcPort,
+				test.sliceList,
+				initEndpointSetWithCalculatedSize,
+				dualAddressType,
+				test.zone,
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(endpoints).To(ConsistOf(test.expEndpoints))
+		})
+	}
+}
+
+func TestEndpointTerminating(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		endpoint    discoveryV1.Endpoint
+		msg         string
+		terminating bool
+	}{
+		{
+			msg: "endpoint terminating",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Terminating: helpers.GetPointer(true),
+				},
+			},
+			terminating: true,
+		},
+		{
+			msg: "nil terminating",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Terminating: nil,
+				},
+			},
+			terminating: false,
+		},
+		{
+			msg: "endpoint not terminating",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Terminating: helpers.GetPointer(false),
+				},
+			},
+			terminating: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(endpointTerminating(tc.endpoint)).To(Equal(tc.terminating))
+		})
+	}
+}
+
 func TestFindPort(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -626,7 +790,7 @@ func bench(b *testing.B, svcNsName types.NamespacedName,
 ) {
 	b.Helper()
 	for range b.N {
-		res, err := resolveEndpoints(svcNsName, v1.ServicePort{Port: 80}, list, initSet, dualAddressType)
+		res, err := resolveEndpoints(svcNsName, v1.ServicePort{Port: 80}, list, initSet, dualAddressType, "")
 		if len(res) != n {
 			b.Fatalf("expected %d endpoints, got %d", n, len(res))
 		}
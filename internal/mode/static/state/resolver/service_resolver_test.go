@@ -47,12 +47,19 @@ func createSlice(
 					"1.0.0.1",
 					"1.0.0.2",
 					"1.0.0.3",
-				}, // these endpoints should be ignored because they are not ready
+				}, // these endpoints should be resolved as terminating because they are not ready
 				Conditions: discoveryV1.EndpointConditions{
 					Serving:     helpers.GetPointer(true),
 					Terminating: helpers.GetPointer(true),
 				},
 			},
+			{
+				// this endpoint should be ignored for slice1 and dupeEndpointSlice, where it is ready
+				Addresses: []string{"9.0.0.1"},
+				Conditions: discoveryV1.EndpointConditions{
+					Terminating: helpers.GetPointer(true),
+				},
+			},
 			{
 				Addresses:  []string{"2.0.0.1", "2.0.0.2", "2.0.0.3"},
 				Conditions: discoveryV1.EndpointConditions{
@@ -172,7 +179,7 @@ var _ = Describe("ServiceResolver", func() {
 			)
 			Expect(err).ToNot(HaveOccurred())
 
-			serviceResolver = resolver.NewServiceResolverImpl(fakeK8sClient)
+			serviceResolver = resolver.NewServiceResolverImpl(fakeK8sClient, "")
 		})
 		It("resolves a service for a given port", func() {
 			expectedEndpoints := []resolver.Endpoint{
@@ -207,6 +214,20 @@ var _ = Describe("ServiceResolver", func() {
 				},
 			}
 
+			for _, address := range []string{"1.0.0.1", "1.0.0.2", "1.0.0.3"} {
+				expectedEndpoints = append(
+					expectedEndpoints,
+					resolver.Endpoint{Address: address, Port: 8080, Terminating: true},
+					resolver.Endpoint{Address: address, Port: 8081, Terminating: true},
+					resolver.Endpoint{Address: address, Port: 8080, IPv6: true, Terminating: true},
+				)
+			}
+			expectedEndpoints = append(
+				expectedEndpoints,
+				resolver.Endpoint{Address: "9.0.0.1", Port: 8081, Terminating: true},
+				resolver.Endpoint{Address: "9.0.0.1", Port: 8080, IPv6: true, Terminating: true},
+			)
+
 			endpoints, err := serviceResolver.Resolve(context.TODO(), svcNsName, svcPort, dualAddressType)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(endpoints).To(ConsistOf(expectedEndpoints))
@@ -19,6 +19,17 @@ type FakeGenericValidator struct {
 	validateEndpointReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateEscapedStringStub        func(string) error
+	validateEscapedStringMutex       sync.RWMutex
+	validateEscapedStringArgsForCall []struct {
+		arg1 string
+	}
+	validateEscapedStringReturns struct {
+		result1 error
+	}
+	validateEscapedStringReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateEscapedStringNoVarExpansionStub        func(string) error
 	validateEscapedStringNoVarExpansionMutex       sync.RWMutex
 	validateEscapedStringNoVarExpansionArgsForCall []struct {
@@ -30,6 +41,28 @@ type FakeGenericValidator struct {
 	validateEscapedStringNoVarExpansionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateHeaderNameStub        func(string) error
+	validateHeaderNameMutex       sync.RWMutex
+	validateHeaderNameArgsForCall []struct {
+		arg1 string
+	}
+	validateHeaderNameReturns struct {
+		result1 error
+	}
+	validateHeaderNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateMIMETypeStub        func(string) error
+	validateMIMETypeMutex       sync.RWMutex
+	validateMIMETypeArgsForCall []struct {
+		arg1 string
+	}
+	validateMIMETypeReturns struct {
+		result1 error
+	}
+	validateMIMETypeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateNginxDurationStub        func(string) error
 	validateNginxDurationMutex       sync.RWMutex
 	validateNginxDurationArgsForCall []struct {
@@ -52,6 +85,17 @@ type FakeGenericValidator struct {
 	validateNginxSizeReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateNginxVariableNameStub        func(string) error
+	validateNginxVariableNameMutex       sync.RWMutex
+	validateNginxVariableNameArgsForCall []struct {
+		arg1 string
+	}
+	validateNginxVariableNameReturns struct {
+		result1 error
+	}
+	validateNginxVariableNameReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateServiceNameStub        func(string) error
 	validateServiceNameMutex       sync.RWMutex
 	validateServiceNameArgsForCall []struct {
@@ -128,6 +172,67 @@ func (fake *FakeGenericValidator) ValidateEndpointReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateEscapedString(arg1 string) error {
+	fake.validateEscapedStringMutex.Lock()
+	ret, specificReturn := fake.validateEscapedStringReturnsOnCall[len(fake.validateEscapedStringArgsForCall)]
+	fake.validateEscapedStringArgsForCall = append(fake.validateEscapedStringArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateEscapedStringStub
+	fakeReturns := fake.validateEscapedStringReturns
+	fake.recordInvocation("ValidateEscapedString", []interface{}{arg1})
+	fake.validateEscapedStringMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateEscapedStringCallCount() int {
+	fake.validateEscapedStringMutex.RLock()
+	defer fake.validateEscapedStringMutex.RUnlock()
+	return len(fake.validateEscapedStringArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateEscapedStringCalls(stub func(string) error) {
+	fake.validateEscapedStringMutex.Lock()
+	defer fake.validateEscapedStringMutex.Unlock()
+	fake.ValidateEscapedStringStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateEscapedStringArgsForCall(i int) string {
+	fake.validateEscapedStringMutex.RLock()
+	defer fake.validateEscapedStringMutex.RUnlock()
+	argsForCall := fake.validateEscapedStringArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateEscapedStringReturns(result1 error) {
+	fake.validateEscapedStringMutex.Lock()
+	defer fake.validateEscapedStringMutex.Unlock()
+	fake.ValidateEscapedStringStub = nil
+	fake.validateEscapedStringReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateEscapedStringReturnsOnCall(i int, result1 error) {
+	fake.validateEscapedStringMutex.Lock()
+	defer fake.validateEscapedStringMutex.Unlock()
+	fake.ValidateEscapedStringStub = nil
+	if fake.validateEscapedStringReturnsOnCall == nil {
+		fake.validateEscapedStringReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateEscapedStringReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateEscapedStringNoVarExpansion(arg1 string) error {
 	fake.validateEscapedStringNoVarExpansionMutex.Lock()
 	ret, specificReturn := fake.validateEscapedStringNoVarExpansionReturnsOnCall[len(fake.validateEscapedStringNoVarExpansionArgsForCall)]
@@ -189,6 +294,128 @@ func (fake *FakeGenericValidator) ValidateEscapedStringNoVarExpansionReturnsOnCa
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateHeaderName(arg1 string) error {
+	fake.validateHeaderNameMutex.Lock()
+	ret, specificReturn := fake.validateHeaderNameReturnsOnCall[len(fake.validateHeaderNameArgsForCall)]
+	fake.validateHeaderNameArgsForCall = append(fake.validateHeaderNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateHeaderNameStub
+	fakeReturns := fake.validateHeaderNameReturns
+	fake.recordInvocation("ValidateHeaderName", []interface{}{arg1})
+	fake.validateHeaderNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateHeaderNameCallCount() int {
+	fake.validateHeaderNameMutex.RLock()
+	defer fake.validateHeaderNameMutex.RUnlock()
+	return len(fake.validateHeaderNameArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateHeaderNameCalls(stub func(string) error) {
+	fake.validateHeaderNameMutex.Lock()
+	defer fake.validateHeaderNameMutex.Unlock()
+	fake.ValidateHeaderNameStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateHeaderNameArgsForCall(i int) string {
+	fake.validateHeaderNameMutex.RLock()
+	defer fake.validateHeaderNameMutex.RUnlock()
+	argsForCall := fake.validateHeaderNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateHeaderNameReturns(result1 error) {
+	fake.validateHeaderNameMutex.Lock()
+	defer fake.validateHeaderNameMutex.Unlock()
+	fake.ValidateHeaderNameStub = nil
+	fake.validateHeaderNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateHeaderNameReturnsOnCall(i int, result1 error) {
+	fake.validateHeaderNameMutex.Lock()
+	defer fake.validateHeaderNameMutex.Unlock()
+	fake.ValidateHeaderNameStub = nil
+	if fake.validateHeaderNameReturnsOnCall == nil {
+		fake.validateHeaderNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateHeaderNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateMIMEType(arg1 string) error {
+	fake.validateMIMETypeMutex.Lock()
+	ret, specificReturn := fake.validateMIMETypeReturnsOnCall[len(fake.validateMIMETypeArgsForCall)]
+	fake.validateMIMETypeArgsForCall = append(fake.validateMIMETypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateMIMETypeStub
+	fakeReturns := fake.validateMIMETypeReturns
+	fake.recordInvocation("ValidateMIMEType", []interface{}{arg1})
+	fake.validateMIMETypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeCallCount() int {
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	return len(fake.validateMIMETypeArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeCalls(stub func(string) error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeArgsForCall(i int) string {
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	argsForCall := fake.validateMIMETypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeReturns(result1 error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = nil
+	fake.validateMIMETypeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateMIMETypeReturnsOnCall(i int, result1 error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = nil
+	if fake.validateMIMETypeReturnsOnCall == nil {
+		fake.validateMIMETypeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateMIMETypeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateNginxDuration(arg1 string) error {
 	fake.validateNginxDurationMutex.Lock()
 	ret, specificReturn := fake.validateNginxDurationReturnsOnCall[len(fake.validateNginxDurationArgsForCall)]
@@ -311,6 +538,67 @@ func (fake *FakeGenericValidator) ValidateNginxSizeReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeGenericValidator) ValidateNginxVariableName(arg1 string) error {
+	fake.validateNginxVariableNameMutex.Lock()
+	ret, specificReturn := fake.validateNginxVariableNameReturnsOnCall[len(fake.validateNginxVariableNameArgsForCall)]
+	fake.validateNginxVariableNameArgsForCall = append(fake.validateNginxVariableNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateNginxVariableNameStub
+	fakeReturns := fake.validateNginxVariableNameReturns
+	fake.recordInvocation("ValidateNginxVariableName", []interface{}{arg1})
+	fake.validateNginxVariableNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenericValidator) ValidateNginxVariableNameCallCount() int {
+	fake.validateNginxVariableNameMutex.RLock()
+	defer fake.validateNginxVariableNameMutex.RUnlock()
+	return len(fake.validateNginxVariableNameArgsForCall)
+}
+
+func (fake *FakeGenericValidator) ValidateNginxVariableNameCalls(stub func(string) error) {
+	fake.validateNginxVariableNameMutex.Lock()
+	defer fake.validateNginxVariableNameMutex.Unlock()
+	fake.ValidateNginxVariableNameStub = stub
+}
+
+func (fake *FakeGenericValidator) ValidateNginxVariableNameArgsForCall(i int) string {
+	fake.validateNginxVariableNameMutex.RLock()
+	defer fake.validateNginxVariableNameMutex.RUnlock()
+	argsForCall := fake.validateNginxVariableNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenericValidator) ValidateNginxVariableNameReturns(result1 error) {
+	fake.validateNginxVariableNameMutex.Lock()
+	defer fake.validateNginxVariableNameMutex.Unlock()
+	fake.ValidateNginxVariableNameStub = nil
+	fake.validateNginxVariableNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGenericValidator) ValidateNginxVariableNameReturnsOnCall(i int, result1 error) {
+	fake.validateNginxVariableNameMutex.Lock()
+	defer fake.validateNginxVariableNameMutex.Unlock()
+	fake.ValidateNginxVariableNameStub = nil
+	if fake.validateNginxVariableNameReturnsOnCall == nil {
+		fake.validateNginxVariableNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateNginxVariableNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGenericValidator) ValidateServiceName(arg1 string) error {
 	fake.validateServiceNameMutex.Lock()
 	ret, specificReturn := fake.validateServiceNameReturnsOnCall[len(fake.validateServiceNameArgsForCall)]
@@ -377,12 +665,20 @@ func (fake *FakeGenericValidator) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.validateEndpointMutex.RLock()
 	defer fake.validateEndpointMutex.RUnlock()
+	fake.validateEscapedStringMutex.RLock()
+	defer fake.validateEscapedStringMutex.RUnlock()
 	fake.validateEscapedStringNoVarExpansionMutex.RLock()
 	defer fake.validateEscapedStringNoVarExpansionMutex.RUnlock()
+	fake.validateHeaderNameMutex.RLock()
+	defer fake.validateHeaderNameMutex.RUnlock()
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
 	fake.validateNginxDurationMutex.RLock()
 	defer fake.validateNginxDurationMutex.RUnlock()
 	fake.validateNginxSizeMutex.RLock()
 	defer fake.validateNginxSizeMutex.RUnlock()
+	fake.validateNginxVariableNameMutex.RLock()
+	defer fake.validateNginxVariableNameMutex.RUnlock()
 	fake.validateServiceNameMutex.RLock()
 	defer fake.validateServiceNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -43,6 +43,10 @@ type HTTPFieldsValidator interface {
 //counterfeiter:generate . GenericValidator
 type GenericValidator interface {
 	ValidateEscapedStringNoVarExpansion(value string) error
+	ValidateEscapedString(value string) error
+	ValidateHeaderName(name string) error
+	ValidateNginxVariableName(name string) error
+	ValidateMIMEType(mimeType string) error
 	ValidateServiceName(name string) error
 	ValidateNginxDuration(duration string) error
 	ValidateNginxSize(size string) error
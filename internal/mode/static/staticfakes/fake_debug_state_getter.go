@@ -0,0 +1,166 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package staticfakes
+
+import (
+	"sync"
+
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/internal/mode/static/status"
+)
+
+type FakeDebugStateGetter struct {
+	GetLatestConfigurationStub        func() *dataplane.Configuration
+	getLatestConfigurationMutex       sync.RWMutex
+	getLatestConfigurationArgsForCall []struct {
+	}
+	getLatestConfigurationReturns struct {
+		result1 *dataplane.Configuration
+	}
+	getLatestConfigurationReturnsOnCall map[int]struct {
+		result1 *dataplane.Configuration
+	}
+	GetLatestReloadResultStub        func() status.NginxReloadResult
+	getLatestReloadResultMutex       sync.RWMutex
+	getLatestReloadResultArgsForCall []struct {
+	}
+	getLatestReloadResultReturns struct {
+		result1 status.NginxReloadResult
+	}
+	getLatestReloadResultReturnsOnCall map[int]struct {
+		result1 status.NginxReloadResult
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDebugStateGetter) GetLatestConfiguration() *dataplane.Configuration {
+	fake.getLatestConfigurationMutex.Lock()
+	ret, specificReturn := fake.getLatestConfigurationReturnsOnCall[len(fake.getLatestConfigurationArgsForCall)]
+	fake.getLatestConfigurationArgsForCall = append(fake.getLatestConfigurationArgsForCall, struct {
+	}{})
+	stub := fake.GetLatestConfigurationStub
+	fakeReturns := fake.getLatestConfigurationReturns
+	fake.recordInvocation("GetLatestConfiguration", []interface{}{})
+	fake.getLatestConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDebugStateGetter) GetLatestConfigurationCallCount() int {
+	fake.getLatestConfigurationMutex.RLock()
+	defer fake.getLatestConfigurationMutex.RUnlock()
+	return len(fake.getLatestConfigurationArgsForCall)
+}
+
+func (fake *FakeDebugStateGetter) GetLatestConfigurationCalls(stub func() *dataplane.Configuration) {
+	fake.getLatestConfigurationMutex.Lock()
+	defer fake.getLatestConfigurationMutex.Unlock()
+	fake.GetLatestConfigurationStub = stub
+}
+
+func (fake *FakeDebugStateGetter) GetLatestConfigurationReturns(result1 *dataplane.Configuration) {
+	fake.getLatestConfigurationMutex.Lock()
+	defer fake.getLatestConfigurationMutex.Unlock()
+	fake.GetLatestConfigurationStub = nil
+	fake.getLatestConfigurationReturns = struct {
+		result1 *dataplane.Configuration
+	}{result1}
+}
+
+func (fake *FakeDebugStateGetter) GetLatestConfigurationReturnsOnCall(i int, result1 *dataplane.Configuration) {
+	fake.getLatestConfigurationMutex.Lock()
+	defer fake.getLatestConfigurationMutex.Unlock()
+	fake.GetLatestConfigurationStub = nil
+	if fake.getLatestConfigurationReturnsOnCall == nil {
+		fake.getLatestConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *dataplane.Configuration
+		})
+	}
+	fake.getLatestConfigurationReturnsOnCall[i] = struct {
+		result1 *dataplane.Configuration
+	}{result1}
+}
+
+func (fake *FakeDebugStateGetter) GetLatestReloadResult() status.NginxReloadResult {
+	fake.getLatestReloadResultMutex.Lock()
+	ret, specificReturn := fake.getLatestReloadResultReturnsOnCall[len(fake.getLatestReloadResultArgsForCall)]
+	fake.getLatestReloadResultArgsForCall = append(fake.getLatestReloadResultArgsForCall, struct {
+	}{})
+	stub := fake.GetLatestReloadResultStub
+	fakeReturns := fake.getLatestReloadResultReturns
+	fake.recordInvocation("GetLatestReloadResult", []interface{}{})
+	fake.getLatestReloadResultMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDebugStateGetter) GetLatestReloadResultCallCount() int {
+	fake.getLatestReloadResultMutex.RLock()
+	defer fake.getLatestReloadResultMutex.RUnlock()
+	return len(fake.getLatestReloadResultArgsForCall)
+}
+
+func (fake *FakeDebugStateGetter) GetLatestReloadResultCalls(stub func() status.NginxReloadResult) {
+	fake.getLatestReloadResultMutex.Lock()
+	defer fake.getLatestReloadResultMutex.Unlock()
+	fake.GetLatestReloadResultStub = stub
+}
+
+func (fake *FakeDebugStateGetter) GetLatestReloadResultReturns(result1 status.NginxReloadResult) {
+	fake.getLatestReloadResultMutex.Lock()
+	defer fake.getLatestReloadResultMutex.Unlock()
+	fake.GetLatestReloadResultStub = nil
+	fake.getLatestReloadResultReturns = struct {
+		result1 status.NginxReloadResult
+	}{result1}
+}
+
+func (fake *FakeDebugStateGetter) GetLatestReloadResultReturnsOnCall(i int, result1 status.NginxReloadResult) {
+	fake.getLatestReloadResultMutex.Lock()
+	defer fake.getLatestReloadResultMutex.Unlock()
+	fake.GetLatestReloadResultStub = nil
+	if fake.getLatestReloadResultReturnsOnCall == nil {
+		fake.getLatestReloadResultReturnsOnCall = make(map[int]struct {
+			result1 status.NginxReloadResult
+		})
+	}
+	fake.getLatestReloadResultReturnsOnCall[i] = struct {
+		result1 status.NginxReloadResult
+	}{result1}
+}
+
+func (fake *FakeDebugStateGetter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getLatestConfigurationMutex.RLock()
+	defer fake.getLatestConfigurationMutex.RUnlock()
+	fake.getLatestReloadResultMutex.RLock()
+	defer fake.getLatestReloadResultMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDebugStateGetter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
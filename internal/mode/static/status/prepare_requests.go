@@ -2,6 +2,8 @@ package status
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -210,30 +212,67 @@ func PrepareGatewayClassRequests(
 
 // PrepareGatewayRequests prepares status UpdateRequests for the given Gateways.
 func PrepareGatewayRequests(
-	gateway *graph.Gateway,
-	ignoredGateways map[types.NamespacedName]*v1.Gateway,
+	gateways map[types.NamespacedName]*graph.Gateway,
 	transitionTime metav1.Time,
 	gwAddresses []v1.GatewayStatusAddress,
 	nginxReloadRes NginxReloadResult,
 ) []frameworkStatus.UpdateRequest {
-	reqs := make([]frameworkStatus.UpdateRequest, 0, 1+len(ignoredGateways))
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(gateways))
 
-	if gateway != nil {
-		reqs = append(reqs, prepareGatewayRequest(gateway, transitionTime, gwAddresses, nginxReloadRes))
+	for _, gw := range gateways {
+		reqs = append(reqs, prepareGatewayRequest(gw, transitionTime, gwAddresses, nginxReloadRes))
 	}
 
-	for nsname, gw := range ignoredGateways {
-		apiConds := conditions.ConvertConditions(staticConds.NewGatewayConflict(), gw.Generation, transitionTime)
-		reqs = append(reqs, frameworkStatus.UpdateRequest{
-			NsName:       nsname,
-			ResourceType: &v1.Gateway{},
-			Setter: newGatewayStatusSetter(v1.GatewayStatus{
-				Conditions: apiConds,
-			}),
-		})
+	return reqs
+}
+
+// newHTTPSRedirectCondition returns the condition that reports which hostnames are redirected to HTTPS.
+func newHTTPSRedirectCondition(redirect *graph.HTTPSRedirect) conditions.Condition {
+	if len(redirect.Redirects) == 0 {
+		return staticConds.NewGatewayHTTPSRedirectNoHostnames()
 	}
 
-	return reqs
+	hostnames := make([]string, 0, len(redirect.Redirects))
+	for _, r := range redirect.Redirects {
+		hostname := r.Hostname
+		// the match-all nginx server name is not meaningful to users
+		if hostname == "~^" {
+			hostname = "*"
+		}
+
+		hostnames = append(hostnames, fmt.Sprintf("%s:%d", hostname, r.HTTPPort))
+	}
+
+	return staticConds.NewGatewayHTTPSRedirectConfigured(hostnames)
+}
+
+// newDefaultServerCondition returns the condition that reports the ports of the default servers
+// and any default server backends that don't exist.
+func newDefaultServerCondition(defaultServers []graph.DefaultServer) conditions.Condition {
+	ports := make([]string, 0, len(defaultServers))
+	var invalidBackends []string
+
+	for _, ds := range defaultServers {
+		ports = append(ports, strconv.Itoa(int(ds.Port)))
+
+		if ds.BackendRef != nil && !ds.BackendRef.Valid {
+			invalidBackends = append(
+				invalidBackends,
+				fmt.Sprintf("%s:%d (port %d)", ds.BackendRef.SvcNsName, ds.Action.BackendRef.Port, ds.Port),
+			)
+		}
+	}
+
+	if len(invalidBackends) > 0 {
+		msg := fmt.Sprintf(
+			"The default server Service or Service port does not exist: %s",
+			strings.Join(invalidBackends, ", "),
+		)
+
+		return staticConds.NewGatewayDefaultServerBackendNotFound(msg)
+	}
+
+	return staticConds.NewGatewayDefaultServerConfigured(ports)
 }
 
 func prepareGatewayRequest(
@@ -306,6 +345,16 @@ func prepareGatewayRequest(
 		)
 	}
 
+	if gateway.HTTPSRedirect != nil {
+		gwConds = append(gwConds, newHTTPSRedirectCondition(gateway.HTTPSRedirect))
+	}
+
+	if len(gateway.DefaultServers) > 0 {
+		gwConds = append(gwConds, newDefaultServerCondition(gateway.DefaultServers))
+	}
+
+	gwConds = append(gwConds, gateway.BackendTLSConditions...)
+
 	apiGwConds := conditions.ConvertConditions(
 		conditions.DeduplicateConditions(gwConds),
 		gateway.Source.Generation,
@@ -369,6 +418,52 @@ func PrepareNGFPolicyRequests(
 	return reqs
 }
 
+// PrepareBackendLBPolicyRequests prepares status UpdateRequests for the given BackendLBPolicies.
+// The ancestors of a BackendLBPolicy are reported the same way as the ancestors of NGF Policies.
+func PrepareBackendLBPolicyRequests(
+	policies map[types.NamespacedName]*graph.BackendLBPolicy,
+	transitionTime metav1.Time,
+	gatewayCtlrName string,
+) []frameworkStatus.UpdateRequest {
+	reqs := make([]frameworkStatus.UpdateRequest, 0, len(policies))
+
+	for nsname, pol := range policies {
+		if len(pol.Ancestors) == 0 {
+			continue
+		}
+
+		ancestorStatuses := make([]v1alpha2.PolicyAncestorStatus, 0, len(pol.Ancestors))
+
+		for _, ancestor := range pol.Ancestors {
+			allConds := make([]conditions.Condition, 0, len(pol.Conditions)+len(ancestor.Conditions)+1)
+
+			// The order of conditions matters here. See PrepareNGFPolicyRequests.
+			allConds = append(allConds, staticConds.NewPolicyAccepted())
+			allConds = append(allConds, ancestor.Conditions...)
+			allConds = append(allConds, pol.Conditions...)
+
+			conds := conditions.DeduplicateConditions(allConds)
+			apiConds := conditions.ConvertConditions(conds, pol.Source.Generation, transitionTime)
+
+			ancestorStatuses = append(ancestorStatuses, v1alpha2.PolicyAncestorStatus{
+				AncestorRef:    ancestor.Ancestor,
+				ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				Conditions:     apiConds,
+			})
+		}
+
+		status := v1alpha2.PolicyStatus{Ancestors: ancestorStatuses}
+
+		reqs = append(reqs, frameworkStatus.UpdateRequest{
+			NsName:       nsname,
+			ResourceType: &v1alpha2.BackendLBPolicy{},
+			Setter:       newBackendLBPolicyStatusSetter(status, gatewayCtlrName),
+		})
+	}
+
+	return reqs
+}
+
 // PrepareBackendTLSPolicyRequests prepares status UpdateRequests for the given BackendTLSPolicies.
 func PrepareBackendTLSPolicyRequests(
 	policies map[types.NamespacedName]*graph.BackendTLSPolicy,
@@ -386,18 +481,20 @@ func PrepareBackendTLSPolicyRequests(
 		apiConds := conditions.ConvertConditions(conds, pol.Source.Generation, transitionTime)
 
 		status := v1alpha2.PolicyStatus{
-			Ancestors: []v1alpha2.PolicyAncestorStatus{
-				{
-					AncestorRef: v1.ParentReference{
-						Namespace: (*v1.Namespace)(&pol.Gateway.Namespace),
-						Name:      v1alpha2.ObjectName(pol.Gateway.Name),
-						Group:     helpers.GetPointer[v1.Group](v1.GroupName),
-						Kind:      helpers.GetPointer[v1.Kind](kinds.Gateway),
-					},
-					ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
-					Conditions:     apiConds,
+			Ancestors: make([]v1alpha2.PolicyAncestorStatus, 0, len(pol.Gateways)),
+		}
+
+		for _, gwNsName := range pol.Gateways {
+			status.Ancestors = append(status.Ancestors, v1alpha2.PolicyAncestorStatus{
+				AncestorRef: v1.ParentReference{
+					Namespace: helpers.GetPointer(v1.Namespace(gwNsName.Namespace)),
+					Name:      v1alpha2.ObjectName(gwNsName.Name),
+					Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+					Kind:      helpers.GetPointer[v1.Kind](kinds.Gateway),
 				},
-			},
+				ControllerName: v1alpha2.GatewayController(gatewayCtlrName),
+				Conditions:     apiConds,
+			})
 		}
 
 		reqs = append(reqs, frameworkStatus.UpdateRequest{
@@ -740,79 +740,262 @@ func TestBuildGatewayStatuses(t *testing.T) {
 	routeKey := graph.RouteKey{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}
 
 	tests := []struct {
-		nginxReloadRes  NginxReloadResult
-		gateway         *graph.Gateway
-		ignoredGateways map[types.NamespacedName]*v1.Gateway
-		expected        map[types.NamespacedName]v1.GatewayStatus
-		name            string
+		nginxReloadRes NginxReloadResult
+		gateway        *graph.Gateway
+		otherGateway   *graph.Gateway
+		expected       map[types.NamespacedName]v1.GatewayStatus
+		name           string
 	}{
 		{
-			name:     "nil gateway and no ignored gateways",
+			name:     "no gateways",
 			expected: map[types.NamespacedName]v1.GatewayStatus{},
 		},
 		{
-			name: "nil gateway and ignored gateways",
-			ignoredGateways: map[types.NamespacedName]*v1.Gateway{
-				{Namespace: "test", Name: "ignored-1"}: {
-					ObjectMeta: metav1.ObjectMeta{
-						Name:       "ignored-1",
-						Namespace:  "test",
-						Generation: 1,
+			name: "multiple gateways",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
 					},
 				},
-				{Namespace: "test", Name: "ignored-2"}: {
+				Valid: true,
+			},
+			otherGateway: &graph.Gateway{
+				Source: &v1.Gateway{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:       "ignored-2",
 						Namespace:  "test",
-						Generation: 2,
+						Name:       "other-gateway",
+						Generation: 1,
 					},
 				},
+				Conditions: staticConds.NewGatewayUnsupportedValue("unsupported value"),
 			},
 			expected: map[types.NamespacedName]v1.GatewayStatus{
-				{Namespace: "test", Name: "ignored-1"}: {
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+				{Namespace: "test", Name: "other-gateway"}: {
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(v1.GatewayConditionAccepted),
 							Status:             metav1.ConditionFalse,
 							ObservedGeneration: 1,
 							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Reason:             string(staticConds.GatewayReasonUnsupportedValue),
+							Message:            "unsupported value",
 						},
 						{
 							Type:               string(v1.GatewayConditionProgrammed),
 							Status:             metav1.ConditionFalse,
 							ObservedGeneration: 1,
 							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Reason:             string(staticConds.GatewayReasonUnsupportedValue),
+							Message:            "unsupported value",
 						},
 					},
 				},
-				{Namespace: "test", Name: "ignored-2"}: {
+			},
+		},
+		{
+			name: "valid gateway; all valid listeners",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+					{
+						Name:   "listener-valid-2",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
 					Conditions: []metav1.Condition{
 						{
 							Type:               string(v1.GatewayConditionAccepted),
-							Status:             metav1.ConditionFalse,
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+						{
+							Name:           "listener-valid-2",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; https redirects configured",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				HTTPSRedirect: &graph.HTTPSRedirect{
+					Redirects: []graph.HTTPSRedirectHost{
+						{Hostname: "cafe.example.com", HTTPPort: 80, HTTPSPort: 443},
+						{Hostname: "~^", HTTPPort: 8080, HTTPSPort: 443},
+					},
+					StatusCode: 301,
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+						{
+							Type:               string(staticConds.GatewayConditionHTTPSRedirect),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(staticConds.GatewayReasonHTTPSRedirectConfigured),
+							Message: "HTTP requests are redirected to HTTPS for hostnames: " +
+								"cafe.example.com:80, *:8080",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; https redirects enabled but no hostnames covered",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				HTTPSRedirect: &graph.HTTPSRedirect{
+					StatusCode: 301,
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
 							ObservedGeneration: 2,
 							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
 						},
 						{
 							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+						{
+							Type:               string(staticConds.GatewayConditionHTTPSRedirect),
 							Status:             metav1.ConditionFalse,
 							ObservedGeneration: 2,
 							LastTransitionTime: transitionTime,
-							Reason:             string(staticConds.GatewayReasonGatewayConflict),
-							Message:            staticConds.GatewayMessageGatewayConflict,
+							Reason:             string(staticConds.GatewayReasonNoHostnamesCovered),
+							Message: "HTTPS redirects are enabled, but no hostnames are redirected; " +
+								"HTTPS Listeners have no Routes or HTTP Routes already serve all hostnames",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "valid gateway; all valid listeners",
+			name: "valid gateway; backend client certificate ref not permitted",
 			gateway: &graph.Gateway{
 				Source: createGateway(),
 				Listeners: []*graph.Listener{
@@ -821,12 +1004,82 @@ func TestBuildGatewayStatuses(t *testing.T) {
 						Valid:  true,
 						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
 					},
+				},
+				BackendTLSConditions: []conditions.Condition{
+					staticConds.NewGatewayBackendTLSRefNotPermitted("ref not permitted"),
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+						{
+							Type:               string(staticConds.GatewayConditionBackendTLS),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.ListenerReasonRefNotPermitted),
+							Message:            "ref not permitted",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
+							AttachedRoutes: 1,
+							Conditions:     validListenerConditions,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; default servers configured",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
 					{
-						Name:   "listener-valid-2",
+						Name:   "listener-valid-1",
 						Valid:  true,
 						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
 					},
 				},
+				DefaultServers: []graph.DefaultServer{
+					{
+						Action: ngfAPI.DefaultServerAction{
+							Return: &ngfAPI.DefaultServerReturn{StatusCode: 418},
+						},
+						ListenerName: "listener-valid-1",
+						Port:         80,
+					},
+					{
+						Action: ngfAPI.DefaultServerAction{
+							BackendRef: &ngfAPI.DefaultServerBackendRef{Namespace: "test", Name: "svc", Port: 80},
+						},
+						BackendRef: &graph.BackendRef{
+							SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc"},
+							Valid:     true,
+						},
+						ListenerName: "listener-valid-1",
+						Port:         443,
+					},
+				},
 				Valid: true,
 			},
 			expected: map[types.NamespacedName]v1.GatewayStatus{
@@ -849,6 +1102,14 @@ func TestBuildGatewayStatuses(t *testing.T) {
 							Reason:             string(v1.GatewayReasonProgrammed),
 							Message:            "Gateway is programmed",
 						},
+						{
+							Type:               string(staticConds.GatewayConditionDefaultServer),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(staticConds.GatewayReasonDefaultServerConfigured),
+							Message:            "Unmatched requests are handled by the default server on ports: 80, 443",
+						},
 					},
 					Listeners: []v1.ListenerStatus{
 						{
@@ -856,8 +1117,74 @@ func TestBuildGatewayStatuses(t *testing.T) {
 							AttachedRoutes: 1,
 							Conditions:     validListenerConditions,
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid gateway; default server backend not found",
+			gateway: &graph.Gateway{
+				Source: createGateway(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid-1",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+				DefaultServers: []graph.DefaultServer{
+					{
+						Action: ngfAPI.DefaultServerAction{
+							Return: &ngfAPI.DefaultServerReturn{StatusCode: 418},
+						},
+						ListenerName: "listener-valid-1",
+						Port:         80,
+					},
+					{
+						Action: ngfAPI.DefaultServerAction{
+							BackendRef: &ngfAPI.DefaultServerBackendRef{Namespace: "test", Name: "svc", Port: 8080},
+						},
+						BackendRef: &graph.BackendRef{
+							SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc"},
+						},
+						ListenerName: "listener-valid-1",
+						Port:         443,
+					},
+				},
+				Valid: true,
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
 						{
-							Name:           "listener-valid-2",
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "Gateway is programmed",
+						},
+						{
+							Type:               string(staticConds.GatewayConditionDefaultServer),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(staticConds.GatewayReasonDefaultServerBackendNotFound),
+							Message:            "The default server Service or Service port does not exist: test/svc:8080 (port 443)",
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid-1",
 							AttachedRoutes: 1,
 							Conditions:     validListenerConditions,
 						},
@@ -1143,32 +1470,30 @@ func TestBuildGatewayStatuses(t *testing.T) {
 
 			k8sClient := createK8sClientFor(&v1.Gateway{})
 
-			var expectedTotalReqs int
+			gateways := make(map[types.NamespacedName]*graph.Gateway)
 
-			if test.gateway != nil {
-				test.gateway.Source.ResourceVersion = ""
-				err := k8sClient.Create(context.Background(), test.gateway.Source)
-				g.Expect(err).ToNot(HaveOccurred())
-				expectedTotalReqs++
-			}
+			for _, gw := range []*graph.Gateway{test.gateway, test.otherGateway} {
+				if gw == nil {
+					continue
+				}
 
-			for _, gw := range test.ignoredGateways {
-				err := k8sClient.Create(context.Background(), gw)
+				gw.Source.ResourceVersion = ""
+				err := k8sClient.Create(context.Background(), gw.Source)
 				g.Expect(err).ToNot(HaveOccurred())
-				expectedTotalReqs++
+
+				gateways[client.ObjectKeyFromObject(gw.Source)] = gw
 			}
 
 			updater := statusFramework.NewUpdater(k8sClient, zap.New())
 
 			reqs := PrepareGatewayRequests(
-				test.gateway,
-				test.ignoredGateways,
+				gateways,
 				transitionTime,
 				addr,
 				test.nginxReloadRes,
 			)
 
-			g.Expect(reqs).To(HaveLen(expectedTotalReqs))
+			g.Expect(reqs).To(HaveLen(len(gateways)))
 
 			updater.Update(context.Background(), reqs...)
 
@@ -1210,7 +1535,7 @@ func TestBuildBackendTLSPolicyStatuses(t *testing.T) {
 			Ignored:      policyCfg.Ignored,
 			IsReferenced: policyCfg.IsReferenced,
 			Conditions:   policyCfg.Conditions,
-			Gateway:      types.NamespacedName{Name: "gateway", Namespace: "test"},
+			Gateways:     []types.NamespacedName{{Name: "gateway", Namespace: "test"}},
 		}
 	}
 
@@ -1783,6 +2108,131 @@ func TestBuildNGFPolicyStatuses(t *testing.T) {
 	}
 }
 
+func TestBuildBackendLBPolicyStatuses(t *testing.T) {
+	t.Parallel()
+
+	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
+
+	getPolicy := func(
+		name string,
+		conds []conditions.Condition,
+		ancestors []graph.PolicyAncestor,
+	) *graph.BackendLBPolicy {
+		return &graph.BackendLBPolicy{
+			Source: &v1alpha2.BackendLBPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       name,
+					Namespace:  "test",
+					Generation: 2,
+				},
+			},
+			Conditions: conds,
+			Ancestors:  ancestors,
+		}
+	}
+
+	gwAncestor := v1.ParentReference{Name: "gateway"}
+	invalidGwAncestor := v1.ParentReference{Name: "invalid-gateway"}
+
+	policies := map[types.NamespacedName]*graph.BackendLBPolicy{
+		{Namespace: "test", Name: "valid"}: getPolicy(
+			"valid",
+			nil,
+			[]graph.PolicyAncestor{
+				{Ancestor: gwAncestor},
+				{
+					Ancestor:   invalidGwAncestor,
+					Conditions: []conditions.Condition{staticConds.NewPolicyTargetNotFound("Parent Gateway is invalid")},
+				},
+			},
+		),
+		{Namespace: "test", Name: "conflicted"}: getPolicy(
+			"conflicted",
+			[]conditions.Condition{staticConds.NewPolicyConflicted("Conflicts with another BackendLBPolicy")},
+			[]graph.PolicyAncestor{{Ancestor: gwAncestor}},
+		),
+		{Namespace: "test", Name: "no-ancestors"}: getPolicy("no-ancestors", nil, nil),
+	}
+
+	expected := map[types.NamespacedName]v1alpha2.PolicyStatus{
+		{Namespace: "test", Name: "valid"}: {
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef:    gwAncestor,
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1alpha2.PolicyConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1alpha2.PolicyReasonAccepted),
+							Message:            "Policy is accepted",
+						},
+					},
+				},
+				{
+					AncestorRef:    invalidGwAncestor,
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1alpha2.PolicyConditionAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1alpha2.PolicyReasonTargetNotFound),
+							Message:            "Parent Gateway is invalid",
+						},
+					},
+				},
+			},
+		},
+		{Namespace: "test", Name: "conflicted"}: {
+			Ancestors: []v1alpha2.PolicyAncestorStatus{
+				{
+					AncestorRef:    gwAncestor,
+					ControllerName: gatewayCtlrName,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1alpha2.PolicyConditionAccepted),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1alpha2.PolicyReasonConflicted),
+							Message:            "Conflicts with another BackendLBPolicy",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	k8sClient := createK8sClientFor(&v1alpha2.BackendLBPolicy{})
+
+	for _, pol := range policies {
+		err := k8sClient.Create(context.Background(), pol.Source)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+
+	updater := statusFramework.NewUpdater(k8sClient, zap.New())
+
+	reqs := PrepareBackendLBPolicyRequests(policies, transitionTime, gatewayCtlrName)
+
+	g.Expect(reqs).To(HaveLen(len(expected)))
+
+	updater.Update(context.Background(), reqs...)
+
+	for nsname, exp := range expected {
+		var pol v1alpha2.BackendLBPolicy
+
+		err := k8sClient.Get(context.Background(), nsname, &pol)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(helpers.Diff(exp, pol.Status)).To(BeEmpty())
+	}
+}
+
 func TestBuildSnippetsFilterStatuses(t *testing.T) {
 	transitionTime := helpers.PrepareTimeForFakeClient(metav1.Now())
 	const gatewayCtlrName = "controller"
@@ -221,7 +221,7 @@ func newBackendTLSPolicyStatusSetter(
 
 		// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
 		// ancestor statuses.
-		maxAncestors := 1 + len(btp.Status.Ancestors)
+		maxAncestors := len(status.Ancestors) + len(btp.Status.Ancestors)
 		ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
 
 		// keep all the ancestor statuses that belong to other controllers
@@ -243,6 +243,37 @@ func newBackendTLSPolicyStatusSetter(
 	}
 }
 
+func newBackendLBPolicyStatusSetter(
+	status v1alpha2.PolicyStatus,
+	gatewayCtlrName string,
+) frameworkStatus.Setter {
+	return func(object client.Object) (wasSet bool) {
+		blp := helpers.MustCastObject[*v1alpha2.BackendLBPolicy](object)
+
+		// maxAncestors is the max number of ancestor statuses which is the sum of all new ancestor statuses and all old
+		// ancestor statuses.
+		maxAncestors := len(status.Ancestors) + len(blp.Status.Ancestors)
+		ancestors := make([]v1alpha2.PolicyAncestorStatus, 0, maxAncestors)
+
+		// keep all the ancestor statuses that belong to other controllers
+		for _, as := range blp.Status.Ancestors {
+			if string(as.ControllerName) != gatewayCtlrName {
+				ancestors = append(ancestors, as)
+			}
+		}
+
+		ancestors = append(ancestors, status.Ancestors...)
+		status.Ancestors = ancestors
+
+		if policyStatusEqual(gatewayCtlrName, blp.Status, status) {
+			return false
+		}
+
+		blp.Status = status
+		return true
+	}
+}
+
 func newNGFPolicyStatusSetter(
 	status v1alpha2.PolicyStatus,
 	gatewayCtlrName string,
@@ -272,15 +272,15 @@ var _ = Describe("Collector", Ordered, func() {
 
 				graph := &graph.Graph{
 					GatewayClass: &graph.GatewayClass{},
-					Gateway:      &graph.Gateway{},
+					Gateways: map[types.NamespacedName]*graph.Gateway{
+						{Name: "gw1"}: {},
+						{Name: "gw2"}: {},
+						{Name: "gw3"}: {},
+					},
 					IgnoredGatewayClasses: map[types.NamespacedName]*gatewayv1.GatewayClass{
 						{Name: "ignoredGC1"}: {},
 						{Name: "ignoredGC2"}: {},
 					},
-					IgnoredGateways: map[types.NamespacedName]*gatewayv1.Gateway{
-						{Name: "ignoredGw1"}: {},
-						{Name: "ignoredGw2"}: {},
-					},
 					Routes: map[graph.RouteKey]*graph.L7Route{
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
 						{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-2"}}: {RouteType: graph.RouteTypeHTTP},
@@ -576,7 +576,9 @@ var _ = Describe("Collector", Ordered, func() {
 
 			graph1 = &graph.Graph{
 				GatewayClass: &graph.GatewayClass{},
-				Gateway:      &graph.Gateway{},
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Name: "gw1"}: {},
+				},
 				Routes: map[graph.RouteKey]*graph.L7Route{
 					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {RouteType: graph.RouteTypeHTTP},
 				},